@@ -1,30 +1,40 @@
 package chainwrappers
 
 import (
-	aptoslib "github.com/aptos-labs/aptos-go-sdk"
-	sol "github.com/gagliardetto/solana-go"
-	solrpc "github.com/gagliardetto/solana-go/rpc"
-	"github.com/xssnick/tonutils-go/ton"
-	tonwallet "github.com/xssnick/tonutils-go/ton/wallet"
-
-	cslclient "github.com/smartcontractkit/chainlink-sui/relayer/client"
-
-	cantonsdk "github.com/smartcontractkit/mcms/sdk/canton"
-	evmsdk "github.com/smartcontractkit/mcms/sdk/evm"
-	suisdk "github.com/smartcontractkit/mcms/sdk/sui"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/aptos"
+	"github.com/smartcontractkit/mcms/sdk/canton"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/solana"
+	"github.com/smartcontractkit/mcms/sdk/stellar"
+	"github.com/smartcontractkit/mcms/sdk/sui"
+	"github.com/smartcontractkit/mcms/sdk/ton"
+	"github.com/smartcontractkit/mcms/sdk/zksync"
 )
 
+// ChainAccessor serves the chains of the families built into MCMS. Importing this package
+// registers these families, see sdk.RegisterFamily.
 type ChainAccessor interface {
 	Selectors() []uint64
-	EVMClient(selector uint64) (evmsdk.ContractDeployBackend, bool)
-	EVMSigner(selector uint64) (*evmsdk.TransactOpts, bool)
-	SolanaClient(selector uint64) (*solrpc.Client, bool)
-	SolanaSigner(selector uint64) (*sol.PrivateKey, bool)
-	AptosClient(selector uint64) (aptoslib.AptosRpcClient, bool)
-	AptosSigner(selector uint64) (aptoslib.TransactionSigner, bool)
-	SuiClient(selector uint64) (cslclient.BindingsClient, bool)
-	SuiSigner(selector uint64) (suisdk.SuiSigner, bool)
-	TonClient(selector uint64) (ton.APIClientWrapped, bool)
-	TonSigner(selector uint64) (*tonwallet.Wallet, bool)
-	CantonChain(selector uint64) (cantonsdk.Chain, bool)
+	evm.ChainAccessor
+	solana.ChainAccessor
+	aptos.ChainAccessor
+	sui.ChainAccessor
+	ton.ChainAccessor
+	canton.ChainAccessor
 }
+
+// StellarChainAccessor is optionally implemented by a ChainAccessor to serve Stellar chains. The
+// Stellar hooks type-assert the ChainAccessor and report a missing client or signer otherwise.
+type StellarChainAccessor = stellar.ChainAccessor
+
+// ZkSyncChainAccessor is optionally implemented by a ChainAccessor to serve zkSync Era chains.
+type ZkSyncChainAccessor = zksync.ChainAccessor
+
+// SuiDevInspectorAccessor is optionally implemented by a ChainAccessor to serve the DevInspector
+// of a Sui chain, with which the simulators simulate operations without their merkle proof.
+type SuiDevInspectorAccessor = sui.DevInspectorAccessor
+
+// FamilyChainAccessor is optionally implemented by a ChainAccessor to serve the chains of
+// families registered with sdk.RegisterFamily that have no dedicated ChainAccessor method.
+type FamilyChainAccessor = sdk.FamilyChainAccessor
@@ -1,18 +1,9 @@
 package chainwrappers
 
 import (
-	"encoding/json"
 	"fmt"
 
-	chainsel "github.com/smartcontractkit/chain-selectors"
-
 	"github.com/smartcontractkit/mcms/sdk"
-	"github.com/smartcontractkit/mcms/sdk/aptos"
-	"github.com/smartcontractkit/mcms/sdk/canton"
-	"github.com/smartcontractkit/mcms/sdk/evm"
-	"github.com/smartcontractkit/mcms/sdk/solana"
-	"github.com/smartcontractkit/mcms/sdk/sui"
-	"github.com/smartcontractkit/mcms/sdk/ton"
 	"github.com/smartcontractkit/mcms/types"
 )
 
@@ -31,49 +22,17 @@ func BuildConverters(chainMetadata map[types.ChainSelector]types.ChainMetadata)
 	return converters, nil
 }
 
+// BuildConverter constructs the timelock converter of the chain family of the selector.
 func BuildConverter(selector types.ChainSelector, metadata types.ChainMetadata) (sdk.TimelockConverter, error) {
 	fam, err := types.GetChainSelectorFamily(selector)
 	if err != nil {
 		return nil, fmt.Errorf("error getting chain family: %w", err)
 	}
 
-	var converter sdk.TimelockConverter
-	switch fam {
-	case chainsel.FamilyEVM:
-		converter = evm.NewTimelockConverter()
-	case chainsel.FamilySolana:
-		converter = solana.NewTimelockConverter()
-	case chainsel.FamilyAptos:
-		converter, err = buildAptosTimelockConverter(metadata)
-		if err != nil {
-			return nil, fmt.Errorf("error creating Aptos converter for selector %d: %w", selector, err)
-		}
-	case chainsel.FamilySui:
-		converter, _ = sui.NewTimelockConverter()
-	case chainsel.FamilyTon:
-		converter = ton.NewTimelockConverter(ton.DefaultSendAmount)
-	case chainsel.FamilyCanton:
-		converter = canton.NewTimelockConverter()
-	default:
+	family, ok := sdk.LookupFamily(fam)
+	if !ok || family.NewTimelockConverter == nil {
 		return nil, fmt.Errorf("unsupported chain family %s", fam)
 	}
 
-	return converter, nil
-}
-
-func buildAptosTimelockConverter(metadata types.ChainMetadata) (sdk.TimelockConverter, error) {
-	if len(metadata.AdditionalFields) == 0 {
-		return aptos.NewTimelockConverter(), nil
-	}
-
-	var af aptos.AdditionalFieldsMetadata
-	if err := json.Unmarshal(metadata.AdditionalFields, &af); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal Aptos additional fields: %w", err)
-	}
-
-	if af.MCMSType.IsCurseMCMS() {
-		return aptos.NewCurseTimelockConverter(), nil
-	}
-
-	return aptos.NewTimelockConverter(), nil
+	return family.NewTimelockConverter(selector, metadata)
 }
@@ -0,0 +1,48 @@
+package chainwrappers
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// BuildEventFilterers builds a map of EventFilterers for the given chain metadata and chain
+// clients. The action selects the MCMS role on families filtering the events of a single role.
+func BuildEventFilterers(
+	chains ChainAccessor,
+	chainMetadata map[types.ChainSelector]types.ChainMetadata,
+	action types.TimelockAction,
+) (map[types.ChainSelector]sdk.EventFilterer, error) {
+	filterers := map[types.ChainSelector]sdk.EventFilterer{}
+	for chainSelector, metadata := range chainMetadata {
+		filterer, err := BuildEventFilterer(chains, chainSelector, action, metadata)
+		if err != nil {
+			return nil, err
+		}
+		filterers[chainSelector] = filterer
+	}
+
+	return filterers, nil
+}
+
+// BuildEventFilterer constructs a chain-family-specific EventFilterer from ChainAccessor plus
+// metadata.
+func BuildEventFilterer(
+	chains ChainAccessor,
+	chainSelector types.ChainSelector,
+	action types.TimelockAction,
+	metadata types.ChainMetadata,
+) (sdk.EventFilterer, error) {
+	family, err := types.GetChainSelectorFamily(chainSelector)
+	if err != nil {
+		return nil, fmt.Errorf("chain family: %w", err)
+	}
+
+	f, ok := sdk.LookupFamily(family)
+	if !ok || f.NewEventFilterer == nil {
+		return nil, fmt.Errorf("unsupported chain family %q", family)
+	}
+
+	return f.NewEventFilterer(chains, chainSelector, action, metadata)
+}
@@ -0,0 +1,219 @@
+package chainwrappers
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	apiv2 "github.com/digital-asset/dazl-client/v8/go/api/com/daml/ledger/api/v2"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/chainwrappers/mocks"
+	"github.com/smartcontractkit/mcms/sdk/aptos"
+	cantonsdk "github.com/smartcontractkit/mcms/sdk/canton"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	solanasdk "github.com/smartcontractkit/mcms/sdk/solana"
+	"github.com/smartcontractkit/mcms/sdk/sui"
+	suimocks "github.com/smartcontractkit/mcms/sdk/sui/mocks/sui"
+	tonsdk "github.com/smartcontractkit/mcms/sdk/ton"
+	zkmocks "github.com/smartcontractkit/mcms/sdk/zksync/mocks"
+	mcmsTypes "github.com/smartcontractkit/mcms/types"
+)
+
+func TestBuildEventFilterers(t *testing.T) {
+	t.Parallel()
+
+	noSetup := func(*testing.T, *mocks.ChainAccessor) {}
+	suiMetadata := mcmsTypes.ChainMetadata{
+		MCMAddress: "0xsui",
+		AdditionalFields: []byte(`{
+			"role":0,
+			"mcms_package_id":"0x123456789abcdef",
+			"account_obj":"0xaccount123",
+			"registry_obj":"0xregistry456",
+			"timelock_obj":"0xtimelock789",
+			"deployer_state_obj":"0xdeployer"
+		}`),
+	}
+	cantonChain := cantonsdk.Chain{Participants: []cantonsdk.Participant{{
+		PartyID:        "party::test",
+		LedgerServices: cantonsdk.LedgerServices{Update: apiv2.NewUpdateServiceClient(nil)},
+	}}}
+
+	tests := []struct {
+		name          string
+		chainMetadata map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata
+		setup         func(t *testing.T, access *mocks.ChainAccessor)
+		setupZkSync   func(t *testing.T, access *mocks.ZkSyncChainAccessor)
+		wantTypes     map[mcmsTypes.ChainSelector]any
+		wantErr       string
+	}{
+		{
+			name:          "empty input",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{},
+			setup:         noSetup,
+			wantTypes:     map[mcmsTypes.ChainSelector]any{},
+		},
+		{
+			name:  "unknown chain family",
+			setup: noSetup,
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				1: {MCMAddress: "0xabc"},
+			},
+			wantErr: "chain family: chain family not found for selector 1",
+		},
+		{
+			name:  "unsupported chain family",
+			setup: noSetup,
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.STELLAR_TESTNET.Selector): {MCMAddress: "0xstellar"},
+			},
+			wantErr: `unsupported chain family "stellar"`,
+		},
+		{
+			name: "missing evm client",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector): {MCMAddress: "0xevm"},
+			},
+			setup: func(t *testing.T, access *mocks.ChainAccessor) {
+				t.Helper()
+				access.EXPECT().EVMClient(mock.Anything).Return(nil, false)
+			},
+			wantErr: "missing EVM chain client",
+		},
+		{
+			name: "zksync client without log filtering",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector): {MCMAddress: "0xzksync"},
+			},
+			setupZkSync: func(t *testing.T, access *mocks.ZkSyncChainAccessor) {
+				t.Helper()
+				access.EXPECT().ZkSyncClient(mock.Anything).Return(zkmocks.NewClient(t), true)
+			},
+			wantErr: "does not support log filtering",
+		},
+		{
+			name: "missing solana client",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.SOLANA_DEVNET.Selector): {MCMAddress: "0xsolana"},
+			},
+			setup: func(t *testing.T, access *mocks.ChainAccessor) {
+				t.Helper()
+				access.EXPECT().SolanaClient(mock.Anything).Return(nil, false)
+			},
+			wantErr: "missing Solana chain client",
+		},
+		{
+			name: "aptos invalid metadata",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.APTOS_TESTNET.Selector): {
+					MCMAddress:       "0xaptos",
+					AdditionalFields: json.RawMessage("{"),
+				},
+			},
+			setup: func(t *testing.T, access *mocks.ChainAccessor) {
+				t.Helper()
+				access.EXPECT().AptosClient(mock.Anything).Return(nil, true)
+			},
+			wantErr: "error parsing aptos metadata",
+		},
+		{
+			name: "sui client without checkpoint queries",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.SUI_TESTNET.Selector): suiMetadata,
+			},
+			setup: func(t *testing.T, access *mocks.ChainAccessor) {
+				t.Helper()
+				access.EXPECT().SuiClient(mock.Anything).Return(suimocks.NewBindingsClient(t), true)
+			},
+			wantErr: "does not support checkpoint queries",
+		},
+		{
+			name: "missing ton client",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.TON_TESTNET.Selector): {MCMAddress: "0xton"},
+			},
+			setup: func(t *testing.T, access *mocks.ChainAccessor) {
+				t.Helper()
+				access.EXPECT().TonClient(mock.Anything).Return(nil, false)
+			},
+			wantErr: "missing Ton chain client",
+		},
+		{
+			name: "missing canton update client",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.CANTON_TESTNET.Selector): {MCMAddress: "0xcanton"},
+			},
+			setup: func(t *testing.T, access *mocks.ChainAccessor) {
+				t.Helper()
+				access.EXPECT().CantonChain(mock.Anything).
+					Return(cantonsdk.Chain{Participants: []cantonsdk.Participant{{PartyID: "party::test"}}}, true)
+			},
+			wantErr: "missing Canton update client",
+		},
+		{
+			name: "all supported families",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector): {MCMAddress: "0xevm"},
+				mcmsTypes.ChainSelector(chainsel.SOLANA_DEVNET.Selector):            {MCMAddress: "0xsolana"},
+				mcmsTypes.ChainSelector(chainsel.APTOS_TESTNET.Selector): {
+					MCMAddress: "0xaptos",
+					AdditionalFields: json.RawMessage(
+						fmt.Sprintf(`{"role":%d,"mcmsType":"%s"}`, aptos.TimelockRoleProposer, aptos.MCMSTypeCurse)),
+				},
+				mcmsTypes.ChainSelector(chainsel.TON_TESTNET.Selector):    {MCMAddress: "0xton"},
+				mcmsTypes.ChainSelector(chainsel.SUI_TESTNET.Selector):    suiMetadata,
+				mcmsTypes.ChainSelector(chainsel.CANTON_TESTNET.Selector): {MCMAddress: "0xcanton"},
+			},
+			setup: func(t *testing.T, access *mocks.ChainAccessor) {
+				t.Helper()
+
+				access.EXPECT().EVMClient(mock.Anything).Return(nil, true)
+				access.EXPECT().SolanaClient(mock.Anything).Return(nil, true)
+				access.EXPECT().AptosClient(mock.Anything).Return(nil, true)
+				access.EXPECT().TonClient(mock.Anything).Return(nil, true)
+				access.EXPECT().SuiClient(mock.Anything).Return(suimocks.NewSuiPTBClient(t), true)
+				access.EXPECT().CantonChain(mock.Anything).Return(cantonChain, true)
+			},
+			wantTypes: map[mcmsTypes.ChainSelector]any{
+				mcmsTypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector): (*evm.EventFilterer)(nil),
+				mcmsTypes.ChainSelector(chainsel.SOLANA_DEVNET.Selector):            (*solanasdk.EventFilterer)(nil),
+				mcmsTypes.ChainSelector(chainsel.APTOS_TESTNET.Selector):            (*aptos.EventFilterer)(nil),
+				mcmsTypes.ChainSelector(chainsel.TON_TESTNET.Selector):              (*tonsdk.EventFilterer)(nil),
+				mcmsTypes.ChainSelector(chainsel.SUI_TESTNET.Selector):              (*sui.EventFilterer)(nil),
+				mcmsTypes.ChainSelector(chainsel.CANTON_TESTNET.Selector):           (*cantonsdk.EventFilterer)(nil),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			access := mocks.NewChainAccessor(t)
+			if tc.setup != nil {
+				tc.setup(t, access)
+			}
+
+			var chains ChainAccessor = access
+			if tc.setupZkSync != nil {
+				chains = withZkSync(t, access, tc.setupZkSync)
+			}
+			filterers, err := BuildEventFilterers(chains, tc.chainMetadata, mcmsTypes.TimelockActionSchedule)
+
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				require.Len(t, filterers, len(tc.wantTypes))
+				for selector, expectedType := range tc.wantTypes {
+					filterer, ok := filterers[selector]
+					require.True(t, ok)
+					require.IsType(t, expectedType, filterer)
+				}
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}
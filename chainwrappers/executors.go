@@ -1,18 +1,9 @@
 package chainwrappers
 
 import (
-	"encoding/json"
 	"fmt"
 
-	chainsel "github.com/smartcontractkit/chain-selectors"
-
 	"github.com/smartcontractkit/mcms/sdk"
-	"github.com/smartcontractkit/mcms/sdk/aptos"
-	cantonsdk "github.com/smartcontractkit/mcms/sdk/canton"
-	"github.com/smartcontractkit/mcms/sdk/evm"
-	"github.com/smartcontractkit/mcms/sdk/solana"
-	"github.com/smartcontractkit/mcms/sdk/sui"
-	"github.com/smartcontractkit/mcms/sdk/ton"
 	"github.com/smartcontractkit/mcms/types"
 )
 
@@ -51,148 +42,11 @@ func BuildExecutor(
 	if err != nil {
 		return nil, fmt.Errorf("error getting chain family: %w", err)
 	}
-	rawSelector := uint64(chainSelector)
-
-	switch family {
-	case chainsel.FamilyEVM:
-		evmEncoder, ok := encoder.(*evm.Encoder)
-		if !ok {
-			return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
-		}
-		client, ok := chains.EVMClient(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing evm chain client for selector %d", chainSelector)
-		}
-		auth, ok := chains.EVMSigner(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing evm signer for selector %d", rawSelector)
-		}
-
-		evmChainMetadata, err := evm.ParseChainMetadata(metadata)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse EVM chain metadata for selector %d: %w", rawSelector, err)
-		}
-		auth.GasPrice = evmChainMetadata.GasPrice
-		auth.GasLimit = evmChainMetadata.GasLimit
-
-		return evm.NewExecutor(evmEncoder, client, auth), nil
-
-	case chainsel.FamilySolana:
-		solanaEncoder, ok := encoder.(*solana.Encoder)
-		if !ok {
-			return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
-		}
-		client, ok := chains.SolanaClient(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing solana chain client for selector %d", chainSelector)
-		}
-		signer, ok := chains.SolanaSigner(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing solana chain signer for selector %d", chainSelector)
-		}
 
-		return solana.NewExecutor(solanaEncoder, client, *signer), nil
-
-	case chainsel.FamilyAptos:
-		encoder, ok := encoder.(*aptos.Encoder)
-		if !ok {
-			return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
-		}
-		role, err := aptos.AptosRoleFromAction(action)
-		if err != nil {
-			return nil, fmt.Errorf("error getting aptos role from proposal: %w", err)
-		}
-		client, ok := chains.AptosClient(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing aptos chain client for selector %d", chainSelector)
-		}
-		signer, ok := chains.AptosSigner(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing aptos chain signer for selector %d", chainSelector)
-		}
-
-		var mcmsType aptos.MCMSType
-		if len(metadata.AdditionalFields) > 0 {
-			var afm aptos.AdditionalFieldsMetadata
-			if err := json.Unmarshal(metadata.AdditionalFields, &afm); err != nil {
-				return nil, fmt.Errorf("failed to unmarshal aptos additional fields metadata for selector %d: %w", rawSelector, err)
-			}
-			mcmsType = afm.MCMSType
-		}
-
-		return aptos.NewExecutorWithMCMSType(client, signer, encoder, role, mcmsType), nil
-
-	case chainsel.FamilySui:
-		encoder, ok := encoder.(*sui.Encoder)
-		if !ok {
-			return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
-		}
-		client, ok := chains.SuiClient(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing sui chain client for selector %d", chainSelector)
-		}
-		signer, ok := chains.SuiSigner(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing sui chain signer for selector %d", chainSelector)
-		}
-
-		suiMetadata, err := sui.SuiMetadata(metadata)
-		if err != nil {
-			return nil, fmt.Errorf("error getting sui metadata from proposal: %w", err)
-		}
-		entrypointEncoder := sui.NewCCIPEntrypointArgEncoder(suiMetadata.RegistryObj, suiMetadata.DeployerStateObj)
-
-		return sui.NewExecutor(client, signer, encoder, entrypointEncoder, suiMetadata.McmsPackageID, suiMetadata.Role,
-			metadata.MCMAddress, suiMetadata.AccountObj, suiMetadata.RegistryObj, suiMetadata.TimelockObj)
-
-	case chainsel.FamilyTon:
-		tonEncoder, ok := encoder.(*ton.Encoder)
-		if !ok {
-			return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
-		}
-		client, ok := chains.TonClient(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing ton chain client for selector %d", chainSelector)
-		}
-		signer, ok := chains.TonSigner(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing ton client signer for selector %d", chainSelector)
-		}
-
-		return ton.NewExecutor(ton.ExecutorOpts{
-			Encoder: tonEncoder,
-			Client:  client,
-			Wallet:  signer,
-			Amount:  ton.DefaultSendAmount,
-		})
-
-	case chainsel.FamilyCanton:
-		ch, ok := chains.CantonChain(rawSelector)
-		if !ok || len(ch.Participants) == 0 {
-			return nil, fmt.Errorf("missing Canton chain participant for selector %d", rawSelector)
-		}
-		participant := ch.Participants[0]
-		mcmsParties := cantonsdk.MCMSPartiesForChain(ch)
-		cantonEncoder, ok := encoder.(*cantonsdk.Encoder)
-		if !ok {
-			return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
-		}
-		role, err := cantonsdk.CantonRoleFromAction(action)
-		if err != nil {
-			return nil, fmt.Errorf("error getting canton role from proposal: %w", err)
-		}
-		inspector := cantonsdk.NewInspector(participant.LedgerServices.State, mcmsParties, role)
-
-		return cantonsdk.NewExecutor(
-			cantonEncoder,
-			inspector,
-			participant.LedgerServices.Command,
-			participant.PartyID,
-			mcmsParties,
-			role,
-		)
-
-	default:
+	f, ok := sdk.LookupFamily(family)
+	if !ok || f.NewExecutor == nil {
 		return nil, fmt.Errorf("unsupported chain family %s", family)
 	}
+
+	return f.NewExecutor(chains, chainSelector, encoder, action, metadata)
 }
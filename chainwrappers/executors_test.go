@@ -9,6 +9,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/stellar/go-stellar-sdk/keypair"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	tonwallet "github.com/xssnick/tonutils-go/ton/wallet"
@@ -20,6 +21,7 @@ import (
 	cantonsdk "github.com/smartcontractkit/mcms/sdk/canton"
 	"github.com/smartcontractkit/mcms/sdk/evm"
 	"github.com/smartcontractkit/mcms/sdk/solana"
+	"github.com/smartcontractkit/mcms/sdk/stellar"
 	"github.com/smartcontractkit/mcms/sdk/sui"
 	suibindmocks "github.com/smartcontractkit/mcms/sdk/sui/mocks/bindutils"
 	suimocks "github.com/smartcontractkit/mcms/sdk/sui/mocks/sui"
@@ -29,14 +31,51 @@ import (
 )
 
 var (
-	evmSelector    = mcmstypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector)
-	solSelector    = mcmstypes.ChainSelector(chainsel.SOLANA_DEVNET.Selector)
-	aptosSelector  = mcmstypes.ChainSelector(chainsel.APTOS_TESTNET.Selector)
-	suiSelector    = mcmstypes.ChainSelector(chainsel.SUI_TESTNET.Selector)
-	tonSelector    = mcmstypes.ChainSelector(chainsel.TON_TESTNET.Selector)
-	cantonSelector = mcmstypes.ChainSelector(chainsel.CANTON_TESTNET.Selector)
+	evmSelector     = mcmstypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector)
+	solSelector     = mcmstypes.ChainSelector(chainsel.SOLANA_DEVNET.Selector)
+	aptosSelector   = mcmstypes.ChainSelector(chainsel.APTOS_TESTNET.Selector)
+	suiSelector     = mcmstypes.ChainSelector(chainsel.SUI_TESTNET.Selector)
+	tonSelector     = mcmstypes.ChainSelector(chainsel.TON_TESTNET.Selector)
+	cantonSelector  = mcmstypes.ChainSelector(chainsel.CANTON_TESTNET.Selector)
+	stellarSelector = mcmstypes.ChainSelector(chainsel.STELLAR_TESTNET.Selector)
 )
 
+// stellarChainAccessor is a ChainAccessor mock which also serves Stellar chains.
+type stellarChainAccessor struct {
+	*mocks.ChainAccessor
+	*mocks.StellarChainAccessor
+}
+
+// withStellar extends the ChainAccessor mock with a StellarChainAccessor mock prepared by setup,
+// unless setup is nil.
+func withStellar(t *testing.T, access *mocks.ChainAccessor, setup func(*mocks.StellarChainAccessor)) ChainAccessor {
+	t.Helper()
+
+	if setup == nil {
+		return access
+	}
+	stellarAccess := mocks.NewStellarChainAccessor(t)
+	setup(stellarAccess)
+
+	return stellarChainAccessor{ChainAccessor: access, StellarChainAccessor: stellarAccess}
+}
+
+// zkSyncChainAccessor is a ChainAccessor mock which also serves zkSync Era chains.
+type zkSyncChainAccessor struct {
+	*mocks.ChainAccessor
+	*mocks.ZkSyncChainAccessor
+}
+
+// withZkSync extends the ChainAccessor mock with a ZkSyncChainAccessor mock prepared by setup.
+func withZkSync(t *testing.T, access *mocks.ChainAccessor, setup func(*testing.T, *mocks.ZkSyncChainAccessor)) ChainAccessor {
+	t.Helper()
+
+	zkAccess := mocks.NewZkSyncChainAccessor(t)
+	setup(t, zkAccess)
+
+	return zkSyncChainAccessor{ChainAccessor: access, ZkSyncChainAccessor: zkAccess}
+}
+
 func TestBuildExecutors(t *testing.T) {
 	t.Parallel()
 
@@ -74,24 +113,29 @@ func TestBuildExecutors(t *testing.T) {
 	cantonExecutor, err := cantonsdk.NewExecutor(cantonEncoder, cantonInspector,
 		cantonChain.Participants[0].LedgerServices.Command, "party::test", []string{"party::test"}, cantonsdk.TimelockRoleProposer)
 	require.NoError(t, err)
+	stellarSigner := keypair.MustRandom()
+	stellarEncoder := stellar.NewEncoder(stellarSelector, 0, false)
+	stellarExecutor := stellar.NewExecutor(stellarEncoder, nil, stellarSigner)
 
 	tests := []struct {
 		name          string
 		encoders      map[mcmstypes.ChainSelector]mcmssdk.Encoder
 		chainMetadata map[mcmstypes.ChainSelector]mcmstypes.ChainMetadata
 		setup         func(accessor *mocks.ChainAccessor)
+		setupStellar  func(accessor *mocks.StellarChainAccessor)
 		want          map[mcmstypes.ChainSelector]mcmssdk.Executor
 		wantErr       string
 	}{
 		{
 			name: "success",
 			encoders: map[mcmstypes.ChainSelector]mcmssdk.Encoder{
-				evmSelector:    evmEncoder,
-				solSelector:    solEncoder,
-				aptosSelector:  aptosEncoder,
-				suiSelector:    suiEncoder,
-				tonSelector:    tonEncoder,
-				cantonSelector: cantonEncoder,
+				evmSelector:     evmEncoder,
+				solSelector:     solEncoder,
+				aptosSelector:   aptosEncoder,
+				suiSelector:     suiEncoder,
+				tonSelector:     tonEncoder,
+				cantonSelector:  cantonEncoder,
+				stellarSelector: stellarEncoder,
 			},
 			chainMetadata: map[mcmstypes.ChainSelector]mcmstypes.ChainMetadata{
 				mcmstypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector): {
@@ -127,6 +171,10 @@ func TestBuildExecutors(t *testing.T) {
 					MCMAddress:      "0xcanton",
 					StartingOpCount: 0,
 				},
+				mcmstypes.ChainSelector(chainsel.STELLAR_TESTNET.Selector): {
+					MCMAddress:      "0xstellar",
+					StartingOpCount: 0,
+				},
 			},
 			setup: func(accessor *mocks.ChainAccessor) {
 				accessor.EXPECT().EVMClient(mock.Anything).Return(nil, true)
@@ -141,13 +189,18 @@ func TestBuildExecutors(t *testing.T) {
 				accessor.EXPECT().TonSigner(mock.Anything).Return(tonSigner, true)
 				accessor.EXPECT().CantonChain(mock.Anything).Return(cantonChain, true)
 			},
+			setupStellar: func(accessor *mocks.StellarChainAccessor) {
+				accessor.EXPECT().StellarClient(mock.Anything).Return(nil, true)
+				accessor.EXPECT().StellarSigner(mock.Anything).Return(stellarSigner, true)
+			},
 			want: map[mcmstypes.ChainSelector]mcmssdk.Executor{
-				evmSelector:    evmExecutor,
-				solSelector:    solExecutor,
-				aptosSelector:  aptosExecutor,
-				suiSelector:    suiExecutor,
-				tonSelector:    tonExecutor,
-				cantonSelector: cantonExecutor,
+				evmSelector:     evmExecutor,
+				solSelector:     solExecutor,
+				aptosSelector:   aptosExecutor,
+				suiSelector:     suiExecutor,
+				tonSelector:     tonExecutor,
+				cantonSelector:  cantonExecutor,
+				stellarSelector: stellarExecutor,
 			},
 		},
 		{
@@ -170,6 +223,17 @@ func TestBuildExecutors(t *testing.T) {
 				aptosSelector: aptosCurseExecutor,
 			},
 		},
+		{
+			name: "chain accessor without stellar chains",
+			encoders: map[mcmstypes.ChainSelector]mcmssdk.Encoder{
+				stellarSelector: stellarEncoder,
+			},
+			chainMetadata: map[mcmstypes.ChainSelector]mcmstypes.ChainMetadata{
+				stellarSelector: {MCMAddress: "0xstellar"},
+			},
+			setup:   func(*mocks.ChainAccessor) {},
+			wantErr: "missing stellar chain client",
+		},
 	}
 
 	for _, tt := range tests {
@@ -179,11 +243,11 @@ func TestBuildExecutors(t *testing.T) {
 			chainAccessor := mocks.NewChainAccessor(t)
 			tt.setup(chainAccessor)
 
-			got, err := BuildExecutors(chainAccessor, tt.chainMetadata, tt.encoders, mcmstypes.TimelockActionSchedule)
+			got, err := BuildExecutors(withStellar(t, chainAccessor, tt.setupStellar), tt.chainMetadata, tt.encoders, mcmstypes.TimelockActionSchedule)
 			if tt.wantErr == "" {
 				require.NoError(t, err)
 				require.Empty(t, cmp.Diff(tt.want, got,
-					cmpopts.IgnoreUnexported(cantonsdk.Inspector{}, cantonsdk.Executor{})))
+					cmpopts.IgnoreUnexported(cantonsdk.Inspector{}, cantonsdk.Executor{}, stellar.Inspector{}, stellar.Executor{})))
 			} else {
 				require.ErrorContains(t, err, tt.wantErr)
 			}
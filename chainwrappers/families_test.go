@@ -0,0 +1,60 @@
+package chainwrappers
+
+import (
+	"testing"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk"
+)
+
+func TestRegisterFamily_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	require.EqualError(t, sdk.RegisterFamily(sdk.Family{Name: chainsel.FamilyEVM}), "chain family evm is already registered")
+}
+
+func TestRegisteredFamilies(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{
+		chainsel.FamilyAptos,
+		chainsel.FamilyCanton,
+		chainsel.FamilyEVM,
+		chainsel.FamilySolana,
+		chainsel.FamilyStellar,
+		chainsel.FamilySui,
+		chainsel.FamilyTon,
+	}, sdk.RegisteredFamilies())
+
+	for _, name := range sdk.RegisteredFamilies() {
+		family, ok := sdk.LookupFamily(name)
+		require.True(t, ok)
+		require.Equal(t, name, family.Name)
+		require.NotNil(t, family.NewEncoder, name)
+		require.NotNil(t, family.NewTimelockConverter, name)
+		require.NotNil(t, family.NewExecutor, name)
+		require.NotNil(t, family.NewInspector, name)
+		require.NotNil(t, family.NewTimelockExecutor, name)
+		require.NotNil(t, family.NewTimelockInspector, name)
+		require.NotNil(t, family.NewTimelockConfigurer, name)
+		require.NotNil(t, family.OperationID, name)
+		require.NotNil(t, family.ValidateAdditionalFields, name)
+	}
+}
+
+func TestTimelockRoleMember(t *testing.T) {
+	t.Parallel()
+
+	family, ok := sdk.LookupFamily(chainsel.FamilyEVM)
+	require.True(t, ok)
+	member, err := family.TimelockRoleMember("0xabcdef0000000000000000000000000000000001")
+	require.NoError(t, err)
+	require.Equal(t, "0xaBCdEf0000000000000000000000000000000001", member)
+
+	family, ok = sdk.LookupFamily(chainsel.FamilySolana)
+	require.True(t, ok)
+	_, err = family.TimelockRoleMember("invalid")
+	require.Error(t, err)
+}
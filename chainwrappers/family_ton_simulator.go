@@ -0,0 +1,6 @@
+//go:build tonemulator
+
+package chainwrappers
+
+// The emulator package registers the TON simulator, see BuildSimulators.
+import _ "github.com/smartcontractkit/mcms/sdk/ton/emulator"
@@ -0,0 +1,78 @@
+//go:build tonemulator
+
+package chainwrappers
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	tonwallet "github.com/xssnick/tonutils-go/ton/wallet"
+
+	"github.com/smartcontractkit/mcms/chainwrappers/mocks"
+	mcmssdk "github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/ton"
+	tonmocks "github.com/smartcontractkit/mcms/sdk/ton/mocks"
+	mcmstypes "github.com/smartcontractkit/mcms/types"
+)
+
+func TestBuildSimulators_TON(t *testing.T) {
+	t.Parallel()
+
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	tonSigner, err := tonwallet.FromPrivateKey(nil, key, tonwallet.V4R2)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		setup   func(accessor *mocks.ChainAccessor)
+		wantErr string
+	}{
+		{
+			name: "success",
+			setup: func(accessor *mocks.ChainAccessor) {
+				accessor.EXPECT().TonClient(mock.Anything).Return(tonmocks.NewAPIClientWrapped(t), true)
+				accessor.EXPECT().TonSigner(mock.Anything).Return(tonSigner, true)
+			},
+		},
+		{
+			name: "failure - missing ton client",
+			setup: func(accessor *mocks.ChainAccessor) {
+				accessor.EXPECT().TonClient(mock.Anything).Return(nil, false)
+			},
+			wantErr: "missing TON chain client",
+		},
+		{
+			name: "failure - missing ton wallet",
+			setup: func(accessor *mocks.ChainAccessor) {
+				accessor.EXPECT().TonClient(mock.Anything).Return(tonmocks.NewAPIClientWrapped(t), true)
+				accessor.EXPECT().TonSigner(mock.Anything).Return(nil, false)
+			},
+			wantErr: "missing TON chain wallet",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chainAccessor := mocks.NewChainAccessor(t)
+			tt.setup(chainAccessor)
+
+			encoders := map[mcmstypes.ChainSelector]mcmssdk.Encoder{tonSelector: ton.NewEncoder(tonSelector, 0, false)}
+			metadata := map[mcmstypes.ChainSelector]mcmstypes.ChainMetadata{tonSelector: {MCMAddress: "0xton"}}
+
+			got, err := BuildSimulators(chainAccessor, metadata, encoders, mcmstypes.TimelockActionSchedule)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			require.NotNil(t, got[tonSelector])
+		})
+	}
+}
@@ -22,6 +22,7 @@ func TestMCMInspectorBuilder_BuildInspectors(t *testing.T) {
 		chainMetadata           map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata
 		chainAccess             *mocks.ChainAccessor
 		setup                   func(access *mocks.ChainAccessor)
+		setupStellar            func(access *mocks.StellarChainAccessor)
 		expectErr               bool
 		errContains             string
 		expectedInspectorsCount int
@@ -48,6 +49,7 @@ func TestMCMInspectorBuilder_BuildInspectors(t *testing.T) {
 				mcmsTypes.ChainSelector(chainsel.SOLANA_DEVNET.Selector):            {MCMAddress: "0xsolana", StartingOpCount: 0},
 				mcmsTypes.ChainSelector(chainsel.APTOS_TESTNET.Selector):            {MCMAddress: "0xaptos", StartingOpCount: 0},
 				mcmsTypes.ChainSelector(chainsel.TON_TESTNET.Selector):              {MCMAddress: "0xton", StartingOpCount: 0},
+				mcmsTypes.ChainSelector(chainsel.STELLAR_TESTNET.Selector):          {MCMAddress: "0xstellar", StartingOpCount: 0},
 				mcmsTypes.ChainSelector(chainsel.SUI_TESTNET.Selector): {
 					MCMAddress:      "0xsui",
 					StartingOpCount: 0,
@@ -71,7 +73,10 @@ func TestMCMInspectorBuilder_BuildInspectors(t *testing.T) {
 				access.EXPECT().SuiSigner(mock.Anything).Return(nil, true)
 				access.EXPECT().TonClient(mock.Anything).Return(nil, true)
 			},
-			expectedInspectorsCount: 5,
+			setupStellar: func(access *mocks.StellarChainAccessor) {
+				access.EXPECT().StellarClient(mock.Anything).Return(nil, true)
+			},
+			expectedInspectorsCount: 6,
 		},
 		{
 			name: "aptos curse mcms from metadata",
@@ -108,7 +113,7 @@ func TestMCMInspectorBuilder_BuildInspectors(t *testing.T) {
 				tc.setup(tc.chainAccess)
 			}
 
-			inspectors, err := BuildInspectors(tc.chainAccess, tc.chainMetadata, mcmsTypes.TimelockActionSchedule)
+			inspectors, err := BuildInspectors(withStellar(t, tc.chainAccess, tc.setupStellar), tc.chainMetadata, mcmsTypes.TimelockActionSchedule)
 			if tc.expectErr {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), tc.errContains)
@@ -0,0 +1,52 @@
+package chainwrappers
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// BuildSimulators gets a map of simulators for the given chain metadata and chain clients
+func BuildSimulators(
+	chains ChainAccessor,
+	chainMetadata map[types.ChainSelector]types.ChainMetadata,
+	encoders map[types.ChainSelector]sdk.Encoder,
+	action types.TimelockAction,
+) (map[types.ChainSelector]sdk.Simulator, error) {
+	simulators := map[types.ChainSelector]sdk.Simulator{}
+	for chainSelector, metadata := range chainMetadata {
+		encoder, ok := encoders[chainSelector]
+		if !ok {
+			return nil, fmt.Errorf("missing encoder for chain selector %d", chainSelector)
+		}
+		simulator, err := BuildSimulator(chains, chainSelector, encoder, action, metadata)
+		if err != nil {
+			return nil, err
+		}
+		simulators[chainSelector] = simulator
+	}
+
+	return simulators, nil
+}
+
+// BuildSimulator constructs a chain-family-specific Simulator from ChainAccessor plus metadata.
+func BuildSimulator(
+	chains ChainAccessor,
+	chainSelector types.ChainSelector,
+	encoder sdk.Encoder,
+	action types.TimelockAction,
+	metadata types.ChainMetadata,
+) (sdk.Simulator, error) {
+	family, err := types.GetChainSelectorFamily(chainSelector)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chain family: %w", err)
+	}
+
+	f, ok := sdk.LookupFamily(family)
+	if !ok || f.NewSimulator == nil {
+		return nil, fmt.Errorf("simulation is not supported for chain family %s", family)
+	}
+
+	return f.NewSimulator(chains, chainSelector, encoder, action, metadata)
+}
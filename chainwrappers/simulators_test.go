@@ -0,0 +1,156 @@
+package chainwrappers
+
+import (
+	"testing"
+
+	"github.com/digital-asset/dazl-client/v8/go/api/com/daml/ledger/api/v2/interactive"
+	sol "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/chainwrappers/mocks"
+	mcmssdk "github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/aptos"
+	cantonsdk "github.com/smartcontractkit/mcms/sdk/canton"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	evmmocks "github.com/smartcontractkit/mcms/sdk/evm/mocks"
+	"github.com/smartcontractkit/mcms/sdk/solana"
+	"github.com/smartcontractkit/mcms/sdk/stellar"
+	"github.com/smartcontractkit/mcms/sdk/sui"
+	mcmstypes "github.com/smartcontractkit/mcms/types"
+)
+
+func TestBuildSimulators(t *testing.T) {
+	t.Parallel()
+
+	cantonChain := cantonsdk.Chain{Participants: []cantonsdk.Participant{{
+		PartyID:        "party::test",
+		LedgerServices: cantonsdk.LedgerServices{Interactive: interactive.NewInteractiveSubmissionServiceClient(nil)},
+	}}}
+
+	evmClient := evmmocks.NewContractDeployBackend(t)
+
+	tests := []struct {
+		name          string
+		encoders      map[mcmstypes.ChainSelector]mcmssdk.Encoder
+		chainMetadata map[mcmstypes.ChainSelector]mcmstypes.ChainMetadata
+		setup         func(accessor *mocks.ChainAccessor)
+		wantTypes     map[mcmstypes.ChainSelector]any
+		wantErr       string
+	}{
+		{
+			name: "success",
+			encoders: map[mcmstypes.ChainSelector]mcmssdk.Encoder{
+				evmSelector:    evm.NewEncoder(evmSelector, 0, false, false),
+				solSelector:    solana.NewEncoder(solSelector, 0, false),
+				aptosSelector:  aptos.NewEncoder(aptosSelector, 0, false),
+				suiSelector:    sui.NewEncoder(suiSelector, 0, false),
+				cantonSelector: cantonsdk.NewEncoder(cantonSelector, 0, false),
+			},
+			chainMetadata: map[mcmstypes.ChainSelector]mcmstypes.ChainMetadata{
+				evmSelector:   {MCMAddress: "0xevm"},
+				solSelector:   {MCMAddress: "0xsolana"},
+				aptosSelector: {MCMAddress: "0xaptos"},
+				suiSelector: {
+					MCMAddress: "0xsui",
+					AdditionalFields: []byte(`{
+						"role":0,
+						"mcms_package_id":"mcms-pkg-id",
+						"account_obj":"0xaccount123",
+						"registry_obj":"0xregistry456",
+						"timelock_obj":"0xtimelock789",
+						"deployer_state_obj":"0xdeployer"
+					}`),
+				},
+				cantonSelector: {MCMAddress: "0xcanton"},
+			},
+			setup: func(accessor *mocks.ChainAccessor) {
+				accessor.EXPECT().EVMClient(mock.Anything).Return(evmClient, true)
+				accessor.EXPECT().SolanaClient(mock.Anything).Return(nil, true)
+				accessor.EXPECT().SolanaSigner(mock.Anything).Return(&sol.PrivateKey{1, 2, 3}, true)
+				accessor.EXPECT().AptosClient(mock.Anything).Return(nil, true)
+				accessor.EXPECT().AptosSigner(mock.Anything).Return(nil, true)
+				accessor.EXPECT().SuiClient(mock.Anything).Return(nil, true)
+				accessor.EXPECT().SuiSigner(mock.Anything).Return(nil, true)
+				accessor.EXPECT().CantonChain(mock.Anything).Return(cantonChain, true)
+			},
+			wantTypes: map[mcmstypes.ChainSelector]any{
+				evmSelector:    (*evm.Simulator)(nil),
+				solSelector:    (*solana.Simulator)(nil),
+				aptosSelector:  (*aptos.Simulator)(nil),
+				suiSelector:    (*sui.Simulator)(nil),
+				cantonSelector: (*cantonsdk.Simulator)(nil),
+			},
+		},
+		{
+			name: "failure - missing encoder",
+			encoders: map[mcmstypes.ChainSelector]mcmssdk.Encoder{
+				solSelector: solana.NewEncoder(solSelector, 0, false),
+			},
+			chainMetadata: map[mcmstypes.ChainSelector]mcmstypes.ChainMetadata{
+				evmSelector: {MCMAddress: "0xevm"},
+			},
+			setup:   func(accessor *mocks.ChainAccessor) {},
+			wantErr: "missing encoder for chain selector",
+		},
+		{
+			name: "failure - missing canton interactive client",
+			encoders: map[mcmstypes.ChainSelector]mcmssdk.Encoder{
+				cantonSelector: cantonsdk.NewEncoder(cantonSelector, 0, false),
+			},
+			chainMetadata: map[mcmstypes.ChainSelector]mcmstypes.ChainMetadata{
+				cantonSelector: {MCMAddress: "0xcanton"},
+			},
+			setup: func(accessor *mocks.ChainAccessor) {
+				accessor.EXPECT().CantonChain(mock.Anything).
+					Return(cantonsdk.Chain{Participants: []cantonsdk.Participant{{PartyID: "party::test"}}}, true)
+			},
+			wantErr: "missing Canton interactive submission client",
+		},
+		{
+			name: "failure - unsupported family",
+			encoders: map[mcmstypes.ChainSelector]mcmssdk.Encoder{
+				stellarSelector: stellar.NewEncoder(stellarSelector, 0, false),
+			},
+			chainMetadata: map[mcmstypes.ChainSelector]mcmstypes.ChainMetadata{
+				stellarSelector: {MCMAddress: "0xstellar"},
+			},
+			setup:   func(accessor *mocks.ChainAccessor) {},
+			wantErr: "simulation is not supported for chain family stellar",
+		},
+		{
+			name: "failure - missing evm client",
+			encoders: map[mcmstypes.ChainSelector]mcmssdk.Encoder{
+				evmSelector: evm.NewEncoder(evmSelector, 0, false, false),
+			},
+			chainMetadata: map[mcmstypes.ChainSelector]mcmstypes.ChainMetadata{
+				evmSelector: {MCMAddress: "0xevm"},
+			},
+			setup: func(accessor *mocks.ChainAccessor) {
+				accessor.EXPECT().EVMClient(mock.Anything).Return(nil, false)
+			},
+			wantErr: "missing evm chain client",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			chainAccessor := mocks.NewChainAccessor(t)
+			tt.setup(chainAccessor)
+
+			got, err := BuildSimulators(chainAccessor, tt.chainMetadata, tt.encoders, mcmstypes.TimelockActionSchedule)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, got, len(tt.wantTypes))
+			for selector, wantType := range tt.wantTypes {
+				require.IsType(t, wantType, got[selector])
+			}
+		})
+	}
+}
@@ -2,21 +2,29 @@ package chainwrappers
 
 import (
 	"encoding/json"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gagliardetto/solana-go"
 	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/stellar/go-stellar-sdk/keypair"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/xssnick/tonutils-go/ton/wallet"
+	zkaccounts "github.com/zksync-sdk/zksync2-go/accounts"
 
 	"github.com/smartcontractkit/mcms/chainwrappers/mocks"
 	"github.com/smartcontractkit/mcms/sdk/aptos"
+	cantonsdk "github.com/smartcontractkit/mcms/sdk/canton"
 	"github.com/smartcontractkit/mcms/sdk/evm"
 	solanasdk "github.com/smartcontractkit/mcms/sdk/solana"
+	"github.com/smartcontractkit/mcms/sdk/stellar"
 	"github.com/smartcontractkit/mcms/sdk/sui"
 	"github.com/smartcontractkit/mcms/sdk/ton"
+	"github.com/smartcontractkit/mcms/sdk/zksync"
+	zkmocks "github.com/smartcontractkit/mcms/sdk/zksync/mocks"
 	mcmsTypes "github.com/smartcontractkit/mcms/types"
 )
 
@@ -27,6 +35,8 @@ func TestBuildTimelockConfigurers(t *testing.T) {
 		name          string
 		chainMetadata map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata
 		setup         func(t *testing.T, access *mocks.ChainAccessor, metadata map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata)
+		setupStellar  func(access *mocks.StellarChainAccessor)
+		setupZkSync   func(t *testing.T, access *mocks.ZkSyncChainAccessor)
 		expectErr     bool
 		errContains   string
 		expectTypes   map[mcmsTypes.ChainSelector]any
@@ -51,6 +61,8 @@ func TestBuildTimelockConfigurers(t *testing.T) {
 				mcmsTypes.ChainSelector(chainsel.SOLANA_DEVNET.Selector):            {MCMAddress: "0xsolana"},
 				mcmsTypes.ChainSelector(chainsel.APTOS_TESTNET.Selector):            {MCMAddress: "0xaptos"},
 				mcmsTypes.ChainSelector(chainsel.TON_TESTNET.Selector):              {MCMAddress: "0xton"},
+				mcmsTypes.ChainSelector(chainsel.STELLAR_TESTNET.Selector):          {MCMAddress: "0xstellar"},
+				mcmsTypes.ChainSelector(chainsel.CANTON_TESTNET.Selector):           {MCMAddress: "0xcanton"},
 				mcmsTypes.ChainSelector(chainsel.SUI_TESTNET.Selector): {
 					MCMAddress: "0xsui",
 					AdditionalFields: []byte(`{
@@ -77,6 +89,14 @@ func TestBuildTimelockConfigurers(t *testing.T) {
 				access.EXPECT().AptosClient(mock.Anything).Return(nil, true)
 
 				access.EXPECT().TonSigner(mock.Anything).Return(&wallet.Wallet{}, true)
+
+				access.EXPECT().CantonChain(mock.Anything).Return(cantonsdk.Chain{
+					Participants: []cantonsdk.Participant{{PartyID: "party::test"}},
+				}, true)
+			},
+			setupStellar: func(access *mocks.StellarChainAccessor) {
+				access.EXPECT().StellarClient(mock.Anything).Return(nil, true)
+				access.EXPECT().StellarSigner(mock.Anything).Return(keypair.MustRandom(), true)
 			},
 			expectTypes: map[mcmsTypes.ChainSelector]any{
 				mcmsTypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector): (*evm.TimelockConfigurer)(nil),
@@ -84,6 +104,8 @@ func TestBuildTimelockConfigurers(t *testing.T) {
 				mcmsTypes.ChainSelector(chainsel.APTOS_TESTNET.Selector):            (*aptos.TimelockConfigurer)(nil),
 				mcmsTypes.ChainSelector(chainsel.SUI_TESTNET.Selector):              (*sui.TimelockConfigurer)(nil),
 				mcmsTypes.ChainSelector(chainsel.TON_TESTNET.Selector):              (*ton.TimelockConfigurer)(nil),
+				mcmsTypes.ChainSelector(chainsel.STELLAR_TESTNET.Selector):          (*stellar.TimelockConfigurer)(nil),
+				mcmsTypes.ChainSelector(chainsel.CANTON_TESTNET.Selector):           (*cantonsdk.TimelockConfigurer)(nil),
 			},
 		},
 		{
@@ -139,6 +161,39 @@ func TestBuildTimelockConfigurers(t *testing.T) {
 			expectErr:   true,
 			errContains: "missing EVM chain signer",
 		},
+		{
+			name: "zksync chain",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector): {MCMAddress: "0xzksync"},
+			},
+			setupZkSync: func(t *testing.T, access *mocks.ZkSyncChainAccessor) {
+				t.Helper()
+
+				signer, err := zkaccounts.NewECDSASignerFromRawPrivateKey(
+					common.FromHex("0x7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110"), big.NewInt(300))
+				require.NoError(t, err)
+
+				access.EXPECT().ZkSyncClient(mock.Anything).Return(zkmocks.NewClient(t), true)
+				access.EXPECT().ZkSyncSigner(mock.Anything).Return(signer, true)
+			},
+			expectTypes: map[mcmsTypes.ChainSelector]any{
+				mcmsTypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector): (*zksync.TimelockConfigurer)(nil),
+			},
+		},
+		{
+			name: "missing zksync signer",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector): {MCMAddress: "0xzksync"},
+			},
+			setupZkSync: func(t *testing.T, access *mocks.ZkSyncChainAccessor) {
+				t.Helper()
+
+				access.EXPECT().ZkSyncClient(mock.Anything).Return(zkmocks.NewClient(t), true)
+				access.EXPECT().ZkSyncSigner(mock.Anything).Return(nil, false)
+			},
+			expectErr:   true,
+			errContains: "missing zksync signer",
+		},
 		{
 			name: "missing ton signer",
 			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
@@ -152,6 +207,19 @@ func TestBuildTimelockConfigurers(t *testing.T) {
 			expectErr:   true,
 			errContains: "missing TON chain wallet",
 		},
+		{
+			name: "missing canton participant",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.CANTON_TESTNET.Selector): {MCMAddress: "0xcanton"},
+			},
+			setup: func(t *testing.T, access *mocks.ChainAccessor, metadata map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata) {
+				t.Helper()
+
+				access.EXPECT().CantonChain(mock.Anything).Return(cantonsdk.Chain{}, true)
+			},
+			expectErr:   true,
+			errContains: "missing Canton chain participant",
+		},
 	}
 
 	for _, tc := range tests {
@@ -162,7 +230,11 @@ func TestBuildTimelockConfigurers(t *testing.T) {
 				tc.setup(t, access, tc.chainMetadata)
 			}
 
-			configurers, err := BuildTimelockConfigurers(access, tc.chainMetadata, mcmsTypes.TimelockActionSchedule)
+			chains := withStellar(t, access, tc.setupStellar)
+			if tc.setupZkSync != nil {
+				chains = withZkSync(t, access, tc.setupZkSync)
+			}
+			configurers, err := BuildTimelockConfigurers(chains, tc.chainMetadata, mcmsTypes.TimelockActionSchedule)
 			if tc.expectErr {
 				require.Error(t, err)
 				require.ErrorContains(t, err, tc.errContains)
@@ -1,18 +1,9 @@
 package chainwrappers
 
 import (
-	"encoding/json"
 	"fmt"
 
-	chainsel "github.com/smartcontractkit/chain-selectors"
-
 	"github.com/smartcontractkit/mcms/sdk"
-	"github.com/smartcontractkit/mcms/sdk/aptos"
-	cantonsdk "github.com/smartcontractkit/mcms/sdk/canton"
-	"github.com/smartcontractkit/mcms/sdk/evm"
-	"github.com/smartcontractkit/mcms/sdk/solana"
-	"github.com/smartcontractkit/mcms/sdk/sui"
-	"github.com/smartcontractkit/mcms/sdk/ton"
 	"github.com/smartcontractkit/mcms/types"
 )
 
@@ -45,112 +36,11 @@ func BuildTimelockExecutor(
 	if err != nil {
 		return nil, fmt.Errorf("error getting chain family: %w", err)
 	}
-	rawSelector := uint64(chainSelector)
-
-	switch family {
-	case chainsel.FamilyEVM:
-		client, ok := chains.EVMClient(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing evm chain client for selector %d", chainSelector)
-		}
-		auth, ok := chains.EVMSigner(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing evm signer for selector %d", rawSelector)
-		}
-
-		evmChainMetadata, err1 := evm.ParseChainMetadata(metadata)
-		if err1 != nil {
-			return nil, fmt.Errorf("failed to parse EVM chain metadata for selector %d: %w", rawSelector, err1)
-		}
-		auth.GasPrice = evmChainMetadata.GasPrice
-		auth.GasLimit = evmChainMetadata.GasLimit
-
-		return evm.NewTimelockExecutor(client, auth), nil
-
-	case chainsel.FamilySolana:
-		client, ok := chains.SolanaClient(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing solana chain client for selector %d", chainSelector)
-		}
-		signer, ok := chains.SolanaSigner(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing solana chain signer for selector %d", chainSelector)
-		}
-
-		return solana.NewTimelockExecutor(client, *signer), nil
-
-	case chainsel.FamilyAptos:
-		client, ok := chains.AptosClient(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing aptos chain client for selector %d", chainSelector)
-		}
-		signer, ok := chains.AptosSigner(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing aptos chain signer for selector %d", chainSelector)
-		}
-
-		mcmsType := aptos.MCMSTypeRegular
-		if len(metadata.AdditionalFields) > 0 {
-			var afm aptos.AdditionalFieldsMetadata
-			if err = json.Unmarshal(metadata.AdditionalFields, &afm); err != nil {
-				return nil, fmt.Errorf("failed to parse Aptos metadata for selector %d: %w", rawSelector, err)
-			}
-			mcmsType = afm.MCMSType
-		}
 
-		return aptos.NewTimelockExecutorWithMCMSType(client, signer, mcmsType), nil
-
-	case chainsel.FamilySui:
-		client, ok := chains.SuiClient(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing sui chain client for selector %d", chainSelector)
-		}
-		signer, ok := chains.SuiSigner(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing sui chain signer for selector %d", chainSelector)
-		}
-
-		suiMetadata, err := sui.SuiMetadata(metadata)
-		if err != nil {
-			return nil, fmt.Errorf("error getting sui metadata from proposal: %w", err)
-		}
-		entrypointEncoder := sui.NewCCIPEntrypointArgEncoder(suiMetadata.RegistryObj, suiMetadata.DeployerStateObj)
-
-		return sui.NewTimelockExecutor(client, signer, entrypointEncoder, suiMetadata.McmsPackageID,
-			suiMetadata.RegistryObj, suiMetadata.AccountObj)
-
-	case chainsel.FamilyTon:
-		client, ok := chains.TonClient(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing ton chain client for selector %d", chainSelector)
-		}
-		signer, ok := chains.TonSigner(rawSelector)
-		if !ok {
-			return nil, fmt.Errorf("missing ton client signer for selector %d", chainSelector)
-		}
-
-		return ton.NewTimelockExecutor(ton.TimelockExecutorOpts{
-			Client: client,
-			Wallet: signer,
-			Amount: ton.DefaultSendAmount,
-		})
-
-	case chainsel.FamilyCanton:
-		ch, ok := chains.CantonChain(rawSelector)
-		if !ok || len(ch.Participants) == 0 {
-			return nil, fmt.Errorf("missing Canton chain participant for selector %d", rawSelector)
-		}
-		participant := ch.Participants[0]
-		mcmsParties := cantonsdk.MCMSPartiesForChain(ch)
-
-		return cantonsdk.NewTimelockExecutor(
-			participant.LedgerServices.Command,
-			participant.LedgerServices.State,
-			participant.PartyID,
-			mcmsParties,
-		), nil
-
-	default:
+	f, ok := sdk.LookupFamily(family)
+	if !ok || f.NewTimelockExecutor == nil {
 		return nil, fmt.Errorf("unsupported chain family %s", family)
 	}
+
+	return f.NewTimelockExecutor(chains, chainSelector, action, metadata)
 }
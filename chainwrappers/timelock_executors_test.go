@@ -7,7 +7,9 @@ import (
 	sol "github.com/gagliardetto/solana-go"
 	solrpc "github.com/gagliardetto/solana-go/rpc"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/stellar/go-stellar-sdk/keypair"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	tonwallet "github.com/xssnick/tonutils-go/ton/wallet"
@@ -18,6 +20,7 @@ import (
 	aptosmocks "github.com/smartcontractkit/mcms/sdk/aptos/mocks/aptos"
 	"github.com/smartcontractkit/mcms/sdk/evm"
 	"github.com/smartcontractkit/mcms/sdk/solana"
+	"github.com/smartcontractkit/mcms/sdk/stellar"
 	"github.com/smartcontractkit/mcms/sdk/sui"
 	suibindmocks "github.com/smartcontractkit/mcms/sdk/sui/mocks/bindutils"
 	suimocks "github.com/smartcontractkit/mcms/sdk/sui/mocks/sui"
@@ -47,11 +50,14 @@ func TestBuildTimelockExecutors(t *testing.T) {
 	tonExecutor, err := ton.NewTimelockExecutor(
 		ton.TimelockExecutorOpts{Client: tonClient, Wallet: tonSigner, Amount: ton.DefaultSendAmount})
 	require.NoError(t, err)
+	stellarSigner := keypair.MustRandom()
+	stellarExecutor := stellar.NewTimelockExecutor(nil, stellarSigner)
 
 	tests := []struct {
 		name          string
 		chainMetadata map[mcmstypes.ChainSelector]mcmstypes.ChainMetadata
 		setup         func(accessor *mocks.ChainAccessor)
+		setupStellar  func(accessor *mocks.StellarChainAccessor)
 		want          map[mcmstypes.ChainSelector]mcmssdk.TimelockExecutor
 		wantErr       string
 	}{
@@ -87,6 +93,10 @@ func TestBuildTimelockExecutors(t *testing.T) {
 					MCMAddress:      "0xton",
 					StartingOpCount: 0,
 				},
+				mcmstypes.ChainSelector(chainsel.STELLAR_TESTNET.Selector): {
+					MCMAddress:      "0xstellar",
+					StartingOpCount: 0,
+				},
 			},
 			setup: func(accessor *mocks.ChainAccessor) {
 				accessor.EXPECT().EVMClient(mock.Anything).Return(nil, true)
@@ -100,12 +110,17 @@ func TestBuildTimelockExecutors(t *testing.T) {
 				accessor.EXPECT().TonClient(mock.Anything).Return(tonClient, true)
 				accessor.EXPECT().TonSigner(mock.Anything).Return(tonSigner, true)
 			},
+			setupStellar: func(accessor *mocks.StellarChainAccessor) {
+				accessor.EXPECT().StellarClient(mock.Anything).Return(nil, true)
+				accessor.EXPECT().StellarSigner(mock.Anything).Return(stellarSigner, true)
+			},
 			want: map[mcmstypes.ChainSelector]mcmssdk.TimelockExecutor{
-				evmSelector:   evmExecutor,
-				solSelector:   solExecutor,
-				aptosSelector: aptosExecutor,
-				suiSelector:   suiExecutor,
-				tonSelector:   tonExecutor,
+				evmSelector:     evmExecutor,
+				solSelector:     solExecutor,
+				aptosSelector:   aptosExecutor,
+				suiSelector:     suiExecutor,
+				tonSelector:     tonExecutor,
+				stellarSelector: stellarExecutor,
 			},
 		},
 	}
@@ -117,10 +132,11 @@ func TestBuildTimelockExecutors(t *testing.T) {
 			chainAccessor := mocks.NewChainAccessor(t)
 			tt.setup(chainAccessor)
 
-			got, err := BuildTimelockExecutors(chainAccessor, tt.chainMetadata, mcmstypes.TimelockActionSchedule)
+			got, err := BuildTimelockExecutors(withStellar(t, chainAccessor, tt.setupStellar), tt.chainMetadata, mcmstypes.TimelockActionSchedule)
 			if tt.wantErr == "" {
 				require.NoError(t, err)
-				require.Empty(t, cmp.Diff(tt.want, got))
+				require.Empty(t, cmp.Diff(tt.want, got,
+					cmpopts.IgnoreUnexported(stellar.TimelockInspector{}, stellar.TimelockExecutor{})))
 			} else {
 				require.ErrorContains(t, err, tt.wantErr)
 			}
@@ -14,8 +14,11 @@ import (
 	cantonsdk "github.com/smartcontractkit/mcms/sdk/canton"
 	"github.com/smartcontractkit/mcms/sdk/evm"
 	solanasdk "github.com/smartcontractkit/mcms/sdk/solana"
+	stellarsdk "github.com/smartcontractkit/mcms/sdk/stellar"
 	"github.com/smartcontractkit/mcms/sdk/sui"
 	tonsdk "github.com/smartcontractkit/mcms/sdk/ton"
+	zksyncsdk "github.com/smartcontractkit/mcms/sdk/zksync"
+	zkmocks "github.com/smartcontractkit/mcms/sdk/zksync/mocks"
 	mcmsTypes "github.com/smartcontractkit/mcms/types"
 )
 
@@ -28,6 +31,8 @@ func TestBuildTimelockInspectors(t *testing.T) {
 		name          string
 		chainMetadata map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata
 		setup         func(t *testing.T, access *mocks.ChainAccessor)
+		setupStellar  func(access *mocks.StellarChainAccessor)
+		setupZkSync   func(t *testing.T, access *mocks.ZkSyncChainAccessor)
 		wantTypes     map[mcmsTypes.ChainSelector]any
 		wantErr       string
 	}{
@@ -191,6 +196,7 @@ func TestBuildTimelockInspectors(t *testing.T) {
 				mcmsTypes.ChainSelector(chainsel.APTOS_TESTNET.Selector):            {MCMAddress: "0xaptos"},
 				mcmsTypes.ChainSelector(chainsel.TON_TESTNET.Selector):              {MCMAddress: "0xton"},
 				mcmsTypes.ChainSelector(chainsel.CANTON_TESTNET.Selector):           {MCMAddress: "0xcanton"},
+				mcmsTypes.ChainSelector(chainsel.STELLAR_TESTNET.Selector):          {MCMAddress: "0xstellar"},
 				mcmsTypes.ChainSelector(chainsel.SUI_TESTNET.Selector): {
 					MCMAddress: "0xsui",
 					AdditionalFields: []byte(`{
@@ -218,15 +224,32 @@ func TestBuildTimelockInspectors(t *testing.T) {
 					},
 				}, true)
 			},
+			setupStellar: func(access *mocks.StellarChainAccessor) {
+				access.EXPECT().StellarClient(mock.Anything).Return(nil, true)
+			},
 			wantTypes: map[mcmsTypes.ChainSelector]any{
 				mcmsTypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector): (*evm.TimelockInspector)(nil),
 				mcmsTypes.ChainSelector(chainsel.SOLANA_DEVNET.Selector):            (*solanasdk.TimelockInspector)(nil),
 				mcmsTypes.ChainSelector(chainsel.APTOS_TESTNET.Selector):            (*aptos.TimelockInspector)(nil),
 				mcmsTypes.ChainSelector(chainsel.TON_TESTNET.Selector):              (*tonsdk.TimelockInspector)(nil),
 				mcmsTypes.ChainSelector(chainsel.CANTON_TESTNET.Selector):           (*cantonsdk.TimelockInspector)(nil),
+				mcmsTypes.ChainSelector(chainsel.STELLAR_TESTNET.Selector):          (*stellarsdk.TimelockInspector)(nil),
 				mcmsTypes.ChainSelector(chainsel.SUI_TESTNET.Selector):              (*sui.TimelockInspector)(nil),
 			},
 		},
+		{
+			name: "zksync chain",
+			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
+				mcmsTypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector): {MCMAddress: "0xzksync"},
+			},
+			setupZkSync: func(t *testing.T, access *mocks.ZkSyncChainAccessor) {
+				t.Helper()
+				access.EXPECT().ZkSyncClient(mock.Anything).Return(zkmocks.NewClient(t), true)
+			},
+			wantTypes: map[mcmsTypes.ChainSelector]any{
+				mcmsTypes.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector): (*zksyncsdk.TimelockInspector)(nil),
+			},
+		},
 		{
 			name: "aptos curse mcms from metadata",
 			chainMetadata: map[mcmsTypes.ChainSelector]mcmsTypes.ChainMetadata{
@@ -255,7 +278,11 @@ func TestBuildTimelockInspectors(t *testing.T) {
 				tc.setup(t, access)
 			}
 
-			inspectors, err := BuildTimelockInspectors(access, tc.chainMetadata)
+			chains := withStellar(t, access, tc.setupStellar)
+			if tc.setupZkSync != nil {
+				chains = withZkSync(t, access, tc.setupZkSync)
+			}
+			inspectors, err := BuildTimelockInspectors(chains, tc.chainMetadata)
 
 			if tc.wantErr == "" {
 				require.NoError(t, err)
@@ -0,0 +1,1255 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/mcms"
+	"github.com/smartcontractkit/mcms/chainwrappers"
+	"github.com/smartcontractkit/mcms/report"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// defaultDerivationPath is the derivation path of the first Ethereum account of a hardware wallet.
+const defaultDerivationPath = "m/44'/60'/0'/0/0"
+
+// proposalFile is a proposal read from disk, which is either an MCMS proposal or a timelock
+// proposal.
+type proposalFile struct {
+	path     string
+	proposal *mcms.Proposal
+	timelock *mcms.TimelockProposal
+}
+
+// readProposalFile reads the proposal at path, detecting its kind.
+func readProposalFile(path string) (*proposalFile, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proposal: %w", err)
+	}
+
+	kind, err := mcms.ProposalKindFromJSON(strings.NewReader(string(b)))
+	if err != nil {
+		return nil, err
+	}
+
+	file := &proposalFile{path: path}
+	switch kind {
+	case types.KindProposal:
+		file.proposal, err = mcms.NewProposal(strings.NewReader(string(b)))
+	case types.KindTimelockProposal:
+		file.timelock, err = mcms.NewTimelockProposal(strings.NewReader(string(b)))
+	default:
+		err = fmt.Errorf("unknown proposal kind %s", kind)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid proposal %s: %w", path, err)
+	}
+
+	return file, nil
+}
+
+// kind returns the kind of the proposal.
+func (f *proposalFile) kind() types.ProposalKind {
+	if f.timelock != nil {
+		return types.KindTimelockProposal
+	}
+
+	return types.KindProposal
+}
+
+// action returns the timelock action of the proposal, defaulting to schedule for MCMS proposals.
+func (f *proposalFile) action() types.TimelockAction {
+	if f.timelock != nil {
+		return f.timelock.Action
+	}
+
+	return types.TimelockActionSchedule
+}
+
+// proposalInterface returns the proposal, whatever its kind.
+func (f *proposalFile) proposalInterface() mcms.ProposalInterface {
+	if f.timelock != nil {
+		return f.timelock
+	}
+
+	return f.proposal
+}
+
+// mcmsProposal returns the MCMS proposal, converting timelock proposals.
+func (f *proposalFile) mcmsProposal(ctx context.Context) (*mcms.Proposal, error) {
+	if f.timelock == nil {
+		return f.proposal, nil
+	}
+
+	converters, err := chainwrappers.BuildConverters(f.timelock.ChainMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, _, err := f.timelock.Convert(ctx, converters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert timelock proposal: %w", err)
+	}
+
+	return &proposal, nil
+}
+
+// signingRequest returns the signing request of the proposal.
+func (f *proposalFile) signingRequest(ctx context.Context) (mcms.SigningRequest, error) {
+	if f.timelock != nil {
+		return f.timelock.SigningRequest(ctx)
+	}
+
+	return f.proposal.SigningRequest()
+}
+
+// mergeSignatures merges the envelopes into the proposal.
+func (f *proposalFile) mergeSignatures(ctx context.Context, envelopes ...mcms.SignatureEnvelope) error {
+	if f.timelock != nil {
+		return f.timelock.MergeSignatures(ctx, envelopes...)
+	}
+
+	return f.proposal.MergeSignatures(envelopes...)
+}
+
+// write writes the proposal to path.
+func (f *proposalFile) write(path string, stdout io.Writer) error {
+	return writeFile(path, stdout, func(w io.Writer) error {
+		if f.timelock != nil {
+			return mcms.WriteTimelockProposal(w, f.timelock)
+		}
+
+		return mcms.WriteProposal(w, f.proposal)
+	})
+}
+
+// writeFile writes the output of write to path, or to stdout when path is "-".
+func writeFile(path string, stdout io.Writer, write func(w io.Writer) error) error {
+	if path == "-" {
+		return write(stdout)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer f.Close()
+
+	if err = write(f); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return f.Close()
+}
+
+// newFlagSet creates the flag set of a command. The proposal path is the single positional
+// argument of every command.
+func newFlagSet(name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: mcms %s [flags] %s\n\nFlags:\n", name, usage)
+		fs.PrintDefaults()
+	}
+
+	return fs
+}
+
+// parseProposalArgs parses the flags and reads the proposal given as positional argument.
+func parseProposalArgs(fs *flag.FlagSet, args []string) (*proposalFile, error) {
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return nil, fmt.Errorf("expected a single proposal file, got %d arguments", fs.NArg())
+	}
+
+	return readProposalFile(fs.Arg(0))
+}
+
+func printJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+func runValidate(_ context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("validate", "<proposal.json>")
+	file, err := parseProposalArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "%s %s is valid\n", file.kind(), file.path)
+
+	return nil
+}
+
+func runHash(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("hash", "<proposal.json>")
+	file, err := parseProposalArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	request, err := file.signingRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(stdout, request)
+}
+
+func runSign(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("sign", "<proposal.json>")
+	signerFlags := addSignerFlags(fs)
+	envelopePath := fs.String("envelope", "", "write a detached signature envelope to this file (- for stdout) "+
+		"instead of adding the signature to the proposal")
+	file, err := parseProposalArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	s, closeSigner, err := signerFlags.signer(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+
+	request, err := file.signingRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	envelope, err := request.Sign(s)
+	if err != nil {
+		return fmt.Errorf("failed to sign proposal: %w", err)
+	}
+
+	if *envelopePath != "" {
+		return writeFile(*envelopePath, stdout, func(w io.Writer) error {
+			return mcms.WriteSignatureEnvelope(w, envelope)
+		})
+	}
+
+	if err = file.mergeSignatures(ctx, envelope); err != nil {
+		return err
+	}
+	if err = file.write(file.path, stdout); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "added signature of %s to %s\n", envelope.Signer, file.path)
+
+	return nil
+}
+
+func runMergeSignatures(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("merge-signatures", "<proposal.json> <envelope.json>...")
+	output := fs.String("output", "", "write the signed proposal to this file (- for stdout), defaults to the input file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 2 {
+		fs.Usage()
+		return errors.New("expected a proposal file and at least one signature envelope")
+	}
+
+	file, err := readProposalFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	envelopes := make([]mcms.SignatureEnvelope, 0, fs.NArg()-1)
+	for _, path := range fs.Args()[1:] {
+		envelope, rerr := readSignatureEnvelope(path)
+		if rerr != nil {
+			return rerr
+		}
+		envelopes = append(envelopes, envelope)
+	}
+
+	if err = file.mergeSignatures(ctx, envelopes...); err != nil {
+		return err
+	}
+
+	if *output == "" {
+		*output = file.path
+	}
+	if err = file.write(*output, stdout); err != nil {
+		return err
+	}
+
+	if *output != "-" {
+		fmt.Fprintf(stdout, "merged %d signatures into %s\n", len(envelopes), *output)
+	}
+
+	return nil
+}
+
+func readSignatureEnvelope(path string) (mcms.SignatureEnvelope, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return mcms.SignatureEnvelope{}, fmt.Errorf("failed to read signature envelope: %w", err)
+	}
+	defer f.Close()
+
+	envelope, err := mcms.NewSignatureEnvelope(f)
+	if err != nil {
+		return mcms.SignatureEnvelope{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return envelope, nil
+}
+
+func runConvert(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("convert", "<timelock-proposal.json>")
+	output := fs.String("output", "-", "write the converted proposal to this file (- for stdout)")
+	file, err := parseProposalArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if file.timelock == nil {
+		return fmt.Errorf("%s is not a timelock proposal", file.path)
+	}
+
+	proposal, err := file.mcmsProposal(ctx)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(*output, stdout, func(w io.Writer) error {
+		return mcms.WriteProposal(w, proposal)
+	})
+}
+
+// defaultValidFor is the validity of the proposals built by the build command.
+const defaultValidFor = 72 * time.Hour
+
+// addressFlag collects selector=address pairs.
+type addressFlag map[types.ChainSelector]string
+
+func (f addressFlag) String() string {
+	return fmt.Sprint(map[types.ChainSelector]string(f))
+}
+
+func (f addressFlag) Set(value string) error {
+	selector, address, ok := strings.Cut(value, "=")
+	if !ok || address == "" {
+		return fmt.Errorf("expected selector=address, got %q", value)
+	}
+
+	sel, err := strconv.ParseUint(selector, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid chain selector %q: %w", selector, err)
+	}
+	f[types.ChainSelector(sel)] = address
+
+	return nil
+}
+
+// stringsFlag collects the values of a repeated flag.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+func runBuild(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("build", "<operations.json>")
+	chainFlags := addChainFlags(fs, false)
+	mcmAddresses := addressFlag{}
+	fs.Var(mcmAddresses, "mcm", "MCM address of a chain as selector=address, may be repeated")
+	timelockAddresses := addressFlag{}
+	fs.Var(timelockAddresses, "timelock", "timelock address of a chain as selector=address, may be repeated")
+	var predecessors stringsFlag
+	fs.Var(&predecessors, "predecessor", "proposal file queued before this one, may be repeated")
+	metadataPath := fs.String("chain-metadata", "", "JSON file of the chain metadata by chain selector, "+
+		"for the chains needing additional fields")
+	action := fs.String("timelock-action", "", "build a timelock proposal with this action (schedule, cancel or bypass) "+
+		"instead of an MCMS proposal")
+	delay := fs.String("delay", "", "timelock delay of a scheduled timelock proposal, e.g. 24h")
+	validFor := fs.Duration("valid-for", defaultValidFor, "validity of the proposal from now")
+	validUntil := fs.Uint("valid-until", 0, "unix timestamp until which the proposal is valid, overrides -valid-for")
+	description := fs.String("description", "", "description of the proposal")
+	overridePreviousRoot := fs.Bool("override-previous-root", false, "override the root of the previous proposal")
+	output := fs.String("output", "-", "write the proposal to this file (- for stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("expected a single operations file, got %d arguments", fs.NArg())
+	}
+
+	metadata, err := buildChainMetadata(mcmAddresses, *metadataPath)
+	if err != nil {
+		return err
+	}
+
+	timelockAction := types.TimelockAction(*action)
+	if timelockAction == "" {
+		timelockAction = types.TimelockActionSchedule
+	}
+	if err = setStartingOpCounts(ctx, chainFlags, metadata, timelockAction, predecessors); err != nil {
+		return err
+	}
+
+	if *validUntil == 0 {
+		*validUntil = uint(time.Now().Add(*validFor).Unix())
+	}
+	if *validUntil > math.MaxUint32 {
+		return fmt.Errorf("-valid-until %d overflows uint32", *validUntil)
+	}
+
+	b, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("failed to read operations: %w", err)
+	}
+
+	file := &proposalFile{}
+	if *action == "" {
+		var ops []types.Operation
+		if err = json.Unmarshal(b, &ops); err != nil {
+			return fmt.Errorf("failed to decode operations %s: %w", fs.Arg(0), err)
+		}
+
+		file.proposal, err = mcms.NewProposalBuilder().
+			SetVersion("v1").
+			SetValidUntil(uint32(*validUntil)).
+			SetDescription(*description).
+			SetOverridePreviousRoot(*overridePreviousRoot).
+			SetChainMetadata(metadata).
+			SetOperations(ops).
+			Build()
+	} else {
+		var bops []types.BatchOperation
+		if err = json.Unmarshal(b, &bops); err != nil {
+			return fmt.Errorf("failed to decode operations %s: %w", fs.Arg(0), err)
+		}
+
+		builder := mcms.NewTimelockProposalBuilder().
+			SetVersion("v1").
+			SetValidUntil(uint32(*validUntil)).
+			SetDescription(*description).
+			SetOverridePreviousRoot(*overridePreviousRoot).
+			SetChainMetadata(metadata).
+			SetAction(timelockAction).
+			SetTimelockAddresses(timelockAddresses).
+			SetOperations(bops)
+		if *delay != "" {
+			d, derr := types.ParseDuration(*delay)
+			if derr != nil {
+				return fmt.Errorf("invalid -delay: %w", derr)
+			}
+			builder.SetDelay(d)
+		}
+		file.timelock, err = builder.Build()
+	}
+	if err != nil {
+		return err
+	}
+
+	return file.write(*output, stdout)
+}
+
+// buildChainMetadata returns the chain metadata of the chain metadata file, with the MCM addresses
+// of the -mcm flags.
+func buildChainMetadata(
+	mcmAddresses addressFlag, path string,
+) (map[types.ChainSelector]types.ChainMetadata, error) {
+	metadata := make(map[types.ChainSelector]types.ChainMetadata)
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read chain metadata: %w", err)
+		}
+		if err = json.Unmarshal(b, &metadata); err != nil {
+			return nil, fmt.Errorf("failed to decode chain metadata %s: %w", path, err)
+		}
+	}
+
+	for selector, address := range mcmAddresses {
+		m := metadata[selector]
+		if m.MCMAddress != "" && m.MCMAddress != address {
+			return nil, fmt.Errorf("chain %d: -mcm %s does not match the chain metadata address %s",
+				selector, address, m.MCMAddress)
+		}
+		m.MCMAddress = address
+		metadata[selector] = m
+	}
+	if len(metadata) == 0 {
+		return nil, errMissingFlag("mcm")
+	}
+
+	return metadata, nil
+}
+
+// setStartingOpCounts sets the starting op counts of the chain metadata after the on-chain op counts
+// of the MCMs, read when a config file is given, and after the predecessor proposals.
+func setStartingOpCounts(
+	ctx context.Context, chainFlags chainFlags, metadata map[types.ChainSelector]types.ChainMetadata,
+	action types.TimelockAction, predecessors []string,
+) error {
+	queue := mcms.NewProposalQueue()
+
+	if *chainFlags.config != "" {
+		accessor, err := chainFlags.accessor(ctx)
+		if err != nil {
+			return err
+		}
+		defer accessor.close()
+
+		inspectors, err := chainwrappers.BuildInspectors(accessor, metadata, action)
+		if err != nil {
+			return err
+		}
+		for selector, m := range metadata {
+			opCount, err := inspectors[selector].GetOpCount(ctx, m.MCMAddress)
+			if err != nil {
+				return fmt.Errorf("failed to get op count of chain %d: %w", selector, err)
+			}
+			queue.SetOpCount(mcms.MCMInstance{ChainSelector: selector, MCMAddress: m.MCMAddress}, opCount)
+		}
+	}
+
+	for _, path := range predecessors {
+		file, err := readProposalFile(path)
+		if err != nil {
+			return err
+		}
+		if err = queue.Add(ctx, path, file.proposalInterface()); err != nil {
+			return err
+		}
+	}
+	if err := queue.Verify(); err != nil {
+		return err
+	}
+
+	for selector, m := range metadata {
+		m.StartingOpCount = queue.NextOpCount(mcms.MCMInstance{ChainSelector: selector, MCMAddress: m.MCMAddress})
+		metadata[selector] = m
+	}
+
+	return nil
+}
+
+// abiFlag collects ContractType=path pairs of ABI files used for decoding.
+type abiFlag map[string]string
+
+func (f abiFlag) String() string {
+	return fmt.Sprint(map[string]string(f))
+}
+
+func (f abiFlag) Set(value string) error {
+	contractType, path, ok := strings.Cut(value, "=")
+	if !ok || contractType == "" || path == "" {
+		return fmt.Errorf("expected ContractType=path, got %q", value)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read ABI of %s: %w", contractType, err)
+	}
+	f[contractType] = string(b)
+
+	return nil
+}
+
+func runDecode(_ context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("decode", "<proposal.json>")
+	abis := abiFlag{}
+	fs.Var(abis, "abi", "ABI (or Anchor IDL, Move function info) file of a contract type as ContractType=path, may be repeated")
+	file, err := parseProposalArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	decoders, err := buildDecoders(file.chainMetadata())
+	if err != nil {
+		return err
+	}
+
+	var batches [][]sdk.DecodedOperation
+	if file.timelock != nil {
+		batches, err = file.timelock.Decode(decoders, abis)
+	} else {
+		var ops []sdk.DecodedOperation
+		ops, err = file.proposal.Decode(decoders, abis)
+		for _, op := range ops {
+			batches = append(batches, []sdk.DecodedOperation{op})
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode proposal: %w", err)
+	}
+
+	for i, batch := range batches {
+		for j, op := range batch {
+			method, inputs, serr := op.String()
+			if serr != nil {
+				return serr
+			}
+			fmt.Fprintf(stdout, "operation %d.%d: %s\n%s\n", i, j, method, inputs)
+		}
+	}
+
+	return nil
+}
+
+func runReport(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("report", "<proposal.json>")
+	format := fs.String("format", "markdown", "report format, markdown or html")
+	output := fs.String("output", "-", "write the report to this file (- for stdout)")
+	abis := abiFlag{}
+	fs.Var(abis, "abi", "ABI (or Anchor IDL, Move function info) file of a contract type as ContractType=path, may be repeated")
+	file, err := parseProposalArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	var write func(r *report.Report, w io.Writer) error
+	switch *format {
+	case "markdown":
+		write = (*report.Report).WriteMarkdown
+	case "html":
+		write = (*report.Report).WriteHTML
+	default:
+		return fmt.Errorf("unknown report format %q", *format)
+	}
+
+	var opts []report.Option
+	if len(abis) > 0 {
+		decoders, derr := buildDecoders(file.chainMetadata())
+		if derr != nil {
+			return derr
+		}
+		opts = append(opts, report.WithDecoders(decoders, abis))
+	}
+
+	var r *report.Report
+	if file.timelock != nil {
+		r, err = report.NewTimelockProposalReport(ctx, file.timelock, opts...)
+	} else {
+		r, err = report.NewProposalReport(file.proposal, opts...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return writeFile(*output, stdout, func(w io.Writer) error {
+		return write(r, w)
+	})
+}
+
+func runDiff(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("diff", "<old-proposal.json> <new-proposal.json>")
+	output := fs.String("output", "-", "write the Markdown diff to this file (- for stdout)")
+	abis := abiFlag{}
+	fs.Var(abis, "abi", "ABI (or Anchor IDL, Move function info) file of a contract type as ContractType=path, may be repeated")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return fmt.Errorf("expected an old and a new proposal file, got %d arguments", fs.NArg())
+	}
+
+	oldFile, err := readProposalFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	newFile, err := readProposalFile(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	if oldFile.kind() != newFile.kind() {
+		return fmt.Errorf("cannot diff a %s with a %s", oldFile.kind(), newFile.kind())
+	}
+
+	var opts []report.Option
+	if len(abis) > 0 {
+		metadata := maps.Clone(oldFile.chainMetadata())
+		maps.Copy(metadata, newFile.chainMetadata())
+		decoders, derr := buildDecoders(metadata)
+		if derr != nil {
+			return derr
+		}
+		opts = append(opts, report.WithDecoders(decoders, abis))
+	}
+
+	var diff *report.Diff
+	if oldFile.timelock != nil {
+		diff, err = report.NewTimelockProposalDiff(ctx, oldFile.timelock, newFile.timelock, opts...)
+	} else {
+		diff, err = report.NewProposalDiff(oldFile.proposal, newFile.proposal, opts...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return writeFile(*output, stdout, diff.WriteMarkdown)
+}
+
+// buildDecoders returns the decoder of the chain family of every chain of the proposal.
+func buildDecoders(metadata map[types.ChainSelector]types.ChainMetadata) (map[types.ChainSelector]sdk.Decoder, error) {
+	decoders := make(map[types.ChainSelector]sdk.Decoder, len(metadata))
+	for selector := range metadata {
+		family, err := types.GetChainSelectorFamily(selector)
+		if err != nil {
+			return nil, err
+		}
+
+		f, ok := sdk.LookupFamily(family)
+		if !ok || f.NewDecoder == nil {
+			return nil, fmt.Errorf("decoding is not supported for chain family %s", family)
+		}
+		decoders[selector] = f.NewDecoder()
+	}
+
+	return decoders, nil
+}
+
+// chainMetadata returns the chain metadata of the proposal.
+func (f *proposalFile) chainMetadata() map[types.ChainSelector]types.ChainMetadata {
+	if f.timelock != nil {
+		return f.timelock.ChainMetadata
+	}
+
+	return f.proposal.ChainMetadata
+}
+
+// signerFlags select the signer of the sign command. The private key is used unless a Ledger, a
+// Trezor, a keystore file or a remote signer is given.
+type signerFlags struct {
+	privateKey       *string
+	useLedger        *bool
+	useTrezor        *bool
+	trezorEmulator   *string
+	derivationPath   *string
+	keystore         *string
+	passwordFile     *string
+	remoteSigner     *string
+	signerAddress    *string
+	remoteSignMethod *string
+}
+
+func addSignerFlags(fs *flag.FlagSet) signerFlags {
+	return signerFlags{
+		privateKey:     fs.String("private-key", "", "hex encoded private key, defaults to $"+privateKeyEnv),
+		useLedger:      fs.Bool("ledger", false, "sign with a Ledger device instead of a private key"),
+		useTrezor:      fs.Bool("trezor", false, "sign with a Trezor device instead of a private key"),
+		trezorEmulator: fs.String("trezor-emulator", "", "sign with the Trezor emulator at this UDP address"),
+		derivationPath: fs.String("derivation-path", defaultDerivationPath, "derivation path of the Ledger or Trezor account"),
+		keystore:       fs.String("keystore", "", "sign with the key of this encrypted keystore file"),
+		passwordFile: fs.String("password-file", "", "file holding the keystore password, "+
+			"defaults to $"+keystorePasswordEnv+" or a prompt"),
+		remoteSigner:  fs.String("remote-signer", "", "sign with a Clef or Web3Signer daemon at this JSON-RPC URL"),
+		signerAddress: fs.String("signer-address", "", "account of the remote signer"),
+		remoteSignMethod: fs.String("remote-sign-method", string(mcms.RemoteSignMethodEthSign),
+			"JSON-RPC method of the remote signer: eth_sign, personal_sign or account_signData"),
+	}
+}
+
+// signer creates the selected signer. The returned function releases it.
+func (f signerFlags) signer(ctx context.Context) (mcms.Signer, func(), error) {
+	noop := func() {}
+
+	switch {
+	case *f.useLedger:
+		path, err := accounts.ParseDerivationPath(*f.derivationPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid derivation path: %w", err)
+		}
+
+		return mcms.NewLedgerSigner(path), noop, nil
+	case *f.useTrezor || *f.trezorEmulator != "":
+		path, err := accounts.ParseDerivationPath(*f.derivationPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid derivation path: %w", err)
+		}
+		opts := []mcms.TrezorSignerOption{
+			mcms.WithTrezorPIN(mcms.PasswordFromPrompt("trezor PIN (positions on the device pinpad): ")),
+			mcms.WithTrezorPassphrase(mcms.PasswordFromPrompt("trezor passphrase: ")),
+		}
+		if *f.trezorEmulator != "" {
+			opts = append(opts, mcms.WithTrezorEmulator(*f.trezorEmulator))
+		}
+
+		return mcms.NewTrezorSigner(path, opts...), noop, nil
+	case *f.keystore != "":
+		password := mcms.PasswordFromPrompt("keystore password: ")
+		if *f.passwordFile != "" {
+			password = mcms.PasswordFromFile(*f.passwordFile)
+		} else if _, ok := os.LookupEnv(keystorePasswordEnv); ok {
+			password = mcms.PasswordFromEnv(keystorePasswordEnv)
+		}
+		s, err := mcms.NewKeystoreSigner(*f.keystore, password)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return s, noop, nil
+	case *f.remoteSigner != "":
+		if !common.IsHexAddress(*f.signerAddress) {
+			return nil, nil, fmt.Errorf("invalid -signer-address %q", *f.signerAddress)
+		}
+		s, err := mcms.NewRemoteSigner(ctx, *f.remoteSigner, common.HexToAddress(*f.signerAddress),
+			mcms.RemoteSignMethod(*f.remoteSignMethod))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return s, s.Close, nil
+	default:
+		key, err := parsePrivateKey(*f.privateKey)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return mcms.NewPrivateKeySigner(key), noop, nil
+	}
+}
+
+// chainFlags are the flags shared by the commands that talk to chains.
+type chainFlags struct {
+	config     *string
+	privateKey *string
+}
+
+func addChainFlags(fs *flag.FlagSet, withSigner bool) chainFlags {
+	flags := chainFlags{config: fs.String("config", "", "chain RPC config file")}
+	if withSigner {
+		flags.privateKey = fs.String("private-key", "", "hex encoded private key of the transaction sender on EVM "+
+			"chains without a privateKeyEnv in the config, defaults to $"+privateKeyEnv)
+	}
+
+	return flags
+}
+
+// accessor dials the chains of the config file.
+func (c chainFlags) accessor(ctx context.Context) (*chainAccessor, error) {
+	if *c.config == "" {
+		return nil, errMissingFlag("config")
+	}
+
+	cfg, err := loadConfig(*c.config)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.privateKey == nil {
+		return newChainAccessor(ctx, cfg, nil)
+	}
+
+	return newChainAccessor(ctx, cfg, &senderKeys{evmKey: *c.privateKey})
+}
+
+func runCheckQuorum(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("check-quorum", "<proposal.json>")
+	chainFlags := addChainFlags(fs, false)
+	file, err := parseProposalArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	accessor, err := chainFlags.accessor(ctx)
+	if err != nil {
+		return err
+	}
+	defer accessor.close()
+
+	proposal, err := file.mcmsProposal(ctx)
+	if err != nil {
+		return err
+	}
+
+	inspectors, err := chainwrappers.BuildInspectors(accessor, proposal.ChainMetadata, file.action())
+	if err != nil {
+		return err
+	}
+
+	signable, err := mcms.NewSignable(proposal, inspectors)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, selector := range slices.Sorted(maps.Keys(proposal.ChainMetadata)) {
+		ok, qerr := signable.CheckQuorum(ctx, selector)
+		switch {
+		case qerr != nil:
+			errs = append(errs, fmt.Errorf("chain %d: %w", selector, qerr))
+		case !ok:
+			errs = append(errs, mcms.NewQuorumNotReachedError(selector))
+		default:
+			fmt.Fprintf(stdout, "chain %d: quorum reached\n", selector)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func runRebase(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("rebase", "<proposal.json>")
+	chainFlags := addChainFlags(fs, false)
+	output := fs.String("output", "", "write the rebased proposal to this file (- for stdout), defaults to the input file")
+	file, err := parseProposalArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	accessor, err := chainFlags.accessor(ctx)
+	if err != nil {
+		return err
+	}
+	defer accessor.close()
+
+	inspectors, err := chainwrappers.BuildInspectors(accessor, file.chainMetadata(), file.action())
+	if err != nil {
+		return err
+	}
+
+	var result *mcms.RebaseResult
+	if file.timelock != nil {
+		result, err = file.timelock.Rebase(ctx, inspectors)
+	} else {
+		result, err = file.proposal.Rebase(ctx, inspectors)
+	}
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		*output = file.path
+	}
+	if err = file.write(*output, stdout); err != nil {
+		return err
+	}
+
+	if *output != "-" {
+		if !result.SigningHashChanged() {
+			fmt.Fprintln(stdout, "proposal is already based on the on-chain op counts")
+			return nil
+		}
+		for _, chain := range result.Chains {
+			fmt.Fprintf(stdout, "chain %d: starting op count %d -> %d\n",
+				chain.ChainSelector, chain.OldStartingOpCount, chain.NewStartingOpCount)
+		}
+		fmt.Fprintf(stdout, "removed %d signatures, the proposal must be signed again\n", result.InvalidatedSignatures)
+	}
+
+	return nil
+}
+
+// newExecutable builds the executable of the proposal on the chains of the accessor.
+func newExecutable(
+	ctx context.Context, file *proposalFile, accessor chainwrappers.ChainAccessor, opts ...mcms.ExecutableOption,
+) (*mcms.Executable, error) {
+	proposal, err := file.mcmsProposal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	encoders, err := proposal.GetEncoders()
+	if err != nil {
+		return nil, err
+	}
+
+	executors, err := chainwrappers.BuildExecutors(accessor, proposal.ChainMetadata, encoders, file.action())
+	if err != nil {
+		return nil, err
+	}
+
+	return mcms.NewExecutable(proposal, executors, opts...)
+}
+
+// waitMined is the ConfirmFunc of the orchestrator, waiting for transactions to be included
+// successfully. The Solana and Stellar executors already wait for the confirmation of their
+// transactions.
+func waitMined(accessor *chainAccessor) mcms.ConfirmFunc {
+	return func(ctx context.Context, selector types.ChainSelector, result types.TransactionResult) error {
+		if client, ok := accessor.evmClients[uint64(selector)]; ok {
+			tx, ok := result.RawData.(*gethtypes.Transaction)
+			if !ok {
+				return waitMinedHash(ctx, client, selector, common.HexToHash(result.Hash))
+			}
+
+			receipt, err := bind.WaitMined(ctx, client, tx)
+			if err != nil {
+				return fmt.Errorf("failed to wait for transaction %s: %w", tx.Hash(), err)
+			}
+
+			return checkReceipt(receipt)
+		}
+
+		if client, ok := accessor.aptosClients[uint64(selector)]; ok {
+			tx, err := client.WaitForTransaction(result.Hash)
+			if err != nil {
+				return fmt.Errorf("failed to wait for transaction %s on chain %d: %w", result.Hash, selector, err)
+			}
+			if !tx.Success {
+				return fmt.Errorf("transaction %s failed: %s", result.Hash, tx.VmStatus)
+			}
+
+			return nil
+		}
+
+		if _, ok := accessor.solanaClients[uint64(selector)]; ok {
+			return nil
+		}
+		if _, ok := accessor.stellarClients[uint64(selector)]; ok {
+			return nil
+		}
+
+		return fmt.Errorf("missing client for chain selector %d", selector)
+	}
+}
+
+func waitMinedHash(ctx context.Context, client bind.DeployBackend, selector types.ChainSelector, hash common.Hash) error {
+	receipt, err := bind.WaitMinedHash(ctx, client, hash)
+	if err != nil {
+		return fmt.Errorf("failed to wait for transaction %s on chain %d: %w", hash, selector, err)
+	}
+
+	return checkReceipt(receipt)
+}
+
+func checkReceipt(receipt *gethtypes.Receipt) error {
+	if receipt.Status != gethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s reverted", receipt.TxHash)
+	}
+
+	return nil
+}
+
+func runSetRoot(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("set-root", "<proposal.json>")
+	chainFlags := addChainFlags(fs, true)
+	selectorFlag := fs.Uint64("chain", 0, "only set the root on this chain selector")
+	file, err := parseProposalArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	accessor, err := chainFlags.accessor(ctx)
+	if err != nil {
+		return err
+	}
+	defer accessor.close()
+
+	executable, err := newExecutable(ctx, file, accessor)
+	if err != nil {
+		return err
+	}
+
+	selectors := slices.Sorted(maps.Keys(file.chainMetadata()))
+	if *selectorFlag != 0 {
+		selector := types.ChainSelector(*selectorFlag)
+		if !slices.Contains(selectors, selector) {
+			return fmt.Errorf("chain selector %d is not part of the proposal", selector)
+		}
+		selectors = []types.ChainSelector{selector}
+	}
+
+	confirm := waitMined(accessor)
+	for _, selector := range selectors {
+		result, serr := executable.SetRoot(ctx, selector)
+		if serr != nil {
+			return fmt.Errorf("failed to set root on chain %d: %w", selector, serr)
+		}
+		if serr = confirm(ctx, selector, result); serr != nil {
+			return serr
+		}
+		fmt.Fprintf(stdout, "chain %d: root set in transaction %s\n", selector, result.Hash)
+	}
+
+	return nil
+}
+
+// orchestratorFlags are the flags controlling the orchestrator.
+type orchestratorFlags struct {
+	journalDir *string
+	parallel   *int
+	retries    *int
+	retryDelay *time.Duration
+}
+
+func addOrchestratorFlags(fs *flag.FlagSet) orchestratorFlags {
+	return orchestratorFlags{
+		journalDir: fs.String("journal", "", "directory of the execution journal, allowing interrupted runs to be resumed"),
+		parallel:   fs.Int("parallel", 0, "maximum number of chains executed in parallel, 0 for all"),
+		retries:    fs.Int("retries", 0, "number of retries of a failed step"),
+		retryDelay: fs.Duration("retry-delay", 5*time.Second, "delay between retries"),
+	}
+}
+
+// executableOptions returns the journal options of the executables. Journaled transactions which
+// reverted or were dropped are sent again.
+func (f orchestratorFlags) executableOptions(accessor *chainAccessor) ([]mcms.ExecutableOption, error) {
+	if *f.journalDir == "" {
+		return nil, nil
+	}
+
+	journal, err := mcms.NewFileJournal(*f.journalDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcms.ExecutableOption{
+		mcms.WithJournal(journal),
+		mcms.WithTransactionCheckers(accessor.transactionCheckers()),
+	}, nil
+}
+
+func (f orchestratorFlags) options(stdout io.Writer, accessor *chainAccessor) []mcms.OrchestratorOption {
+	opts := []mcms.OrchestratorOption{
+		mcms.WithRetries(*f.retries, *f.retryDelay),
+		mcms.WithConfirmFunc(waitMined(accessor)),
+		mcms.WithProgressFunc(func(step mcms.ExecutionStep) { printStep(stdout, step) }),
+	}
+	if *f.parallel > 0 {
+		opts = append(opts, mcms.WithMaxParallelChains(*f.parallel))
+	}
+
+	return opts
+}
+
+func printStep(w io.Writer, step mcms.ExecutionStep) {
+	msg := fmt.Sprintf("chain %d: %s", step.ChainSelector, step.Kind)
+	if step.OpIndex >= 0 {
+		msg += fmt.Sprintf(" operation %d", step.OpIndex)
+	}
+	msg += ": " + string(step.Status)
+	if step.Result.Hash != "" {
+		msg += " (" + step.Result.Hash + ")"
+	}
+	if step.Err != nil {
+		msg += ": " + step.Err.Error()
+	}
+
+	fmt.Fprintln(w, msg)
+}
+
+func runExecute(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("execute", "<proposal.json>")
+	chainFlags := addChainFlags(fs, true)
+	orchestratorFlags := addOrchestratorFlags(fs)
+	file, err := parseProposalArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	accessor, err := chainFlags.accessor(ctx)
+	if err != nil {
+		return err
+	}
+	defer accessor.close()
+
+	executableOpts, err := orchestratorFlags.executableOptions(accessor)
+	if err != nil {
+		return err
+	}
+
+	executable, err := newExecutable(ctx, file, accessor, executableOpts...)
+	if err != nil {
+		return err
+	}
+
+	orchestrator, err := mcms.NewOrchestrator(executable, orchestratorFlags.options(stdout, accessor)...)
+	if err != nil {
+		return err
+	}
+
+	runReport, err := orchestrator.Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	return runReport.Err()
+}
+
+func runTimelockExecute(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("timelock-execute", "<timelock-proposal.json>")
+	chainFlags := addChainFlags(fs, true)
+	orchestratorFlags := addOrchestratorFlags(fs)
+	callProxy := fs.String("call-proxy", "", "address of the call proxy to execute through")
+	file, err := parseProposalArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if file.timelock == nil {
+		return fmt.Errorf("%s is not a timelock proposal", file.path)
+	}
+	if file.timelock.Action != types.TimelockActionSchedule {
+		return fmt.Errorf("only scheduled operations can be executed, the proposal action is %s", file.timelock.Action)
+	}
+
+	accessor, err := chainFlags.accessor(ctx)
+	if err != nil {
+		return err
+	}
+	defer accessor.close()
+
+	executableOpts, err := orchestratorFlags.executableOptions(accessor)
+	if err != nil {
+		return err
+	}
+
+	executable, err := newExecutable(ctx, file, accessor, executableOpts...)
+	if err != nil {
+		return err
+	}
+
+	timelockExecutors, err := chainwrappers.BuildTimelockExecutors(accessor, file.timelock.ChainMetadata, file.timelock.Action)
+	if err != nil {
+		return err
+	}
+
+	timelockExecutable, err := mcms.NewTimelockExecutable(ctx, file.timelock, timelockExecutors, executableOpts...)
+	if err != nil {
+		return err
+	}
+
+	var timelockOpts []mcms.Option
+	if *callProxy != "" {
+		timelockOpts = append(timelockOpts, mcms.WithCallProxy(*callProxy))
+	}
+
+	opts := append(orchestratorFlags.options(stdout, accessor), mcms.WithTimelockExecutable(timelockExecutable, timelockOpts...))
+	orchestrator, err := mcms.NewOrchestrator(executable, opts...)
+	if err != nil {
+		return err
+	}
+
+	runReport, err := orchestrator.Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	return runReport.Err()
+}
@@ -0,0 +1,414 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+
+	aptoslib "github.com/aptos-labs/aptos-go-sdk"
+	aptoscrypto "github.com/aptos-labs/aptos-go-sdk/crypto"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	sol "github.com/gagliardetto/solana-go"
+	solrpc "github.com/gagliardetto/solana-go/rpc"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/stellar/go-stellar-sdk/clients/rpcclient"
+	"github.com/stellar/go-stellar-sdk/keypair"
+	"github.com/xssnick/tonutils-go/ton"
+	tonwallet "github.com/xssnick/tonutils-go/ton/wallet"
+
+	cslclient "github.com/smartcontractkit/chainlink-sui/relayer/client"
+
+	"github.com/smartcontractkit/mcms/chainwrappers"
+	"github.com/smartcontractkit/mcms/sdk"
+	cantonsdk "github.com/smartcontractkit/mcms/sdk/canton"
+	evmsdk "github.com/smartcontractkit/mcms/sdk/evm"
+	stellarsdk "github.com/smartcontractkit/mcms/sdk/stellar"
+	suisdk "github.com/smartcontractkit/mcms/sdk/sui"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// privateKeyEnv is the environment variable read when no -private-key flag is given.
+const privateKeyEnv = "MCMS_PRIVATE_KEY"
+
+// keystorePasswordEnv is the environment variable read for the keystore password when no
+// -password-file flag is given.
+const keystorePasswordEnv = "MCMS_KEYSTORE_PASSWORD"
+
+// Config is the chain RPC configuration file read by the commands that talk to chains.
+//
+//	{
+//	  "chains": [
+//	    {"selector": 16015286601757825753, "rpcURL": "https://sepolia.example.com"},
+//	    {"selector": 16423721717087811551, "rpcURL": "https://api.devnet.solana.com", "privateKeyEnv": "SOLANA_KEY"}
+//	  ]
+//	}
+type Config struct {
+	Chains []ChainConfig `json:"chains"`
+}
+
+// ChainConfig is the configuration of a single chain.
+type ChainConfig struct {
+	Selector types.ChainSelector `json:"selector"`
+	RPCURL   string              `json:"rpcURL"`
+	// PrivateKeyEnv is the environment variable holding the key of the transaction sender, read by
+	// the commands sending transactions: a hex encoded secp256k1 key on EVM chains, a base58
+	// encoded keypair on Solana, a hex encoded Ed25519 key on Aptos and a secret seed on Stellar.
+	// EVM chains default to the -private-key flag.
+	PrivateKeyEnv string `json:"privateKeyEnv,omitempty"`
+}
+
+// loadConfig reads and validates the configuration file at path.
+func loadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var cfg Config
+	if err = json.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode config %s: %w", path, err)
+	}
+
+	seen := make(map[types.ChainSelector]struct{}, len(cfg.Chains))
+	for _, chain := range cfg.Chains {
+		if _, ok := seen[chain.Selector]; ok {
+			return nil, fmt.Errorf("duplicate chain selector %d in config", chain.Selector)
+		}
+		seen[chain.Selector] = struct{}{}
+
+		family, err := types.GetChainSelectorFamily(chain.Selector)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := sdk.LookupFamily(family); !ok {
+			return nil, fmt.Errorf("chain selector %d: chain family %s is not registered", chain.Selector, family)
+		}
+		if _, ok := chainDialers[family]; !ok {
+			return nil, fmt.Errorf("chain selector %d: chain family %s is not supported by the CLI", chain.Selector, family)
+		}
+		if chain.RPCURL == "" {
+			return nil, fmt.Errorf("chain selector %d: missing rpcURL", chain.Selector)
+		}
+	}
+
+	return &cfg, nil
+}
+
+// parsePrivateKey parses a hex encoded private key, falling back to the MCMS_PRIVATE_KEY
+// environment variable when hexKey is empty.
+func parsePrivateKey(hexKey string) (*ecdsa.PrivateKey, error) {
+	if hexKey == "" {
+		hexKey = os.Getenv(privateKeyEnv)
+	}
+	if hexKey == "" {
+		return nil, fmt.Errorf("no private key given: set -private-key or %s", privateKeyEnv)
+	}
+
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	return key, nil
+}
+
+var _ chainwrappers.ChainAccessor = (*chainAccessor)(nil)
+var _ chainwrappers.StellarChainAccessor = (*chainAccessor)(nil)
+
+// chainAccessor is the chainwrappers.ChainAccessor of the CLI, backed by the chains of the config
+// file. The executors, inspectors and simulators of the chains are built by the chain families
+// registered in chainwrappers.
+type chainAccessor struct {
+	evmClients     map[uint64]*ethclient.Client
+	evmSigners     map[uint64]*bind.TransactOpts
+	solanaClients  map[uint64]*solrpc.Client
+	solanaSigners  map[uint64]*sol.PrivateKey
+	aptosClients   map[uint64]*aptoslib.Client
+	aptosSigners   map[uint64]*aptoslib.Account
+	stellarClients map[uint64]*rpcclient.Client
+	stellarSigners map[uint64]*keypair.Full
+}
+
+// senderKeys are the keys of the transaction senders of the commands sending transactions.
+type senderKeys struct {
+	// evmKey is the hex encoded key of the -private-key flag, used on the EVM chains without a
+	// privateKeyEnv.
+	evmKey string
+}
+
+// chainDialer connects a chain of the config, and creates its transaction sender when keys is not
+// nil.
+type chainDialer func(ctx context.Context, a *chainAccessor, chain ChainConfig, keys *senderKeys) error
+
+// chainDialers are the chain families supported by the CLI, keyed by chain-selectors family name.
+var chainDialers = map[string]chainDialer{
+	chainsel.FamilyEVM:     dialEVM,
+	chainsel.FamilySolana:  dialSolana,
+	chainsel.FamilyAptos:   dialAptos,
+	chainsel.FamilyStellar: dialStellar,
+}
+
+// newChainAccessor dials every chain of the config. If keys is not nil, transaction senders are
+// created for every chain as well.
+func newChainAccessor(ctx context.Context, cfg *Config, keys *senderKeys) (*chainAccessor, error) {
+	accessor := &chainAccessor{
+		evmClients:     make(map[uint64]*ethclient.Client),
+		evmSigners:     make(map[uint64]*bind.TransactOpts),
+		solanaClients:  make(map[uint64]*solrpc.Client),
+		solanaSigners:  make(map[uint64]*sol.PrivateKey),
+		aptosClients:   make(map[uint64]*aptoslib.Client),
+		aptosSigners:   make(map[uint64]*aptoslib.Account),
+		stellarClients: make(map[uint64]*rpcclient.Client),
+		stellarSigners: make(map[uint64]*keypair.Full),
+	}
+
+	for _, chain := range cfg.Chains {
+		family, err := types.GetChainSelectorFamily(chain.Selector)
+		if err != nil {
+			return nil, err
+		}
+		dial, ok := chainDialers[family]
+		if !ok {
+			return nil, fmt.Errorf("chain selector %d: chain family %s is not supported by the CLI", chain.Selector, family)
+		}
+
+		if err = dial(ctx, accessor, chain, keys); err != nil {
+			accessor.close()
+			return nil, err
+		}
+	}
+
+	return accessor, nil
+}
+
+// senderKey returns the key in the privateKeyEnv variable of the chain, or fallback if the chain
+// has none.
+func senderKey(chain ChainConfig, fallback string) (string, error) {
+	if chain.PrivateKeyEnv == "" {
+		return fallback, nil
+	}
+
+	key := os.Getenv(chain.PrivateKeyEnv)
+	if key == "" {
+		return "", fmt.Errorf("chain selector %d: %s is not set", chain.Selector, chain.PrivateKeyEnv)
+	}
+
+	return key, nil
+}
+
+func dialEVM(ctx context.Context, a *chainAccessor, chain ChainConfig, keys *senderKeys) error {
+	selector := uint64(chain.Selector)
+
+	client, err := ethclient.DialContext(ctx, chain.RPCURL)
+	if err != nil {
+		return fmt.Errorf("failed to dial chain %d: %w", selector, err)
+	}
+	a.evmClients[selector] = client
+
+	if keys == nil {
+		return nil
+	}
+
+	hexKey, err := senderKey(chain, keys.evmKey)
+	if err != nil {
+		return err
+	}
+	key, err := parsePrivateKey(hexKey)
+	if err != nil {
+		return err
+	}
+	chainID, err := chainsel.ChainIdFromSelector(selector)
+	if err != nil {
+		return err
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, new(big.Int).SetUint64(chainID))
+	if err != nil {
+		return fmt.Errorf("failed to create signer for chain %d: %w", selector, err)
+	}
+	a.evmSigners[selector] = auth
+
+	return nil
+}
+
+func dialSolana(_ context.Context, a *chainAccessor, chain ChainConfig, keys *senderKeys) error {
+	selector := uint64(chain.Selector)
+	a.solanaClients[selector] = solrpc.New(chain.RPCURL)
+
+	if keys == nil {
+		return nil
+	}
+
+	encoded, err := senderKey(chain, "")
+	if err != nil {
+		return err
+	}
+	if encoded == "" {
+		return fmt.Errorf("chain selector %d: missing privateKeyEnv", selector)
+	}
+	key, err := sol.PrivateKeyFromBase58(encoded)
+	if err != nil {
+		return fmt.Errorf("chain selector %d: invalid private key: %w", selector, err)
+	}
+	a.solanaSigners[selector] = &key
+
+	return nil
+}
+
+func dialAptos(_ context.Context, a *chainAccessor, chain ChainConfig, keys *senderKeys) error {
+	selector := uint64(chain.Selector)
+
+	client, err := aptoslib.NewClient(aptoslib.NetworkConfig{NodeUrl: chain.RPCURL})
+	if err != nil {
+		return fmt.Errorf("failed to dial chain %d: %w", selector, err)
+	}
+	a.aptosClients[selector] = client
+
+	if keys == nil {
+		return nil
+	}
+
+	hexKey, err := senderKey(chain, "")
+	if err != nil {
+		return err
+	}
+	if hexKey == "" {
+		return fmt.Errorf("chain selector %d: missing privateKeyEnv", selector)
+	}
+	key := &aptoscrypto.Ed25519PrivateKey{}
+	if err = key.FromHex(hexKey); err != nil {
+		return fmt.Errorf("chain selector %d: invalid private key: %w", selector, err)
+	}
+	account, err := aptoslib.NewAccountFromSigner(key)
+	if err != nil {
+		return fmt.Errorf("failed to create signer for chain %d: %w", selector, err)
+	}
+	a.aptosSigners[selector] = account
+
+	return nil
+}
+
+func dialStellar(_ context.Context, a *chainAccessor, chain ChainConfig, keys *senderKeys) error {
+	selector := uint64(chain.Selector)
+	a.stellarClients[selector] = rpcclient.NewClient(chain.RPCURL, nil)
+
+	if keys == nil {
+		return nil
+	}
+
+	seed, err := senderKey(chain, "")
+	if err != nil {
+		return err
+	}
+	if seed == "" {
+		return fmt.Errorf("chain selector %d: missing privateKeyEnv", selector)
+	}
+	key, err := keypair.ParseFull(seed)
+	if err != nil {
+		return fmt.Errorf("chain selector %d: invalid private key: %w", selector, err)
+	}
+	a.stellarSigners[selector] = key
+
+	return nil
+}
+
+func (a *chainAccessor) Selectors() []uint64 {
+	var selectors []uint64
+	selectors = slices.AppendSeq(selectors, maps.Keys(a.evmClients))
+	selectors = slices.AppendSeq(selectors, maps.Keys(a.solanaClients))
+	selectors = slices.AppendSeq(selectors, maps.Keys(a.aptosClients))
+	selectors = slices.AppendSeq(selectors, maps.Keys(a.stellarClients))
+	slices.Sort(selectors)
+
+	return selectors
+}
+
+func (a *chainAccessor) EVMClient(selector uint64) (evmsdk.ContractDeployBackend, bool) {
+	client, ok := a.evmClients[selector]
+	if !ok {
+		return nil, false
+	}
+
+	return client, true
+}
+
+func (a *chainAccessor) EVMSigner(selector uint64) (*evmsdk.TransactOpts, bool) {
+	auth, ok := a.evmSigners[selector]
+	return auth, ok
+}
+
+func (a *chainAccessor) SolanaClient(selector uint64) (*solrpc.Client, bool) {
+	client, ok := a.solanaClients[selector]
+	return client, ok
+}
+
+func (a *chainAccessor) SolanaSigner(selector uint64) (*sol.PrivateKey, bool) {
+	key, ok := a.solanaSigners[selector]
+	return key, ok
+}
+
+func (a *chainAccessor) AptosClient(selector uint64) (aptoslib.AptosRpcClient, bool) {
+	client, ok := a.aptosClients[selector]
+	if !ok {
+		return nil, false
+	}
+
+	return client, true
+}
+
+func (a *chainAccessor) AptosSigner(selector uint64) (aptoslib.TransactionSigner, bool) {
+	account, ok := a.aptosSigners[selector]
+	if !ok {
+		return nil, false
+	}
+
+	return account, true
+}
+
+func (a *chainAccessor) StellarClient(selector uint64) (stellarsdk.RPCClient, bool) {
+	client, ok := a.stellarClients[selector]
+	if !ok {
+		return nil, false
+	}
+
+	return client, true
+}
+
+func (a *chainAccessor) StellarSigner(selector uint64) (*keypair.Full, bool) {
+	key, ok := a.stellarSigners[selector]
+	return key, ok
+}
+
+func (a *chainAccessor) SuiClient(uint64) (cslclient.BindingsClient, bool) { return nil, false }
+func (a *chainAccessor) SuiSigner(uint64) (suisdk.SuiSigner, bool)         { return nil, false }
+func (a *chainAccessor) TonClient(uint64) (ton.APIClientWrapped, bool)     { return nil, false }
+func (a *chainAccessor) TonSigner(uint64) (*tonwallet.Wallet, bool)        { return nil, false }
+func (a *chainAccessor) CantonChain(uint64) (cantonsdk.Chain, bool)        { return cantonsdk.Chain{}, false }
+
+// transactionCheckers returns the transaction checkers of the chains of the accessor, used to
+// reconcile the execution journal with the transactions which reverted or were dropped.
+func (a *chainAccessor) transactionCheckers() map[types.ChainSelector]sdk.TransactionChecker {
+	checkers := make(map[types.ChainSelector]sdk.TransactionChecker, len(a.evmClients))
+	for selector, client := range a.evmClients {
+		checkers[types.ChainSelector(selector)] = evmsdk.NewTransactionChecker(client)
+	}
+
+	return checkers
+}
+
+// close closes every client of the accessor.
+func (a *chainAccessor) close() {
+	for _, client := range a.evmClients {
+		client.Close()
+	}
+	for _, client := range a.solanaClients {
+		_ = client.Close()
+	}
+}
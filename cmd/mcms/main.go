@@ -0,0 +1,87 @@
+// Command mcms manages the lifecycle of MCMS proposals: building, validating, signing, checking
+// quorum, decoding, diffing, converting, rebasing, setting roots and executing.
+//
+// Usage:
+//
+//	mcms <command> [flags]
+//
+// Run "mcms help" for the list of commands, and "mcms <command> -h" for the flags of a command.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+)
+
+// command is a single mcms subcommand.
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, args []string, stdout io.Writer) error
+}
+
+var commands = []command{
+	{name: "build", summary: "build a proposal from a file of operations", run: runBuild},
+	{name: "validate", summary: "validate a proposal file", run: runValidate},
+	{name: "hash", summary: "print the signing hash of a proposal", run: runHash},
+	{name: "sign", summary: "sign a proposal with a private key, a keystore, a Ledger, a Trezor or a remote signer", run: runSign},
+	{name: "merge-signatures", summary: "merge detached signature envelopes into a proposal", run: runMergeSignatures},
+	{name: "check-quorum", summary: "check that the proposal signatures reach quorum on every chain", run: runCheckQuorum},
+	{name: "decode", summary: "decode the operations of a proposal", run: runDecode},
+	{name: "report", summary: "render a Markdown or HTML report of a proposal for review", run: runReport},
+	{name: "diff", summary: "render a Markdown diff of two versions of a proposal", run: runDiff},
+	{name: "convert", summary: "convert a timelock proposal to an MCMS proposal", run: runConvert},
+	{name: "rebase", summary: "rebase a proposal onto the current on-chain op counts", run: runRebase},
+	{name: "set-root", summary: "set the proposal root on chain", run: runSetRoot},
+	{name: "execute", summary: "set roots and execute the operations of a proposal", run: runExecute},
+	{name: "timelock-execute", summary: "execute the scheduled operations of a timelock proposal", run: runTimelockExecute},
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+// run dispatches the arguments to the matching command.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage(stderr)
+		return nil
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(ctx, args[1:], stdout)
+		}
+	}
+
+	printUsage(stderr)
+
+	return fmt.Errorf("unknown command %q", args[0])
+}
+
+func printUsage(w io.Writer) {
+	var b strings.Builder
+	b.WriteString("Usage: mcms <command> [flags]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(&b, "  %-18s %s\n", cmd.name, cmd.summary)
+	}
+	b.WriteString("\nRun \"mcms <command> -h\" for the flags of a command.\n")
+
+	fmt.Fprint(w, b.String())
+}
+
+// errMissingFlag is returned when a required flag is not set.
+func errMissingFlag(name string) error {
+	return errors.New("missing required flag -" + name)
+}
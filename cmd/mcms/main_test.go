@@ -0,0 +1,463 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	sol "github.com/gagliardetto/solana-go"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms"
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/internal/testutils/trezoremu"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func writeTestProposal(t *testing.T) string {
+	t.Helper()
+
+	proposal := &mcms.Proposal{
+		BaseProposal: mcms.BaseProposal{
+			Version:    "v1",
+			Kind:       types.KindProposal,
+			ValidUntil: 2004259681,
+			ChainMetadata: map[types.ChainSelector]types.ChainMetadata{
+				chaintest.Chain1Selector: {MCMAddress: "0x0000000000000000000000000000000000000001"},
+			},
+			Signatures: []types.Signature{},
+		},
+		Operations: []types.Operation{{
+			ChainSelector: chaintest.Chain1Selector,
+			Transaction:   evm.NewTransaction(common.HexToAddress("0x1234"), []byte{0x01}, big.NewInt(0), "", nil),
+		}},
+	}
+
+	path := filepath.Join(t.TempDir(), "proposal.json")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, mcms.WriteProposal(f, proposal))
+
+	return path
+}
+
+func writeTestTimelockProposal(t *testing.T) string {
+	t.Helper()
+
+	proposal := &mcms.TimelockProposal{
+		BaseProposal: mcms.BaseProposal{
+			Version:    "v1",
+			Kind:       types.KindTimelockProposal,
+			ValidUntil: 2004259681,
+			ChainMetadata: map[types.ChainSelector]types.ChainMetadata{
+				chaintest.Chain1Selector: {MCMAddress: "0x0000000000000000000000000000000000000001"},
+			},
+			Signatures: []types.Signature{},
+		},
+		Action: types.TimelockActionSchedule,
+		Delay:  types.MustParseDuration("1h"),
+		TimelockAddresses: map[types.ChainSelector]string{
+			chaintest.Chain1Selector: "0x0000000000000000000000000000000000000002",
+		},
+		Operations: []types.BatchOperation{{
+			ChainSelector: chaintest.Chain1Selector,
+			Transactions: []types.Transaction{
+				evm.NewTransaction(common.HexToAddress("0x1234"), []byte{0x01}, big.NewInt(0), "", nil),
+			},
+		}},
+	}
+
+	path := filepath.Join(t.TempDir(), "timelock-proposal.json")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, mcms.WriteTimelockProposal(f, proposal))
+
+	return path
+}
+
+func runCommand(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	err := run(t.Context(), args, &stdout, &stderr)
+
+	return stdout.String(), err
+}
+
+func TestRun_Usage(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+	require.NoError(t, run(t.Context(), nil, &stdout, &stderr))
+	require.Contains(t, stderr.String(), "Usage: mcms <command> [flags]")
+
+	err := run(t.Context(), []string{"unknown"}, &stdout, &stderr)
+	require.EqualError(t, err, `unknown command "unknown"`)
+}
+
+func TestRun_Validate(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestProposal(t)
+	out, err := runCommand(t, "validate", path)
+	require.NoError(t, err)
+	require.Equal(t, "Proposal "+path+" is valid\n", out)
+
+	path = writeTestTimelockProposal(t)
+	out, err = runCommand(t, "validate", path)
+	require.NoError(t, err)
+	require.Equal(t, "TimelockProposal "+path+" is valid\n", out)
+
+	invalid := filepath.Join(t.TempDir(), "invalid.json")
+	require.NoError(t, os.WriteFile(invalid, []byte(`{"kind":"Proposal"}`), 0o600))
+	_, err = runCommand(t, "validate", invalid)
+	require.ErrorContains(t, err, "invalid proposal "+invalid)
+
+	_, err = runCommand(t, "validate")
+	require.EqualError(t, err, "expected a single proposal file, got 0 arguments")
+}
+
+func TestRun_SignAndMerge(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestProposal(t)
+	dir := t.TempDir()
+
+	key1, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key2, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	hexKey := func(key *ecdsa.PrivateKey) string {
+		return common.Bytes2Hex(crypto.FromECDSA(key))
+	}
+
+	// Detached envelopes are collected and merged into the proposal
+	envelope1 := filepath.Join(dir, "signer1.json")
+	envelope2 := filepath.Join(dir, "signer2.json")
+	_, err = runCommand(t, "sign", "-private-key", hexKey(key1), "-envelope", envelope1, path)
+	require.NoError(t, err)
+	_, err = runCommand(t, "sign", "-private-key", "0x"+hexKey(key2), "-envelope", envelope2, path)
+	require.NoError(t, err)
+
+	out, err := runCommand(t, "merge-signatures", path, envelope1, envelope2)
+	require.NoError(t, err)
+	require.Equal(t, "merged 2 signatures into "+path+"\n", out)
+
+	file, err := readProposalFile(path)
+	require.NoError(t, err)
+	signers, err := file.proposal.RecoverSigningAddressesStrict()
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{crypto.PubkeyToAddress(key1.PublicKey), crypto.PubkeyToAddress(key2.PublicKey)}, signers)
+
+	// Merging the same envelope twice is rejected
+	_, err = runCommand(t, "merge-signatures", path, envelope1)
+	require.ErrorContains(t, err, "duplicate signer detected")
+
+	// Signing in place appends the signature to the proposal
+	path = writeTestTimelockProposal(t)
+	out, err = runCommand(t, "sign", "-private-key", hexKey(key1), path)
+	require.NoError(t, err)
+	require.Equal(t, "added signature of "+crypto.PubkeyToAddress(key1.PublicKey).Hex()+" to "+path+"\n", out)
+
+	file, err = readProposalFile(path)
+	require.NoError(t, err)
+	require.Len(t, file.timelock.Signatures, 1)
+
+	_, err = runCommand(t, "sign", "-private-key", "zz", path)
+	require.ErrorContains(t, err, "invalid private key")
+}
+
+func TestRun_SignWithKeystore(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         uuid.New(),
+		Address:    crypto.PubkeyToAddress(key.PublicKey),
+		PrivateKey: key,
+	}, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	keystorePath := filepath.Join(dir, "key.json")
+	require.NoError(t, os.WriteFile(keystorePath, keyJSON, 0o600))
+	passwordPath := filepath.Join(dir, "password.txt")
+	require.NoError(t, os.WriteFile(passwordPath, []byte("secret\n"), 0o600))
+
+	path := writeTestProposal(t)
+	out, err := runCommand(t, "sign", "-keystore", keystorePath, "-password-file", passwordPath, path)
+	require.NoError(t, err)
+	require.Equal(t, "added signature of "+crypto.PubkeyToAddress(key.PublicKey).Hex()+" to "+path+"\n", out)
+
+	_, err = runCommand(t, "sign", "-remote-signer", "http://localhost:8550", "-signer-address", "0x12", path)
+	require.EqualError(t, err, `invalid -signer-address "0x12"`)
+}
+
+func TestRun_SignWithTrezorEmulator(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	emulator := trezoremu.New(t, key)
+
+	path := writeTestProposal(t)
+	out, err := runCommand(t, "sign", "-trezor-emulator", emulator.Addr(), path)
+	require.NoError(t, err)
+	require.Equal(t, "added signature of "+crypto.PubkeyToAddress(key.PublicKey).Hex()+" to "+path+"\n", out)
+
+	paths, _ := emulator.SignRequests()
+	require.Equal(t, []accounts.DerivationPath{accounts.DefaultBaseDerivationPath}, paths)
+
+	_, err = runCommand(t, "sign", "-trezor", "-derivation-path", "m/x", path)
+	require.ErrorContains(t, err, "invalid derivation path")
+}
+
+func TestRun_Hash(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestProposal(t)
+	out, err := runCommand(t, "hash", path)
+	require.NoError(t, err)
+
+	file, err := readProposalFile(path)
+	require.NoError(t, err)
+	hash, err := file.proposal.SigningHash()
+	require.NoError(t, err)
+	require.Contains(t, out, `"signingHash": "`+hash.Hex()+`"`)
+}
+
+func TestRun_Convert(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestTimelockProposal(t)
+	output := filepath.Join(t.TempDir(), "converted.json")
+	_, err := runCommand(t, "convert", "-output", output, path)
+	require.NoError(t, err)
+
+	converted, err := readProposalFile(output)
+	require.NoError(t, err)
+	require.Equal(t, types.KindProposal, converted.kind())
+	require.Len(t, converted.proposal.Operations, 1)
+
+	_, err = runCommand(t, "convert", writeTestProposal(t))
+	require.ErrorContains(t, err, "is not a timelock proposal")
+}
+
+func TestRun_Report(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestTimelockProposal(t)
+	out, err := runCommand(t, "report", path)
+	require.NoError(t, err)
+	require.Contains(t, out, "# TimelockProposal\n")
+	require.Contains(t, out, "| Timelock action | schedule |\n")
+
+	out, err = runCommand(t, "report", "-format", "html", path)
+	require.NoError(t, err)
+	require.Contains(t, out, "<h1>TimelockProposal</h1>")
+
+	_, err = runCommand(t, "report", "-format", "pdf", path)
+	require.EqualError(t, err, `unknown report format "pdf"`)
+}
+
+func TestRun_Diff(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestProposal(t)
+	file, err := readProposalFile(path)
+	require.NoError(t, err)
+	file.proposal.ChainMetadata[chaintest.Chain1Selector] = types.ChainMetadata{
+		MCMAddress:      "0x0000000000000000000000000000000000000001",
+		StartingOpCount: 2,
+	}
+	newPath := filepath.Join(t.TempDir(), "new-proposal.json")
+	f, err := os.Create(newPath)
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, mcms.WriteProposal(f, file.proposal))
+
+	out, err := runCommand(t, "diff", path, newPath)
+	require.NoError(t, err)
+	require.Contains(t, out, "# Proposal diff\n")
+	require.Contains(t, out, "**The signing hash changed.**\n")
+	require.Contains(t, out, "| Starting op count | `0` | `2` |\n")
+
+	out, err = runCommand(t, "diff", path, path)
+	require.NoError(t, err)
+	require.Contains(t, out, "No changes.\n")
+
+	_, err = runCommand(t, "diff", path, writeTestTimelockProposal(t))
+	require.EqualError(t, err, "cannot diff a Proposal with a TimelockProposal")
+
+	_, err = runCommand(t, "diff", path)
+	require.EqualError(t, err, "expected an old and a new proposal file, got 1 arguments")
+}
+
+func TestRun_Build(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tx := evm.NewTransaction(common.HexToAddress("0x1234"), []byte{0x01}, big.NewInt(0), "", nil)
+	write := func(name string, v any) string {
+		b, err := json.Marshal(v)
+		require.NoError(t, err)
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, b, 0o600))
+
+		return path
+	}
+	ops := write("ops.json", []types.Operation{{ChainSelector: chaintest.Chain1Selector, Transaction: tx}})
+	bops := write("bops.json", []types.BatchOperation{{ChainSelector: chaintest.Chain1Selector, Transactions: []types.Transaction{tx}}})
+	mcmFlag := "-mcm=3379446385462418246=0x0000000000000000000000000000000000000001"
+
+	// The predecessor uses op count 0 of the MCM, so the proposal starts at 1
+	output := filepath.Join(dir, "proposal.json")
+	_, err := runCommand(t, "build", mcmFlag, "-predecessor", writeTestProposal(t), "-valid-until", "2004259681",
+		"-description", "built", "-output", output, ops)
+	require.NoError(t, err)
+	file, err := readProposalFile(output)
+	require.NoError(t, err)
+	require.NotNil(t, file.proposal)
+	require.Equal(t, "built", file.proposal.Description)
+	require.Equal(t, uint32(2004259681), file.proposal.ValidUntil)
+	require.Equal(t, types.ChainMetadata{
+		MCMAddress:      "0x0000000000000000000000000000000000000001",
+		StartingOpCount: 1,
+	}, file.proposal.ChainMetadata[chaintest.Chain1Selector])
+	require.Len(t, file.proposal.Operations, 1)
+
+	output = filepath.Join(dir, "timelock-proposal.json")
+	_, err = runCommand(t, "build", mcmFlag, "-timelock=3379446385462418246=0x0000000000000000000000000000000000000002",
+		"-timelock-action", "schedule", "-delay", "1h", "-output", output, bops)
+	require.NoError(t, err)
+	file, err = readProposalFile(output)
+	require.NoError(t, err)
+	require.NotNil(t, file.timelock)
+	require.Equal(t, types.TimelockActionSchedule, file.timelock.Action)
+	require.Equal(t, types.MustParseDuration("1h"), file.timelock.Delay)
+	require.Equal(t, uint64(0), file.timelock.ChainMetadata[chaintest.Chain1Selector].StartingOpCount)
+
+	_, err = runCommand(t, "build", ops)
+	require.EqualError(t, err, "missing required flag -mcm")
+
+	_, err = runCommand(t, "build", "-mcm=1", ops)
+	require.ErrorContains(t, err, `expected selector=address, got "1"`)
+
+	_, err = runCommand(t, "build", mcmFlag)
+	require.EqualError(t, err, "expected a single operations file, got 0 arguments")
+}
+
+func TestRun_ChainCommandsRequireConfig(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestProposal(t)
+	for _, cmd := range []string{"check-quorum", "rebase", "set-root", "execute"} {
+		_, err := runCommand(t, cmd, path)
+		require.EqualError(t, err, "missing required flag -config", cmd)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		return path
+	}
+
+	cfg, err := loadConfig(write("valid.json", `{"chains":[{"selector":16015286601757825753,"rpcURL":"http://localhost:8545"}]}`))
+	require.NoError(t, err)
+	require.Equal(t, []ChainConfig{{Selector: chaintest.Chain2Selector, RPCURL: "http://localhost:8545"}}, cfg.Chains)
+
+	cfg, err = loadConfig(write("solana.json",
+		`{"chains":[{"selector":16423721717087811551,"rpcURL":"http://a","privateKeyEnv":"SOLANA_KEY"}]}`))
+	require.NoError(t, err)
+	require.Equal(t, []ChainConfig{{Selector: chaintest.Chain4Selector, RPCURL: "http://a", PrivateKeyEnv: "SOLANA_KEY"}}, cfg.Chains)
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "invalid json",
+			content: `{`,
+			wantErr: "failed to decode config",
+		},
+		{
+			name:    "missing rpc url",
+			content: `{"chains":[{"selector":16015286601757825753}]}`,
+			wantErr: "chain selector 16015286601757825753: missing rpcURL",
+		},
+		{
+			name: "duplicate selector",
+			content: `{"chains":[{"selector":16015286601757825753,"rpcURL":"http://a"},` +
+				`{"selector":16015286601757825753,"rpcURL":"http://b"}]}`,
+			wantErr: "duplicate chain selector 16015286601757825753 in config",
+		},
+		{
+			name:    "unsupported family",
+			content: `{"chains":[{"selector":1399300952838017768,"rpcURL":"http://a"}]}`,
+			wantErr: "chain family ton is not supported by the CLI",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := loadConfig(write(tt.name+".json", tt.content))
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestNewChainAccessor(t *testing.T) {
+	solanaKey, err := sol.NewRandomPrivateKey()
+	require.NoError(t, err)
+	t.Setenv("MCMS_TEST_SOLANA_KEY", solanaKey.String())
+
+	cfg := &Config{Chains: []ChainConfig{
+		{Selector: chaintest.Chain4Selector, RPCURL: "http://localhost:8899", PrivateKeyEnv: "MCMS_TEST_SOLANA_KEY"},
+	}}
+
+	accessor, err := newChainAccessor(t.Context(), cfg, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{chaintest.Chain4RawSelector}, accessor.Selectors())
+	_, ok := accessor.SolanaClient(chaintest.Chain4RawSelector)
+	require.True(t, ok)
+	_, ok = accessor.SolanaSigner(chaintest.Chain4RawSelector)
+	require.False(t, ok)
+	accessor.close()
+
+	accessor, err = newChainAccessor(t.Context(), cfg, &senderKeys{})
+	require.NoError(t, err)
+	signer, ok := accessor.SolanaSigner(chaintest.Chain4RawSelector)
+	require.True(t, ok)
+	require.Equal(t, solanaKey.PublicKey(), signer.PublicKey())
+	accessor.close()
+
+	cfg.Chains[0].PrivateKeyEnv = "MCMS_TEST_UNSET_KEY"
+	_, err = newChainAccessor(t.Context(), cfg, &senderKeys{})
+	require.EqualError(t, err, "chain selector 16423721717087811551: MCMS_TEST_UNSET_KEY is not set")
+
+	cfg.Chains[0].PrivateKeyEnv = ""
+	_, err = newChainAccessor(t.Context(), cfg, &senderKeys{})
+	require.EqualError(t, err, "chain selector 16423721717087811551: missing privateKeyEnv")
+}
@@ -0,0 +1,100 @@
+//go:build e2e
+
+package aptos
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-aptos/bindings/mcms"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	aptossdk "github.com/smartcontractkit/mcms/sdk/aptos"
+	"github.com/smartcontractkit/mcms/sdk/conformance"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// TestConformance runs the sdk conformance suite against the Aptos localnet. The timelock is
+// built into the MCMS package, which only executes timelock operations.
+func (a *TestSuite) TestConformance() {
+	// updateMinDelay returns a call of the timelock keeping its min delay at zero
+	updateMinDelay := func(t *testing.T, mcmAddress string) types.Transaction {
+		t.Helper()
+
+		result, err := aptossdk.NewTimelockConfigurer(a.AptosRPCClient).UpdateDelay(t.Context(), mcmAddress, 0)
+		require.NoError(t, err)
+		tx, ok := result.RawData.(types.Transaction)
+		require.True(t, ok, "prepared Aptos update delay operation should be an MCMS transaction")
+
+		return tx
+	}
+	metadata := func(t *testing.T, mcmAddress string, opCount uint64) types.ChainMetadata {
+		t.Helper()
+
+		fields, err := json.Marshal(aptossdk.AdditionalFieldsMetadata{Role: aptossdk.TimelockRoleProposer})
+		require.NoError(t, err)
+
+		return types.ChainMetadata{StartingOpCount: opCount, MCMAddress: mcmAddress, AdditionalFields: fields}
+	}
+
+	conformance.Run(a.T(), conformance.Harness{
+		ChainSelector: a.ChainSelector,
+		DeployMCM: func(t *testing.T) string {
+			t.Helper()
+
+			addr, tx, _, err := mcms.DeployToResourceAccount(a.deployerAccount, a.AptosRPCClient, mcms.DefaultSeed+time.Now().String())
+			require.NoError(t, err)
+			data, err := a.AptosRPCClient.WaitForTransaction(tx.Hash)
+			require.NoError(t, err)
+			require.True(t, data.Success, data.VmStatus)
+
+			return addr.StringLong()
+		},
+		DeployTimelock: func(_ *testing.T, mcmAddress string) string { return mcmAddress },
+		NewExecutor: func(t *testing.T, encoder sdk.Encoder) sdk.Executor {
+			t.Helper()
+
+			aptosEncoder, ok := encoder.(*aptossdk.Encoder)
+			require.True(t, ok)
+
+			return aptossdk.NewExecutor(a.AptosRPCClient, a.deployerAccount, aptosEncoder, aptossdk.TimelockRoleProposer)
+		},
+		NewInspector: func(*testing.T) sdk.Inspector {
+			return aptossdk.NewInspector(a.AptosRPCClient, aptossdk.TimelockRoleProposer)
+		},
+		NewConfigurer: func(*testing.T) sdk.Configurer {
+			return aptossdk.NewConfigurer(a.AptosRPCClient, a.deployerAccount, aptossdk.TimelockRoleProposer)
+		},
+		NewTimelockConverter: func(*testing.T) sdk.TimelockConverter { return aptossdk.NewTimelockConverter() },
+		NewTimelockExecutor: func(*testing.T) sdk.TimelockExecutor {
+			return aptossdk.NewTimelockExecutor(a.AptosRPCClient, a.deployerAccount)
+		},
+		NewTimelockInspector: func(*testing.T) sdk.TimelockInspector {
+			return aptossdk.NewTimelockInspector(a.AptosRPCClient)
+		},
+		NewTransaction: func(t *testing.T, mcmAddress string) types.Transaction {
+			t.Helper()
+
+			return conformance.ScheduleTransaction(t, aptossdk.NewTimelockConverter(), a.ChainSelector,
+				metadata(t, mcmAddress, 0), mcmAddress, updateMinDelay(t, mcmAddress))
+		},
+		NewTimelockTransaction: updateMinDelay,
+		ChainMetadata:          metadata,
+		Delay:                  10 * time.Second,
+		Confirm: func(_ *testing.T, result types.TransactionResult) error {
+			data, err := a.AptosRPCClient.WaitForTransaction(result.Hash)
+			if err != nil {
+				return err
+			}
+			if !data.Success {
+				return errors.New(data.VmStatus)
+			}
+
+			return nil
+		},
+	})
+}
@@ -0,0 +1,105 @@
+//go:build e2e
+
+package canton
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	cantonsdk "github.com/smartcontractkit/mcms/sdk/canton"
+	"github.com/smartcontractkit/mcms/sdk/conformance"
+	mcmstypes "github.com/smartcontractkit/mcms/types"
+)
+
+// ConformanceTestSuite runs the sdk conformance suite against the Canton ledger. The timelock
+// is built into the MCMS contract, which only executes timelock operations.
+type ConformanceTestSuite struct {
+	TestSuite
+}
+
+func (s *ConformanceTestSuite) TestConformance() {
+	const chainID = int64(1)
+	owner := s.participant.PartyID
+	parties := []string{owner}
+	command := s.participant.LedgerServices.Command
+	state := s.participant.LedgerServices.State
+
+	// mcmsIDs maps the InstanceAddress of the deployed MCMS contracts to their instanceId
+	mcmsIDs := map[string]string{}
+	metadata := func(t *testing.T, mcmAddress string, opCount uint64) mcmstypes.ChainMetadata {
+		t.Helper()
+
+		mcmsID := mcmsIDs[mcmAddress]
+		m, err := cantonsdk.NewChainMetadata(opCount, chainID, fmt.Sprintf("%s@%s-proposer", mcmsID, owner), mcmAddress, mcmsID)
+		require.NoError(t, err)
+
+		return m
+	}
+	// updateMinDelay returns a self-dispatched call of the MCMS setting its min delay to 1s, the
+	// lowest delay the contract accepts
+	updateMinDelay := func(t *testing.T, mcmAddress string) mcmstypes.Transaction {
+		t.Helper()
+
+		tx, err := cantonsdk.NewUpdateMinDelayTransaction(mcmsIDs[mcmAddress]+"@"+owner, 1)
+		require.NoError(t, err)
+
+		return tx
+	}
+
+	conformance.Run(s.T(), conformance.Harness{
+		ChainSelector: s.chainSelector,
+		DeployMCM: func(t *testing.T) string {
+			t.Helper()
+
+			mcmsID := "mcms-" + uuid.NewString()[:8]
+			address := s.createMCMS(t.Context(), s.participant, owner, chainID, mcmsID)
+			mcmsIDs[address] = mcmsID
+
+			return address
+		},
+		DeployTimelock: func(_ *testing.T, mcmAddress string) string { return mcmAddress },
+		NewExecutor: func(t *testing.T, encoder sdk.Encoder) sdk.Executor {
+			t.Helper()
+
+			cantonEncoder, ok := encoder.(*cantonsdk.Encoder)
+			require.True(t, ok)
+			inspector := cantonsdk.NewInspector(state, parties, cantonsdk.TimelockRoleProposer)
+			executor, err := cantonsdk.NewExecutor(cantonEncoder, inspector, command, s.submittingParty, parties, cantonsdk.TimelockRoleProposer)
+			require.NoError(t, err)
+
+			return executor
+		},
+		NewInspector: func(*testing.T) sdk.Inspector {
+			return cantonsdk.NewInspector(state, parties, cantonsdk.TimelockRoleProposer)
+		},
+		NewConfigurer: func(t *testing.T) sdk.Configurer {
+			t.Helper()
+
+			configurer, err := cantonsdk.NewConfigurer(command, state, parties, cantonsdk.TimelockRoleProposer)
+			require.NoError(t, err)
+
+			return configurer
+		},
+		NewTimelockConverter: func(*testing.T) sdk.TimelockConverter { return cantonsdk.NewTimelockConverter() },
+		NewTimelockExecutor: func(*testing.T) sdk.TimelockExecutor {
+			return cantonsdk.NewTimelockExecutor(command, state, s.submittingParty, parties)
+		},
+		NewTimelockInspector: func(*testing.T) sdk.TimelockInspector {
+			return cantonsdk.NewTimelockInspector(command, state, s.submittingParty, parties)
+		},
+		NewTransaction: func(t *testing.T, mcmAddress string) mcmstypes.Transaction {
+			t.Helper()
+
+			return conformance.ScheduleTransaction(t, cantonsdk.NewTimelockConverter(), s.chainSelector,
+				metadata(t, mcmAddress, 0), mcmAddress, updateMinDelay(t, mcmAddress))
+		},
+		NewTimelockTransaction: updateMinDelay,
+		ChainMetadata:          metadata,
+		Delay:                  10 * time.Second,
+	})
+}
@@ -40,6 +40,7 @@ func TestSuiSuite(t *testing.T) {
 	suite.Run(t, new(suie2e.MCMSUserTestSuite))
 	suite.Run(t, new(suie2e.TimelockCancelProposalTestSuite))
 	suite.Run(t, new(suie2e.MCMSUserUpgradeTestSuite))
+	suite.Run(t, new(suie2e.ConformanceTestSuite))
 }
 
 func TestTONSuite(t *testing.T) {
@@ -49,6 +50,7 @@ func TestTONSuite(t *testing.T) {
 	suite.Run(t, new(tone2e.InspectionTestSuite))
 	suite.Run(t, new(tone2e.ExecutionTestSuite))
 	suite.Run(t, new(tone2e.TimelockInspectionTestSuite))
+	suite.Run(t, new(tone2e.ConformanceTestSuite))
 }
 
 func TestCantonSuite(t *testing.T) {
@@ -59,4 +61,5 @@ func TestCantonSuite(t *testing.T) {
 	suite.Run(t, new(cantone2e.TimelockCancelTestSuite))
 	suite.Run(t, new(cantone2e.TimelockBypassTestSuite))
 	suite.Run(t, new(cantone2e.SetRootExecuteTestSuite))
+	suite.Run(t, new(cantone2e.ConformanceTestSuite))
 }
@@ -0,0 +1,202 @@
+//go:build e2e
+
+package solanae2e
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/contracts/tests/testutils"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/v0_1_1/access_controller"
+	cpistub "github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/v0_1_1/external_program_cpi_stub"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/v0_1_1/timelock"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+
+	e2eutils "github.com/smartcontractkit/mcms/e2e/utils/solana"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/conformance"
+	solanasdk "github.com/smartcontractkit/mcms/sdk/solana"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// conformanceInstance is an MCM deployed by the conformance harness, with the access controllers
+// of the timelock sharing its seed.
+type conformanceInstance struct {
+	seed              solanasdk.PDASeed
+	accessControllers map[timelock.Role]solana.PrivateKey
+}
+
+// TestConformance runs the sdk conformance suite against the solana localnet. Each MCM and its
+// timelock are deployed under a new random seed.
+func (s *TestSuite) TestConformance() {
+	wallet, err := solana.PrivateKeyFromBase58(privateKey)
+	s.Require().NoError(err)
+
+	// instances maps the addresses of the deployed MCMs to their seeds and access controllers
+	instances := map[string]conformanceInstance{}
+	instance := func(t *testing.T, mcmAddress string) conformanceInstance {
+		t.Helper()
+
+		inst, ok := instances[mcmAddress]
+		require.Truef(t, ok, "unknown MCM %s", mcmAddress)
+
+		return inst
+	}
+	var u8Value uint8
+
+	conformance.Run(s.T(), conformance.Harness{
+		ChainSelector: s.ChainSelector,
+		DeployMCM: func(t *testing.T) string {
+			t.Helper()
+
+			var seed solanasdk.PDASeed
+			_, err := rand.Read(seed[:])
+			require.NoError(t, err)
+			InitializeMCMProgram(t.Context(), t, s.SolanaClient, s.MCMProgramID, seed, uint64(s.ChainSelector))
+
+			accessControllers := map[timelock.Role]solana.PrivateKey{}
+			for _, role := range []timelock.Role{timelock.Proposer_Role, timelock.Executor_Role, timelock.Canceller_Role, timelock.Bypasser_Role} {
+				accessControllers[role], err = solana.NewRandomPrivateKey()
+				require.NoError(t, err)
+			}
+			address := solanasdk.ContractAddress(s.MCMProgramID, seed)
+			instances[address] = conformanceInstance{seed: seed, accessControllers: accessControllers}
+
+			signerPDA, err := solanasdk.FindSignerPDA(s.MCMProgramID, seed)
+			require.NoError(t, err)
+			e2eutils.FundAccounts(t, []solana.PublicKey{signerPDA}, 1, s.SolanaClient)
+
+			return address
+		},
+		DeployTimelock: func(t *testing.T, mcmAddress string) string {
+			t.Helper()
+
+			s.deployConformanceTimelock(t, wallet, instance(t, mcmAddress))
+
+			return solanasdk.ContractAddress(s.TimelockProgramID, instance(t, mcmAddress).seed)
+		},
+		NewExecutor: func(t *testing.T, encoder sdk.Encoder) sdk.Executor {
+			t.Helper()
+
+			solanaEncoder, ok := encoder.(*solanasdk.Encoder)
+			require.True(t, ok)
+
+			return solanasdk.NewExecutor(solanaEncoder, s.SolanaClient, wallet)
+		},
+		NewInspector: func(*testing.T) sdk.Inspector { return solanasdk.NewInspector(s.SolanaClient) },
+		NewConfigurer: func(*testing.T) sdk.Configurer {
+			return solanasdk.NewConfigurer(s.SolanaClient, wallet, s.ChainSelector)
+		},
+		NewTimelockConverter: func(*testing.T) sdk.TimelockConverter { return solanasdk.NewTimelockConverter() },
+		NewTimelockExecutor: func(*testing.T) sdk.TimelockExecutor {
+			return solanasdk.NewTimelockExecutor(s.SolanaClient, wallet)
+		},
+		NewTimelockInspector: func(*testing.T) sdk.TimelockInspector {
+			return solanasdk.NewTimelockInspector(s.SolanaClient)
+		},
+		// A call of the cpi stub without accounts, distinct by its data
+		NewTransaction: func(t *testing.T, _ string) types.Transaction {
+			t.Helper()
+
+			cpistub.SetProgramID(s.CPIStubProgramID)
+			u8Value++
+			ix, err := cpistub.NewU8InstructionDataInstruction(u8Value).ValidateAndBuild()
+			require.NoError(t, err)
+			tx, err := solanasdk.NewTransactionFromInstruction(ix, "CPIStub", []string{"cpi-stub-u8data"})
+			require.NoError(t, err)
+
+			return tx
+		},
+		ChainMetadata: func(t *testing.T, mcmAddress string, opCount uint64) types.ChainMetadata {
+			t.Helper()
+
+			inst := instance(t, mcmAddress)
+			metadata, err := solanasdk.NewChainMetadata(opCount, s.MCMProgramID, inst.seed,
+				inst.accessControllers[timelock.Proposer_Role].PublicKey(),
+				inst.accessControllers[timelock.Canceller_Role].PublicKey(),
+				inst.accessControllers[timelock.Bypasser_Role].PublicKey())
+			require.NoError(t, err)
+
+			return metadata
+		},
+		TimelockRoleMember: func(t *testing.T, mcmAddress string) string {
+			t.Helper()
+
+			signerPDA, err := solanasdk.FindSignerPDA(s.MCMProgramID, instance(t, mcmAddress).seed)
+			require.NoError(t, err)
+
+			return signerPDA.String()
+		},
+		Delay: 5 * time.Second,
+	})
+}
+
+// deployConformanceTimelock initializes the access controllers and the timelock sharing the seed
+// of the MCM, with a min delay of 1s. The MCM signer is granted the proposer, canceller and
+// bypasser roles, and the wallet the executor role.
+func (s *TestSuite) deployConformanceTimelock(t *testing.T, wallet solana.PrivateKey, inst conformanceInstance) {
+	t.Helper()
+
+	ctx := t.Context()
+	timelock.SetProgramID(s.TimelockProgramID)
+	access_controller.SetProgramID(s.AccessControllerProgramID)
+
+	for _, ac := range inst.accessControllers {
+		ixs := s.getInitAccessControllersIxs(ctx, ac.PublicKey(), wallet)
+		testutils.SendAndConfirm(ctx, t, s.SolanaClient, ixs, wallet, rpc.CommitmentConfirmed, common.AddSigners(ac))
+	}
+
+	data, err := s.SolanaClient.GetAccountInfoWithOpts(ctx, s.TimelockProgramID, &rpc.GetAccountInfoOpts{
+		Commitment: rpc.CommitmentConfirmed,
+	})
+	require.NoError(t, err)
+	var programData struct {
+		DataType uint32
+		Address  solana.PublicKey
+	}
+	require.NoError(t, bin.UnmarshalBorsh(&programData, data.Bytes()))
+
+	configPDA, err := solanasdk.FindTimelockConfigPDA(s.TimelockProgramID, inst.seed)
+	require.NoError(t, err)
+	ix, err := timelock.NewInitializeInstruction(
+		inst.seed,
+		1,
+		configPDA,
+		wallet.PublicKey(),
+		solana.SystemProgramID,
+		s.TimelockProgramID,
+		programData.Address,
+		s.AccessControllerProgramID,
+		inst.accessControllers[timelock.Proposer_Role].PublicKey(),
+		inst.accessControllers[timelock.Executor_Role].PublicKey(),
+		inst.accessControllers[timelock.Canceller_Role].PublicKey(),
+		inst.accessControllers[timelock.Bypasser_Role].PublicKey(),
+	).ValidateAndBuild()
+	require.NoError(t, err)
+	testutils.SendAndConfirm(ctx, t, s.SolanaClient, []solana.Instruction{ix}, wallet, rpc.CommitmentConfirmed)
+
+	mcmSignerPDA, err := solanasdk.FindSignerPDA(s.MCMProgramID, inst.seed)
+	require.NoError(t, err)
+	members := map[timelock.Role]solana.PublicKey{
+		timelock.Proposer_Role:  mcmSignerPDA,
+		timelock.Canceller_Role: mcmSignerPDA,
+		timelock.Bypasser_Role:  mcmSignerPDA,
+		timelock.Executor_Role:  wallet.PublicKey(),
+	}
+	for role, member := range members {
+		ixs := s.getBatchAddAccessIxs(ctx, inst.seed, inst.accessControllers[role].PublicKey(), role,
+			[]solana.PublicKey{member}, wallet, 1)
+		testutils.SendAndConfirm(ctx, t, s.SolanaClient, ixs, wallet, rpc.CommitmentConfirmed)
+	}
+
+	timelockSignerPDA, err := solanasdk.FindTimelockSignerPDA(s.TimelockProgramID, inst.seed)
+	require.NoError(t, err)
+	e2eutils.FundAccounts(t, []solana.PublicKey{timelockSignerPDA}, 1, s.SolanaClient)
+}
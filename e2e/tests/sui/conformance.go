@@ -0,0 +1,157 @@
+//go:build e2e
+
+package sui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/conformance"
+	suisdk "github.com/smartcontractkit/mcms/sdk/sui"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// ConformanceTestSuite runs the sdk conformance suite against the Sui localnet. Each MCM is a
+// new publish of the MCMS package, whose timelock only executes calls of the package.
+type ConformanceTestSuite struct {
+	TestSuite
+}
+
+// conformanceDeployment holds the objects of an MCMS package published by the harness.
+type conformanceDeployment struct {
+	packageID   string
+	accountObj  string
+	registryObj string
+	timelockObj string
+	depStateObj string
+}
+
+func (s *ConformanceTestSuite) TestConformance() {
+	// deployments maps the MultisigState objects to their package. The constructors use the
+	// objects of the latest deployment, which is the one under test.
+	deployments := map[string]conformanceDeployment{}
+	deployment := func(t *testing.T, mcmAddress string) conformanceDeployment {
+		t.Helper()
+
+		d, ok := deployments[mcmAddress]
+		require.Truef(t, ok, "unknown MCM %s", mcmAddress)
+
+		return d
+	}
+	metadata := func(t *testing.T, mcmAddress string, opCount uint64) types.ChainMetadata {
+		t.Helper()
+
+		d := deployment(t, mcmAddress)
+		m, err := suisdk.NewChainMetadata(opCount, suisdk.TimelockRoleProposer, d.packageID, mcmAddress,
+			d.accountObj, d.registryObj, d.timelockObj, d.depStateObj)
+		require.NoError(t, err)
+
+		return m
+	}
+	// updateMinDelay returns a call of the timelock keeping its min delay at zero
+	updateMinDelay := func(t *testing.T, mcmAddress string) types.Transaction {
+		t.Helper()
+
+		d := deployment(t, mcmAddress)
+		result, err := suisdk.NewTimelockConfigurer(d.packageID).UpdateDelay(t.Context(), d.timelockObj, 0)
+		require.NoError(t, err)
+		tx, ok := result.RawData.(types.Transaction)
+		require.True(t, ok, "prepared Sui update delay operation should be an MCMS transaction")
+
+		return tx
+	}
+
+	conformance.Run(s.T(), conformance.Harness{
+		ChainSelector: s.chainSelector,
+		DeployMCM: func(*testing.T) string {
+			s.DeployMCMSContract()
+			deployments[s.mcmsObj] = conformanceDeployment{
+				packageID:   s.mcmsPackageID,
+				accountObj:  s.accountObj,
+				registryObj: s.registryObj,
+				timelockObj: s.timelockObj,
+				depStateObj: s.depStateObj,
+			}
+
+			return s.mcmsObj
+		},
+		DeployTimelock: func(t *testing.T, mcmAddress string) string {
+			t.Helper()
+
+			return deployment(t, mcmAddress).timelockObj
+		},
+		NewExecutor: func(t *testing.T, encoder sdk.Encoder) sdk.Executor {
+			t.Helper()
+
+			suiEncoder, ok := encoder.(*suisdk.Encoder)
+			require.True(t, ok)
+			executor, err := suisdk.NewExecutor(s.client, s.signer, suiEncoder, s.entrypointArgEncoder, s.mcmsPackageID,
+				suisdk.TimelockRoleProposer, s.mcmsObj, s.accountObj, s.registryObj, s.timelockObj)
+			require.NoError(t, err)
+
+			return executor
+		},
+		NewInspector: func(t *testing.T) sdk.Inspector {
+			t.Helper()
+
+			inspector, err := suisdk.NewInspector(s.client, s.signer, s.mcmsPackageID, suisdk.TimelockRoleProposer)
+			require.NoError(t, err)
+
+			return inspector
+		},
+		NewConfigurer: func(t *testing.T) sdk.Configurer {
+			t.Helper()
+
+			configurer, err := suisdk.NewConfigurer(s.client, s.signer, suisdk.TimelockRoleProposer, s.mcmsPackageID,
+				s.ownerCapObj, uint64(s.chainSelector))
+			require.NoError(t, err)
+
+			return configurer
+		},
+		NewTimelockConverter: func(t *testing.T) sdk.TimelockConverter {
+			t.Helper()
+
+			converter, err := suisdk.NewTimelockConverter()
+			require.NoError(t, err)
+
+			return converter
+		},
+		NewTimelockExecutor: func(t *testing.T) sdk.TimelockExecutor {
+			t.Helper()
+
+			executor, err := suisdk.NewTimelockExecutor(s.client, s.signer, s.entrypointArgEncoder, s.mcmsPackageID,
+				s.registryObj, s.accountObj)
+			require.NoError(t, err)
+
+			return executor
+		},
+		NewTimelockInspector: func(t *testing.T) sdk.TimelockInspector {
+			t.Helper()
+
+			inspector, err := suisdk.NewTimelockInspector(s.client, s.signer, s.mcmsPackageID)
+			require.NoError(t, err)
+
+			return inspector
+		},
+		NewTransaction: func(t *testing.T, mcmAddress string) types.Transaction {
+			t.Helper()
+
+			converter, err := suisdk.NewTimelockConverter()
+			require.NoError(t, err)
+
+			return conformance.ScheduleTransaction(t, converter, s.chainSelector, metadata(t, mcmAddress, 0),
+				deployment(t, mcmAddress).timelockObj, updateMinDelay(t, mcmAddress))
+		},
+		NewTimelockTransaction: updateMinDelay,
+		ChainMetadata:          metadata,
+		RootMetadataAddress: func(t *testing.T, mcmAddress string) string {
+			t.Helper()
+
+			return deployment(t, mcmAddress).packageID
+		},
+		Delay: 10 * time.Second,
+	})
+}
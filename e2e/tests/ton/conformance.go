@@ -0,0 +1,152 @@
+//go:build e2e
+
+package tone2e
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/ton/wallet"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	toncommon "github.com/smartcontractkit/chainlink-ton/cciplib/ccip/bindings/common"
+	"github.com/smartcontractkit/chainlink-ton/cciplib/ton/hash"
+	"github.com/smartcontractkit/chainlink-ton/cciplib/ton/tvm"
+	"github.com/smartcontractkit/chainlink-ton/pkg/bindings/mcms/mcms"
+	"github.com/smartcontractkit/chainlink-ton/pkg/bindings/mcms/timelock"
+	"github.com/smartcontractkit/chainlink-ton/pkg/ton/tracetracking"
+
+	e2e "github.com/smartcontractkit/mcms/e2e/tests"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/conformance"
+	mcmston "github.com/smartcontractkit/mcms/sdk/ton"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// ConformanceTestSuite runs the sdk conformance suite against the TON localnet.
+type ConformanceTestSuite struct {
+	suite.Suite
+	e2e.TestSetup
+
+	wallet        *wallet.Wallet
+	chainSelector types.ChainSelector
+}
+
+// SetupSuite runs before the test suite
+func (s *ConformanceTestSuite) SetupSuite() {
+	s.TestSetup = *e2e.InitializeSharedTestSetup(s.T())
+
+	var err error
+	s.wallet, err = tvm.MyLocalTONWalletDefault(s.TonClient)
+	s.Require().NoError(err)
+
+	details, err := chainsel.GetChainDetailsByChainIDAndFamily(s.TonBlockchain.ChainID, s.TonBlockchain.Family)
+	s.Require().NoError(err)
+	s.chainSelector = types.ChainSelector(details.ChainSelector)
+}
+
+func (s *ConformanceTestSuite) TestConformance() {
+	amount := tlb.MustFromTON("0.1")
+
+	conformance.Run(s.T(), conformance.Harness{
+		ChainSelector: s.chainSelector,
+		DeployMCM: func(t *testing.T) string {
+			t.Helper()
+
+			chainID, err := strconv.ParseInt(s.TonBlockchain.ChainID, 10, 64)
+			require.NoError(t, err)
+			data := mcms.EmptyDataFrom(hash.CRC32("test.conformance.mcms."+uuid.NewString()), s.wallet.Address(), chainID)
+			// TODO (ton): when MCMS is out of gas, executions fail silently
+			mcmAddress, err := DeployMCMSContract(t.Context(), s.TonClient, s.wallet, tlb.MustFromTON("10"), data)
+			require.NoError(t, err)
+
+			return mcmAddress.String()
+		},
+		DeployTimelock: func(t *testing.T, mcmAddress string) string {
+			t.Helper()
+
+			mcm := []toncommon.AddressWrap{{Val: address.MustParseAddr(mcmAddress)}}
+			body := timelock.Init{
+				QueryID:                  0,
+				MinDelay:                 0,
+				Admin:                    s.wallet.Address(),
+				Proposers:                mcm,
+				Executors:                []toncommon.AddressWrap{{Val: s.wallet.Address()}},
+				Cancellers:               mcm,
+				Bypassers:                mcm,
+				ExecutorRoleCheckEnabled: true,
+				OpFinalizationTimeout:    0,
+			}
+			data := timelock.EmptyDataFrom(hash.CRC32("test.conformance.timelock." + uuid.NewString()))
+			timelockAddress, err := DeployTimelockContract(t.Context(), s.TonClient, s.wallet, tlb.MustFromTON("0.8"), data, body)
+			require.NoError(t, err)
+
+			return timelockAddress.String()
+		},
+		NewExecutor: func(t *testing.T, encoder sdk.Encoder) sdk.Executor {
+			t.Helper()
+
+			tonEncoder, ok := encoder.(*mcmston.Encoder)
+			require.True(t, ok)
+			executor, err := mcmston.NewExecutor(mcmston.ExecutorOpts{
+				Encoder: tonEncoder,
+				Client:  s.TonClient,
+				Wallet:  s.wallet,
+				Amount:  amount,
+			})
+			require.NoError(t, err)
+
+			return executor
+		},
+		NewInspector: func(*testing.T) sdk.Inspector { return mcmston.NewInspector(s.TonClient) },
+		NewConfigurer: func(t *testing.T) sdk.Configurer {
+			t.Helper()
+
+			configurer, err := mcmston.NewConfigurer(s.wallet, amount)
+			require.NoError(t, err)
+
+			return configurer
+		},
+		NewTimelockConverter: func(*testing.T) sdk.TimelockConverter { return mcmston.NewTimelockConverter(amount) },
+		NewTimelockExecutor: func(t *testing.T) sdk.TimelockExecutor {
+			t.Helper()
+
+			executor, err := mcmston.NewTimelockExecutor(mcmston.TimelockExecutorOpts{
+				Client: s.TonClient,
+				Wallet: s.wallet,
+				Amount: amount,
+			})
+			require.NoError(t, err)
+
+			return executor
+		},
+		NewTimelockInspector: func(*testing.T) sdk.TimelockInspector { return mcmston.NewTimelockInspector(s.TonClient) },
+		// A transfer to the wallet with an empty body
+		NewTransaction: func(t *testing.T, _ string) types.Transaction {
+			t.Helper()
+
+			tx, err := mcmston.NewTransaction(s.wallet.Address(), cell.BeginCell().EndCell().BeginParse(),
+				tlb.MustFromTON("0.01").Nano(), "Wallet", nil, "", nil)
+			require.NoError(t, err)
+
+			return tx
+		},
+		TimelockRoleMember: func(_ *testing.T, mcmAddress string) string { return mcmAddress },
+		Delay:              10 * time.Second,
+		Confirm: func(t *testing.T, result types.TransactionResult) error {
+			t.Helper()
+
+			tx, ok := result.RawData.(*tlb.Transaction)
+			require.True(t, ok)
+
+			return tracetracking.WaitForTrace(t.Context(), s.TonClient, tx)
+		},
+	})
+}
@@ -39,6 +39,19 @@ func (e OperationNotDoneError) Error() string {
 	return fmt.Sprintf("operation %d is not done", e.OpIndex)
 }
 
+// PendingTransactionError is returned when the execution journal holds a transaction for a step
+// that is not yet reflected on chain.
+type PendingTransactionError struct {
+	ChainSelector types.ChainSelector
+	OpIndex       int
+	TxHash        string
+}
+
+// Error implements the error interface.
+func (e *PendingTransactionError) Error() string {
+	return fmt.Sprintf("transaction %s for operation %d on chain %d is pending confirmation", e.TxHash, e.OpIndex, e.ChainSelector)
+}
+
 // InvalidProposalKindError is returned when an invalid proposal kind is provided.
 type InvalidProposalKindError struct {
 	ProvidedKind types.ProposalKind
@@ -174,3 +187,50 @@ type DuplicateSignersError struct {
 func (e *DuplicateSignersError) Error() string {
 	return "duplicate signer detected: " + e.signer
 }
+
+// ForeignSignatureError is returned when a signature envelope was produced for a different signing
+// hash than the one of the proposal it is merged into.
+type ForeignSignatureError struct {
+	Signer      common.Address
+	SigningHash common.Hash
+	Expected    common.Hash
+}
+
+func (e *ForeignSignatureError) Error() string {
+	return fmt.Sprintf("signature from %s is for signing hash %s, expected %s", e.Signer, e.SigningHash, e.Expected)
+}
+
+// OpCountConflictKind is the kind of an OpCountConflictError.
+type OpCountConflictKind string
+
+const (
+	// OpCountConflictOverlap is a proposal starting before the end of the proposal queued before it.
+	OpCountConflictOverlap OpCountConflictKind = "overlap"
+	// OpCountConflictGap is a proposal starting after the end of the proposal queued before it, or
+	// after the on-chain op count.
+	OpCountConflictGap OpCountConflictKind = "gap"
+)
+
+// OpCountConflictError is returned when the op count ranges of queued proposals do not follow each
+// other on an MCM.
+type OpCountConflictError struct {
+	Instance MCMInstance
+	Kind     OpCountConflictKind
+	// Previous is the ID of the proposal queued before Next, empty when Next is compared with the
+	// on-chain op count.
+	Previous string
+	Next     string
+	// Expected is the op count Next should start at, and Start the op count it starts at.
+	Expected uint64
+	Start    uint64
+}
+
+func (e *OpCountConflictError) Error() string {
+	previous := "the on-chain op count"
+	if e.Previous != "" {
+		previous = fmt.Sprintf("proposal %q", e.Previous)
+	}
+
+	return fmt.Sprintf("proposal %q has an op count %s with %s on MCM %s of chain %d: starts at %d, expected %d",
+		e.Next, e.Kind, previous, e.Instance.MCMAddress, e.Instance.ChainSelector, e.Start, e.Expected)
+}
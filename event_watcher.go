@@ -0,0 +1,246 @@
+package mcms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+const (
+	defaultEventPollInterval  = 10 * time.Second
+	defaultEventMaxBlockRange = 1000
+)
+
+// EventWatcherOption configures an EventWatcher.
+type EventWatcherOption func(*eventWatcherOptions)
+
+type eventWatcherOptions struct {
+	pollInterval  time.Duration
+	maxBlockRange uint64
+	confirmations uint64
+}
+
+// WithPollInterval sets how often Watch polls the chains for new blocks. Defaults to 10 seconds.
+func WithPollInterval(interval time.Duration) EventWatcherOption {
+	return func(o *eventWatcherOptions) {
+		o.pollInterval = interval
+	}
+}
+
+// WithMaxBlockRange limits the number of blocks requested from a chain by a single FilterEvents
+// call. Defaults to 1000.
+func WithMaxBlockRange(n uint64) EventWatcherOption {
+	return func(o *eventWatcherOptions) {
+		o.maxBlockRange = n
+	}
+}
+
+// WithConfirmations sets how many blocks behind the latest block of a chain the EventWatcher
+// stays, so that only events unlikely to be reorganized away are returned. Defaults to 0.
+func WithConfirmations(n uint64) EventWatcherOption {
+	return func(o *eventWatcherOptions) {
+		o.confirmations = n
+	}
+}
+
+// EventWatcher follows the events of the MCMS and timelock contracts across chains through the
+// EventFilterer of each chain. It backfills the events from the FromBlock of the query of each
+// chain, and then follows the new blocks of the chains as they are produced.
+type EventWatcher struct {
+	filterers map[types.ChainSelector]sdk.EventFilterer
+	opts      eventWatcherOptions
+}
+
+// NewEventWatcher creates a new EventWatcher for the given EventFilterers.
+func NewEventWatcher(filterers map[types.ChainSelector]sdk.EventFilterer, opts ...EventWatcherOption) (*EventWatcher, error) {
+	w := &EventWatcher{
+		filterers: filterers,
+		opts: eventWatcherOptions{
+			pollInterval:  defaultEventPollInterval,
+			maxBlockRange: defaultEventMaxBlockRange,
+		},
+	}
+	for _, opt := range opts {
+		opt(&w.opts)
+	}
+
+	if w.opts.pollInterval <= 0 {
+		return nil, fmt.Errorf("invalid poll interval: %s", w.opts.pollInterval)
+	}
+	if w.opts.maxBlockRange == 0 {
+		return nil, errors.New("invalid max block range: 0")
+	}
+
+	return w, nil
+}
+
+// Backfill returns the events of the queries, ordered by chain selector and then as they were
+// emitted on each chain. A query with a ToBlock of 0 is backfilled up to the latest confirmed
+// block of its chain.
+func (w *EventWatcher) Backfill(ctx context.Context, queries map[types.ChainSelector]types.EventQuery) ([]types.Event, error) {
+	if err := w.checkFilterers(queries); err != nil {
+		return nil, err
+	}
+
+	var events []types.Event
+	for _, selector := range slices.Sorted(maps.Keys(queries)) {
+		filterer, query := w.filterers[selector], queries[selector]
+		if query.ToBlock == 0 {
+			confirmed, ok, err := w.confirmedBlock(ctx, filterer)
+			if err != nil {
+				return nil, fmt.Errorf("chain %d: %w", selector, err)
+			}
+			if !ok {
+				continue
+			}
+			query.ToBlock = confirmed
+		}
+
+		_, err := w.scan(ctx, filterer, query, query.FromBlock, query.ToBlock, func(chunk []types.Event) error {
+			events = append(events, chunk...)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("chain %d: %w", selector, err)
+		}
+	}
+
+	return events, nil
+}
+
+// Watch streams the events of the queries from their FromBlock onwards, following the chains
+// until the context is cancelled. The ToBlock of the queries is ignored. Events of a chain are
+// sent in the order they were emitted, while the events of different chains are interleaved.
+//
+// Errors polling a chain are sent on the error channel and the chain is polled again at the next
+// interval, resuming from the first block not yet returned. Both channels are closed once the
+// context is cancelled, and must be drained by the caller until then.
+func (w *EventWatcher) Watch(
+	ctx context.Context, queries map[types.ChainSelector]types.EventQuery,
+) (<-chan types.Event, <-chan error, error) {
+	if err := w.checkFilterers(queries); err != nil {
+		return nil, nil, err
+	}
+
+	events := make(chan types.Event)
+	errs := make(chan error)
+
+	var wg sync.WaitGroup
+	for selector, query := range queries {
+		wg.Go(func() {
+			w.watchChain(ctx, selector, query, events, errs)
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(events)
+		close(errs)
+	}()
+
+	return events, errs, nil
+}
+
+func (w *EventWatcher) watchChain(
+	ctx context.Context, selector types.ChainSelector, query types.EventQuery, events chan<- types.Event, errs chan<- error,
+) {
+	filterer := w.filterers[selector]
+	from := query.FromBlock
+	ticker := time.NewTicker(w.opts.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		next, err := w.poll(ctx, filterer, query, from, events)
+		from = next
+		if err != nil && ctx.Err() == nil {
+			select {
+			case errs <- fmt.Errorf("chain %d: %w", selector, err):
+			case <-ctx.Done():
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// poll sends the events from the block from up to the latest confirmed block, and returns the
+// first block whose events have not been sent yet.
+func (w *EventWatcher) poll(
+	ctx context.Context, filterer sdk.EventFilterer, query types.EventQuery, from uint64, events chan<- types.Event,
+) (uint64, error) {
+	confirmed, ok, err := w.confirmedBlock(ctx, filterer)
+	if err != nil || !ok || confirmed < from {
+		return from, err
+	}
+
+	return w.scan(ctx, filterer, query, from, confirmed, func(chunk []types.Event) error {
+		for _, event := range chunk {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		return nil
+	})
+}
+
+// scan passes the events of the blocks from to to to emit, at most maxBlockRange blocks at a
+// time, and returns the first block whose events have not been emitted.
+func (w *EventWatcher) scan(
+	ctx context.Context, filterer sdk.EventFilterer, query types.EventQuery, from, to uint64, emit func([]types.Event) error,
+) (uint64, error) {
+	for from <= to {
+		chunkEnd := min(from+w.opts.maxBlockRange-1, to)
+		query.FromBlock, query.ToBlock = from, chunkEnd
+
+		events, err := filterer.FilterEvents(ctx, query)
+		if err != nil {
+			return from, fmt.Errorf("failed to filter events of blocks %d to %d: %w", from, chunkEnd, err)
+		}
+		if err = emit(events); err != nil {
+			return from, err
+		}
+		if chunkEnd == to {
+			return to + 1, nil
+		}
+		from = chunkEnd + 1
+	}
+
+	return from, nil
+}
+
+// confirmedBlock returns the latest block of the chain with enough confirmations. ok is false
+// when the chain has fewer blocks than the required confirmations.
+func (w *EventWatcher) confirmedBlock(ctx context.Context, filterer sdk.EventFilterer) (block uint64, ok bool, err error) {
+	latest, err := filterer.LatestBlock(ctx)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	if latest < w.opts.confirmations {
+		return 0, false, nil
+	}
+
+	return latest - w.opts.confirmations, true, nil
+}
+
+func (w *EventWatcher) checkFilterers(queries map[types.ChainSelector]types.EventQuery) error {
+	for selector := range queries {
+		if _, ok := w.filterers[selector]; !ok {
+			return fmt.Errorf("no event filterer for chain %d", selector)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package mcms
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/mocks"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestNewEventWatcher(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewEventWatcher(nil, WithPollInterval(0))
+	require.EqualError(t, err, "invalid poll interval: 0s")
+
+	_, err = NewEventWatcher(nil, WithMaxBlockRange(0))
+	require.EqualError(t, err, "invalid max block range: 0")
+
+	watcher, err := NewEventWatcher(nil, WithPollInterval(time.Second), WithMaxBlockRange(10), WithConfirmations(2))
+	require.NoError(t, err)
+	require.Equal(t, eventWatcherOptions{pollInterval: time.Second, maxBlockRange: 10, confirmations: 2}, watcher.opts)
+}
+
+func TestEventWatcher_Backfill(t *testing.T) {
+	t.Parallel()
+
+	filterer1 := mocks.NewEventFilterer(t)
+	filterer2 := mocks.NewEventFilterer(t)
+	watcher, err := NewEventWatcher(map[types.ChainSelector]sdk.EventFilterer{
+		chaintest.Chain1Selector: filterer1,
+		chaintest.Chain2Selector: filterer2,
+	}, WithMaxBlockRange(5), WithConfirmations(2))
+	require.NoError(t, err)
+
+	// Chain 1 is backfilled up to the latest confirmed block in ranges of 5 blocks.
+	filterer1.EXPECT().LatestBlock(mock.Anything).Return(14, nil).Once()
+	filterer1.EXPECT().FilterEvents(mock.Anything, types.EventQuery{MCMAddress: "0x1", FromBlock: 3, ToBlock: 7}).
+		Return([]types.Event{{Kind: types.EventKindNewRoot, Block: 4}}, nil).Once()
+	filterer1.EXPECT().FilterEvents(mock.Anything, types.EventQuery{MCMAddress: "0x1", FromBlock: 8, ToBlock: 12}).
+		Return([]types.Event{{Kind: types.EventKindOpExecuted, Block: 9}}, nil).Once()
+	// Chain 2 is backfilled up to its ToBlock.
+	filterer2.EXPECT().FilterEvents(mock.Anything, types.EventQuery{TimelockAddress: "0x2", FromBlock: 1, ToBlock: 4}).
+		Return([]types.Event{{Kind: types.EventKindCallScheduled, Block: 2}}, nil).Once()
+
+	events, err := watcher.Backfill(t.Context(), map[types.ChainSelector]types.EventQuery{
+		chaintest.Chain1Selector: {MCMAddress: "0x1", FromBlock: 3},
+		chaintest.Chain2Selector: {TimelockAddress: "0x2", FromBlock: 1, ToBlock: 4},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.Event{
+		{Kind: types.EventKindNewRoot, Block: 4},
+		{Kind: types.EventKindOpExecuted, Block: 9},
+		{Kind: types.EventKindCallScheduled, Block: 2},
+	}, events)
+}
+
+func TestEventWatcher_Backfill_Errors(t *testing.T) {
+	t.Parallel()
+
+	filterer := mocks.NewEventFilterer(t)
+	watcher, err := NewEventWatcher(map[types.ChainSelector]sdk.EventFilterer{chaintest.Chain1Selector: filterer})
+	require.NoError(t, err)
+
+	_, err = watcher.Backfill(t.Context(), map[types.ChainSelector]types.EventQuery{chaintest.Chain2Selector: {}})
+	require.EqualError(t, err, "no event filterer for chain 16015286601757825753")
+
+	filterer.EXPECT().LatestBlock(mock.Anything).Return(0, errors.New("rpc error")).Once()
+	_, err = watcher.Backfill(t.Context(), map[types.ChainSelector]types.EventQuery{chaintest.Chain1Selector: {}})
+	require.EqualError(t, err, "chain 3379446385462418246: failed to get latest block: rpc error")
+
+	filterer.EXPECT().FilterEvents(mock.Anything, mock.Anything).Return(nil, errors.New("rpc error")).Once()
+	_, err = watcher.Backfill(t.Context(), map[types.ChainSelector]types.EventQuery{chaintest.Chain1Selector: {FromBlock: 1, ToBlock: 2}})
+	require.EqualError(t, err, "chain 3379446385462418246: failed to filter events of blocks 1 to 2: rpc error")
+}
+
+func TestEventWatcher_Watch(t *testing.T) {
+	t.Parallel()
+
+	filterer := mocks.NewEventFilterer(t)
+	watcher, err := NewEventWatcher(map[types.ChainSelector]sdk.EventFilterer{chaintest.Chain1Selector: filterer},
+		WithPollInterval(time.Millisecond), WithMaxBlockRange(10))
+	require.NoError(t, err)
+
+	// The first poll fails, the following polls backfill and then follow the new blocks.
+	filterer.EXPECT().LatestBlock(mock.Anything).Return(0, errors.New("rpc error")).Once()
+	filterer.EXPECT().LatestBlock(mock.Anything).Return(7, nil).Once()
+	filterer.EXPECT().FilterEvents(mock.Anything, types.EventQuery{MCMAddress: "0x1", FromBlock: 5, ToBlock: 7}).
+		Return([]types.Event{{Kind: types.EventKindNewRoot, Block: 6}}, nil).Once()
+	filterer.EXPECT().LatestBlock(mock.Anything).Return(7, nil).Once()
+	filterer.EXPECT().LatestBlock(mock.Anything).Return(9, nil).Once()
+	filterer.EXPECT().FilterEvents(mock.Anything, types.EventQuery{MCMAddress: "0x1", FromBlock: 8, ToBlock: 9}).
+		Return([]types.Event{{Kind: types.EventKindOpExecuted, Block: 8}, {Kind: types.EventKindOpExecuted, Block: 9}}, nil).Once()
+	filterer.EXPECT().LatestBlock(mock.Anything).Return(9, nil).Maybe()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	events, errs, err := watcher.Watch(ctx, map[types.ChainSelector]types.EventQuery{
+		chaintest.Chain1Selector: {MCMAddress: "0x1", FromBlock: 5, ToBlock: 6},
+	})
+	require.NoError(t, err)
+
+	require.EqualError(t, <-errs, "chain 3379446385462418246: failed to get latest block: rpc error")
+	var blocks []uint64
+	for range 3 {
+		blocks = append(blocks, (<-events).Block)
+	}
+	require.Equal(t, []uint64{6, 8, 9}, blocks)
+
+	cancel()
+	for range events {
+	}
+	for range errs {
+	}
+}
+
+func TestEventWatcher_Watch_MissingFilterer(t *testing.T) {
+	t.Parallel()
+
+	watcher, err := NewEventWatcher(nil)
+	require.NoError(t, err)
+
+	_, _, err = watcher.Watch(t.Context(), map[types.ChainSelector]types.EventQuery{chaintest.Chain1Selector: {}})
+	require.EqualError(t, err, "no event filterer for chain 3379446385462418246")
+}
@@ -20,13 +20,20 @@ type Executable struct {
 	encoders  map[types.ChainSelector]sdk.Encoder
 	tree      *merkle.Tree
 	txNonces  []uint64
+	journal   *executionJournal
 }
 
 // NewExecutable creates a new Executable from a proposal and a map of executors.
 func NewExecutable(
 	proposal *Proposal,
 	executors map[types.ChainSelector]sdk.Executor,
+	opts ...ExecutableOption,
 ) (*Executable, error) {
+	execOpts := &executableOptions{}
+	for _, opt := range opts {
+		opt(execOpts)
+	}
+
 	// Generate the encoders from the proposal
 	encoders, err := proposal.GetEncoders()
 	if err != nil {
@@ -45,16 +52,47 @@ func NewExecutable(
 		return nil, err
 	}
 
+	// Entries are keyed by the signing hash, which changes whenever the operations or metadata do
+	var journal *executionJournal
+	if execOpts.journal != nil {
+		hash, err := proposal.SigningHash()
+		if err != nil {
+			return nil, fmt.Errorf("unable to compute journal key: %w", err)
+		}
+		journal = &executionJournal{journal: execOpts.journal, proposalHash: hash, checkers: execOpts.checkers}
+	}
+
 	return &Executable{
 		proposal:  proposal,
 		executors: executors,
 		encoders:  encoders,
 		tree:      tree,
 		txNonces:  txNonces,
+		journal:   journal,
 	}, nil
 }
 
+// SetRoot sets the proposal root on the given chain. If the executable has a journal, a root
+// already submitted for the chain is not sent again.
 func (e *Executable) SetRoot(ctx context.Context, chainSelector types.ChainSelector) (types.TransactionResult, error) {
+	key := JournalKey{Kind: ExecutionStepSetRoot, ChainSelector: chainSelector, OpIndex: -1}
+
+	return e.journal.run(ctx, key,
+		func(ctx context.Context) (bool, error) {
+			root, _, err := e.executors[chainSelector].GetRoot(ctx, e.proposal.ChainMetadata[chainSelector].MCMAddress)
+			if err != nil {
+				return false, err
+			}
+
+			return root == e.tree.Root, nil
+		},
+		func(ctx context.Context) (types.TransactionResult, error) {
+			return e.setRoot(ctx, chainSelector)
+		},
+	)
+}
+
+func (e *Executable) setRoot(ctx context.Context, chainSelector types.ChainSelector) (types.TransactionResult, error) {
 	metadata := e.proposal.ChainMetadata[chainSelector]
 
 	metadataHash, err := e.encoders[chainSelector].HashMetadata(metadata)
@@ -91,7 +129,28 @@ func (e *Executable) SetRoot(ctx context.Context, chainSelector types.ChainSelec
 	)
 }
 
+// Execute executes the operation at the given index. If the executable has a journal, an operation
+// already submitted is not sent again.
 func (e *Executable) Execute(ctx context.Context, index int) (types.TransactionResult, error) {
+	op := e.proposal.Operations[index]
+	key := JournalKey{Kind: ExecutionStepExecute, ChainSelector: op.ChainSelector, OpIndex: index}
+
+	return e.journal.run(ctx, key,
+		func(ctx context.Context) (bool, error) {
+			opCount, err := e.executors[op.ChainSelector].GetOpCount(ctx, e.proposal.ChainMetadata[op.ChainSelector].MCMAddress)
+			if err != nil {
+				return false, err
+			}
+
+			return e.txNonces[index] < opCount, nil
+		},
+		func(ctx context.Context) (types.TransactionResult, error) {
+			return e.execute(ctx, index)
+		},
+	)
+}
+
+func (e *Executable) execute(ctx context.Context, index int) (types.TransactionResult, error) {
 	op := e.proposal.Operations[index]
 	chainSelector := op.ChainSelector
 	metadata := e.proposal.ChainMetadata[chainSelector]
@@ -4,16 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	chainsel "github.com/smartcontractkit/chain-selectors"
-
 	"github.com/smartcontractkit/mcms/chainwrappers"
 	"github.com/smartcontractkit/mcms/sdk"
-	"github.com/smartcontractkit/mcms/sdk/aptos"
-	"github.com/smartcontractkit/mcms/sdk/canton"
-	"github.com/smartcontractkit/mcms/sdk/evm"
-	"github.com/smartcontractkit/mcms/sdk/solana"
-	"github.com/smartcontractkit/mcms/sdk/sui"
-	"github.com/smartcontractkit/mcms/sdk/ton"
 
 	"github.com/smartcontractkit/mcms/types"
 )
@@ -28,49 +20,12 @@ func newEncoder(
 		return nil, err
 	}
 
-	var encoder sdk.Encoder
-	switch family {
-	case chainsel.FamilyEVM:
-		encoder = evm.NewEncoder(
-			csel,
-			txCount,
-			overridePreviousRoot,
-			isSim,
-		)
-	case chainsel.FamilySolana:
-		encoder = solana.NewEncoder(
-			csel,
-			txCount,
-			overridePreviousRoot,
-			// isSim,
-		)
-	case chainsel.FamilyAptos:
-		encoder = aptos.NewEncoder(
-			csel,
-			txCount,
-			overridePreviousRoot,
-		)
-	case chainsel.FamilySui:
-		encoder = sui.NewEncoder(
-			csel,
-			txCount,
-			overridePreviousRoot,
-		)
-	case chainsel.FamilyTon:
-		encoder = ton.NewEncoder(
-			csel,
-			txCount,
-			overridePreviousRoot,
-		)
-	case chainsel.FamilyCanton:
-		encoder = canton.NewEncoder(
-			csel,
-			txCount,
-			overridePreviousRoot,
-		)
+	f, ok := sdk.LookupFamily(family)
+	if !ok || f.NewEncoder == nil {
+		return nil, fmt.Errorf("unsupported chain family %s", family)
 	}
 
-	return encoder, nil
+	return f.NewEncoder(csel, txCount, overridePreviousRoot, isSim), nil
 }
 
 // newTimelockConverter a new TimelockConverter that can convert timelock proposals
@@ -86,20 +41,26 @@ func operationIDFn(_ context.Context, csel types.ChainSelector) (sdk.OperationID
 		return nil, err
 	}
 
-	switch family {
-	case chainsel.FamilyEVM:
-		return evm.OperationID, nil
-	case chainsel.FamilySolana:
-		return solana.OperationID, nil
-	case chainsel.FamilyAptos:
-		return aptos.OperationID, nil
-	case chainsel.FamilySui:
-		return sui.OperationID, nil
-	case chainsel.FamilyTon:
-		return ton.OperationID, nil
-	case chainsel.FamilyCanton:
-		return canton.OperationID, nil
-	default:
+	f, ok := sdk.LookupFamily(family)
+	if !ok || f.OperationID == nil {
 		return nil, fmt.Errorf("unsupported chain family %s", family)
 	}
+
+	return f.OperationID, nil
+}
+
+// timelockRoleMemberFn returns the TimelockRoleMember hook of the chain family, or nil if the
+// family does not provide one.
+func timelockRoleMemberFn(csel types.ChainSelector) (func(mcmAddress string) (string, error), error) {
+	family, err := types.GetChainSelectorFamily(csel)
+	if err != nil {
+		return nil, err
+	}
+
+	f, ok := sdk.LookupFamily(family)
+	if !ok {
+		return nil, fmt.Errorf("unsupported chain family %s", family)
+	}
+
+	return f.TimelockRoleMember, nil
 }
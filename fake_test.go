@@ -21,23 +21,23 @@ func (w *fakeWriter) Write(p []byte) (n int, err error) {
 	return w.n, w.err
 }
 
-// fakeSigner implements the signer interface for testing purposes
+// fakeSigner implements the Signer interface for testing purposes
 type fakeSigner struct {
 	sigB []byte
 	err  error
 }
 
 // newFakeSigner creates a new fakeSigner. The args provided will be returned when Sign is called.
-func newFakeSigner(sigB []byte, err error) signer {
+func newFakeSigner(sigB []byte, err error) Signer {
 	return &fakeSigner{sigB: sigB, err: err}
 }
 
-// Sign implemnts the signer interface.
+// Sign implemnts the Signer interface.
 func (f *fakeSigner) Sign(payload []byte) ([]byte, error) {
 	return f.sigB, f.err
 }
 
-// GetAddress implements the signer interface and returns a fixed address.
+// GetAddress implements the Signer interface and returns a fixed address.
 func (f *fakeSigner) GetAddress() (common.Address, error) {
 	if f.err != nil {
 		return common.Address{}, f.err
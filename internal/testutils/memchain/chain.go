@@ -0,0 +1,257 @@
+// Package memchain implements an in-memory chain hosting ManyChainMultiSig and RBACTimelock
+// contracts for testing purposes.
+//
+// The contracts follow the semantics of the EVM contracts: roots are only set with a quorum of
+// strictly increasing signatures over the signed root, metadata and operations are verified
+// against the root with their merkle proofs, and operations are executed in op count order. The
+// timelock enforces roles, the min delay and predecessors against a clock controlled by the test.
+//
+// Operations are hashed with the EVM encoder, so proposals for an EVM chain selector can be
+// signed, set and executed against the chain without a backend.
+package memchain
+
+import (
+	"encoding/binary"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// Call is a call made by a contract of the chain to another contract.
+type Call struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Data  []byte
+}
+
+// CallHandler handles the calls to a target contract. Returning an error reverts the transaction.
+// Handlers are invoked with the chain locked and must not call back into the chain.
+type CallHandler func(call Call) error
+
+// Chain is an in-memory chain. It is safe for concurrent use.
+type Chain struct {
+	mu sync.Mutex
+
+	selector types.ChainSelector
+	chainID  uint64
+	now      time.Time
+
+	// nonce is incremented by every deployment and transaction.
+	nonce     uint64
+	multiSigs map[common.Address]*multiSig
+	timelocks map[common.Address]*timelock
+	handlers  map[common.Address]CallHandler
+	calls     []Call
+}
+
+// NewChain creates an empty chain for the EVM chain selector. The clock starts at the current
+// time, truncated to seconds.
+func NewChain(selector types.ChainSelector) (*Chain, error) {
+	chainID, err := chainsel.ChainIdFromSelector(uint64(selector))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID for selector %d: %w", selector, err)
+	}
+
+	return &Chain{
+		selector:  selector,
+		chainID:   chainID,
+		now:       time.Now().Truncate(time.Second),
+		multiSigs: map[common.Address]*multiSig{},
+		timelocks: map[common.Address]*timelock{},
+		handlers:  map[common.Address]CallHandler{},
+	}, nil
+}
+
+// Selector returns the chain selector of the chain.
+func (c *Chain) Selector() types.ChainSelector {
+	return c.selector
+}
+
+// Now returns the current block time of the chain.
+func (c *Chain) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// SetTime sets the block time of the chain.
+func (c *Chain) SetTime(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
+
+// AdvanceTime moves the block time of the chain forward by d.
+func (c *Chain) AdvanceTime(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// DeployMCM deploys a ManyChainMultiSig owned by owner, without a config, and returns its
+// address.
+func (c *Chain) DeployMCM(owner common.Address) common.Address {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	address := c.nextAddress()
+	c.multiSigs[address] = &multiSig{
+		owner:      owner,
+		config:     &types.Config{},
+		seenHashes: map[common.Hash]bool{},
+	}
+
+	return address
+}
+
+// DeployTimelock deploys an RBACTimelock with the given min delay and role members, and returns
+// its address. The timelock is an admin of itself, so executed calls can update its delay and
+// roles.
+func (c *Chain) DeployTimelock(
+	minDelay time.Duration, admin common.Address, proposers, executors, cancellers, bypassers []common.Address,
+) common.Address {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	address := c.nextAddress()
+	c.timelocks[address] = &timelock{
+		minDelay: uint64(minDelay / time.Second),
+		roles: map[common.Hash][]common.Address{
+			adminRole:     {address, admin},
+			proposerRole:  slices.Clone(proposers),
+			executorRole:  slices.Clone(executors),
+			cancellerRole: slices.Clone(cancellers),
+			bypasserRole:  slices.Clone(bypassers),
+		},
+		timestamps: map[common.Hash]uint64{},
+	}
+
+	return address
+}
+
+// HandleCalls sets the handler of the calls to target. Calls to targets without a handler succeed.
+func (c *Chain) HandleCalls(target common.Address, handler CallHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.handlers[target] = handler
+}
+
+// Calls returns the calls made to contracts other than the timelocks, in execution order.
+// Calls of reverted transactions are not included.
+func (c *Chain) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Clone(c.calls)
+}
+
+// nextAddress returns the address of the next deployment.
+func (c *Chain) nextAddress() common.Address {
+	c.nonce++
+
+	return crypto.CreateAddress(common.BigToAddress(new(big.Int).SetUint64(uint64(c.selector))), c.nonce)
+}
+
+// timestamp returns the current block timestamp.
+func (c *Chain) timestamp() uint64 {
+	return uint64(c.now.Unix()) //nolint:gosec // block times are after the epoch
+}
+
+// transact runs fn as a transaction: the state changes of fn are reverted if it returns an error.
+// It returns the hash of the transaction.
+func (c *Chain) transact(fn func() error) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snapshot := c.snapshot()
+	if err := fn(); err != nil {
+		c.restore(snapshot)
+
+		return "", err
+	}
+	c.nonce++
+
+	var preimage [16]byte
+	binary.BigEndian.PutUint64(preimage[:8], uint64(c.selector))
+	binary.BigEndian.PutUint64(preimage[8:], c.nonce)
+
+	return crypto.Keccak256Hash(preimage[:]).Hex(), nil
+}
+
+// view runs fn with the chain locked, without changing its state.
+func (c *Chain) view(fn func() error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return fn()
+}
+
+// call dispatches a call made by a contract of the chain.
+func (c *Chain) call(call Call) error {
+	if tl, ok := c.timelocks[call.To]; ok {
+		return c.callTimelock(call.To, tl, call)
+	}
+
+	if handler, ok := c.handlers[call.To]; ok {
+		if err := handler(call); err != nil {
+			return err
+		}
+	}
+	c.calls = append(c.calls, call)
+
+	return nil
+}
+
+type chainState struct {
+	multiSigs map[common.Address]multiSig
+	timelocks map[common.Address]timelock
+	calls     int
+}
+
+func (c *Chain) snapshot() chainState {
+	state := chainState{
+		multiSigs: make(map[common.Address]multiSig, len(c.multiSigs)),
+		timelocks: make(map[common.Address]timelock, len(c.timelocks)),
+		calls:     len(c.calls),
+	}
+	for address, ms := range c.multiSigs {
+		state.multiSigs[address] = ms.clone()
+	}
+	for address, tl := range c.timelocks {
+		state.timelocks[address] = tl.clone()
+	}
+
+	return state
+}
+
+func (c *Chain) restore(state chainState) {
+	for address, ms := range state.multiSigs {
+		*c.multiSigs[address] = ms
+	}
+	for address, tl := range state.timelocks {
+		*c.timelocks[address] = tl
+	}
+	c.calls = c.calls[:state.calls]
+}
+
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+	if m == nil {
+		return nil
+	}
+
+	return maps.Clone(m)
+}
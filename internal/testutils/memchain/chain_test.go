@@ -0,0 +1,246 @@
+package memchain
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms"
+	"github.com/smartcontractkit/mcms/internal/testutils"
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// testEnv is a chain with a configured MCM and a timelock proposed to and executed by the MCM.
+type testEnv struct {
+	chain    *Chain
+	owner    common.Address
+	signers  []testutils.ECDSASigner
+	mcm      common.Address
+	timelock common.Address
+}
+
+func newTestEnv(t *testing.T, quorum uint8, numSigners int) testEnv {
+	t.Helper()
+
+	chain, err := NewChain(chaintest.Chain1Selector)
+	require.NoError(t, err)
+
+	owner := testutils.NewECDSASigner().Address()
+	signers := testutils.MakeNewECDSASigners(numSigners)
+	signerAddrs := make([]common.Address, 0, numSigners)
+	for _, signer := range signers {
+		signerAddrs = append(signerAddrs, signer.Address())
+	}
+
+	mcm := chain.DeployMCM(owner)
+	_, err = NewConfigurer(chain, owner).SetConfig(t.Context(), mcm.Hex(), &types.Config{Quorum: quorum, Signers: signerAddrs}, false)
+	require.NoError(t, err)
+
+	mcmAddrs := []common.Address{mcm}
+	timelock := chain.DeployTimelock(time.Hour, owner, mcmAddrs, []common.Address{owner}, mcmAddrs, mcmAddrs)
+
+	return testEnv{chain: chain, owner: owner, signers: signers, mcm: mcm, timelock: timelock}
+}
+
+// newProposal builds a proposal of the operations for the MCM of the env, signed by signers.
+func (e testEnv) newProposal(t *testing.T, ops []types.Operation, signers ...testutils.ECDSASigner) *mcms.Proposal {
+	t.Helper()
+
+	opCount, err := NewInspector(e.chain).GetOpCount(t.Context(), e.mcm.Hex())
+	require.NoError(t, err)
+
+	proposal, err := mcms.NewProposalBuilder().
+		SetVersion("v1").
+		SetValidUntil(uint32(e.chain.Now().Add(time.Hour).Unix())). //nolint:gosec // test time
+		AddChainMetadata(chaintest.Chain1Selector, types.ChainMetadata{StartingOpCount: opCount, MCMAddress: e.mcm.Hex()}).
+		SetOperations(ops).
+		Build()
+	require.NoError(t, err)
+
+	e.sign(t, proposal, signers...)
+
+	return proposal
+}
+
+func (e testEnv) sign(t *testing.T, proposal *mcms.Proposal, signers ...testutils.ECDSASigner) {
+	t.Helper()
+
+	signable, err := mcms.NewSignable(proposal, map[types.ChainSelector]sdk.Inspector{
+		chaintest.Chain1Selector: NewInspector(e.chain),
+	})
+	require.NoError(t, err)
+	for _, signer := range signers {
+		_, err = signable.SignAndAppend(mcms.NewPrivateKeySigner(signer.Key))
+		require.NoError(t, err)
+	}
+}
+
+func (e testEnv) newExecutable(t *testing.T, proposal *mcms.Proposal) *mcms.Executable {
+	t.Helper()
+
+	encoders, err := proposal.GetEncoders()
+	require.NoError(t, err)
+	encoder, ok := encoders[chaintest.Chain1Selector].(*evm.Encoder)
+	require.True(t, ok)
+
+	executable, err := mcms.NewExecutable(proposal, map[types.ChainSelector]sdk.Executor{
+		chaintest.Chain1Selector: NewExecutor(encoder, e.chain),
+	})
+	require.NoError(t, err)
+
+	return executable
+}
+
+func newOperation(to common.Address, data []byte) types.Operation {
+	return types.Operation{
+		ChainSelector: chaintest.Chain1Selector,
+		Transaction:   evm.NewTransaction(to, data, big.NewInt(0), "Target", nil),
+	}
+}
+
+func TestNewChain(t *testing.T) {
+	t.Parallel()
+
+	chain, err := NewChain(chaintest.Chain1Selector)
+	require.NoError(t, err)
+	assert.Equal(t, chaintest.Chain1Selector, chain.Selector())
+	assert.Equal(t, chaintest.Chain1EVMID, chain.chainID)
+
+	_, err = NewChain(chaintest.ChainInvalidSelector)
+	require.ErrorContains(t, err, "failed to get chain ID for selector 0")
+}
+
+func TestChain_Time(t *testing.T) {
+	t.Parallel()
+
+	chain, err := NewChain(chaintest.Chain1Selector)
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+	chain.SetTime(now)
+	assert.Equal(t, now, chain.Now())
+
+	chain.AdvanceTime(time.Minute)
+	assert.Equal(t, now.Add(time.Minute), chain.Now())
+}
+
+func TestChain_TimelockProposalLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newTestEnv(t, 2, 3)
+	target := common.HexToAddress("0x1000")
+
+	timelockProposal, err := mcms.NewTimelockProposalBuilder().
+		SetVersion("v1").
+		SetValidUntil(uint32(env.chain.Now().Add(time.Hour).Unix())). //nolint:gosec // test time
+		AddChainMetadata(chaintest.Chain1Selector, types.ChainMetadata{MCMAddress: env.mcm.Hex()}).
+		AddTimelockAddress(chaintest.Chain1Selector, env.timelock.Hex()).
+		SetAction(types.TimelockActionSchedule).
+		SetDelay(types.NewDuration(time.Hour)).
+		SetOperations([]types.BatchOperation{
+			{
+				ChainSelector: chaintest.Chain1Selector,
+				Transactions:  []types.Transaction{evm.NewTransaction(target, []byte{0x01}, big.NewInt(0), "Target", nil)},
+			},
+			{
+				ChainSelector: chaintest.Chain1Selector,
+				Transactions: []types.Transaction{
+					evm.NewTransaction(target, []byte{0x02}, big.NewInt(0), "Target", nil),
+					evm.NewTransaction(target, []byte{0x03}, big.NewInt(1), "Target", nil),
+				},
+			},
+		}).
+		Build()
+	require.NoError(t, err)
+
+	proposal, _, err := timelockProposal.Convert(ctx, map[types.ChainSelector]sdk.TimelockConverter{
+		chaintest.Chain1Selector: NewTimelockConverter(),
+	})
+	require.NoError(t, err)
+	env.sign(t, &proposal, env.signers[0], env.signers[2])
+
+	// Schedule the operations through the MCM
+	executable := env.newExecutable(t, &proposal)
+	_, err = executable.SetRoot(ctx, chaintest.Chain1Selector)
+	require.NoError(t, err)
+	for i := range proposal.Operations {
+		_, err = executable.Execute(ctx, i)
+		require.NoError(t, err)
+	}
+
+	opCount, err := NewInspector(env.chain).GetOpCount(ctx, env.mcm.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), opCount)
+
+	timelockExecutable, err := mcms.NewTimelockExecutable(ctx, timelockProposal, map[types.ChainSelector]sdk.TimelockExecutor{
+		chaintest.Chain1Selector: NewTimelockExecutor(env.chain, env.owner),
+	})
+	require.NoError(t, err)
+	require.NoError(t, timelockExecutable.IsChainPending(ctx, chaintest.Chain1Selector))
+	require.Error(t, timelockExecutable.IsReady(ctx))
+
+	// The operations can only be executed once the delay has passed
+	_, err = timelockExecutable.Execute(ctx, 0)
+	require.ErrorIs(t, err, ErrOperationNotReady)
+
+	env.chain.AdvanceTime(time.Hour)
+	require.NoError(t, timelockExecutable.IsReady(ctx))
+	for i := range timelockProposal.Operations {
+		_, err = timelockExecutable.Execute(ctx, i)
+		require.NoError(t, err)
+	}
+	require.NoError(t, timelockExecutable.IsChainDone(ctx, chaintest.Chain1Selector))
+
+	assert.Equal(t, []Call{
+		{From: env.timelock, To: target, Value: big.NewInt(0), Data: []byte{0x01}},
+		{From: env.timelock, To: target, Value: big.NewInt(0), Data: []byte{0x02}},
+		{From: env.timelock, To: target, Value: big.NewInt(1), Data: []byte{0x03}},
+	}, env.chain.Calls())
+}
+
+func TestChain_RevertedTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newTestEnv(t, 1, 1)
+	target := common.HexToAddress("0x1000")
+	errReverted := errors.New("reverted")
+	env.chain.HandleCalls(target, func(call Call) error {
+		if call.Data[0] == 0x02 {
+			return errReverted
+		}
+
+		return nil
+	})
+
+	proposal := env.newProposal(t, []types.Operation{
+		newOperation(target, []byte{0x01}),
+		newOperation(target, []byte{0x02}),
+	}, env.signers[0])
+	executable := env.newExecutable(t, proposal)
+	_, err := executable.SetRoot(ctx, chaintest.Chain1Selector)
+	require.NoError(t, err)
+	_, err = executable.Execute(ctx, 0)
+	require.NoError(t, err)
+
+	_, err = executable.Execute(ctx, 1)
+	require.ErrorIs(t, err, ErrCallReverted)
+	require.ErrorIs(t, err, errReverted)
+
+	// The failed operation is rolled back
+	opCount, err := NewInspector(env.chain).GetOpCount(ctx, env.mcm.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), opCount)
+	assert.Equal(t, []Call{
+		{From: env.mcm, To: target, Value: big.NewInt(0), Data: []byte{0x01}},
+	}, env.chain.Calls())
+}
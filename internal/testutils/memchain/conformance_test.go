@@ -0,0 +1,53 @@
+package memchain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils"
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/conformance"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	chain, err := NewChain(chaintest.Chain1Selector)
+	require.NoError(t, err)
+	sender := testutils.NewECDSASigner().Address()
+
+	conformance.Run(t, conformance.Harness{
+		ChainSelector: chaintest.Chain1Selector,
+		DeployMCM: func(*testing.T) string {
+			return chain.DeployMCM(sender).Hex()
+		},
+		DeployTimelock: func(_ *testing.T, mcmAddress string) string {
+			mcm := []common.Address{common.HexToAddress(mcmAddress)}
+			return chain.DeployTimelock(time.Minute, sender, mcm, []common.Address{sender}, mcm, mcm).Hex()
+		},
+		NewExecutor: func(t *testing.T, encoder sdk.Encoder) sdk.Executor {
+			t.Helper()
+
+			evmEncoder, ok := encoder.(*evm.Encoder)
+			require.True(t, ok)
+
+			return NewExecutor(evmEncoder, chain)
+		},
+		NewInspector:         func(*testing.T) sdk.Inspector { return NewInspector(chain) },
+		NewConfigurer:        func(*testing.T) sdk.Configurer { return NewConfigurer(chain, sender) },
+		NewTimelockConverter: func(*testing.T) sdk.TimelockConverter { return NewTimelockConverter() },
+		NewTimelockExecutor:  func(*testing.T) sdk.TimelockExecutor { return NewTimelockExecutor(chain, sender) },
+		NewTimelockInspector: func(*testing.T) sdk.TimelockInspector { return NewTimelockInspector(chain) },
+		NewTransaction: func(*testing.T, string) types.Transaction {
+			return evm.NewTransaction(common.HexToAddress("0x1000"), []byte{0x01}, big.NewInt(0), "Target", nil)
+		},
+		AdvanceTime: func(_ *testing.T, d time.Duration) { chain.AdvanceTime(d) },
+	})
+}
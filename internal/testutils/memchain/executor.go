@@ -0,0 +1,202 @@
+package memchain
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var (
+	_ sdk.Inspector            = (*Inspector)(nil)
+	_ sdk.PostOpCountInspector = (*Inspector)(nil)
+	_ sdk.Executor             = (*Executor)(nil)
+	_ sdk.Configurer           = (*Configurer)(nil)
+)
+
+// Inspector is an Inspector implementation for the ManyChainMultiSig contracts of a Chain.
+type Inspector struct {
+	chain *Chain
+}
+
+// NewInspector creates a new Inspector for the chain.
+func NewInspector(chain *Chain) *Inspector {
+	return &Inspector{chain: chain}
+}
+
+func (i *Inspector) GetConfig(_ context.Context, mcmAddr string) (*types.Config, error) {
+	var cfg *types.Config
+	err := i.chain.view(func() error {
+		ms, err := i.chain.multiSig(common.HexToAddress(mcmAddr))
+		if err != nil {
+			return err
+		}
+		cfg = cloneConfig(ms.config)
+
+		return nil
+	})
+
+	return cfg, err
+}
+
+func (i *Inspector) GetOpCount(_ context.Context, mcmAddr string) (uint64, error) {
+	var opCount uint64
+	err := i.chain.view(func() error {
+		ms, err := i.chain.multiSig(common.HexToAddress(mcmAddr))
+		if err != nil {
+			return err
+		}
+		opCount = ms.opCount
+
+		return nil
+	})
+
+	return opCount, err
+}
+
+func (i *Inspector) GetRoot(_ context.Context, mcmAddr string) (common.Hash, uint32, error) {
+	var (
+		root       common.Hash
+		validUntil uint32
+	)
+	err := i.chain.view(func() error {
+		ms, err := i.chain.multiSig(common.HexToAddress(mcmAddr))
+		if err != nil {
+			return err
+		}
+		root, validUntil = ms.root, ms.validUntil
+
+		return nil
+	})
+
+	return root, validUntil, err
+}
+
+func (i *Inspector) GetRootMetadata(_ context.Context, mcmAddr string) (types.ChainMetadata, error) {
+	var metadata types.ChainMetadata
+	err := i.chain.view(func() error {
+		ms, err := i.chain.multiSig(common.HexToAddress(mcmAddr))
+		if err != nil {
+			return err
+		}
+		metadata = types.ChainMetadata{
+			StartingOpCount: ms.metadata.preOpCount,
+			MCMAddress:      mcmAddr,
+		}
+
+		return nil
+	})
+
+	return metadata, err
+}
+
+func (i *Inspector) GetRootPostOpCount(_ context.Context, mcmAddr string) (uint64, error) {
+	var postOpCount uint64
+	err := i.chain.view(func() error {
+		ms, err := i.chain.multiSig(common.HexToAddress(mcmAddr))
+		if err != nil {
+			return err
+		}
+		postOpCount = ms.metadata.postOpCount
+
+		return nil
+	})
+
+	return postOpCount, err
+}
+
+// Executor is an Executor implementation for the ManyChainMultiSig contracts of a Chain. Operations
+// and root metadata are converted with the EVM encoder.
+type Executor struct {
+	*evm.Encoder
+	*Inspector
+}
+
+// NewExecutor creates a new Executor for the chain.
+func NewExecutor(encoder *evm.Encoder, chain *Chain) *Executor {
+	return &Executor{
+		Encoder:   encoder,
+		Inspector: NewInspector(chain),
+	}
+}
+
+func (e *Executor) ExecuteOperation(
+	_ context.Context,
+	metadata types.ChainMetadata,
+	nonce uint32,
+	proof []common.Hash,
+	op types.Operation,
+) (types.TransactionResult, error) {
+	if e.Encoder == nil {
+		return types.TransactionResult{}, errors.New("failed to create sdk.Executor - encoder (sdk.Encoder) is nil")
+	}
+
+	bindOp, err := e.ToGethOperation(nonce, metadata, op)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	hash, err := e.chain.transact(func() error {
+		return e.chain.execute(common.HexToAddress(metadata.MCMAddress), bindOp, proof)
+	})
+	if err != nil {
+		return types.TransactionResult{ChainFamily: chainsel.FamilyEVM}, err
+	}
+
+	return types.NewTransactionResult(hash, nil, chainsel.FamilyEVM), nil
+}
+
+func (e *Executor) SetRoot(
+	ctx context.Context,
+	metadata types.ChainMetadata,
+	proof []common.Hash,
+	root [32]byte,
+	validUntil uint32,
+	sortedSignatures []types.Signature,
+) (types.TransactionResult, error) {
+	if e.Encoder == nil {
+		return types.TransactionResult{}, errors.New("failed to create sdk.Executor - encoder (sdk.Encoder) is nil")
+	}
+
+	bindMeta, err := e.ToGethRootMetadata(ctx, metadata)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	hash, err := e.chain.transact(func() error {
+		return e.chain.setRoot(common.HexToAddress(metadata.MCMAddress), root, validUntil, bindMeta, proof, sortedSignatures)
+	})
+	if err != nil {
+		return types.TransactionResult{ChainFamily: chainsel.FamilyEVM}, err
+	}
+
+	return types.NewTransactionResult(hash, nil, chainsel.FamilyEVM), nil
+}
+
+// Configurer is a Configurer implementation for the ManyChainMultiSig contracts of a Chain.
+type Configurer struct {
+	chain  *Chain
+	sender common.Address
+}
+
+// NewConfigurer creates a new Configurer sending its transactions from sender, which must own
+// the configured contracts.
+func NewConfigurer(chain *Chain, sender common.Address) *Configurer {
+	return &Configurer{chain: chain, sender: sender}
+}
+
+func (c *Configurer) SetConfig(_ context.Context, mcmAddr string, cfg *types.Config, clearRoot bool) (types.TransactionResult, error) {
+	hash, err := c.chain.transact(func() error {
+		return c.chain.setConfig(c.sender, common.HexToAddress(mcmAddr), cfg, clearRoot)
+	})
+	if err != nil {
+		return types.TransactionResult{ChainFamily: chainsel.FamilyEVM}, err
+	}
+
+	return types.NewTransactionResult(hash, nil, chainsel.FamilyEVM), nil
+}
@@ -0,0 +1,238 @@
+package memchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms"
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestInspector(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newTestEnv(t, 1, 2)
+	inspector := NewInspector(env.chain)
+
+	cfg, err := inspector.GetConfig(ctx, env.mcm.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, &types.Config{
+		Quorum:       1,
+		Signers:      []common.Address{env.signers[0].Address(), env.signers[1].Address()},
+		GroupSigners: []types.Config{},
+	}, cfg)
+
+	root, validUntil, err := inspector.GetRoot(ctx, env.mcm.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, common.Hash{}, root)
+	assert.Zero(t, validUntil)
+
+	proposal := env.newProposal(t, []types.Operation{newOperation(common.HexToAddress("0x1000"), []byte{0x00})}, env.signers[1])
+	executable := env.newExecutable(t, proposal)
+	_, err = executable.SetRoot(ctx, chaintest.Chain1Selector)
+	require.NoError(t, err)
+
+	tree, err := proposal.MerkleTree()
+	require.NoError(t, err)
+	root, validUntil, err = inspector.GetRoot(ctx, env.mcm.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, tree.Root, root)
+	assert.Equal(t, proposal.ValidUntil, validUntil)
+
+	metadata, err := inspector.GetRootMetadata(ctx, env.mcm.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, types.ChainMetadata{StartingOpCount: 0, MCMAddress: env.mcm.Hex()}, metadata)
+
+	_, err = inspector.GetOpCount(ctx, common.HexToAddress("0x1").Hex())
+	require.ErrorContains(t, err, "no ManyChainMultiSig deployed at")
+}
+
+func TestExecutor_SetRoot(t *testing.T) {
+	t.Parallel()
+
+	target := common.HexToAddress("0x1000")
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, env testEnv) *mcms.Proposal
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(t *testing.T, env testEnv) *mcms.Proposal {
+				t.Helper()
+
+				return env.newProposal(t, []types.Operation{newOperation(target, []byte{0x00})}, env.signers[0], env.signers[1])
+			},
+		},
+		{
+			name: "failure: insufficient signers",
+			setup: func(t *testing.T, env testEnv) *mcms.Proposal {
+				t.Helper()
+
+				return env.newProposal(t, []types.Operation{newOperation(target, []byte{0x00})}, env.signers[0])
+			},
+			wantErr: ErrInsufficientSigners,
+		},
+		{
+			name: "failure: valid until has passed",
+			setup: func(t *testing.T, env testEnv) *mcms.Proposal {
+				t.Helper()
+
+				proposal := env.newProposal(t, []types.Operation{newOperation(target, []byte{0x00})}, env.signers[0], env.signers[1])
+				env.chain.AdvanceTime(2 * time.Hour)
+
+				return proposal
+			},
+			wantErr: ErrValidUntilHasAlreadyPassed,
+		},
+		{
+			name: "failure: wrong pre op count",
+			setup: func(t *testing.T, env testEnv) *mcms.Proposal {
+				t.Helper()
+
+				proposal := env.newProposal(t, []types.Operation{newOperation(target, []byte{0x00})})
+				metadata := proposal.ChainMetadata[chaintest.Chain1Selector]
+				metadata.StartingOpCount = 1
+				proposal.ChainMetadata[chaintest.Chain1Selector] = metadata
+				env.sign(t, proposal, env.signers[0], env.signers[1])
+
+				return proposal
+			},
+			wantErr: ErrWrongPreOpCount,
+		},
+		{
+			name: "failure: pending ops",
+			setup: func(t *testing.T, env testEnv) *mcms.Proposal {
+				t.Helper()
+
+				pending := env.newProposal(t, []types.Operation{newOperation(target, []byte{0x00})}, env.signers[0], env.signers[1])
+				_, err := env.newExecutable(t, pending).SetRoot(t.Context(), chaintest.Chain1Selector)
+				require.NoError(t, err)
+
+				return env.newProposal(t, []types.Operation{newOperation(target, []byte{0x01})}, env.signers[0], env.signers[1])
+			},
+			wantErr: ErrPendingOps,
+		},
+		{
+			name: "failure: signed hash already seen",
+			setup: func(t *testing.T, env testEnv) *mcms.Proposal {
+				t.Helper()
+
+				proposal := env.newProposal(t, []types.Operation{newOperation(target, []byte{0x00})}, env.signers[0], env.signers[1])
+				_, err := env.newExecutable(t, proposal).SetRoot(t.Context(), chaintest.Chain1Selector)
+				require.NoError(t, err)
+
+				return proposal
+			},
+			wantErr: ErrSignedHashAlreadySeen,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newTestEnv(t, 2, 3)
+			proposal := tt.setup(t, env)
+
+			result, err := env.newExecutable(t, proposal).SetRoot(t.Context(), chaintest.Chain1Selector)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, result.Hash)
+		})
+	}
+}
+
+func TestExecutor_SetRoot_UnsortedSignatures(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newTestEnv(t, 2, 2)
+	proposal := env.newProposal(t, []types.Operation{newOperation(common.HexToAddress("0x1000"), []byte{0x00})}, env.signers[0], env.signers[1])
+
+	encoders, err := proposal.GetEncoders()
+	require.NoError(t, err)
+	encoder, ok := encoders[chaintest.Chain1Selector].(*evm.Encoder)
+	require.True(t, ok)
+	tree, err := proposal.MerkleTree()
+	require.NoError(t, err)
+	metadata := proposal.ChainMetadata[chaintest.Chain1Selector]
+	metadataHash, err := encoder.HashMetadata(metadata)
+	require.NoError(t, err)
+	proof, err := tree.GetProof(metadataHash)
+	require.NoError(t, err)
+
+	// The signers of the env are sorted by address, so the reversed signatures are decreasing
+	signatures := []types.Signature{proposal.Signatures[1], proposal.Signatures[0]}
+	_, err = NewExecutor(encoder, env.chain).SetRoot(ctx, metadata, proof, tree.Root, proposal.ValidUntil, signatures)
+	require.ErrorIs(t, err, ErrSignersAddressesMustBeStrictlyIncreasing)
+}
+
+func TestExecutor_ExecuteOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newTestEnv(t, 1, 1)
+	target := common.HexToAddress("0x1000")
+
+	proposal := env.newProposal(t, []types.Operation{
+		newOperation(target, []byte{0x01}),
+		newOperation(target, []byte{0x02}),
+	}, env.signers[0])
+	executable := env.newExecutable(t, proposal)
+	_, err := executable.SetRoot(ctx, chaintest.Chain1Selector)
+	require.NoError(t, err)
+
+	_, err = executable.Execute(ctx, 1)
+	require.ErrorIs(t, err, ErrWrongNonce)
+
+	_, err = executable.Execute(ctx, 0)
+	require.NoError(t, err)
+
+	env.chain.AdvanceTime(2 * time.Hour)
+	_, err = executable.Execute(ctx, 1)
+	require.ErrorIs(t, err, ErrRootExpired)
+}
+
+func TestConfigurer_SetConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newTestEnv(t, 1, 1)
+	target := common.HexToAddress("0x1000")
+	cfg := &types.Config{Quorum: 1, Signers: []common.Address{env.signers[0].Address()}}
+
+	_, err := NewConfigurer(env.chain, target).SetConfig(ctx, env.mcm.Hex(), cfg, false)
+	require.ErrorIs(t, err, ErrNotOwner)
+
+	_, err = NewConfigurer(env.chain, env.owner).SetConfig(ctx, env.mcm.Hex(), &types.Config{Quorum: 2}, false)
+	require.Error(t, err)
+
+	// Clearing the root discards its pending operations
+	proposal := env.newProposal(t, []types.Operation{newOperation(target, []byte{0x00})}, env.signers[0])
+	_, err = env.newExecutable(t, proposal).SetRoot(ctx, chaintest.Chain1Selector)
+	require.NoError(t, err)
+
+	_, err = NewConfigurer(env.chain, env.owner).SetConfig(ctx, env.mcm.Hex(), cfg, true)
+	require.NoError(t, err)
+
+	root, validUntil, err := NewInspector(env.chain).GetRoot(ctx, env.mcm.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, common.Hash{}, root)
+	assert.Zero(t, validUntil)
+
+	proposal = env.newProposal(t, []types.Operation{newOperation(target, []byte{0x01})}, env.signers[0])
+	_, err = env.newExecutable(t, proposal).SetRoot(ctx, chaintest.Chain1Selector)
+	require.NoError(t, err)
+}
@@ -0,0 +1,287 @@
+package memchain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/mcms/internal/utils/abi"
+	"github.com/smartcontractkit/mcms/sdk/evm/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// Errors of the ManyChainMultiSig contract, named after its custom errors.
+var (
+	ErrMissingConfig                            = errors.New("MissingConfig")
+	ErrSignedHashAlreadySeen                    = errors.New("SignedHashAlreadySeen")
+	ErrSignersAddressesMustBeStrictlyIncreasing = errors.New("SignersAddressesMustBeStrictlyIncreasing")
+	ErrInvalidSigner                            = errors.New("InvalidSigner")
+	ErrInsufficientSigners                      = errors.New("InsufficientSigners")
+	ErrValidUntilHasAlreadyPassed               = errors.New("ValidUntilHasAlreadyPassed")
+	ErrProofCannotBeVerified                    = errors.New("ProofCannotBeVerified")
+	ErrWrongChainID                             = errors.New("WrongChainId")
+	ErrWrongMultiSig                            = errors.New("WrongMultiSig")
+	ErrPendingOps                               = errors.New("PendingOps")
+	ErrWrongPreOpCount                          = errors.New("WrongPreOpCount")
+	ErrWrongPostOpCount                         = errors.New("WrongPostOpCount")
+	ErrPostOpCountReached                       = errors.New("PostOpCountReached")
+	ErrRootExpired                              = errors.New("RootExpired")
+	ErrWrongNonce                               = errors.New("WrongNonce")
+	ErrCallReverted                             = errors.New("CallReverted")
+	ErrNotOwner                                 = errors.New("Ownable: caller is not the owner")
+)
+
+var (
+	// domainSeparatorOp and domainSeparatorMetadata are the domain separators of the merkle
+	// leaves, as in the ManyChainMultiSig contract.
+	domainSeparatorOp       = crypto.Keccak256Hash([]byte("MANY_CHAIN_MULTI_SIG_DOMAIN_SEPARATOR_OP"))
+	domainSeparatorMetadata = crypto.Keccak256Hash([]byte("MANY_CHAIN_MULTI_SIG_DOMAIN_SEPARATOR_METADATA"))
+)
+
+const (
+	signMsgABI  = `[{"type":"bytes32"},{"type":"uint32"}]`
+	opLeafABI   = `[{"type":"bytes32"},{"type":"tuple","components":[{"name":"chainId","type":"uint256"},{"name":"multiSig","type":"address"},{"name":"nonce","type":"uint40"},{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"}]}]`
+	metaLeafABI = `[{"type":"bytes32"},{"type":"tuple","components":[{"name":"chainId","type":"uint256"},{"name":"multiSig","type":"address"},{"name":"preOpCount","type":"uint40"},{"name":"postOpCount","type":"uint40"},{"name":"overridePreviousRoot","type":"bool"}]}]`
+)
+
+// rootMetadata is the metadata of the current root of a ManyChainMultiSig.
+type rootMetadata struct {
+	chainID              uint64
+	multiSig             common.Address
+	preOpCount           uint64
+	postOpCount          uint64
+	overridePreviousRoot bool
+}
+
+// multiSig is the state of a ManyChainMultiSig contract.
+type multiSig struct {
+	owner common.Address
+	// config is replaced, never modified, by SetConfig.
+	config     *types.Config
+	seenHashes map[common.Hash]bool
+
+	root       common.Hash
+	validUntil uint32
+	opCount    uint64
+	metadata   rootMetadata
+}
+
+func (ms *multiSig) clone() multiSig {
+	clone := *ms
+	clone.seenHashes = cloneMap(ms.seenHashes)
+
+	return clone
+}
+
+func (c *Chain) multiSig(address common.Address) (*multiSig, error) {
+	ms, ok := c.multiSigs[address]
+	if !ok {
+		return nil, fmt.Errorf("no ManyChainMultiSig deployed at %s", address.Hex())
+	}
+
+	return ms, nil
+}
+
+// setRoot mirrors ManyChainMultiSig.setRoot.
+func (c *Chain) setRoot(
+	address common.Address,
+	root common.Hash,
+	validUntil uint32,
+	metadata bindings.ManyChainMultiSigRootMetadata,
+	metadataProof []common.Hash,
+	signatures []types.Signature,
+) error {
+	ms, err := c.multiSig(address)
+	if err != nil {
+		return err
+	}
+	if ms.config.Quorum == 0 {
+		return ErrMissingConfig
+	}
+
+	msg, err := abi.Encode(signMsgABI, root, validUntil)
+	if err != nil {
+		return err
+	}
+	signedHash := ethSignedMessageHash(crypto.Keccak256Hash(msg))
+	if ms.seenHashes[signedHash] {
+		return ErrSignedHashAlreadySeen
+	}
+
+	allSigners := ms.config.GetAllSigners()
+	recovered := make([]common.Address, 0, len(signatures))
+	var prev common.Address
+	for _, sig := range signatures {
+		signer, recoverErr := sig.Recover(signedHash)
+		if recoverErr != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidSigner, recoverErr)
+		}
+		if bytes.Compare(prev.Bytes(), signer.Bytes()) >= 0 {
+			return ErrSignersAddressesMustBeStrictlyIncreasing
+		}
+		prev = signer
+		if !slices.Contains(allSigners, signer) {
+			return fmt.Errorf("%w: %s", ErrInvalidSigner, signer.Hex())
+		}
+		recovered = append(recovered, signer)
+	}
+	if !ms.config.QuorumMet(recovered) {
+		return ErrInsufficientSigners
+	}
+
+	if uint64(validUntil) < c.timestamp() {
+		return ErrValidUntilHasAlreadyPassed
+	}
+
+	leaf, err := hashLeaf(metaLeafABI, domainSeparatorMetadata, metadata)
+	if err != nil {
+		return err
+	}
+	if !verifyProof(metadataProof, root, leaf) {
+		return ErrProofCannotBeVerified
+	}
+
+	if !metadata.ChainId.IsUint64() || metadata.ChainId.Uint64() != c.chainID {
+		return ErrWrongChainID
+	}
+	if metadata.MultiSig != address {
+		return ErrWrongMultiSig
+	}
+	if ms.opCount != ms.metadata.postOpCount && !metadata.OverridePreviousRoot {
+		return ErrPendingOps
+	}
+	if metadata.PreOpCount.Uint64() != ms.opCount {
+		return ErrWrongPreOpCount
+	}
+	if metadata.PreOpCount.Cmp(metadata.PostOpCount) > 0 {
+		return ErrWrongPostOpCount
+	}
+
+	ms.seenHashes[signedHash] = true
+	ms.root = root
+	ms.validUntil = validUntil
+	ms.opCount = metadata.PreOpCount.Uint64()
+	ms.metadata = rootMetadata{
+		chainID:              c.chainID,
+		multiSig:             address,
+		preOpCount:           metadata.PreOpCount.Uint64(),
+		postOpCount:          metadata.PostOpCount.Uint64(),
+		overridePreviousRoot: metadata.OverridePreviousRoot,
+	}
+
+	return nil
+}
+
+// execute mirrors ManyChainMultiSig.execute.
+func (c *Chain) execute(address common.Address, op bindings.ManyChainMultiSigOp, proof []common.Hash) error {
+	ms, err := c.multiSig(address)
+	if err != nil {
+		return err
+	}
+
+	if ms.metadata.postOpCount <= ms.opCount {
+		return ErrPostOpCountReached
+	}
+	if !op.ChainId.IsUint64() || op.ChainId.Uint64() != c.chainID {
+		return ErrWrongChainID
+	}
+	if op.MultiSig != address {
+		return ErrWrongMultiSig
+	}
+	if c.timestamp() > uint64(ms.validUntil) {
+		return ErrRootExpired
+	}
+	if !op.Nonce.IsUint64() || op.Nonce.Uint64() != ms.opCount {
+		return ErrWrongNonce
+	}
+
+	leaf, err := hashLeaf(opLeafABI, domainSeparatorOp, op)
+	if err != nil {
+		return err
+	}
+	if !verifyProof(proof, ms.root, leaf) {
+		return ErrProofCannotBeVerified
+	}
+
+	ms.opCount++
+
+	if err := c.call(Call{From: address, To: op.To, Value: op.Value, Data: op.Data}); err != nil {
+		return fmt.Errorf("%w: %w", ErrCallReverted, err)
+	}
+
+	return nil
+}
+
+// setConfig mirrors ManyChainMultiSig.setConfig.
+func (c *Chain) setConfig(sender common.Address, address common.Address, cfg *types.Config, clearRoot bool) error {
+	ms, err := c.multiSig(address)
+	if err != nil {
+		return err
+	}
+	if sender != ms.owner {
+		return ErrNotOwner
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	if clearRoot {
+		ms.root = common.Hash{}
+		ms.validUntil = 0
+		ms.metadata = rootMetadata{
+			chainID:              c.chainID,
+			multiSig:             address,
+			preOpCount:           ms.opCount,
+			postOpCount:          ms.opCount,
+			overridePreviousRoot: true,
+		}
+	}
+	ms.config = cloneConfig(cfg)
+
+	return nil
+}
+
+func cloneConfig(cfg *types.Config) *types.Config {
+	clone := &types.Config{
+		Quorum:       cfg.Quorum,
+		Signers:      slices.Clone(cfg.Signers),
+		GroupSigners: make([]types.Config, 0, len(cfg.GroupSigners)),
+	}
+	for _, group := range cfg.GroupSigners {
+		clone.GroupSigners = append(clone.GroupSigners, *cloneConfig(&group))
+	}
+
+	return clone
+}
+
+// hashLeaf hashes a merkle leaf as the ManyChainMultiSig contract.
+func hashLeaf(leafABI string, domainSeparator common.Hash, value any) (common.Hash, error) {
+	encoded, err := abi.Encode(leafABI, domainSeparator, value)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// verifyProof verifies a merkle proof of sorted pairs, as OpenZeppelin MerkleProof.verify.
+func verifyProof(proof []common.Hash, root common.Hash, leaf common.Hash) bool {
+	computed := leaf
+	for _, node := range proof {
+		if bytes.Compare(computed.Bytes(), node.Bytes()) < 0 {
+			computed = crypto.Keccak256Hash(computed.Bytes(), node.Bytes())
+		} else {
+			computed = crypto.Keccak256Hash(node.Bytes(), computed.Bytes())
+		}
+	}
+
+	return computed == root
+}
+
+func ethSignedMessageHash(hash common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte("\x19Ethereum Signed Message:\n32"), hash.Bytes())
+}
@@ -0,0 +1,275 @@
+package memchain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/evm/bindings"
+)
+
+// Errors of the RBACTimelock contract, with its revert reasons.
+var (
+	ErrMissingRole             = errors.New("AccessControl: missing role")
+	ErrEmptyCalls              = errors.New("RBACTimelock: empty calls")
+	ErrOperationScheduled      = errors.New("RBACTimelock: operation already scheduled")
+	ErrInsufficientDelay       = errors.New("RBACTimelock: insufficient delay")
+	ErrSelectorBlocked         = errors.New("RBACTimelock: selector is blocked")
+	ErrOperationNotCancellable = errors.New("RBACTimelock: operation cannot be cancelled")
+	ErrOperationNotReady       = errors.New("RBACTimelock: operation is not ready")
+	ErrMissingDependency       = errors.New("RBACTimelock: missing dependency")
+	ErrUnderlyingCallReverted  = errors.New("RBACTimelock: underlying transaction reverted")
+	ErrCallerMustBeTimelock    = errors.New("RBACTimelock: caller must be timelock")
+	ErrUnsupportedTimelockCall = errors.New("unsupported RBACTimelock call")
+)
+
+// doneTimestamp marks an executed operation, as _DONE_TIMESTAMP of the RBACTimelock contract.
+const doneTimestamp = 1
+
+var (
+	adminRole     = crypto.Keccak256Hash([]byte("ADMIN_ROLE"))
+	proposerRole  = crypto.Keccak256Hash([]byte("PROPOSER_ROLE"))
+	executorRole  = crypto.Keccak256Hash([]byte("EXECUTOR_ROLE"))
+	cancellerRole = crypto.Keccak256Hash([]byte("CANCELLER_ROLE"))
+	bypasserRole  = crypto.Keccak256Hash([]byte("BYPASSER_ROLE"))
+)
+
+// timelock is the state of an RBACTimelock contract.
+type timelock struct {
+	minDelay uint64
+	// roles holds the members of every role in the order they were granted.
+	roles            map[common.Hash][]common.Address
+	timestamps       map[common.Hash]uint64
+	blockedSelectors [][4]byte
+}
+
+func (tl *timelock) clone() timelock {
+	clone := *tl
+	clone.roles = make(map[common.Hash][]common.Address, len(tl.roles))
+	for role, members := range tl.roles {
+		clone.roles[role] = slices.Clone(members)
+	}
+	clone.timestamps = cloneMap(tl.timestamps)
+	clone.blockedSelectors = slices.Clone(tl.blockedSelectors)
+
+	return clone
+}
+
+func (tl *timelock) hasRole(role common.Hash, account common.Address) bool {
+	return slices.Contains(tl.roles[role], account)
+}
+
+// checkRoleOrAdmin mirrors the onlyRoleOrAdminRole modifier.
+func (tl *timelock) checkRoleOrAdmin(role common.Hash, account common.Address) error {
+	if tl.hasRole(role, account) || tl.hasRole(adminRole, account) {
+		return nil
+	}
+
+	return missingRoleError(role, account)
+}
+
+func (tl *timelock) isOperation(id common.Hash) bool {
+	return tl.timestamps[id] > 0
+}
+
+func (tl *timelock) isOperationPending(id common.Hash) bool {
+	return tl.timestamps[id] > doneTimestamp
+}
+
+func (tl *timelock) isOperationReady(id common.Hash, now uint64) bool {
+	timestamp := tl.timestamps[id]
+
+	return timestamp > doneTimestamp && timestamp <= now
+}
+
+func (tl *timelock) isOperationDone(id common.Hash) bool {
+	return tl.timestamps[id] == doneTimestamp
+}
+
+func missingRoleError(role common.Hash, account common.Address) error {
+	return fmt.Errorf("%w %s for account %s", ErrMissingRole, role.Hex(), strings.ToLower(account.Hex()))
+}
+
+func (c *Chain) timelock(address common.Address) (*timelock, error) {
+	tl, ok := c.timelocks[address]
+	if !ok {
+		return nil, fmt.Errorf("no RBACTimelock deployed at %s", address.Hex())
+	}
+
+	return tl, nil
+}
+
+// callTimelock decodes the calldata of a call to an RBACTimelock and runs the called function.
+func (c *Chain) callTimelock(address common.Address, tl *timelock, call Call) error {
+	timelockABI, err := bindings.RBACTimelockMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	if len(call.Data) < 4 {
+		return ErrUnsupportedTimelockCall
+	}
+	method, err := timelockABI.MethodById(call.Data[:4])
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnsupportedTimelockCall, err)
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return fmt.Errorf("failed to unpack %s arguments: %w", method.Name, err)
+	}
+
+	switch method.Name {
+	case "scheduleBatch":
+		delay := abi.ConvertType(args[3], new(big.Int)).(*big.Int) //nolint:forcetypeassert // ABI type
+		if !delay.IsUint64() {
+			return ErrInsufficientDelay
+		}
+
+		return c.scheduleBatch(tl, call.From, toCalls(args[0]), toHash(args[1]), toHash(args[2]), delay.Uint64())
+	case "cancel":
+		return c.cancel(tl, call.From, toHash(args[0]))
+	case "executeBatch":
+		return c.executeBatch(address, tl, call.From, toCalls(args[0]), toHash(args[1]), toHash(args[2]))
+	case "bypasserExecuteBatch":
+		return c.bypasserExecuteBatch(address, tl, call.From, toCalls(args[0]))
+	case "updateDelay":
+		if call.From != address {
+			return ErrCallerMustBeTimelock
+		}
+		delay := abi.ConvertType(args[0], new(big.Int)).(*big.Int) //nolint:forcetypeassert // ABI type
+		tl.minDelay = delay.Uint64()
+
+		return nil
+	case "grantRole", "revokeRole":
+		if !tl.hasRole(adminRole, call.From) {
+			return missingRoleError(adminRole, call.From)
+		}
+		role, account := toHash(args[0]), abi.ConvertType(args[1], new(common.Address)).(*common.Address) //nolint:forcetypeassert // ABI type
+		members := slices.DeleteFunc(tl.roles[role], func(member common.Address) bool { return member == *account })
+		if method.Name == "grantRole" {
+			members = append(members, *account)
+		}
+		tl.roles[role] = members
+
+		return nil
+	case "blockFunctionSelector", "unblockFunctionSelector":
+		if !tl.hasRole(adminRole, call.From) {
+			return missingRoleError(adminRole, call.From)
+		}
+		selector := *abi.ConvertType(args[0], new([4]byte)).(*[4]byte) //nolint:forcetypeassert // ABI type
+		tl.blockedSelectors = slices.DeleteFunc(tl.blockedSelectors, func(s [4]byte) bool { return s == selector })
+		if method.Name == "blockFunctionSelector" {
+			tl.blockedSelectors = append(tl.blockedSelectors, selector)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedTimelockCall, method.Name)
+	}
+}
+
+// scheduleBatch mirrors RBACTimelock.scheduleBatch.
+func (c *Chain) scheduleBatch(
+	tl *timelock, sender common.Address, calls []bindings.RBACTimelockCall, predecessor, salt common.Hash, delay uint64,
+) error {
+	if err := tl.checkRoleOrAdmin(proposerRole, sender); err != nil {
+		return err
+	}
+	if len(calls) == 0 {
+		return ErrEmptyCalls
+	}
+	for _, call := range calls {
+		if len(call.Data) >= 4 && slices.Contains(tl.blockedSelectors, [4]byte(call.Data[:4])) {
+			return ErrSelectorBlocked
+		}
+	}
+
+	id, err := evm.HashOperationBatch(calls, predecessor, salt)
+	if err != nil {
+		return err
+	}
+	if tl.isOperation(id) {
+		return ErrOperationScheduled
+	}
+	if delay < tl.minDelay {
+		return ErrInsufficientDelay
+	}
+	tl.timestamps[id] = c.timestamp() + delay
+
+	return nil
+}
+
+// cancel mirrors RBACTimelock.cancel.
+func (c *Chain) cancel(tl *timelock, sender common.Address, id common.Hash) error {
+	if err := tl.checkRoleOrAdmin(cancellerRole, sender); err != nil {
+		return err
+	}
+	if !tl.isOperationPending(id) {
+		return ErrOperationNotCancellable
+	}
+	delete(tl.timestamps, id)
+
+	return nil
+}
+
+// executeBatch mirrors RBACTimelock.executeBatch.
+func (c *Chain) executeBatch(
+	address common.Address, tl *timelock, sender common.Address, calls []bindings.RBACTimelockCall, predecessor, salt common.Hash,
+) error {
+	// onlyRoleOrOpenRole: the role is open when the zero address holds it.
+	if !tl.hasRole(executorRole, sender) && !tl.hasRole(executorRole, common.Address{}) {
+		return missingRoleError(executorRole, sender)
+	}
+
+	id, err := evm.HashOperationBatch(calls, predecessor, salt)
+	if err != nil {
+		return err
+	}
+	if !tl.isOperationReady(id, c.timestamp()) {
+		return ErrOperationNotReady
+	}
+	if predecessor != (common.Hash{}) && !tl.isOperationDone(predecessor) {
+		return ErrMissingDependency
+	}
+
+	if err := c.executeCalls(address, calls); err != nil {
+		return err
+	}
+	tl.timestamps[id] = doneTimestamp
+
+	return nil
+}
+
+// bypasserExecuteBatch mirrors RBACTimelock.bypasserExecuteBatch.
+func (c *Chain) bypasserExecuteBatch(address common.Address, tl *timelock, sender common.Address, calls []bindings.RBACTimelockCall) error {
+	if err := tl.checkRoleOrAdmin(bypasserRole, sender); err != nil {
+		return err
+	}
+
+	return c.executeCalls(address, calls)
+}
+
+func (c *Chain) executeCalls(address common.Address, calls []bindings.RBACTimelockCall) error {
+	for _, call := range calls {
+		err := c.call(Call{From: address, To: call.Target, Value: call.Value, Data: call.Data})
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrUnderlyingCallReverted, err)
+		}
+	}
+
+	return nil
+}
+
+func toCalls(arg any) []bindings.RBACTimelockCall {
+	return *abi.ConvertType(arg, new([]bindings.RBACTimelockCall)).(*[]bindings.RBACTimelockCall) //nolint:forcetypeassert // ABI type
+}
+
+func toHash(arg any) common.Hash {
+	return *abi.ConvertType(arg, new(common.Hash)).(*common.Hash) //nolint:forcetypeassert // ABI type
+}
@@ -0,0 +1,168 @@
+package memchain
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/evm/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var (
+	_ sdk.TimelockInspector = (*TimelockInspector)(nil)
+	_ sdk.TimelockExecutor  = (*TimelockExecutor)(nil)
+	_ sdk.TimelockConverter = (*evm.TimelockConverter)(nil)
+)
+
+// NewTimelockConverter creates a TimelockConverter for the chain. The RBACTimelock of the chain
+// decodes EVM calldata, so the EVM converter is used.
+func NewTimelockConverter() *evm.TimelockConverter {
+	return evm.NewTimelockConverter()
+}
+
+// TimelockInspector is a TimelockInspector implementation for the RBACTimelock contracts of a Chain.
+type TimelockInspector struct {
+	chain *Chain
+}
+
+// NewTimelockInspector creates a new TimelockInspector for the chain.
+func NewTimelockInspector(chain *Chain) *TimelockInspector {
+	return &TimelockInspector{chain: chain}
+}
+
+// GetProposers returns the list of addresses with the proposer role
+func (i *TimelockInspector) GetProposers(_ context.Context, address string) ([]string, error) {
+	return i.getAddressesWithRole(address, proposerRole)
+}
+
+// GetExecutors returns the list of addresses with the executor role
+func (i *TimelockInspector) GetExecutors(_ context.Context, address string) ([]string, error) {
+	return i.getAddressesWithRole(address, executorRole)
+}
+
+// GetBypassers returns the list of addresses with the bypasser role
+func (i *TimelockInspector) GetBypassers(_ context.Context, address string) ([]string, error) {
+	return i.getAddressesWithRole(address, bypasserRole)
+}
+
+// GetCancellers returns the list of addresses with the canceller role
+func (i *TimelockInspector) GetCancellers(_ context.Context, address string) ([]string, error) {
+	return i.getAddressesWithRole(address, cancellerRole)
+}
+
+func (i *TimelockInspector) IsOperation(_ context.Context, address string, opID [32]byte) (bool, error) {
+	return i.checkOperation(address, func(tl *timelock) bool { return tl.isOperation(opID) })
+}
+
+func (i *TimelockInspector) IsOperationPending(_ context.Context, address string, opID [32]byte) (bool, error) {
+	return i.checkOperation(address, func(tl *timelock) bool { return tl.isOperationPending(opID) })
+}
+
+func (i *TimelockInspector) IsOperationReady(_ context.Context, address string, opID [32]byte) (bool, error) {
+	return i.checkOperation(address, func(tl *timelock) bool { return tl.isOperationReady(opID, i.chain.timestamp()) })
+}
+
+func (i *TimelockInspector) IsOperationDone(_ context.Context, address string, opID [32]byte) (bool, error) {
+	return i.checkOperation(address, func(tl *timelock) bool { return tl.isOperationDone(opID) })
+}
+
+// GetMinDelay returns the minimum delay of the timelock, in seconds
+func (i *TimelockInspector) GetMinDelay(_ context.Context, address string) (uint64, error) {
+	var minDelay uint64
+	err := i.chain.view(func() error {
+		tl, err := i.chain.timelock(common.HexToAddress(address))
+		if err != nil {
+			return err
+		}
+		minDelay = tl.minDelay
+
+		return nil
+	})
+
+	return minDelay, err
+}
+
+func (i *TimelockInspector) getAddressesWithRole(address string, role common.Hash) ([]string, error) {
+	var addresses []string
+	err := i.chain.view(func() error {
+		tl, err := i.chain.timelock(common.HexToAddress(address))
+		if err != nil {
+			return err
+		}
+		addresses = make([]string, 0, len(tl.roles[role]))
+		for _, member := range tl.roles[role] {
+			addresses = append(addresses, member.String())
+		}
+
+		return nil
+	})
+
+	return addresses, err
+}
+
+func (i *TimelockInspector) checkOperation(address string, check func(tl *timelock) bool) (bool, error) {
+	var result bool
+	err := i.chain.view(func() error {
+		tl, err := i.chain.timelock(common.HexToAddress(address))
+		if err != nil {
+			return err
+		}
+		result = check(tl)
+
+		return nil
+	})
+
+	return result, err
+}
+
+// TimelockExecutor is a TimelockExecutor implementation for the RBACTimelock contracts of a Chain.
+type TimelockExecutor struct {
+	*TimelockInspector
+	sender common.Address
+}
+
+// NewTimelockExecutor creates a new TimelockExecutor sending its transactions from sender.
+func NewTimelockExecutor(chain *Chain, sender common.Address) *TimelockExecutor {
+	return &TimelockExecutor{
+		TimelockInspector: NewTimelockInspector(chain),
+		sender:            sender,
+	}
+}
+
+func (e *TimelockExecutor) Execute(
+	_ context.Context, bop types.BatchOperation, timelockAddress string, predecessor common.Hash, salt common.Hash,
+) (types.TransactionResult, error) {
+	calls := make([]bindings.RBACTimelockCall, len(bop.Transactions))
+	for i, tx := range bop.Transactions {
+		var additionalFields evm.AdditionalFields
+		if err := json.Unmarshal(tx.AdditionalFields, &additionalFields); err != nil {
+			return types.TransactionResult{}, err
+		}
+
+		calls[i] = bindings.RBACTimelockCall{
+			Target: common.HexToAddress(tx.To),
+			Data:   tx.Data,
+			Value:  additionalFields.Value,
+		}
+	}
+
+	timelockAddr := common.HexToAddress(timelockAddress)
+	hash, err := e.chain.transact(func() error {
+		tl, err := e.chain.timelock(timelockAddr)
+		if err != nil {
+			return err
+		}
+
+		return e.chain.executeBatch(timelockAddr, tl, e.sender, calls, predecessor, salt)
+	})
+	if err != nil {
+		return types.TransactionResult{ChainFamily: chainsel.FamilyEVM}, err
+	}
+
+	return types.NewTransactionResult(hash, nil, chainsel.FamilyEVM), nil
+}
@@ -0,0 +1,194 @@
+package memchain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/evm/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// callTimelock sends a call to the timelock of the env from sender.
+func (e testEnv) callTimelock(t *testing.T, sender common.Address, method string, args ...any) error {
+	t.Helper()
+
+	timelockABI, err := bindings.RBACTimelockMetaData.GetAbi()
+	require.NoError(t, err)
+	data, err := timelockABI.Pack(method, args...)
+	require.NoError(t, err)
+
+	_, err = e.chain.transact(func() error {
+		return e.chain.call(Call{From: sender, To: e.timelock, Value: big.NewInt(0), Data: data})
+	})
+
+	return err
+}
+
+func newBatchOperation(to common.Address, data []byte) types.BatchOperation {
+	return types.BatchOperation{
+		Transactions: []types.Transaction{evm.NewTransaction(to, data, big.NewInt(0), "Target", nil)},
+	}
+}
+
+func toTimelockCalls(bop types.BatchOperation) []bindings.RBACTimelockCall {
+	calls := make([]bindings.RBACTimelockCall, 0, len(bop.Transactions))
+	for _, tx := range bop.Transactions {
+		calls = append(calls, bindings.RBACTimelockCall{Target: common.HexToAddress(tx.To), Value: big.NewInt(0), Data: tx.Data})
+	}
+
+	return calls
+}
+
+func TestTimelockInspector(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newTestEnv(t, 1, 1)
+	inspector := NewTimelockInspector(env.chain)
+	address := env.timelock.Hex()
+
+	proposers, err := inspector.GetProposers(ctx, address)
+	require.NoError(t, err)
+	assert.Equal(t, []string{env.mcm.Hex()}, proposers)
+
+	executors, err := inspector.GetExecutors(ctx, address)
+	require.NoError(t, err)
+	assert.Equal(t, []string{env.owner.Hex()}, executors)
+
+	cancellers, err := inspector.GetCancellers(ctx, address)
+	require.NoError(t, err)
+	assert.Equal(t, []string{env.mcm.Hex()}, cancellers)
+
+	bypassers, err := inspector.GetBypassers(ctx, address)
+	require.NoError(t, err)
+	assert.Equal(t, []string{env.mcm.Hex()}, bypassers)
+
+	minDelay, err := inspector.GetMinDelay(ctx, address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3600), minDelay)
+
+	_, err = inspector.GetMinDelay(ctx, common.HexToAddress("0x1").Hex())
+	require.ErrorContains(t, err, "no RBACTimelock deployed at")
+}
+
+func TestTimelockExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newTestEnv(t, 1, 1)
+	inspector := NewTimelockInspector(env.chain)
+	executor := NewTimelockExecutor(env.chain, env.owner)
+	target := common.HexToAddress("0x1000")
+
+	first := newBatchOperation(target, []byte{0x01})
+	second := newBatchOperation(target, []byte{0x02})
+	firstID, err := evm.HashOperationBatch(toTimelockCalls(first), common.Hash{}, common.Hash{})
+	require.NoError(t, err)
+	secondID, err := evm.HashOperationBatch(toTimelockCalls(second), firstID, common.Hash{})
+	require.NoError(t, err)
+
+	// Only proposers can schedule, with at least the min delay
+	err = env.callTimelock(t, target, "scheduleBatch", toTimelockCalls(first), common.Hash{}, common.Hash{}, big.NewInt(3600))
+	require.ErrorIs(t, err, ErrMissingRole)
+	err = env.callTimelock(t, env.mcm, "scheduleBatch", toTimelockCalls(first), common.Hash{}, common.Hash{}, big.NewInt(60))
+	require.ErrorIs(t, err, ErrInsufficientDelay)
+
+	require.NoError(t, env.callTimelock(t, env.mcm, "scheduleBatch", toTimelockCalls(first), common.Hash{}, common.Hash{}, big.NewInt(3600)))
+	require.NoError(t, env.callTimelock(t, env.mcm, "scheduleBatch", toTimelockCalls(second), firstID, common.Hash{}, big.NewInt(3600)))
+	err = env.callTimelock(t, env.mcm, "scheduleBatch", toTimelockCalls(first), common.Hash{}, common.Hash{}, big.NewInt(3600))
+	require.ErrorIs(t, err, ErrOperationScheduled)
+
+	pending, err := inspector.IsOperationPending(ctx, env.timelock.Hex(), firstID)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	ready, err := inspector.IsOperationReady(ctx, env.timelock.Hex(), firstID)
+	require.NoError(t, err)
+	assert.False(t, ready)
+
+	_, err = executor.Execute(ctx, first, env.timelock.Hex(), common.Hash{}, common.Hash{})
+	require.ErrorIs(t, err, ErrOperationNotReady)
+
+	env.chain.AdvanceTime(time.Hour)
+
+	_, err = NewTimelockExecutor(env.chain, target).Execute(ctx, first, env.timelock.Hex(), common.Hash{}, common.Hash{})
+	require.ErrorIs(t, err, ErrMissingRole)
+	_, err = executor.Execute(ctx, second, env.timelock.Hex(), firstID, common.Hash{})
+	require.ErrorIs(t, err, ErrMissingDependency)
+
+	_, err = executor.Execute(ctx, first, env.timelock.Hex(), common.Hash{}, common.Hash{})
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, second, env.timelock.Hex(), firstID, common.Hash{})
+	require.NoError(t, err)
+
+	done, err := inspector.IsOperationDone(ctx, env.timelock.Hex(), secondID)
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.Len(t, env.chain.Calls(), 2)
+}
+
+func TestTimelock_CancelAndBypass(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newTestEnv(t, 1, 1)
+	inspector := NewTimelockInspector(env.chain)
+	target := common.HexToAddress("0x1000")
+
+	bop := newBatchOperation(target, []byte{0x01})
+	id, err := evm.HashOperationBatch(toTimelockCalls(bop), common.Hash{}, common.Hash{})
+	require.NoError(t, err)
+
+	err = env.callTimelock(t, env.mcm, "cancel", id)
+	require.ErrorIs(t, err, ErrOperationNotCancellable)
+
+	require.NoError(t, env.callTimelock(t, env.mcm, "scheduleBatch", toTimelockCalls(bop), common.Hash{}, common.Hash{}, big.NewInt(3600)))
+	require.NoError(t, env.callTimelock(t, env.mcm, "cancel", id))
+
+	isOperation, err := inspector.IsOperation(ctx, env.timelock.Hex(), id)
+	require.NoError(t, err)
+	assert.False(t, isOperation)
+
+	require.NoError(t, env.callTimelock(t, env.mcm, "bypasserExecuteBatch", toTimelockCalls(bop)))
+	calls := env.chain.Calls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, env.timelock, calls[0].From)
+	assert.Equal(t, []byte{0x01}, calls[0].Data)
+}
+
+func TestTimelock_Admin(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newTestEnv(t, 1, 1)
+	inspector := NewTimelockInspector(env.chain)
+	target := common.HexToAddress("0x1000")
+
+	err := env.callTimelock(t, env.owner, "updateDelay", big.NewInt(60))
+	require.ErrorIs(t, err, ErrCallerMustBeTimelock)
+	require.NoError(t, env.callTimelock(t, env.timelock, "updateDelay", big.NewInt(60)))
+
+	minDelay, err := inspector.GetMinDelay(ctx, env.timelock.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(60), minDelay)
+
+	err = env.callTimelock(t, env.mcm, "grantRole", proposerRole, target)
+	require.ErrorIs(t, err, ErrMissingRole)
+	require.NoError(t, env.callTimelock(t, env.owner, "grantRole", proposerRole, target))
+	require.NoError(t, env.callTimelock(t, env.owner, "revokeRole", proposerRole, env.mcm))
+
+	proposers, err := inspector.GetProposers(ctx, env.timelock.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, []string{target.Hex()}, proposers)
+
+	require.NoError(t, env.callTimelock(t, env.owner, "blockFunctionSelector", [4]byte{0x01, 0x02, 0x03, 0x04}))
+	err = env.callTimelock(t, target, "scheduleBatch", []bindings.RBACTimelockCall{
+		{Target: target, Value: big.NewInt(0), Data: []byte{0x01, 0x02, 0x03, 0x04}},
+	}, common.Hash{}, common.Hash{}, big.NewInt(60))
+	require.ErrorIs(t, err, ErrSelectorBlocked)
+}
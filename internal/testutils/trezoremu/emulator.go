@@ -0,0 +1,228 @@
+// Package trezoremu implements a fake Trezor emulator for testing purposes.
+//
+// The fake listens on UDP like the Trezor emulator and speaks the same wire protocol: messages are
+// framed in 64 byte packets, one per datagram, and encoded with the protobuf definitions of the
+// device. It supports unlocking with a PIN, deriving Ethereum addresses and signing personal
+// messages, which require a button confirmation like on the device.
+package trezoremu
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/usbwallet/trezor"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/mcms/internal/utils/safecast"
+)
+
+const packetSize = 64
+
+// Emulator is a fake Trezor emulator holding a single key, which is returned for any derivation
+// path.
+type Emulator struct {
+	Key *ecdsa.PrivateKey
+
+	conn *net.UDPConn
+	pin  string
+
+	mu       sync.Mutex
+	unlocked bool
+	paths    []accounts.DerivationPath // Derivation paths of the signing requests
+	messages [][]byte                  // Messages of the signing requests
+	pending  proto.Message             // Reply sent once the user confirmation is acked
+}
+
+// Option configures an Emulator.
+type Option func(*Emulator)
+
+// WithPIN locks the emulator with a PIN. The PIN is expected as entered on the pinpad, which is
+// not scrambled by the fake.
+func WithPIN(pin string) Option {
+	return func(e *Emulator) {
+		e.pin = pin
+	}
+}
+
+// New starts an emulator on a random local port, which is stopped at the end of the test.
+func New(t *testing.T, key *ecdsa.PrivateKey, opts ...Option) *Emulator {
+	t.Helper()
+
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+
+	e := &Emulator{Key: key, conn: conn}
+	for _, opt := range opts {
+		opt(e)
+	}
+	e.unlocked = e.pin == ""
+
+	go e.serve()
+	t.Cleanup(func() { conn.Close() })
+
+	return e
+}
+
+// Addr returns the UDP address of the emulator.
+func (e *Emulator) Addr() string {
+	return e.conn.LocalAddr().String()
+}
+
+// SignRequests returns the derivation paths and messages of the signing requests received.
+func (e *Emulator) SignRequests() ([]accounts.DerivationPath, [][]byte) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return slices.Clone(e.paths), slices.Clone(e.messages)
+}
+
+// serve answers the requests until the connection is closed.
+func (e *Emulator) serve() {
+	var (
+		packet = make([]byte, packetSize)
+		kind   uint16
+		data   []byte
+		size   int
+	)
+	for {
+		n, addr, err := e.conn.ReadFromUDP(packet)
+		if err != nil {
+			return
+		}
+		if n != packetSize || packet[0] != '?' {
+			continue
+		}
+		// Reassemble the message from its packets
+		if data == nil {
+			if packet[1] != '#' || packet[2] != '#' {
+				continue
+			}
+			kind = binary.BigEndian.Uint16(packet[3:5])
+			size = int(binary.BigEndian.Uint32(packet[5:9]))
+			data = append(make([]byte, 0, size), packet[9:]...)
+		} else {
+			data = append(data, packet[1:]...)
+		}
+		if len(data) < size {
+			continue
+		}
+
+		reply := e.handle(kind, data[:size])
+		data = nil
+
+		for _, chunk := range frame(reply) {
+			if _, err := e.conn.WriteToUDP(chunk, addr); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// handle returns the reply to a request.
+func (e *Emulator) handle(kind uint16, data []byte) proto.Message {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	switch trezor.MessageType(kind) {
+	case trezor.MessageType_MessageType_Initialize:
+		major, minor, patch, label := uint32(2), uint32(8), uint32(0), "emulator"
+
+		return &trezor.Features{MajorVersion: &major, MinorVersion: &minor, PatchVersion: &patch, Label: &label}
+	case trezor.MessageType_MessageType_Ping:
+		if !e.unlocked {
+			return &trezor.PinMatrixRequest{}
+		}
+
+		return &trezor.Success{}
+	case trezor.MessageType_MessageType_PinMatrixAck:
+		req := new(trezor.PinMatrixAck)
+		if err := proto.Unmarshal(data, req); err != nil {
+			return failure(err.Error())
+		}
+		if req.GetPin() != e.pin {
+			return failure("PIN invalid")
+		}
+		e.unlocked = true
+
+		return &trezor.Success{}
+	case trezor.MessageType_MessageType_EthereumGetAddress:
+		if !e.unlocked {
+			return failure("Device is locked")
+		}
+		hex := crypto.PubkeyToAddress(e.Key.PublicKey).Hex()
+
+		return &trezor.EthereumAddress{AddressHex: &hex}
+	case trezor.MessageType_MessageType_EthereumSignMessage:
+		req := new(trezor.EthereumSignMessage)
+		if err := proto.Unmarshal(data, req); err != nil {
+			return failure(err.Error())
+		}
+		if !e.unlocked {
+			return failure("Device is locked")
+		}
+		sig, err := crypto.Sign(accounts.TextHash(req.GetMessage()), e.Key)
+		if err != nil {
+			return failure(err.Error())
+		}
+		sig[crypto.RecoveryIDOffset] += 27
+		hex := crypto.PubkeyToAddress(e.Key.PublicKey).Hex()
+
+		e.paths = append(e.paths, req.GetAddressN())
+		e.messages = append(e.messages, req.GetMessage())
+		e.pending = &trezor.EthereumMessageSignature{Signature: sig, AddressHex: &hex}
+
+		return &trezor.ButtonRequest{}
+	case trezor.MessageType_MessageType_ButtonAck:
+		if e.pending == nil {
+			return failure("Unexpected message")
+		}
+		reply := e.pending
+		e.pending = nil
+
+		return reply
+	default:
+		return failure("Unexpected message")
+	}
+}
+
+// failure returns a failure reply with the message msg.
+func failure(msg string) proto.Message {
+	return &trezor.Failure{Message: &msg}
+}
+
+// frame encodes the reply into 64 byte packets.
+func frame(msg proto.Message) [][]byte {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		msg = failure(err.Error())
+		data, _ = proto.Marshal(msg)
+	}
+	size, err := safecast.IntToUint32(len(data))
+	if err != nil {
+		panic(err)
+	}
+
+	payload := make([]byte, 8+len(data))
+	copy(payload, "##")
+	binary.BigEndian.PutUint16(payload[2:], trezor.Type(msg))
+	binary.BigEndian.PutUint32(payload[4:], size)
+	copy(payload[8:], data)
+
+	var chunks [][]byte
+	for len(payload) > 0 {
+		chunk := make([]byte, packetSize)
+		chunk[0] = '?'
+		n := copy(chunk[1:], payload)
+		payload = payload[n:]
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
@@ -0,0 +1,351 @@
+package mcms
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// JournalStatus is the confirmation status of a transaction recorded in an ExecutionJournal.
+type JournalStatus string
+
+const (
+	// JournalStatusSubmitted means the transaction was sent but has not yet been observed on chain.
+	JournalStatusSubmitted JournalStatus = "submitted"
+	// JournalStatusConfirmed means the on-chain state shows the step was applied.
+	JournalStatusConfirmed JournalStatus = "confirmed"
+)
+
+// JournalKey identifies a single step of a proposal execution. OpIndex is -1 for SetRoot steps.
+type JournalKey struct {
+	Kind          ExecutionStepKind   `json:"kind"`
+	ChainSelector types.ChainSelector `json:"chainSelector"`
+	OpIndex       int                 `json:"opIndex"`
+}
+
+// JournalEntry records the transaction submitted for a step.
+type JournalEntry struct {
+	JournalKey
+
+	TxHash      string        `json:"txHash"`
+	ChainFamily string        `json:"chainFamily,omitempty"`
+	Status      JournalStatus `json:"status"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+}
+
+// Result returns the recorded transaction as a TransactionResult. The raw transaction data is not
+// journaled, so RawData is always nil.
+func (e JournalEntry) Result() types.TransactionResult {
+	return types.TransactionResult{Hash: e.TxHash, ChainFamily: e.ChainFamily}
+}
+
+// ExecutionJournal stores the transactions submitted while executing a proposal, keyed by a hash
+// identifying the proposal. Executables consult the journal before sending a transaction so that a
+// re-run never submits the same step twice.
+//
+// Implementations must be safe for concurrent use.
+type ExecutionJournal interface {
+	// Get returns the entry recorded for the step, and false if there is none.
+	Get(ctx context.Context, proposalHash common.Hash, key JournalKey) (JournalEntry, bool, error)
+	// Put records the entry, replacing any previous entry for the same step.
+	Put(ctx context.Context, proposalHash common.Hash, entry JournalEntry) error
+	// Delete removes the entry for the step, so that the step is sent again. Executables do this
+	// for transactions which reverted or were dropped when they are given a TransactionChecker for
+	// the chain, see WithTransactionCheckers; for other chains, Delete is the way to discard such a
+	// transaction.
+	Delete(ctx context.Context, proposalHash common.Hash, key JournalKey) error
+	// Entries returns all the entries recorded for the proposal.
+	Entries(ctx context.Context, proposalHash common.Hash) ([]JournalEntry, error)
+}
+
+var _ ExecutionJournal = (*FileJournal)(nil)
+
+// FileJournal is an ExecutionJournal that stores the entries of each proposal in a JSON file named
+// after the proposal hash.
+type FileJournal struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileJournal creates a new FileJournal storing its files in dir, creating the directory if it
+// does not exist.
+func NewFileJournal(dir string) (*FileJournal, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create journal directory: %w", err)
+	}
+
+	return &FileJournal{dir: dir}, nil
+}
+
+// Get returns the entry recorded for the step, and false if there is none.
+func (j *FileJournal) Get(_ context.Context, proposalHash common.Hash, key JournalKey) (JournalEntry, bool, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	entries, err := j.read(proposalHash)
+	if err != nil {
+		return JournalEntry{}, false, err
+	}
+
+	idx := slices.IndexFunc(entries, func(e JournalEntry) bool { return e.JournalKey == key })
+	if idx == -1 {
+		return JournalEntry{}, false, nil
+	}
+
+	return entries[idx], true, nil
+}
+
+// Put records the entry, replacing any previous entry for the same step.
+func (j *FileJournal) Put(_ context.Context, proposalHash common.Hash, entry JournalEntry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	entries, err := j.read(proposalHash)
+	if err != nil {
+		return err
+	}
+
+	entries = slices.DeleteFunc(entries, func(e JournalEntry) bool { return e.JournalKey == entry.JournalKey })
+	entries = append(entries, entry)
+
+	return j.write(proposalHash, entries)
+}
+
+// Delete removes the entry for the step.
+func (j *FileJournal) Delete(_ context.Context, proposalHash common.Hash, key JournalKey) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	entries, err := j.read(proposalHash)
+	if err != nil {
+		return err
+	}
+
+	return j.write(proposalHash, slices.DeleteFunc(entries, func(e JournalEntry) bool { return e.JournalKey == key }))
+}
+
+// Entries returns all the entries recorded for the proposal, ordered by chain selector, step kind
+// and operation index.
+func (j *FileJournal) Entries(_ context.Context, proposalHash common.Hash) ([]JournalEntry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.read(proposalHash)
+}
+
+func (j *FileJournal) path(proposalHash common.Hash) string {
+	return filepath.Join(j.dir, proposalHash.Hex()+".json")
+}
+
+func (j *FileJournal) read(proposalHash common.Hash) ([]JournalEntry, error) {
+	b, err := os.ReadFile(j.path(proposalHash))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read journal: %w", err)
+	}
+
+	var entries []JournalEntry
+	if err = json.Unmarshal(b, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode journal %s: %w", j.path(proposalHash), err)
+	}
+
+	return entries, nil
+}
+
+// write replaces the journal file atomically, so that a crash never leaves a partially written
+// journal behind.
+func (j *FileJournal) write(proposalHash common.Hash, entries []JournalEntry) error {
+	slices.SortFunc(entries, func(a, b JournalEntry) int {
+		return cmp.Or(
+			cmp.Compare(a.ChainSelector, b.ChainSelector),
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.OpIndex, b.OpIndex),
+		)
+	})
+
+	b, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode journal: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(j.dir, ".journal-*")
+	if err != nil {
+		return fmt.Errorf("failed to write journal: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write journal: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write journal: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), j.path(proposalHash)); err != nil {
+		return fmt.Errorf("failed to write journal: %w", err)
+	}
+
+	return nil
+}
+
+// ExecutableOption configures an Executable or a TimelockExecutable.
+type ExecutableOption func(*executableOptions)
+
+type executableOptions struct {
+	journal  ExecutionJournal
+	checkers map[types.ChainSelector]sdk.TransactionChecker
+}
+
+// WithJournal makes the executable record every submitted transaction in the journal and consult
+// it before sending, so that an interrupted execution can be resumed without double-submitting.
+func WithJournal(journal ExecutionJournal) ExecutableOption {
+	return func(opts *executableOptions) {
+		opts.journal = journal
+	}
+}
+
+// WithTransactionCheckers makes the executable look up the journaled transaction of a step which
+// is not applied on chain. If the transaction reverted or is unknown to the chain, e.g. because it
+// was dropped from the mempool, its entry is discarded and the step is sent again instead of
+// returning a PendingTransactionError. It has no effect without WithJournal.
+func WithTransactionCheckers(checkers map[types.ChainSelector]sdk.TransactionChecker) ExecutableOption {
+	return func(opts *executableOptions) {
+		opts.checkers = checkers
+	}
+}
+
+// executionJournal wraps an ExecutionJournal with the hash of the proposal being executed.
+type executionJournal struct {
+	journal      ExecutionJournal
+	proposalHash common.Hash
+	checkers     map[types.ChainSelector]sdk.TransactionChecker
+}
+
+// run sends the step unless the journal already holds a transaction for it. A recorded transaction
+// is returned as-is once isApplied reports it on chain, and a PendingTransactionError is returned
+// while it is not, unless the transaction is known to have failed.
+func (j *executionJournal) run(
+	ctx context.Context,
+	key JournalKey,
+	isApplied func(context.Context) (bool, error),
+	send func(context.Context) (types.TransactionResult, error),
+) (types.TransactionResult, error) {
+	if j == nil {
+		return send(ctx)
+	}
+
+	entry, found, err := j.journal.Get(ctx, j.proposalHash, key)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to read execution journal: %w", err)
+	}
+
+	if found {
+		if entry.Status == JournalStatusConfirmed {
+			return entry.Result(), nil
+		}
+
+		applied, err := isApplied(ctx)
+		if err != nil {
+			return types.TransactionResult{}, err
+		}
+		if applied {
+			if err = j.confirm(ctx, key); err != nil {
+				return types.TransactionResult{}, err
+			}
+
+			return entry.Result(), nil
+		}
+
+		failed, err := j.discardFailed(ctx, entry)
+		if err != nil {
+			return types.TransactionResult{}, err
+		}
+		if !failed {
+			return types.TransactionResult{}, &PendingTransactionError{
+				ChainSelector: key.ChainSelector,
+				OpIndex:       key.OpIndex,
+				TxHash:        entry.TxHash,
+			}
+		}
+	}
+
+	result, err := send(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	err = j.journal.Put(ctx, j.proposalHash, JournalEntry{
+		JournalKey:  key,
+		TxHash:      result.Hash,
+		ChainFamily: result.ChainFamily,
+		Status:      JournalStatusSubmitted,
+		UpdatedAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		return result, fmt.Errorf("failed to record transaction %s in execution journal: %w", result.Hash, err)
+	}
+
+	return result, nil
+}
+
+// discardFailed looks up the transaction of the entry with the checker of its chain, and removes
+// the entry if the transaction reverted or is unknown to the chain. It returns false if there is no
+// checker for the chain.
+func (j *executionJournal) discardFailed(ctx context.Context, entry JournalEntry) (bool, error) {
+	checker, ok := j.checkers[entry.ChainSelector]
+	if !ok {
+		return false, nil
+	}
+
+	state, err := checker.TransactionState(ctx, entry.TxHash)
+	if err != nil {
+		return false, fmt.Errorf("failed to look up transaction %s on chain %d: %w", entry.TxHash, entry.ChainSelector, err)
+	}
+	if state != sdk.TransactionStateFailed && state != sdk.TransactionStateNotFound {
+		return false, nil
+	}
+
+	if err = j.journal.Delete(ctx, j.proposalHash, entry.JournalKey); err != nil {
+		return false, fmt.Errorf("failed to update execution journal: %w", err)
+	}
+
+	return true, nil
+}
+
+// confirm marks the entry for the step as confirmed. It is a no-op if there is no entry.
+func (j *executionJournal) confirm(ctx context.Context, key JournalKey) error {
+	if j == nil {
+		return nil
+	}
+
+	entry, found, err := j.journal.Get(ctx, j.proposalHash, key)
+	if err != nil {
+		return fmt.Errorf("failed to read execution journal: %w", err)
+	}
+	if !found || entry.Status == JournalStatusConfirmed {
+		return nil
+	}
+
+	entry.Status = JournalStatusConfirmed
+	entry.UpdatedAt = time.Now().UTC()
+	if err = j.journal.Put(ctx, j.proposalHash, entry); err != nil {
+		return fmt.Errorf("failed to update execution journal: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,315 @@
+package mcms
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/mocks"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestFileJournal(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	dir := filepath.Join(t.TempDir(), "journal")
+	proposalHash := common.HexToHash("0x01")
+
+	journal, err := NewFileJournal(dir)
+	require.NoError(t, err)
+
+	setRootKey := JournalKey{Kind: ExecutionStepSetRoot, ChainSelector: chaintest.Chain2Selector, OpIndex: -1}
+	executeKey := JournalKey{Kind: ExecutionStepExecute, ChainSelector: chaintest.Chain1Selector, OpIndex: 0}
+
+	_, found, err := journal.Get(ctx, proposalHash, setRootKey)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	updatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	setRoot := JournalEntry{JournalKey: setRootKey, TxHash: "0xaa", Status: JournalStatusSubmitted, UpdatedAt: updatedAt}
+	execute := JournalEntry{JournalKey: executeKey, TxHash: "0xbb", ChainFamily: "evm", Status: JournalStatusSubmitted, UpdatedAt: updatedAt}
+	require.NoError(t, journal.Put(ctx, proposalHash, setRoot))
+	require.NoError(t, journal.Put(ctx, proposalHash, execute))
+
+	execute.Status = JournalStatusConfirmed
+	require.NoError(t, journal.Put(ctx, proposalHash, execute))
+
+	// Entries are persisted and visible to a new journal on the same directory
+	journal, err = NewFileJournal(dir)
+	require.NoError(t, err)
+
+	got, found, err := journal.Get(ctx, proposalHash, executeKey)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, execute, got)
+	require.Equal(t, types.TransactionResult{Hash: "0xbb", ChainFamily: "evm"}, got.Result())
+
+	entries, err := journal.Entries(ctx, proposalHash)
+	require.NoError(t, err)
+	require.Equal(t, []JournalEntry{execute, setRoot}, entries)
+
+	entries, err = journal.Entries(ctx, common.HexToHash("0x02"))
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	require.NoError(t, journal.Delete(ctx, proposalHash, setRootKey))
+	entries, err = journal.Entries(ctx, proposalHash)
+	require.NoError(t, err)
+	require.Equal(t, []JournalEntry{execute}, entries)
+}
+
+func TestFileJournal_CorruptFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	proposalHash := common.HexToHash("0x01")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, proposalHash.Hex()+".json"), []byte("{"), 0o600))
+
+	journal, err := NewFileJournal(dir)
+	require.NoError(t, err)
+
+	_, _, err = journal.Get(t.Context(), proposalHash, JournalKey{})
+	require.ErrorContains(t, err, "failed to decode journal")
+}
+
+func TestExecutable_Execute_Journal(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	proposal := orchestratorTestProposal(chaintest.Chain1Selector)
+	journal, err := NewFileJournal(t.TempDir())
+	require.NoError(t, err)
+
+	executor := mocks.NewExecutor(t)
+	executable, err := NewExecutable(proposal, map[types.ChainSelector]sdk.Executor{
+		chaintest.Chain1Selector: executor,
+	}, WithJournal(journal))
+	require.NoError(t, err)
+
+	proposalHash, err := proposal.SigningHash()
+	require.NoError(t, err)
+	key := JournalKey{Kind: ExecutionStepExecute, ChainSelector: chaintest.Chain1Selector, OpIndex: 0}
+
+	// The first call sends the transaction and records it
+	executor.EXPECT().ExecuteOperation(mock.Anything, mock.Anything, uint32(0), mock.Anything, mock.Anything).
+		Return(types.TransactionResult{Hash: "0xabc", ChainFamily: "evm"}, nil).Once()
+	result, err := executable.Execute(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, "0xabc", result.Hash)
+
+	entry, found, err := journal.Get(ctx, proposalHash, key)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, JournalStatusSubmitted, entry.Status)
+
+	// While the operation is not reflected on chain, it is not sent again
+	executor.EXPECT().GetOpCount(mock.Anything, "0x1").Return(uint64(0), nil).Once()
+	_, err = executable.Execute(ctx, 0)
+	var pendingErr *PendingTransactionError
+	require.ErrorAs(t, err, &pendingErr)
+	require.EqualError(t, err, "transaction 0xabc for operation 0 on chain 3379446385462418246 is pending confirmation")
+
+	// Once it is, the recorded transaction is returned and marked as confirmed
+	executor.EXPECT().GetOpCount(mock.Anything, "0x1").Return(uint64(1), nil).Once()
+	result, err = executable.Execute(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.TransactionResult{Hash: "0xabc", ChainFamily: "evm"}, result)
+
+	entry, _, err = journal.Get(ctx, proposalHash, key)
+	require.NoError(t, err)
+	require.Equal(t, JournalStatusConfirmed, entry.Status)
+
+	// Confirmed entries are returned without querying the chain
+	result, err = executable.Execute(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, "0xabc", result.Hash)
+}
+
+// transactionCheckerFunc is a sdk.TransactionChecker returning the state from a function.
+type transactionCheckerFunc func(txHash string) (sdk.TransactionState, error)
+
+func (f transactionCheckerFunc) TransactionState(_ context.Context, txHash string) (sdk.TransactionState, error) {
+	return f(txHash)
+}
+
+func TestExecutable_Execute_Journal_TransactionChecker(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		state    sdk.TransactionState
+		checkErr error
+		wantSent bool
+		wantErr  string
+	}{
+		{
+			name:    "pending transaction",
+			state:   sdk.TransactionStatePending,
+			wantErr: "transaction 0xabc for operation 0 on chain 3379446385462418246 is pending confirmation",
+		},
+		{
+			name:     "reverted transaction",
+			state:    sdk.TransactionStateFailed,
+			wantSent: true,
+		},
+		{
+			name:     "dropped transaction",
+			state:    sdk.TransactionStateNotFound,
+			wantSent: true,
+		},
+		{
+			name:     "lookup error",
+			checkErr: errors.New("rpc down"),
+			wantErr:  "failed to look up transaction 0xabc on chain 3379446385462418246: rpc down",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			proposal := orchestratorTestProposal(chaintest.Chain1Selector)
+			journal, err := NewFileJournal(t.TempDir())
+			require.NoError(t, err)
+
+			proposalHash, err := proposal.SigningHash()
+			require.NoError(t, err)
+			key := JournalKey{Kind: ExecutionStepExecute, ChainSelector: chaintest.Chain1Selector, OpIndex: 0}
+			require.NoError(t, journal.Put(ctx, proposalHash, JournalEntry{JournalKey: key, TxHash: "0xabc", Status: JournalStatusSubmitted}))
+
+			checker := transactionCheckerFunc(func(txHash string) (sdk.TransactionState, error) {
+				require.Equal(t, "0xabc", txHash)
+				return tt.state, tt.checkErr
+			})
+
+			executor := mocks.NewExecutor(t)
+			executor.EXPECT().GetOpCount(mock.Anything, "0x1").Return(uint64(0), nil).Once()
+			if tt.wantSent {
+				executor.EXPECT().ExecuteOperation(mock.Anything, mock.Anything, uint32(0), mock.Anything, mock.Anything).
+					Return(types.TransactionResult{Hash: "0xdef"}, nil).Once()
+			}
+
+			executable, err := NewExecutable(proposal, map[types.ChainSelector]sdk.Executor{
+				chaintest.Chain1Selector: executor,
+			}, WithJournal(journal), WithTransactionCheckers(map[types.ChainSelector]sdk.TransactionChecker{
+				chaintest.Chain1Selector: checker,
+			}))
+			require.NoError(t, err)
+
+			result, err := executable.Execute(ctx, 0)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "0xdef", result.Hash)
+
+			// The failed transaction is replaced by the new one
+			entry, found, err := journal.Get(ctx, proposalHash, key)
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, "0xdef", entry.TxHash)
+			require.Equal(t, JournalStatusSubmitted, entry.Status)
+		})
+	}
+}
+
+func TestExecutable_SetRoot_Journal(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	proposal := orchestratorTestProposal(chaintest.Chain1Selector)
+	journal, err := NewFileJournal(t.TempDir())
+	require.NoError(t, err)
+
+	proposalHash, err := proposal.SigningHash()
+	require.NoError(t, err)
+	key := JournalKey{Kind: ExecutionStepSetRoot, ChainSelector: chaintest.Chain1Selector, OpIndex: -1}
+	require.NoError(t, journal.Put(ctx, proposalHash, JournalEntry{JournalKey: key, TxHash: "0xabc", Status: JournalStatusSubmitted}))
+
+	tree, err := proposal.MerkleTree()
+	require.NoError(t, err)
+
+	executor := mocks.NewExecutor(t)
+	executor.EXPECT().GetRoot(mock.Anything, "0x1").Return(tree.Root, proposal.ValidUntil, nil).Once()
+
+	executable, err := NewExecutable(proposal, map[types.ChainSelector]sdk.Executor{
+		chaintest.Chain1Selector: executor,
+	}, WithJournal(journal))
+	require.NoError(t, err)
+
+	result, err := executable.SetRoot(ctx, chaintest.Chain1Selector)
+	require.NoError(t, err)
+	require.Equal(t, "0xabc", result.Hash)
+}
+
+func TestTimelockExecutable_Execute_Journal(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	proposal := &TimelockProposal{
+		BaseProposal: BaseProposal{
+			Version:    "v1",
+			Kind:       types.KindTimelockProposal,
+			ValidUntil: 2004259681,
+			ChainMetadata: map[types.ChainSelector]types.ChainMetadata{
+				chaintest.Chain1Selector: {MCMAddress: "0x1"},
+			},
+		},
+		Action: types.TimelockActionSchedule,
+		Delay:  types.MustParseDuration("1h"),
+		TimelockAddresses: map[types.ChainSelector]string{
+			chaintest.Chain1Selector: "0x2",
+		},
+		Operations: []types.BatchOperation{{
+			ChainSelector: chaintest.Chain1Selector,
+			Transactions: []types.Transaction{
+				evm.NewTransaction(common.HexToAddress("0x1234"), []byte{0x01}, big.NewInt(0), "", nil),
+			},
+		}},
+	}
+	journal, err := NewFileJournal(t.TempDir())
+	require.NoError(t, err)
+
+	executor := mocks.NewTimelockExecutor(t)
+	executable, err := NewTimelockExecutable(ctx, proposal, map[types.ChainSelector]sdk.TimelockExecutor{
+		chaintest.Chain1Selector: executor,
+	}, WithJournal(journal))
+	require.NoError(t, err)
+
+	executor.EXPECT().Execute(mock.Anything, mock.Anything, "0x2", mock.Anything, mock.Anything).
+		Return(types.TransactionResult{Hash: "0xabc"}, nil).Once()
+	_, err = executable.Execute(ctx, 0)
+	require.NoError(t, err)
+
+	executor.EXPECT().IsOperationDone(mock.Anything, "0x2", mock.Anything).Return(false, nil).Once()
+	_, err = executable.Execute(ctx, 0)
+	var pendingErr *PendingTransactionError
+	require.ErrorAs(t, err, &pendingErr)
+
+	executor.EXPECT().IsOperationDone(mock.Anything, "0x2", mock.Anything).Return(false, errors.New("rpc down")).Once()
+	_, err = executable.Execute(ctx, 0)
+	require.EqualError(t, err, "rpc down")
+
+	// Dropping the entry allows the operation to be sent again
+	require.NoError(t, executable.journal.journal.Delete(ctx, executable.journal.proposalHash,
+		JournalKey{Kind: ExecutionStepTimelockExecute, ChainSelector: chaintest.Chain1Selector, OpIndex: 0}))
+	executor.EXPECT().Execute(mock.Anything, mock.Anything, "0x2", mock.Anything, mock.Anything).
+		Return(types.TransactionResult{Hash: "0xdef"}, nil).Once()
+	result, err := executable.Execute(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, "0xdef", result.Hash)
+}
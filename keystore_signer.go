@@ -0,0 +1,105 @@
+package mcms
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/term"
+)
+
+// PasswordSource returns the password of an encrypted keystore file.
+type PasswordSource func() (string, error)
+
+// PasswordFromEnv reads the password from the environment variable name.
+func PasswordFromEnv(name string) PasswordSource {
+	return func() (string, error) {
+		password, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+
+		return password, nil
+	}
+}
+
+// PasswordFromFile reads the password from the first line of the file at path.
+func PasswordFromFile(path string) PasswordSource {
+	return func() (string, error) {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read password file: %w", err)
+		}
+		password, _, _ := strings.Cut(string(b), "\n")
+
+		return strings.TrimSuffix(password, "\r"), nil
+	}
+}
+
+// PasswordFromPrompt prompts for the password on the terminal, without echoing it. The prompt is
+// written to stderr, so that it does not mix with the output of the program.
+func PasswordFromPrompt(prompt string) PasswordSource {
+	return func() (string, error) {
+		fd := int(os.Stdin.Fd())
+		if !term.IsTerminal(fd) {
+			return "", errors.New("cannot prompt for the password: stdin is not a terminal")
+		}
+
+		fmt.Fprint(os.Stderr, prompt)
+		password, err := term.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", fmt.Errorf("failed to read password: %w", err)
+		}
+
+		return string(password), nil
+	}
+}
+
+var _ Signer = &KeystoreSigner{}
+
+// KeystoreSigner signs payloads using the key of an encrypted keystore file, as written by geth
+// and Clef.
+type KeystoreSigner struct {
+	signer *PrivateKeySigner
+}
+
+// NewKeystoreSigner creates a new KeystoreSigner from the keystore file at path. The key is
+// decrypted once, with the password returned by password.
+func NewKeystoreSigner(path string, password PasswordSource) (*KeystoreSigner, error) {
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore file: %w", err)
+	}
+
+	return NewKeystoreSignerFromJSON(keyJSON, password)
+}
+
+// NewKeystoreSignerFromJSON creates a new KeystoreSigner from the content of a keystore file.
+func NewKeystoreSignerFromJSON(keyJSON []byte, password PasswordSource) (*KeystoreSigner, error) {
+	auth, err := password()
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(keyJSON, auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore: %w", err)
+	}
+
+	return &KeystoreSigner{signer: NewPrivateKeySigner(key.PrivateKey)}, nil
+}
+
+// Sign signs the payload using the keystore key.
+// The payload here should be without the EIP 191 prefix,
+// and the function will add it before signing.
+func (s *KeystoreSigner) Sign(payload []byte) ([]byte, error) {
+	return s.signer.Sign(payload)
+}
+
+// GetAddress returns the address of the keystore key.
+func (s *KeystoreSigner) GetAddress() (common.Address, error) {
+	return s.signer.GetAddress()
+}
@@ -0,0 +1,108 @@
+package mcms
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestKeystore writes the test private key to a keystore file encrypted with password.
+func writeTestKeystore(t *testing.T, password string) string {
+	t.Helper()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         uuid.New(),
+		Address:    crypto.PubkeyToAddress(privKey.PublicKey),
+		PrivateKey: privKey,
+	}, password, keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, os.WriteFile(path, keyJSON, 0o600))
+
+	return path
+}
+
+func TestNewKeystoreSigner(t *testing.T) {
+	t.Parallel()
+
+	keystorePath := writeTestKeystore(t, "secret")
+	passwordPath := filepath.Join(t.TempDir(), "password.txt")
+	require.NoError(t, os.WriteFile(passwordPath, []byte("secret\r\nignored\n"), 0o600))
+	wrongPasswordPath := filepath.Join(t.TempDir(), "wrong.txt")
+	require.NoError(t, os.WriteFile(wrongPasswordPath, []byte("wrong"), 0o600))
+
+	tests := []struct {
+		name     string
+		path     string
+		password PasswordSource
+		wantErr  string
+	}{
+		{
+			name:     "success: password from file",
+			path:     keystorePath,
+			password: PasswordFromFile(passwordPath),
+		},
+		{
+			name:     "failure: wrong password",
+			path:     keystorePath,
+			password: PasswordFromFile(wrongPasswordPath),
+			wantErr:  "failed to decrypt keystore: could not decrypt key with given password",
+		},
+		{
+			name:     "failure: missing environment variable",
+			path:     keystorePath,
+			password: PasswordFromEnv("MCMS_TEST_UNSET_KEYSTORE_PASSWORD"),
+			wantErr:  "environment variable MCMS_TEST_UNSET_KEYSTORE_PASSWORD is not set",
+		},
+		{
+			name:     "failure: missing keystore file",
+			path:     filepath.Join(t.TempDir(), "missing.json"),
+			password: PasswordFromFile(passwordPath),
+			wantErr:  "failed to read keystore file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			signer, err := NewKeystoreSigner(tt.path, tt.password)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+
+				addr, err := signer.GetAddress()
+				require.NoError(t, err)
+				require.Equal(t, common.HexToAddress("0xFe6d23D3C194bA84C035be35ad82775ddf0BFf4e"), addr)
+			}
+		})
+	}
+}
+
+func TestKeystoreSigner_Sign(t *testing.T) {
+	t.Setenv("MCMS_TEST_KEYSTORE_PASSWORD", "secret")
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	payload := []byte("0x000000000000000000000000000000")
+	want, err := NewPrivateKeySigner(privKey).Sign(payload)
+	require.NoError(t, err)
+
+	signer, err := NewKeystoreSigner(writeTestKeystore(t, "secret"), PasswordFromEnv("MCMS_TEST_KEYSTORE_PASSWORD"))
+	require.NoError(t, err)
+
+	got, err := signer.Sign(payload)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
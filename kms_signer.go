@@ -0,0 +1,95 @@
+package mcms
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// KMSClient is the subset of a cloud KMS API used by the KMSSigner, for a single secp256k1 key,
+// e.g. an AWS KMS key of the ECC_SECG_P256K1 key spec or a GCP KMS key of the
+// EC_SIGN_SECP256K1_SHA256 algorithm.
+//
+// The AWS and GCP SDK clients are adapted by passing the key ID or version name to their calls:
+// Sign maps to the AWS Sign API with the ECDSA_SHA_256 algorithm and the DIGEST message type, or
+// to the GCP AsymmetricSign API with the digest in the sha256 field, and PublicKey maps to their
+// GetPublicKey APIs. Such adapters are provided by the sdk/awskms and sdk/gcpkms packages, and
+// work against local KMS emulators as well.
+type KMSClient interface {
+	// Sign signs the 32 byte digest and returns the ASN.1 DER encoded ECDSA signature.
+	Sign(ctx context.Context, digest []byte) ([]byte, error)
+	// PublicKey returns the DER or PEM encoded SubjectPublicKeyInfo of the key.
+	PublicKey(ctx context.Context) ([]byte, error)
+}
+
+var _ Signer = &KMSSigner{}
+
+// KMSSigner signs payloads with a secp256k1 key held in a cloud KMS.
+//
+// The address of the key is fetched from the KMS on first use and cached afterwards.
+type KMSSigner struct {
+	client KMSClient
+
+	mu   sync.Mutex
+	addr *common.Address
+}
+
+// NewKMSSigner creates a new KMSSigner.
+func NewKMSSigner(client KMSClient) *KMSSigner {
+	return &KMSSigner{client: client}
+}
+
+// Sign signs the payload with the KMS key.
+// The payload here should be without the EIP 191 prefix,
+// and the function will add it before signing.
+func (s *KMSSigner) Sign(payload []byte) ([]byte, error) {
+	ctx := context.Background()
+
+	address, err := s.address(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := toEthSignedMessageHash(payload)
+	der, err := s.client.Sign(ctx, hash.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with KMS key: %w", err)
+	}
+	r, sv, err := parseDERSignature(der)
+	if err != nil {
+		return nil, err
+	}
+
+	return recoverableSignature(hash, r, sv, address)
+}
+
+// GetAddress returns the address of the KMS key.
+func (s *KMSSigner) GetAddress() (common.Address, error) {
+	return s.address(context.Background())
+}
+
+func (s *KMSSigner) address(ctx context.Context) (common.Address, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.addr != nil {
+		return *s.addr, nil
+	}
+
+	b, err := s.client.PublicKey(ctx)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get KMS public key: %w", err)
+	}
+	pubKey, err := parseSecp256k1PublicKeyInfo(b)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	addr := crypto.PubkeyToAddress(*pubKey)
+	s.addr = &addr
+
+	return addr, nil
+}
@@ -0,0 +1,201 @@
+package mcms
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeKMSClient implements the KMSClient interface with a local key, encoding its signatures and
+// public key as cloud KMS APIs do.
+type fakeKMSClient struct {
+	pk        *ecdsa.PrivateKey
+	highS     bool
+	pemPubKey bool
+	err       error
+
+	publicKeyCalls int
+}
+
+func (c *fakeKMSClient) Sign(_ context.Context, digest []byte) ([]byte, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	sig, err := crypto.Sign(digest, c.pk)
+	if err != nil {
+		return nil, err
+	}
+
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64])
+	if c.highS {
+		s.Sub(crypto.S256().Params().N, s)
+	}
+
+	return asn1.Marshal(struct{ R, S *big.Int }{r, s})
+}
+
+func (c *fakeKMSClient) PublicKey(context.Context) ([]byte, error) {
+	c.publicKeyCalls++
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return marshalSecp256k1PublicKeyInfo(&c.pk.PublicKey, c.pemPubKey)
+}
+
+func marshalSecp256k1PublicKeyInfo(pubKey *ecdsa.PublicKey, toPEM bool) ([]byte, error) {
+	point := crypto.FromECDSAPub(pubKey)
+	der, err := asn1.Marshal(struct {
+		Algorithm struct {
+			Algorithm  asn1.ObjectIdentifier
+			Parameters asn1.ObjectIdentifier
+		}
+		PublicKey asn1.BitString
+	}{
+		Algorithm: struct {
+			Algorithm  asn1.ObjectIdentifier
+			Parameters asn1.ObjectIdentifier
+		}{oidPublicKeyECDSA, oidNamedCurveSecp256k1},
+		PublicKey: asn1.BitString{Bytes: point, BitLength: 8 * len(point)},
+	})
+	if err != nil || !toPEM {
+		return der, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+func TestKMSSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	payload := []byte("0x000000000000000000000000000000")
+	want, err := NewPrivateKeySigner(privKey).Sign(payload)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		client  *fakeKMSClient
+		wantErr string
+	}{
+		{
+			name:   "success: signs the proposal",
+			client: &fakeKMSClient{pk: privKey},
+		},
+		{
+			name:   "success: normalizes high S values",
+			client: &fakeKMSClient{pk: privKey, highS: true},
+		},
+		{
+			name:   "success: PEM encoded public key",
+			client: &fakeKMSClient{pk: privKey, pemPubKey: true},
+		},
+		{
+			name:    "failure: KMS error",
+			client:  &fakeKMSClient{pk: privKey, err: errors.New("access denied")},
+			wantErr: "failed to get KMS public key: access denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewKMSSigner(tt.client).Sign(payload)
+
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestKMSSigner_GetAddress(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+
+	addr, err := NewKMSSigner(&fakeKMSClient{pk: privKey}).GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0xFe6d23D3C194bA84C035be35ad82775ddf0BFf4e"), addr)
+
+	// P-256 keys are rejected.
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	p256PubKey, err := x509.MarshalPKIXPublicKey(&p256Key.PublicKey)
+	require.NoError(t, err)
+	_, err = NewKMSSigner(&staticKMSClient{pubKey: p256PubKey}).GetAddress()
+	require.ErrorContains(t, err, "unsupported public key algorithm")
+}
+
+func TestKMSSigner_Sign_CachesAddress(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	client := &fakeKMSClient{pk: privKey}
+	signer := NewKMSSigner(client)
+
+	for range 3 {
+		_, err = signer.Sign([]byte("payload"))
+		require.NoError(t, err)
+	}
+	_, err = signer.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, 1, client.publicKeyCalls)
+
+	// Failures are not cached.
+	client = &fakeKMSClient{pk: privKey, err: errors.New("throttled")}
+	signer = NewKMSSigner(client)
+	_, err = signer.GetAddress()
+	require.EqualError(t, err, "failed to get KMS public key: throttled")
+	client.err = nil
+	_, err = signer.Sign([]byte("payload"))
+	require.NoError(t, err)
+	require.Equal(t, 2, client.publicKeyCalls)
+}
+
+func TestKMSSigner_Sign_WrongKey(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubKey, err := marshalSecp256k1PublicKeyInfo(&otherKey.PublicKey, false)
+	require.NoError(t, err)
+
+	client := &staticKMSClient{pubKey: pubKey, sign: (&fakeKMSClient{pk: privKey}).Sign}
+	_, err = NewKMSSigner(client).Sign([]byte("payload"))
+	require.ErrorContains(t, err, "signature does not recover to")
+}
+
+// staticKMSClient implements the KMSClient interface with a fixed public key.
+type staticKMSClient struct {
+	pubKey []byte
+	sign   func(ctx context.Context, digest []byte) ([]byte, error)
+}
+
+func (c *staticKMSClient) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	return c.sign(ctx, digest)
+}
+
+func (c *staticKMSClient) PublicKey(context.Context) ([]byte, error) {
+	return c.pubKey, nil
+}
@@ -1,69 +1,119 @@
 package mcms
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"maps"
-	"time"
 
 	"github.com/smartcontractkit/mcms/types"
 )
 
-// Merge merges the given timelock proposal with the current one
-func (m *TimelockProposal) Merge(ctx context.Context, other *TimelockProposal) (*TimelockProposal, error) {
-	if m.Version != other.Version {
-		return nil, errors.New("cannot merge proposals with different versions")
+// ConflictPolicy selects how a field whose value differs between two merged proposals is
+// merged. Each field supports a subset of the policies, see the With*Policy merge options.
+type ConflictPolicy string
+
+const (
+	// ConflictError fails the merge.
+	ConflictError ConflictPolicy = "error"
+	// ConflictConcatenate joins both values, separated by a newline.
+	ConflictConcatenate ConflictPolicy = "concatenate"
+	// ConflictTakeMax keeps the largest value.
+	ConflictTakeMax ConflictPolicy = "take-max"
+	// ConflictTakeMin keeps the smallest value.
+	ConflictTakeMin ConflictPolicy = "take-min"
+)
+
+// MergeOption configures the conflict policies of a merge.
+type MergeOption func(*mergeOptions)
+
+type mergeOptions struct {
+	description ConflictPolicy
+	validUntil  ConflictPolicy
+	delay       ConflictPolicy
+}
+
+// WithDescriptionPolicy sets the policy for different descriptions: ConflictConcatenate (the
+// default) or ConflictError. Empty descriptions never conflict.
+func WithDescriptionPolicy(policy ConflictPolicy) MergeOption {
+	return func(o *mergeOptions) {
+		o.description = policy
 	}
-	if m.Kind != other.Kind {
-		return nil, errors.New("cannot merge proposals with different kinds")
+}
+
+// WithValidUntilPolicy sets the policy for different valid until timestamps: ConflictTakeMin (the
+// default), ConflictTakeMax or ConflictError.
+func WithValidUntilPolicy(policy ConflictPolicy) MergeOption {
+	return func(o *mergeOptions) {
+		o.validUntil = policy
 	}
-	if m.Action != other.Action {
-		return nil, errors.New("cannot merge proposals with different actions")
+}
+
+// WithDelayPolicy sets the policy for different timelock delays: ConflictTakeMax (the default),
+// ConflictTakeMin or ConflictError.
+func WithDelayPolicy(policy ConflictPolicy) MergeOption {
+	return func(o *mergeOptions) {
+		o.delay = policy
 	}
+}
 
-	if m.OverridePreviousRoot || other.OverridePreviousRoot {
-		// FIXME: log warning when DX-1650 is done
-		m.OverridePreviousRoot = true
+func newMergeOptions(opts []MergeOption) (mergeOptions, error) {
+	o := mergeOptions{
+		description: ConflictConcatenate,
+		validUntil:  ConflictTakeMin,
+		delay:       ConflictTakeMax,
+	}
+	for _, opt := range opts {
+		opt(&o)
 	}
 
-	if other.Description != "" {
-		if m.Description != "" {
-			m.Description += "\n"
+	if o.description != ConflictConcatenate && o.description != ConflictError {
+		return o, fmt.Errorf("unsupported conflict policy %q for description", o.description)
+	}
+	for field, policy := range map[string]ConflictPolicy{"valid until": o.validUntil, "delay": o.delay} {
+		if policy != ConflictTakeMin && policy != ConflictTakeMax && policy != ConflictError {
+			return o, fmt.Errorf("unsupported conflict policy %q for %s", policy, field)
 		}
-		m.Description += other.Description
 	}
 
-	m.Signatures = nil // reset signatures, as existing ones are no longer valid
+	return o, nil
+}
 
-	m.Metadata = mergeMetadata(m.Metadata, other.Metadata)
+// Merge merges the given timelock proposal with the current one
+func (m *TimelockProposal) Merge(ctx context.Context, other *TimelockProposal, opts ...MergeOption) (*TimelockProposal, error) {
+	o, err := newMergeOptions(opts)
+	if err != nil {
+		return nil, err
+	}
 
-	for chainSelector, otherMetadata := range other.ChainMetadata {
-		thisMetadata, exists := m.ChainMetadata[chainSelector]
-		if !exists {
-			m.ChainMetadata[chainSelector] = otherMetadata
-			continue
-		}
+	if err = validateMergeable(m.BaseProposal, other.BaseProposal); err != nil {
+		return nil, err
+	}
+	if m.Action != other.Action {
+		return nil, errors.New("cannot merge proposals with different actions")
+	}
 
-		mergedMetadata, err := thisMetadata.Merge(otherMetadata)
-		if err != nil {
-			return nil, fmt.Errorf("failed to merge metadata for chain %v: %w", chainSelector, err)
+	// Check every conflict before merging anything, so that a failed merge leaves m unchanged
+	delay, err := mergeConflict("delays", m.Delay.Duration, other.Delay.Duration, o.delay)
+	if err != nil {
+		return nil, err
+	}
+	for chainSelector, otherTimelockAddress := range other.TimelockAddresses {
+		currentAddress, exists := m.TimelockAddresses[chainSelector]
+		if exists && currentAddress != otherTimelockAddress {
+			return nil, fmt.Errorf("cannot merge proposals with different timelock addresses (chain %v): %q vs %q",
+				chainSelector, currentAddress, otherTimelockAddress)
 		}
-
-		m.ChainMetadata[chainSelector] = mergedMetadata
 	}
 
-	m.ValidUntil = min(m.ValidUntil, other.ValidUntil)
-	m.Delay = types.NewDuration(time.Duration(max(m.Delay.Nanoseconds(), other.Delay.Nanoseconds())))
+	if err = m.BaseProposal.merge(other.BaseProposal, o); err != nil {
+		return nil, err
+	}
 
+	m.Delay = types.NewDuration(delay)
 	for chainSelector, otherTimelockAddress := range other.TimelockAddresses {
-		currentAddress, exists := m.TimelockAddresses[chainSelector]
-		if exists {
-			if currentAddress != otherTimelockAddress {
-				return nil, fmt.Errorf("cannot merge proposals with different timelock addresses (chain %v): %q vs %q",
-					chainSelector, currentAddress, otherTimelockAddress)
-			}
-		} else {
+		if _, exists := m.TimelockAddresses[chainSelector]; !exists {
 			m.TimelockAddresses[chainSelector] = otherTimelockAddress
 		}
 	}
@@ -83,6 +133,109 @@ func (m *TimelockProposal) Merge(ctx context.Context, other *TimelockProposal) (
 	return m, nil
 }
 
+// Merge merges the given proposal with the current one, with the same semantics as the merge of
+// timelock proposals: the operations of other are appended, the chain metadata is merged with
+// ChainMetadata.Merge, which keeps the lowest starting op count of every chain, and the
+// signatures are reset.
+func (p *Proposal) Merge(_ context.Context, other *Proposal, opts ...MergeOption) (*Proposal, error) {
+	o, err := newMergeOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateMergeable(p.BaseProposal, other.BaseProposal); err != nil {
+		return nil, err
+	}
+
+	if err = p.BaseProposal.merge(other.BaseProposal, o); err != nil {
+		return nil, err
+	}
+
+	p.Operations = append(p.Operations, other.Operations...)
+
+	return p, nil
+}
+
+func validateMergeable(base, other BaseProposal) error {
+	if base.Version != other.Version {
+		return errors.New("cannot merge proposals with different versions")
+	}
+	if base.Kind != other.Kind {
+		return errors.New("cannot merge proposals with different kinds")
+	}
+
+	return nil
+}
+
+// merge merges the fields shared by all proposals. It checks every conflict before merging, so
+// that p is left unchanged on error.
+func (p *BaseProposal) merge(other BaseProposal, o mergeOptions) error {
+	description := p.Description
+	if other.Description != "" {
+		switch {
+		case p.Description == "":
+			description = other.Description
+		case o.description == ConflictError:
+			if p.Description != other.Description {
+				return fmt.Errorf("cannot merge proposals with different descriptions: %q vs %q", p.Description, other.Description)
+			}
+		default:
+			description += "\n" + other.Description
+		}
+	}
+
+	validUntil, err := mergeConflict("valid until timestamps", p.ValidUntil, other.ValidUntil, o.validUntil)
+	if err != nil {
+		return err
+	}
+
+	chainMetadata := maps.Clone(p.ChainMetadata)
+	if chainMetadata == nil {
+		chainMetadata = make(map[types.ChainSelector]types.ChainMetadata, len(other.ChainMetadata))
+	}
+	for chainSelector, otherMetadata := range other.ChainMetadata {
+		thisMetadata, exists := chainMetadata[chainSelector]
+		if !exists {
+			chainMetadata[chainSelector] = otherMetadata
+			continue
+		}
+
+		mergedMetadata, err := thisMetadata.Merge(otherMetadata)
+		if err != nil {
+			return fmt.Errorf("failed to merge metadata for chain %v: %w", chainSelector, err)
+		}
+
+		chainMetadata[chainSelector] = mergedMetadata
+	}
+
+	if p.OverridePreviousRoot || other.OverridePreviousRoot {
+		// FIXME: log warning when DX-1650 is done
+		p.OverridePreviousRoot = true
+	}
+	p.Description = description
+	p.ValidUntil = validUntil
+	p.ChainMetadata = chainMetadata
+	p.Signatures = nil // reset signatures, as existing ones are no longer valid
+	p.Metadata = mergeMetadata(p.Metadata, other.Metadata)
+
+	return nil
+}
+
+// mergeConflict merges two different values of a field following the policy, the plural name of
+// the field being used in the error.
+func mergeConflict[T cmp.Ordered](field string, a, b T, policy ConflictPolicy) (T, error) {
+	switch {
+	case a == b:
+		return a, nil
+	case policy == ConflictTakeMin:
+		return min(a, b), nil
+	case policy == ConflictTakeMax:
+		return max(a, b), nil
+	default:
+		return a, fmt.Errorf("cannot merge proposals with different %s: %v vs %v", field, a, b)
+	}
+}
+
 func mergeMetadata(m1, m2 map[string]any) map[string]any {
 	if len(m2) == 0 {
 		return m1
@@ -2,6 +2,8 @@ package mcms
 
 import (
 	"encoding/json"
+	"maps"
+	"slices"
 	"testing"
 	"time"
 
@@ -52,6 +54,7 @@ func TestTimelockProposal_Merge(t *testing.T) {
 		name      string
 		proposal1 *TimelockProposal
 		proposal2 *TimelockProposal
+		opts      []MergeOption
 		wantErr   string
 		assert    func(t *testing.T, merged *TimelockProposal)
 	}{
@@ -315,6 +318,50 @@ func TestTimelockProposal_Merge(t *testing.T) {
 				require.Equal(t, want, merged.Metadata)
 			},
 		},
+		{
+			name:      "success: take min delay and max valid until",
+			proposal1: mustBuild(t, baseProposalBuilder()),
+			proposal2: mustBuild(t, baseProposalBuilder().
+				SetValidUntil(2053987200). // 2035-02-02 00:00:00 UTC
+				SetDelay(types.NewDuration(2*time.Minute))),
+			opts: []MergeOption{WithDelayPolicy(ConflictTakeMin), WithValidUntilPolicy(ConflictTakeMax)},
+			assert: func(t *testing.T, merged *TimelockProposal) {
+				t.Helper()
+				require.Equal(t, types.NewDuration(1*time.Minute), merged.Delay)
+				require.Equal(t, uint32(2053987200), merged.ValidUntil)
+			},
+		},
+		{
+			name:      "success: same description with error policy",
+			proposal1: mustBuild(t, baseProposalBuilder()),
+			proposal2: mustBuild(t, baseProposalBuilder()),
+			opts:      []MergeOption{WithDescriptionPolicy(ConflictError)},
+			assert: func(t *testing.T, merged *TimelockProposal) {
+				t.Helper()
+				require.Equal(t, "proposal 1", merged.Description)
+			},
+		},
+		{
+			name:      "failure: different delays with error policy",
+			proposal1: mustBuild(t, baseProposalBuilder()),
+			proposal2: mustBuild(t, baseProposalBuilder().SetDelay(types.NewDuration(2*time.Minute))),
+			opts:      []MergeOption{WithDelayPolicy(ConflictError)},
+			wantErr:   "cannot merge proposals with different delays: 1m0s vs 2m0s",
+		},
+		{
+			name:      "failure: different descriptions with error policy",
+			proposal1: mustBuild(t, baseProposalBuilder()),
+			proposal2: mustBuild(t, baseProposalBuilder().SetDescription("proposal 2")),
+			opts:      []MergeOption{WithDescriptionPolicy(ConflictError)},
+			wantErr:   `cannot merge proposals with different descriptions: "proposal 1" vs "proposal 2"`,
+		},
+		{
+			name:      "failure: unsupported delay policy",
+			proposal1: mustBuild(t, baseProposalBuilder()),
+			proposal2: mustBuild(t, baseProposalBuilder()),
+			opts:      []MergeOption{WithDelayPolicy(ConflictConcatenate)},
+			wantErr:   `unsupported conflict policy "concatenate" for delay`,
+		},
 		{
 			name:      "failure: different versions",
 			proposal1: mustBuild(t, baseProposalBuilder()),
@@ -418,9 +465,178 @@ func TestTimelockProposal_Merge(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			merged, err := tt.proposal1.Merge(t.Context(), tt.proposal2)
+			// Copy the fields updated by a merge
+			before := *tt.proposal1
+			before.ChainMetadata = maps.Clone(tt.proposal1.ChainMetadata)
+			before.Signatures = slices.Clone(tt.proposal1.Signatures)
+			before.TimelockAddresses = maps.Clone(tt.proposal1.TimelockAddresses)
+			before.Operations = slices.Clone(tt.proposal1.Operations)
+
+			merged, err := tt.proposal1.Merge(t.Context(), tt.proposal2, tt.opts...)
 			if tt.wantErr != "" {
 				require.ErrorContains(t, err, tt.wantErr)
+
+				// A failed merge leaves the proposal unchanged
+				require.Equal(t, before, *tt.proposal1)
+			} else {
+				require.NoError(t, err)
+				tt.assert(t, merged)
+			}
+		})
+	}
+}
+
+func TestProposal_Merge(t *testing.T) {
+	t.Parallel()
+
+	sig1, err := types.NewSignatureFromBytes(common.Hex2Bytes("0000000000000000000000000000000000000000000000001111111111111111000000000000000000000000000000000000000000000000aaaaaaaaaaaaaaaa1b"))
+	require.NoError(t, err)
+
+	operation := func(selector types.ChainSelector, to string) types.Operation {
+		return types.Operation{
+			ChainSelector: selector,
+			Transaction: types.Transaction{
+				To:               to,
+				Data:             common.Hex2Bytes("0x0001"),
+				AdditionalFields: json.RawMessage(`{"value":0}`),
+			},
+		}
+	}
+
+	baseProposalBuilder := func() *ProposalBuilder {
+		return NewProposalBuilder().
+			SetDescription("proposal 1").
+			SetVersion("v1").
+			SetValidUntil(2051222400). // 2035-01-01 00:00:00 UTC
+			AddSignature(sig1).
+			AddChainMetadata(chaintest.Chain1Selector, types.ChainMetadata{
+				StartingOpCount: 3,
+				MCMAddress:      "0xchain1McmAddress",
+			}).
+			AddOperation(operation(chaintest.Chain1Selector, "0xchain1ToAddress1"))
+	}
+
+	tests := []struct {
+		name      string
+		proposal1 *Proposal
+		proposal2 *Proposal
+		opts      []MergeOption
+		wantErr   string
+		assert    func(t *testing.T, merged *Proposal)
+	}{
+		{
+			name:      "success: merge with overlapping and new chains",
+			proposal1: mustBuild(t, baseProposalBuilder()),
+			proposal2: mustBuild(t, NewProposalBuilder().
+				SetDescription("proposal 2").
+				SetVersion("v1").
+				SetValidUntil(2053987200). // 2035-02-02 00:00:00 UTC
+				SetOverridePreviousRoot(true).
+				AddChainMetadata(chaintest.Chain1Selector, types.ChainMetadata{
+					StartingOpCount: 1,
+					MCMAddress:      "0xchain1McmAddress",
+				}).
+				AddChainMetadata(chaintest.Chain2Selector, types.ChainMetadata{
+					StartingOpCount: 7,
+					MCMAddress:      "0xchain2McmAddress",
+				}).
+				AddOperation(operation(chaintest.Chain1Selector, "0xchain1ToAddress2")).
+				AddOperation(operation(chaintest.Chain2Selector, "0xchain2ToAddress1")),
+			),
+			assert: func(t *testing.T, merged *Proposal) {
+				t.Helper()
+				want := mustBuild(t, baseProposalBuilder().
+					SetDescription("proposal 1\nproposal 2").
+					SetSignatures([]types.Signature(nil)).
+					SetOverridePreviousRoot(true).
+					AddChainMetadata(chaintest.Chain1Selector, types.ChainMetadata{
+						StartingOpCount: 1, // lowest opcount
+						MCMAddress:      "0xchain1McmAddress",
+					}).
+					AddChainMetadata(chaintest.Chain2Selector, types.ChainMetadata{
+						StartingOpCount: 7,
+						MCMAddress:      "0xchain2McmAddress",
+					}).
+					AddOperation(operation(chaintest.Chain1Selector, "0xchain1ToAddress2")).
+					AddOperation(operation(chaintest.Chain2Selector, "0xchain2ToAddress1")),
+				)
+
+				require.Equal(t, want, merged)
+				require.Equal(t, map[types.ChainSelector]uint64{
+					chaintest.Chain1Selector: 2,
+					chaintest.Chain2Selector: 1,
+				}, merged.TransactionCounts())
+			},
+		},
+		{
+			name:      "success: take max valid until",
+			proposal1: mustBuild(t, baseProposalBuilder()),
+			proposal2: mustBuild(t, baseProposalBuilder().SetValidUntil(2053987200)),
+			opts:      []MergeOption{WithValidUntilPolicy(ConflictTakeMax)},
+			assert: func(t *testing.T, merged *Proposal) {
+				t.Helper()
+				require.Equal(t, uint32(2053987200), merged.ValidUntil)
+				require.Len(t, merged.Operations, 2)
+			},
+		},
+		{
+			name:      "failure: different valid until with error policy",
+			proposal1: mustBuild(t, baseProposalBuilder()),
+			proposal2: mustBuild(t, baseProposalBuilder().SetValidUntil(2053987200)),
+			opts:      []MergeOption{WithValidUntilPolicy(ConflictError)},
+			wantErr:   "cannot merge proposals with different valid until timestamps: 2051222400 vs 2053987200",
+		},
+		{
+			name:      "failure: different descriptions with error policy",
+			proposal1: mustBuild(t, baseProposalBuilder()),
+			proposal2: mustBuild(t, baseProposalBuilder().SetDescription("proposal 2")),
+			opts:      []MergeOption{WithDescriptionPolicy(ConflictError)},
+			wantErr:   `cannot merge proposals with different descriptions: "proposal 1" vs "proposal 2"`,
+		},
+		{
+			name:      "failure: unsupported description policy",
+			proposal1: mustBuild(t, baseProposalBuilder()),
+			proposal2: mustBuild(t, baseProposalBuilder()),
+			opts:      []MergeOption{WithDescriptionPolicy(ConflictTakeMax)},
+			wantErr:   `unsupported conflict policy "take-max" for description`,
+		},
+		{
+			name:      "failure: different versions",
+			proposal1: mustBuild(t, baseProposalBuilder()),
+			proposal2: func() *Proposal {
+				proposal := mustBuild(t, baseProposalBuilder())
+				proposal.Version = "v2"
+
+				return proposal
+			}(),
+			wantErr: "cannot merge proposals with different versions",
+		},
+		{
+			name:      "failure: different mcm addresses for same chain",
+			proposal1: mustBuild(t, baseProposalBuilder()),
+			proposal2: mustBuild(t, baseProposalBuilder().
+				AddChainMetadata(chaintest.Chain1Selector, types.ChainMetadata{MCMAddress: "0xotherMcmAddress"}),
+			),
+			wantErr: "cannot merge ChainMetadata with different MCMAddress",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Copy the fields updated by a merge
+			before := *tt.proposal1
+			before.ChainMetadata = maps.Clone(tt.proposal1.ChainMetadata)
+			before.Signatures = slices.Clone(tt.proposal1.Signatures)
+			before.Operations = slices.Clone(tt.proposal1.Operations)
+
+			merged, err := tt.proposal1.Merge(t.Context(), tt.proposal2, tt.opts...)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+
+				// A failed merge leaves the proposal unchanged
+				require.Equal(t, before, *tt.proposal1)
 			} else {
 				require.NoError(t, err)
 				tt.assert(t, merged)
@@ -0,0 +1,429 @@
+package mcms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// ConfirmFunc waits for a transaction sent by the Orchestrator to be confirmed on the given chain.
+// Returning an error marks the attempt as failed, after which the step is retried if retries remain.
+type ConfirmFunc func(ctx context.Context, chainSelector types.ChainSelector, result types.TransactionResult) error
+
+// ExecutionStepKind identifies the kind of call an ExecutionStep performs.
+type ExecutionStepKind string
+
+const (
+	ExecutionStepSetRoot         ExecutionStepKind = "set_root"
+	ExecutionStepExecute         ExecutionStepKind = "execute"
+	ExecutionStepTimelockExecute ExecutionStepKind = "timelock_execute"
+)
+
+// ExecutionStepStatus is the outcome of an ExecutionStep.
+type ExecutionStepStatus string
+
+const (
+	// ExecutionStepExecuted means a transaction was sent (and confirmed, if a ConfirmFunc is set).
+	ExecutionStepExecuted ExecutionStepStatus = "executed"
+	// ExecutionStepSkipped means the on-chain state showed the step was already applied.
+	ExecutionStepSkipped ExecutionStepStatus = "skipped"
+	// ExecutionStepFailed means every attempt failed. Err holds the last error.
+	ExecutionStepFailed ExecutionStepStatus = "failed"
+)
+
+// ExecutionStep records the outcome of a single SetRoot, Execute or timelock Execute call.
+type ExecutionStep struct {
+	Kind          ExecutionStepKind
+	ChainSelector types.ChainSelector
+	// OpIndex is the index of the operation in the proposal the step belongs to. It is -1 for
+	// SetRoot steps.
+	OpIndex  int
+	Status   ExecutionStepStatus
+	Attempts int
+	Result   types.TransactionResult
+	Err      error
+}
+
+// ChainExecutionReport holds the steps run against a single chain. Steps on a chain stop at the
+// first failure, which is also recorded in Err.
+type ChainExecutionReport struct {
+	ChainSelector types.ChainSelector
+	Steps         []ExecutionStep
+	Err           error
+}
+
+// ExecutionReport is the structured result of an Orchestrator run.
+type ExecutionReport struct {
+	Chains map[types.ChainSelector]*ChainExecutionReport
+}
+
+// Err returns the failures of all chains joined together, ordered by chain selector, or nil if
+// every chain completed.
+func (r *ExecutionReport) Err() error {
+	var errs []error
+	for _, selector := range slices.Sorted(maps.Keys(r.Chains)) {
+		if err := r.Chains[selector].Err; err != nil {
+			errs = append(errs, fmt.Errorf("chain %d: %w", selector, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// OrchestratorOption configures an Orchestrator.
+type OrchestratorOption func(*orchestratorOptions)
+
+type orchestratorOptions struct {
+	timelockExecutable *TimelockExecutable
+	timelockOpts       []Option
+	maxParallelChains  int
+	maxRetries         int
+	retryDelay         time.Duration
+	confirm            ConfirmFunc
+	onProgress         func(ExecutionStep)
+}
+
+// WithTimelockExecutable makes the Orchestrator execute the scheduled timelock operations of each
+// chain once its MCMS operations have been executed. The options are passed on to
+// TimelockExecutable.Execute.
+func WithTimelockExecutable(executable *TimelockExecutable, opts ...Option) OrchestratorOption {
+	return func(o *orchestratorOptions) {
+		o.timelockExecutable = executable
+		o.timelockOpts = opts
+	}
+}
+
+// WithMaxParallelChains limits the number of chains processed at the same time. A value of 0,
+// the default, processes all chains in parallel.
+func WithMaxParallelChains(n int) OrchestratorOption {
+	return func(o *orchestratorOptions) {
+		o.maxParallelChains = n
+	}
+}
+
+// WithRetries sets how many times a failed step is retried and the delay between attempts.
+func WithRetries(maxRetries int, delay time.Duration) OrchestratorOption {
+	return func(o *orchestratorOptions) {
+		o.maxRetries = maxRetries
+		o.retryDelay = delay
+	}
+}
+
+// WithConfirmFunc sets the function used to wait for each sent transaction to be confirmed.
+func WithConfirmFunc(confirm ConfirmFunc) OrchestratorOption {
+	return func(o *orchestratorOptions) {
+		o.confirm = confirm
+	}
+}
+
+// WithProgressFunc sets a callback invoked after every completed step. Calls are serialized, so
+// the callback does not need to be safe for concurrent use.
+func WithProgressFunc(onProgress func(ExecutionStep)) OrchestratorOption {
+	return func(o *orchestratorOptions) {
+		o.onProgress = onProgress
+	}
+}
+
+// Orchestrator drives an Executable through the full execution plan: SetRoot on every chain whose
+// MCM contract does not already hold the proposal root, followed by the chain's operations in
+// order, and optionally the scheduled timelock operations.
+//
+// Every step is checked against the on-chain state before it is sent, so an interrupted run can
+// be resumed by running the Orchestrator again with the same proposal.
+type Orchestrator struct {
+	executable *Executable
+	opts       orchestratorOptions
+
+	progressMu sync.Mutex
+}
+
+// NewOrchestrator creates a new Orchestrator for the given Executable.
+func NewOrchestrator(executable *Executable, opts ...OrchestratorOption) (*Orchestrator, error) {
+	if executable == nil {
+		return nil, errors.New("orchestrator requires an executable")
+	}
+
+	o := &Orchestrator{executable: executable}
+	for _, opt := range opts {
+		opt(&o.opts)
+	}
+
+	if o.opts.maxParallelChains < 0 {
+		return nil, fmt.Errorf("invalid max parallel chains: %d", o.opts.maxParallelChains)
+	}
+	if o.opts.maxRetries < 0 {
+		return nil, fmt.Errorf("invalid max retries: %d", o.opts.maxRetries)
+	}
+
+	return o, nil
+}
+
+// Run executes the plan on every chain of the proposal. Chains are processed independently, so a
+// failure on one chain does not stop the others. The returned report is always non-nil and the
+// error is the same as ExecutionReport.Err.
+func (o *Orchestrator) Run(ctx context.Context) (*ExecutionReport, error) {
+	selectors := o.executable.proposal.ChainSelectors()
+
+	report := &ExecutionReport{
+		Chains: make(map[types.ChainSelector]*ChainExecutionReport, len(selectors)),
+	}
+	for _, selector := range selectors {
+		report.Chains[selector] = &ChainExecutionReport{ChainSelector: selector}
+	}
+
+	parallel := o.opts.maxParallelChains
+	if parallel == 0 {
+		parallel = len(selectors)
+	}
+	sem := make(chan struct{}, max(parallel, 1))
+
+	var wg sync.WaitGroup
+	for _, selector := range selectors {
+		chainReport := report.Chains[selector]
+		wg.Go(func() {
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				chainReport.Err = ctx.Err()
+				return
+			}
+
+			chainReport.Err = o.runChain(ctx, chainReport)
+		})
+	}
+	wg.Wait()
+
+	return report, report.Err()
+}
+
+// runChain runs SetRoot, Execute and timelock Execute steps for a single chain, stopping at the
+// first failed step.
+func (o *Orchestrator) runChain(ctx context.Context, report *ChainExecutionReport) error {
+	selector := report.ChainSelector
+	executor, ok := o.executable.executors[selector]
+	if !ok {
+		return fmt.Errorf("executor not provided for chain selector %d", selector)
+	}
+	mcmAddress := o.executable.proposal.ChainMetadata[selector].MCMAddress
+	root := o.executable.tree.Root
+
+	setRoot := ExecutionStep{Kind: ExecutionStepSetRoot, ChainSelector: selector, OpIndex: -1}
+	err := o.runStep(ctx, report, &setRoot,
+		func(ctx context.Context) (bool, error) {
+			onChainRoot, _, err := executor.GetRoot(ctx, mcmAddress)
+			if err != nil {
+				return false, fmt.Errorf("failed to get root: %w", err)
+			}
+
+			return onChainRoot == root, nil
+		},
+		func(ctx context.Context) (types.TransactionResult, error) {
+			return o.executable.SetRoot(ctx, selector)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for idx, op := range o.executable.proposal.Operations {
+		if op.ChainSelector != selector {
+			continue
+		}
+
+		txNonce := o.executable.txNonces[idx]
+		step := ExecutionStep{Kind: ExecutionStepExecute, ChainSelector: selector, OpIndex: idx}
+		err = o.runStep(ctx, report, &step,
+			func(ctx context.Context) (bool, error) {
+				opCount, err := executor.GetOpCount(ctx, mcmAddress)
+				if err != nil {
+					return false, fmt.Errorf("failed to get op count: %w", err)
+				}
+
+				return txNonce < opCount, nil
+			},
+			func(ctx context.Context) (types.TransactionResult, error) {
+				return o.executable.Execute(ctx, idx)
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return o.runTimelockChain(ctx, report)
+}
+
+// runTimelockChain executes the scheduled timelock operations of a chain in order. Operations that
+// are not ready yet count as failed attempts, so retries can be used to wait out the timelock delay.
+func (o *Orchestrator) runTimelockChain(ctx context.Context, report *ChainExecutionReport) error {
+	te := o.opts.timelockExecutable
+	if te == nil {
+		return nil
+	}
+
+	selector := report.ChainSelector
+	for idx, bop := range te.proposal.Operations {
+		if bop.ChainSelector != selector {
+			continue
+		}
+
+		executor, ok := te.executors[selector]
+		if !ok {
+			return fmt.Errorf("timelock executor not provided for chain selector %d", selector)
+		}
+		timelock := te.proposal.TimelockAddresses[selector]
+
+		var opID common.Hash
+		step := ExecutionStep{Kind: ExecutionStepTimelockExecute, ChainSelector: selector, OpIndex: idx}
+		err := o.runStep(ctx, report, &step,
+			func(ctx context.Context) (bool, error) {
+				var err error
+				opID, err = te.GetOpID(ctx, idx, bop, selector)
+				if err != nil {
+					return false, fmt.Errorf("unable to get operation ID: %w", err)
+				}
+
+				return executor.IsOperationDone(ctx, timelock, opID)
+			},
+			func(ctx context.Context) (types.TransactionResult, error) {
+				ready, err := executor.IsOperationReady(ctx, timelock, opID)
+				if err != nil {
+					return types.TransactionResult{}, err
+				}
+				if !ready {
+					return types.TransactionResult{}, &OperationNotReadyError{OpIndex: idx}
+				}
+
+				return te.Execute(ctx, idx, o.opts.timelockOpts...)
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runStep runs a single step with retries. Before every attempt isDone is consulted so that steps
+// already applied on chain, including by a previous attempt whose result was lost, are not sent
+// again.
+func (o *Orchestrator) runStep(
+	ctx context.Context,
+	report *ChainExecutionReport,
+	step *ExecutionStep,
+	isDone func(context.Context) (bool, error),
+	send func(context.Context) (types.TransactionResult, error),
+) error {
+	defer func() {
+		report.Steps = append(report.Steps, *step)
+		o.reportProgress(*step)
+	}()
+
+	var lastErr error
+	for attempt := 0; attempt <= o.opts.maxRetries; attempt++ {
+		if attempt > 0 {
+			if err := sleepContext(ctx, o.opts.retryDelay); err != nil {
+				lastErr = err
+				break
+			}
+		}
+
+		done, err := isDone(ctx)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if done {
+			step.Status = ExecutionStepSkipped
+
+			return o.confirmJournal(ctx, step)
+		}
+
+		step.Attempts++
+		result, err := send(ctx)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		step.Result = result
+
+		if o.opts.confirm == nil {
+			step.Status = ExecutionStepExecuted
+
+			return nil
+		}
+
+		if err = o.opts.confirm(ctx, step.ChainSelector, result); err != nil {
+			lastErr = fmt.Errorf("failed to confirm transaction %s: %w", result.Hash, err)
+			continue
+		}
+		step.Status = ExecutionStepExecuted
+
+		return o.confirmJournal(ctx, step)
+	}
+
+	step.Status = ExecutionStepFailed
+	step.Err = lastErr
+
+	switch step.Kind {
+	case ExecutionStepSetRoot:
+		return fmt.Errorf("failed to set root: %w", lastErr)
+	case ExecutionStepTimelockExecute:
+		return fmt.Errorf("failed to execute timelock operation %d: %w", step.OpIndex, lastErr)
+	default:
+		return fmt.Errorf("failed to execute operation %d: %w", step.OpIndex, lastErr)
+	}
+}
+
+// confirmJournal marks the step as confirmed in the journal of the executable it belongs to, if
+// any. A failure to do so fails the step.
+func (o *Orchestrator) confirmJournal(ctx context.Context, step *ExecutionStep) error {
+	journal := o.executable.journal
+	if step.Kind == ExecutionStepTimelockExecute {
+		journal = o.opts.timelockExecutable.journal
+	}
+
+	key := JournalKey{Kind: step.Kind, ChainSelector: step.ChainSelector, OpIndex: step.OpIndex}
+	if err := journal.confirm(ctx, key); err != nil {
+		step.Status = ExecutionStepFailed
+		step.Err = err
+
+		return err
+	}
+
+	return nil
+}
+
+func (o *Orchestrator) reportProgress(step ExecutionStep) {
+	if o.opts.onProgress == nil {
+		return
+	}
+
+	o.progressMu.Lock()
+	defer o.progressMu.Unlock()
+
+	o.opts.onProgress(step)
+}
+
+// sleepContext waits for the given duration or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
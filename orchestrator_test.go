@@ -0,0 +1,289 @@
+package mcms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/internal/testutils/evmsim"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/evm/bindings"
+	"github.com/smartcontractkit/mcms/sdk/mocks"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestNewOrchestrator(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewOrchestrator(nil)
+	require.EqualError(t, err, "orchestrator requires an executable")
+
+	executable := &Executable{proposal: &Proposal{}}
+
+	_, err = NewOrchestrator(executable, WithMaxParallelChains(-1))
+	require.EqualError(t, err, "invalid max parallel chains: -1")
+
+	_, err = NewOrchestrator(executable, WithRetries(-1, 0))
+	require.EqualError(t, err, "invalid max retries: -1")
+
+	orchestrator, err := NewOrchestrator(executable, WithMaxParallelChains(2), WithRetries(3, 0))
+	require.NoError(t, err)
+	require.NotNil(t, orchestrator)
+}
+
+func TestOrchestrator_Run_SkipsAppliedStepsAndRetries(t *testing.T) {
+	t.Parallel()
+
+	proposal := orchestratorTestProposal(chaintest.Chain1Selector)
+	tree, err := proposal.MerkleTree()
+	require.NoError(t, err)
+
+	executor := mocks.NewExecutor(t)
+	executor.EXPECT().GetRoot(mock.Anything, "0x1").Return(tree.Root, proposal.ValidUntil, nil).Once()
+	executor.EXPECT().GetOpCount(mock.Anything, "0x1").Return(uint64(1), nil).Times(3)
+	executor.EXPECT().ExecuteOperation(mock.Anything, mock.Anything, uint32(1), mock.Anything, mock.Anything).
+		Return(types.TransactionResult{}, errors.New("nonce too low")).Once()
+	executor.EXPECT().ExecuteOperation(mock.Anything, mock.Anything, uint32(1), mock.Anything, mock.Anything).
+		Return(types.TransactionResult{Hash: "0xabc"}, nil).Once()
+
+	executable, err := NewExecutable(proposal, map[types.ChainSelector]sdk.Executor{
+		chaintest.Chain1Selector: executor,
+	})
+	require.NoError(t, err)
+
+	var progress []ExecutionStep
+	confirmed := 0
+	orchestrator, err := NewOrchestrator(executable,
+		WithRetries(1, 0),
+		WithProgressFunc(func(step ExecutionStep) { progress = append(progress, step) }),
+		WithConfirmFunc(func(_ context.Context, selector types.ChainSelector, result types.TransactionResult) error {
+			require.Equal(t, chaintest.Chain1Selector, selector)
+			require.Equal(t, "0xabc", result.Hash)
+			confirmed++
+
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	report, err := orchestrator.Run(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, confirmed)
+
+	chainReport := report.Chains[chaintest.Chain1Selector]
+	require.NoError(t, chainReport.Err)
+	require.Equal(t, progress, chainReport.Steps)
+	require.Equal(t, []ExecutionStep{
+		{Kind: ExecutionStepSetRoot, ChainSelector: chaintest.Chain1Selector, OpIndex: -1, Status: ExecutionStepSkipped},
+		{Kind: ExecutionStepExecute, ChainSelector: chaintest.Chain1Selector, OpIndex: 0, Status: ExecutionStepSkipped},
+		{
+			Kind:          ExecutionStepExecute,
+			ChainSelector: chaintest.Chain1Selector,
+			OpIndex:       1,
+			Status:        ExecutionStepExecuted,
+			Attempts:      2,
+			Result:        types.TransactionResult{Hash: "0xabc"},
+		},
+	}, chainReport.Steps)
+}
+
+func TestOrchestrator_Run_Failures(t *testing.T) {
+	t.Parallel()
+
+	proposal := orchestratorTestProposal(chaintest.Chain1Selector, chaintest.Chain2Selector)
+
+	executor := mocks.NewExecutor(t)
+	executor.EXPECT().GetRoot(mock.Anything, "0x1").Return(common.Hash{}, 0, errors.New("rpc down")).Twice()
+
+	executable, err := NewExecutable(proposal, map[types.ChainSelector]sdk.Executor{
+		chaintest.Chain1Selector: executor,
+	})
+	require.NoError(t, err)
+
+	orchestrator, err := NewOrchestrator(executable, WithRetries(1, 0), WithMaxParallelChains(1))
+	require.NoError(t, err)
+
+	report, err := orchestrator.Run(t.Context())
+	require.EqualError(t, err, "chain 3379446385462418246: failed to set root: failed to get root: rpc down\n"+
+		"chain 16015286601757825753: executor not provided for chain selector 16015286601757825753")
+	require.Equal(t, err.Error(), report.Err().Error())
+
+	steps := report.Chains[chaintest.Chain1Selector].Steps
+	require.Len(t, steps, 1)
+	require.Equal(t, ExecutionStepFailed, steps[0].Status)
+	require.Zero(t, steps[0].Attempts)
+	require.EqualError(t, steps[0].Err, "failed to get root: rpc down")
+	require.Empty(t, report.Chains[chaintest.Chain2Selector].Steps)
+}
+
+func TestOrchestrator_Run_E2E(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	sim := evmsim.NewSimulatedChain(t, 1)
+	mcmC, _ := sim.DeployMCMContract(t, sim.Signers[0])
+	sim.SetMCMSConfig(t, sim.Signers[0], mcmC)
+	timelockC, _ := sim.DeployRBACTimelock(t, sim.Signers[0], mcmC.Address(), []common.Address{}, []common.Address{}, []common.Address{}, []common.Address{})
+
+	timelockAbi, err := bindings.RBACTimelockMetaData.GetAbi()
+	require.NoError(t, err)
+
+	proposal := Proposal{
+		BaseProposal: BaseProposal{
+			Version:    "v1",
+			Kind:       types.KindProposal,
+			ValidUntil: 2004259681,
+			Signatures: []types.Signature{},
+			ChainMetadata: map[types.ChainSelector]types.ChainMetadata{
+				chaintest.Chain1Selector: {MCMAddress: mcmC.Address().Hex()},
+			},
+		},
+	}
+	for _, role := range []common.Hash{proposerRole, bypasserRole} {
+		data, err := timelockAbi.Pack("grantRole", role, mcmC.Address())
+		require.NoError(t, err)
+		proposal.Operations = append(proposal.Operations, types.Operation{
+			ChainSelector: chaintest.Chain1Selector,
+			Transaction:   evm.NewTransaction(timelockC.Address(), data, big.NewInt(0), "RBACTimelock", nil),
+		})
+	}
+	proposal.UseSimulatedBackend(true)
+
+	signable, err := NewSignable(&proposal, map[types.ChainSelector]sdk.Inspector{
+		chaintest.Chain1Selector: evm.NewInspector(sim.Backend.Client()),
+	})
+	require.NoError(t, err)
+	_, err = signable.SignAndAppend(NewPrivateKeySigner(sim.Signers[0].PrivateKey))
+	require.NoError(t, err)
+
+	encoders, err := proposal.GetEncoders()
+	require.NoError(t, err)
+	executable, err := NewExecutable(&proposal, map[types.ChainSelector]sdk.Executor{
+		chaintest.Chain1Selector: evm.NewExecutor(
+			encoders[chaintest.Chain1Selector].(*evm.Encoder),
+			sim.Backend.Client(),
+			sim.Signers[0].NewTransactOpts(t),
+		),
+	})
+	require.NoError(t, err)
+
+	commit := WithConfirmFunc(func(context.Context, types.ChainSelector, types.TransactionResult) error {
+		sim.Backend.Commit()
+		return nil
+	})
+
+	orchestrator, err := NewOrchestrator(executable, commit)
+	require.NoError(t, err)
+	report, err := orchestrator.Run(ctx)
+	require.NoError(t, err)
+	for _, step := range report.Chains[chaintest.Chain1Selector].Steps {
+		require.Equal(t, ExecutionStepExecuted, step.Status)
+		require.NotEmpty(t, step.Result.Hash)
+	}
+
+	opCount, err := mcmC.GetOpCount(&bind.CallOpts{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), opCount.Uint64())
+
+	// Running again resumes from the on-chain state and has nothing left to do
+	orchestrator, err = NewOrchestrator(executable, commit)
+	require.NoError(t, err)
+	report, err = orchestrator.Run(ctx)
+	require.NoError(t, err)
+	steps := report.Chains[chaintest.Chain1Selector].Steps
+	require.Len(t, steps, 3)
+	for _, step := range steps {
+		require.Equal(t, ExecutionStepSkipped, step.Status)
+	}
+}
+
+func TestOrchestrator_Run_Timelock(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	sim, _, timelockC, timelockProposal, _ := scheduleGrantRolesProposal(t,
+		[]common.Hash{proposerRole}, types.MustParseDuration("0s"))
+
+	proposal, _, err := timelockProposal.Convert(ctx, map[types.ChainSelector]sdk.TimelockConverter{
+		chaintest.Chain1Selector: &evm.TimelockConverter{},
+	})
+	require.NoError(t, err)
+	proposal.UseSimulatedBackend(true)
+
+	signable, err := NewSignable(&proposal, map[types.ChainSelector]sdk.Inspector{
+		chaintest.Chain1Selector: evm.NewInspector(sim.Backend.Client()),
+	})
+	require.NoError(t, err)
+	_, err = signable.SignAndAppend(NewPrivateKeySigner(sim.Signers[0].PrivateKey))
+	require.NoError(t, err)
+
+	encoders, err := proposal.GetEncoders()
+	require.NoError(t, err)
+	executable, err := NewExecutable(&proposal, map[types.ChainSelector]sdk.Executor{
+		chaintest.Chain1Selector: evm.NewExecutor(
+			encoders[chaintest.Chain1Selector].(*evm.Encoder),
+			sim.Backend.Client(),
+			sim.Signers[0].NewTransactOpts(t),
+		),
+	})
+	require.NoError(t, err)
+
+	timelockExecutable, err := NewTimelockExecutable(ctx, &timelockProposal, map[types.ChainSelector]sdk.TimelockExecutor{
+		chaintest.Chain1Selector: evm.NewTimelockExecutor(sim.Backend.Client(), sim.Signers[0].NewTransactOpts(t)),
+	})
+	require.NoError(t, err)
+
+	orchestrator, err := NewOrchestrator(executable,
+		WithTimelockExecutable(timelockExecutable),
+		WithConfirmFunc(func(context.Context, types.ChainSelector, types.TransactionResult) error {
+			sim.Backend.Commit()
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	report, err := orchestrator.Run(ctx)
+	require.NoError(t, err)
+
+	steps := report.Chains[chaintest.Chain1Selector].Steps
+	require.Len(t, steps, 3)
+	require.Equal(t, ExecutionStepTimelockExecute, steps[2].Kind)
+	require.Equal(t, ExecutionStepExecuted, steps[2].Status)
+
+	hasRole, err := timelockC.HasRole(&bind.CallOpts{}, proposerRole, sim.Signers[0].Address(t))
+	require.NoError(t, err)
+	require.True(t, hasRole)
+}
+
+// orchestratorTestProposal returns a proposal with two operations on each of the given chains.
+func orchestratorTestProposal(selectors ...types.ChainSelector) *Proposal {
+	proposal := &Proposal{
+		BaseProposal: BaseProposal{
+			Version:       "v1",
+			Kind:          types.KindProposal,
+			ValidUntil:    2004259681,
+			ChainMetadata: map[types.ChainSelector]types.ChainMetadata{},
+		},
+	}
+	for i, selector := range selectors {
+		proposal.ChainMetadata[selector] = types.ChainMetadata{MCMAddress: fmt.Sprintf("0x%d", i+1)}
+		for range 2 {
+			proposal.Operations = append(proposal.Operations, types.Operation{
+				ChainSelector: selector,
+				Transaction:   evm.NewTransaction(common.HexToAddress("0x1234"), []byte{0x01}, big.NewInt(0), "", nil),
+			})
+		}
+	}
+	proposal.UseSimulatedBackend(true)
+
+	return proposal
+}
@@ -0,0 +1,101 @@
+package mcms
+
+import (
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// PKCS11Session is the subset of a logged in PKCS#11 session used by the PKCS11Signer, for a
+// single secp256k1 key pair of the token.
+//
+// A binding such as github.com/miekg/pkcs11 is adapted by holding the session and the handles of
+// the private and public key objects: SignDigest maps to SignInit with the CKM_ECDSA mechanism
+// followed by Sign, and ECPoint maps to GetAttributeValue of CKA_EC_POINT. Such an adapter is
+// provided by the sdk/pkcs11 package, and works against SoftHSM as well.
+type PKCS11Session interface {
+	// SignDigest signs the 32 byte digest with the CKM_ECDSA mechanism and returns the 64 byte
+	// R || S signature.
+	SignDigest(digest []byte) ([]byte, error)
+	// ECPoint returns the CKA_EC_POINT attribute of the public key, which is the uncompressed
+	// point, usually wrapped in a DER octet string.
+	ECPoint() ([]byte, error)
+}
+
+var _ Signer = &PKCS11Signer{}
+
+// PKCS11Signer signs payloads with a secp256k1 key held in a PKCS#11 token, such as an HSM.
+//
+// The address of the key is read from the token on first use and cached afterwards.
+type PKCS11Signer struct {
+	session PKCS11Session
+
+	mu   sync.Mutex
+	addr *common.Address
+}
+
+// NewPKCS11Signer creates a new PKCS11Signer.
+func NewPKCS11Signer(session PKCS11Session) *PKCS11Signer {
+	return &PKCS11Signer{session: session}
+}
+
+// Sign signs the payload with the PKCS#11 key.
+// The payload here should be without the EIP 191 prefix,
+// and the function will add it before signing.
+func (s *PKCS11Signer) Sign(payload []byte) ([]byte, error) {
+	address, err := s.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := toEthSignedMessageHash(payload)
+	sig, err := s.session.SignDigest(hash.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with PKCS#11 key: %w", err)
+	}
+	if len(sig) != 64 {
+		return nil, fmt.Errorf("invalid PKCS#11 signature length: %d", len(sig))
+	}
+
+	return recoverableSignature(hash, new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:]), address)
+}
+
+// GetAddress returns the address of the PKCS#11 key.
+func (s *PKCS11Signer) GetAddress() (common.Address, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.addr != nil {
+		return *s.addr, nil
+	}
+
+	point, err := s.session.ECPoint()
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get PKCS#11 public key: %w", err)
+	}
+
+	// Tokens following the specification wrap the point in a DER octet string, while some
+	// return it as is.
+	if len(point) != 65 {
+		var unwrapped []byte
+		rest, uerr := asn1.Unmarshal(point, &unwrapped)
+		if uerr != nil || len(rest) != 0 {
+			return common.Address{}, errors.New("failed to parse PKCS#11 EC point")
+		}
+		point = unwrapped
+	}
+	pubKey, err := crypto.UnmarshalPubkey(point)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to parse PKCS#11 public key: %w", err)
+	}
+
+	addr := crypto.PubkeyToAddress(*pubKey)
+	s.addr = &addr
+
+	return addr, nil
+}
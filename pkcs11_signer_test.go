@@ -0,0 +1,125 @@
+package mcms
+
+import (
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePKCS11Session implements the PKCS11Session interface with a local key.
+type fakePKCS11Session struct {
+	pk         *ecdsa.PrivateKey
+	rawECPoint bool
+	sigLength  int
+	err        error
+
+	ecPointCalls int
+}
+
+func (s *fakePKCS11Session) SignDigest(digest []byte) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	sig, err := crypto.Sign(digest, s.pk)
+	if err != nil {
+		return nil, err
+	}
+	if s.sigLength != 0 {
+		return sig[:s.sigLength], nil
+	}
+
+	return sig[:64], nil
+}
+
+func (s *fakePKCS11Session) ECPoint() ([]byte, error) {
+	s.ecPointCalls++
+	point := crypto.FromECDSAPub(&s.pk.PublicKey)
+	if s.rawECPoint {
+		return point, nil
+	}
+
+	return asn1.Marshal(point)
+}
+
+func TestPKCS11Signer_Sign(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	payload := []byte("0x000000000000000000000000000000")
+	want, err := NewPrivateKeySigner(privKey).Sign(payload)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		session *fakePKCS11Session
+		wantErr string
+	}{
+		{
+			name:    "success: signs the proposal",
+			session: &fakePKCS11Session{pk: privKey},
+		},
+		{
+			name:    "success: raw EC point",
+			session: &fakePKCS11Session{pk: privKey, rawECPoint: true},
+		},
+		{
+			name:    "failure: token error",
+			session: &fakePKCS11Session{pk: privKey, err: errors.New("CKR_USER_NOT_LOGGED_IN")},
+			wantErr: "failed to sign with PKCS#11 key: CKR_USER_NOT_LOGGED_IN",
+		},
+		{
+			name:    "failure: invalid signature length",
+			session: &fakePKCS11Session{pk: privKey, sigLength: 65},
+			wantErr: "invalid PKCS#11 signature length: 65",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewPKCS11Signer(tt.session).Sign(payload)
+
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestPKCS11Signer_GetAddress(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+
+	addr, err := NewPKCS11Signer(&fakePKCS11Session{pk: privKey}).GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0xFe6d23D3C194bA84C035be35ad82775ddf0BFf4e"), addr)
+}
+
+func TestPKCS11Signer_Sign_CachesAddress(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	session := &fakePKCS11Session{pk: privKey}
+	signer := NewPKCS11Signer(session)
+
+	for range 3 {
+		_, err = signer.Sign([]byte("payload"))
+		require.NoError(t, err)
+	}
+	_, err = signer.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, 1, session.ecPointCalls)
+}
@@ -0,0 +1,303 @@
+package mcms
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// FindingSeverity is the severity of a preflight Finding.
+type FindingSeverity string
+
+const (
+	// FindingSeverityError means the proposal will fail on chain as it stands.
+	FindingSeverityError FindingSeverity = "error"
+	// FindingSeverityWarning means the proposal may fail or have unintended effects on chain.
+	FindingSeverityWarning FindingSeverity = "warning"
+)
+
+// FindingCode identifies the check which produced a preflight Finding.
+type FindingCode string
+
+const (
+	// FindingMissingConfig means the MCM contract has no signers configured.
+	FindingMissingConfig FindingCode = "missing_config"
+	// FindingStartingOpCountMismatch means the StartingOpCount of the chain metadata differs from
+	// the op count of the MCM contract.
+	FindingStartingOpCountMismatch FindingCode = "starting_op_count_mismatch"
+	// FindingRootAlreadySet means the MCM contract already holds the root of the proposal.
+	FindingRootAlreadySet FindingCode = "root_already_set"
+	// FindingPendingRoot means the MCM contract holds a root with operations left to execute,
+	// expired or not, which prevents setting a new root without overriding it.
+	FindingPendingRoot FindingCode = "pending_root"
+	// FindingRootOverride means the proposal overrides a root of the MCM contract with operations
+	// left to execute, discarding them.
+	FindingRootOverride FindingCode = "root_override"
+	// FindingMissingTimelockRole means the MCM does not hold the timelock role of the action.
+	FindingMissingTimelockRole FindingCode = "missing_timelock_role"
+	// FindingUnverifiedTimelockRole means the timelock role of the MCM could not be checked
+	// because the chain family cannot tell which account holds it.
+	FindingUnverifiedTimelockRole FindingCode = "unverified_timelock_role"
+	// FindingDelayBelowMinDelay means the delay of the proposal is lower than the min delay of
+	// the timelock.
+	FindingDelayBelowMinDelay FindingCode = "delay_below_min_delay"
+)
+
+// Finding is a problem found by a preflight check of a proposal against the on-chain state.
+type Finding struct {
+	ChainSelector types.ChainSelector
+	Severity      FindingSeverity
+	Code          FindingCode
+	Message       string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: chain %d: %s: %s", f.Severity, f.ChainSelector, f.Code, f.Message)
+}
+
+// PreflightReport holds the findings of a preflight check, ordered by chain selector.
+type PreflightReport struct {
+	Findings []Finding
+}
+
+// HasErrors returns true if any finding has the error severity.
+func (r *PreflightReport) HasErrors() bool {
+	return slices.ContainsFunc(r.Findings, func(f Finding) bool { return f.Severity == FindingSeverityError })
+}
+
+// Err returns the findings with the error severity joined together, or nil if there are none.
+func (r *PreflightReport) Err() error {
+	var errs []error
+	for _, f := range r.Findings {
+		if f.Severity == FindingSeverityError {
+			errs = append(errs, errors.New(f.String()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *PreflightReport) add(selector types.ChainSelector, severity FindingSeverity, code FindingCode, format string, args ...any) {
+	r.Findings = append(r.Findings, Finding{
+		ChainSelector: selector,
+		Severity:      severity,
+		Code:          code,
+		Message:       fmt.Sprintf(format, args...),
+	})
+}
+
+// Preflight checks the proposal against the state of the MCM contracts of every chain, using the
+// given inspectors, and reports the problems which would make it fail or misbehave on chain.
+// It is meant to be run before collecting signatures. An error is only returned if the checks
+// could not be run.
+func (p *Proposal) Preflight(ctx context.Context, inspectors map[types.ChainSelector]sdk.Inspector) (*PreflightReport, error) {
+	tree, err := p.MerkleTree()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &PreflightReport{}
+	for _, sel := range p.ChainSelectors() {
+		inspector, ok := inspectors[sel]
+		if !ok {
+			return nil, fmt.Errorf("inspector not found for chain %d", sel)
+		}
+
+		if err := p.preflightMCM(ctx, report, sel, inspector, tree.Root); err != nil {
+			return nil, fmt.Errorf("failed to check chain %d: %w", sel, err)
+		}
+	}
+
+	return report, nil
+}
+
+func (p *Proposal) preflightMCM(
+	ctx context.Context, report *PreflightReport, sel types.ChainSelector, inspector sdk.Inspector, proposalRoot common.Hash,
+) error {
+	metadata := p.ChainMetadata[sel]
+
+	config, err := inspector.GetConfig(ctx, metadata.MCMAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+	if config.Quorum == 0 {
+		report.add(sel, FindingSeverityError, FindingMissingConfig, "MCM %s has no signers configured", metadata.MCMAddress)
+	}
+
+	opCount, err := inspector.GetOpCount(ctx, metadata.MCMAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get op count: %w", err)
+	}
+	if metadata.StartingOpCount != opCount {
+		report.add(sel, FindingSeverityError, FindingStartingOpCountMismatch,
+			"starting op count %d does not match the op count %d of MCM %s", metadata.StartingOpCount, opCount, metadata.MCMAddress)
+	}
+
+	root, validUntil, err := inspector.GetRoot(ctx, metadata.MCMAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get root: %w", err)
+	}
+	if root == proposalRoot {
+		report.add(sel, FindingSeverityWarning, FindingRootAlreadySet, "MCM %s already holds the proposal root", metadata.MCMAddress)
+
+		return nil
+	}
+	if root == (common.Hash{}) {
+		return nil
+	}
+
+	// The MCM rejects a new root until its op count reaches the post-op count of the current root,
+	// even once that root expired, unless the new root overrides it.
+	pending, reason, err := pendingOperations(ctx, inspector, metadata.MCMAddress, opCount)
+	if err != nil {
+		return err
+	}
+	if !pending {
+		return nil
+	}
+	if p.OverridePreviousRoot {
+		report.add(sel, FindingSeverityWarning, FindingRootOverride,
+			"proposal overrides root %s of MCM %s, valid until %d, whose %s; its operations left to execute are discarded",
+			root.Hex(), metadata.MCMAddress, validUntil, reason)
+	} else {
+		report.add(sel, FindingSeverityError, FindingPendingRoot,
+			"MCM %s holds root %s, valid until %d, whose %s; the root cannot be set until its operations are executed",
+			metadata.MCMAddress, root.Hex(), validUntil, reason)
+	}
+
+	return nil
+}
+
+// pendingOperations reports whether the root held by the MCM has operations left to execute,
+// with the reason. The root is taken as unfinished when the inspector cannot read its post-op
+// count.
+func pendingOperations(ctx context.Context, inspector sdk.Inspector, mcmAddress string, opCount uint64) (bool, string, error) {
+	postOpCountInspector, ok := inspector.(sdk.PostOpCountInspector)
+	if !ok {
+		return true, fmt.Sprintf("post-op count is unknown at op count %d", opCount), nil
+	}
+
+	postOpCount, err := postOpCountInspector.GetRootPostOpCount(ctx, mcmAddress)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get root post-op count: %w", err)
+	}
+	if opCount == postOpCount {
+		return false, "", nil
+	}
+
+	return true, fmt.Sprintf("post-op count %d is not reached at op count %d", postOpCount, opCount), nil
+}
+
+// Preflight checks the proposal against the state of the MCM and timelock contracts of every
+// chain, using the given inspectors. On top of the checks of Proposal.Preflight on the converted
+// proposal, the MCM must hold the timelock role of the action and the delay of a schedule must
+// not be lower than the min delay of the timelock.
+func (m *TimelockProposal) Preflight(
+	ctx context.Context,
+	inspectors map[types.ChainSelector]sdk.Inspector,
+	timelockInspectors map[types.ChainSelector]sdk.TimelockInspector,
+) (*PreflightReport, error) {
+	converters, err := m.buildTimelockConverters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	converted, _, err := m.Convert(ctx, converters)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := converted.Preflight(ctx, inspectors)
+	if err != nil {
+		return nil, err
+	}
+
+	timelockReport := &PreflightReport{}
+	for _, sel := range converted.ChainSelectors() {
+		timelockAddress, ok := m.TimelockAddresses[sel]
+		if !ok {
+			continue
+		}
+		inspector, ok := timelockInspectors[sel]
+		if !ok {
+			return nil, fmt.Errorf("timelock inspector not found for chain %d", sel)
+		}
+
+		if err := m.preflightTimelock(ctx, timelockReport, sel, inspector, timelockAddress); err != nil {
+			return nil, fmt.Errorf("failed to check timelock of chain %d: %w", sel, err)
+		}
+	}
+
+	// Keep the findings ordered by chain selector
+	report.Findings = append(report.Findings, timelockReport.Findings...)
+	slices.SortStableFunc(report.Findings, func(a, b Finding) int {
+		return cmp.Compare(a.ChainSelector, b.ChainSelector)
+	})
+
+	return report, nil
+}
+
+func (m *TimelockProposal) preflightTimelock(
+	ctx context.Context, report *PreflightReport, sel types.ChainSelector, inspector sdk.TimelockInspector, timelockAddress string,
+) error {
+	mcmAddress := m.ChainMetadata[sel].MCMAddress
+
+	if m.Action == types.TimelockActionSchedule {
+		minDelay, err := inspector.GetMinDelay(ctx, timelockAddress)
+		if err != nil {
+			return fmt.Errorf("failed to get min delay: %w", err)
+		}
+		if m.Delay.Seconds() < float64(minDelay) {
+			report.add(sel, FindingSeverityError, FindingDelayBelowMinDelay,
+				"delay %s is lower than the min delay %s of timelock %s", m.Delay, time.Duration(minDelay)*time.Second, timelockAddress) //nolint:gosec // min delays fit in a Duration
+		}
+	}
+
+	roleMember, err := timelockRoleMemberFn(sel)
+	if err != nil {
+		return err
+	}
+	if roleMember == nil {
+		report.add(sel, FindingSeverityWarning, FindingUnverifiedTimelockRole,
+			"the %s role of MCM %s on timelock %s cannot be checked for this chain family", m.Action, mcmAddress, timelockAddress)
+
+		return nil
+	}
+	member, err := roleMember(mcmAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get timelock role member of MCM %s: %w", mcmAddress, err)
+	}
+
+	var (
+		role       string
+		getMembers func(ctx context.Context, address string) ([]string, error)
+	)
+	switch m.Action {
+	case types.TimelockActionSchedule:
+		role, getMembers = "proposer", inspector.GetProposers
+	case types.TimelockActionCancel:
+		role, getMembers = "canceller", inspector.GetCancellers
+	case types.TimelockActionBypass:
+		role, getMembers = "bypasser", inspector.GetBypassers
+	default:
+		return fmt.Errorf("unsupported timelock action %s", m.Action)
+	}
+
+	members, err := getMembers(ctx, timelockAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get %s role members: %w", role, err)
+	}
+	if !slices.Contains(members, member) {
+		report.add(sel, FindingSeverityError, FindingMissingTimelockRole,
+			"MCM %s (%s) does not hold the %s role of timelock %s", mcmAddress, member, role, timelockAddress)
+	}
+
+	return nil
+}
@@ -0,0 +1,311 @@
+package mcms
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils"
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/internal/testutils/memchain"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/mocks"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// preflightEnv is an in-memory chain with an MCM, configured with a single signer, and a timelock
+// for which the MCM is a proposer but not a bypasser.
+type preflightEnv struct {
+	chain    *memchain.Chain
+	signer   testutils.ECDSASigner
+	mcm      common.Address
+	timelock common.Address
+}
+
+func newPreflightEnv(t *testing.T) preflightEnv {
+	t.Helper()
+
+	chain, err := memchain.NewChain(chaintest.Chain1Selector)
+	require.NoError(t, err)
+
+	owner := testutils.NewECDSASigner().Address()
+	signer := *testutils.NewECDSASigner()
+	mcm := chain.DeployMCM(owner)
+	_, err = memchain.NewConfigurer(chain, owner).SetConfig(t.Context(), mcm.Hex(),
+		&types.Config{Quorum: 1, Signers: []common.Address{signer.Address()}}, false)
+	require.NoError(t, err)
+	timelock := chain.DeployTimelock(time.Hour, owner, []common.Address{mcm}, []common.Address{owner}, []common.Address{mcm}, nil)
+
+	return preflightEnv{chain: chain, signer: signer, mcm: mcm, timelock: timelock}
+}
+
+func (e preflightEnv) inspectors() map[types.ChainSelector]sdk.Inspector {
+	return map[types.ChainSelector]sdk.Inspector{chaintest.Chain1Selector: memchain.NewInspector(e.chain)}
+}
+
+func (e preflightEnv) timelockInspectors() map[types.ChainSelector]sdk.TimelockInspector {
+	return map[types.ChainSelector]sdk.TimelockInspector{chaintest.Chain1Selector: memchain.NewTimelockInspector(e.chain)}
+}
+
+func (e preflightEnv) newProposal(t *testing.T, startingOpCount uint64, data byte, override bool) *Proposal {
+	t.Helper()
+
+	proposal, err := NewProposalBuilder().
+		SetVersion("v1").
+		SetValidUntil(uint32(time.Now().Add(time.Hour).Unix())). //nolint:gosec // test time
+		SetOverridePreviousRoot(override).
+		AddChainMetadata(chaintest.Chain1Selector, types.ChainMetadata{StartingOpCount: startingOpCount, MCMAddress: e.mcm.Hex()}).
+		AddOperation(types.Operation{
+			ChainSelector: chaintest.Chain1Selector,
+			Transaction:   evm.NewTransaction(common.HexToAddress("0x1000"), []byte{data}, big.NewInt(0), "Target", nil),
+		}).
+		Build()
+	require.NoError(t, err)
+
+	return proposal
+}
+
+// setRoot signs the proposal and sets its root.
+func (e preflightEnv) setRoot(t *testing.T, proposal *Proposal) *Executable {
+	t.Helper()
+
+	signable, err := NewSignable(proposal, e.inspectors())
+	require.NoError(t, err)
+	_, err = signable.SignAndAppend(NewPrivateKeySigner(e.signer.Key))
+	require.NoError(t, err)
+
+	encoders, err := proposal.GetEncoders()
+	require.NoError(t, err)
+	encoder, ok := encoders[chaintest.Chain1Selector].(*evm.Encoder)
+	require.True(t, ok)
+	executable, err := NewExecutable(proposal, map[types.ChainSelector]sdk.Executor{
+		chaintest.Chain1Selector: memchain.NewExecutor(encoder, e.chain),
+	})
+	require.NoError(t, err)
+	_, err = executable.SetRoot(t.Context(), chaintest.Chain1Selector)
+	require.NoError(t, err)
+
+	return executable
+}
+
+func findingCodes(report *PreflightReport) []FindingCode {
+	codes := make([]FindingCode, 0, len(report.Findings))
+	for _, f := range report.Findings {
+		codes = append(codes, f.Code)
+	}
+
+	return codes
+}
+
+func TestProposal_Preflight(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setup     func(t *testing.T, env preflightEnv) *Proposal
+		wantCodes []FindingCode
+		wantErr   bool
+	}{
+		{
+			name: "no findings",
+			setup: func(t *testing.T, env preflightEnv) *Proposal {
+				t.Helper()
+				return env.newProposal(t, 0, 0x01, false)
+			},
+			wantCodes: []FindingCode{},
+		},
+		{
+			name: "stale starting op count",
+			setup: func(t *testing.T, env preflightEnv) *Proposal {
+				t.Helper()
+				return env.newProposal(t, 3, 0x01, false)
+			},
+			wantCodes: []FindingCode{FindingStartingOpCountMismatch},
+			wantErr:   true,
+		},
+		{
+			name: "root already set",
+			setup: func(t *testing.T, env preflightEnv) *Proposal {
+				t.Helper()
+				proposal := env.newProposal(t, 0, 0x01, false)
+				env.setRoot(t, proposal)
+
+				return proposal
+			},
+			wantCodes: []FindingCode{FindingRootAlreadySet},
+		},
+		{
+			name: "pending root",
+			setup: func(t *testing.T, env preflightEnv) *Proposal {
+				t.Helper()
+				env.setRoot(t, env.newProposal(t, 0, 0x01, false))
+
+				return env.newProposal(t, 0, 0x02, false)
+			},
+			wantCodes: []FindingCode{FindingPendingRoot},
+			wantErr:   true,
+		},
+		{
+			name: "executed root",
+			setup: func(t *testing.T, env preflightEnv) *Proposal {
+				t.Helper()
+				executable := env.setRoot(t, env.newProposal(t, 0, 0x01, false))
+				_, err := executable.Execute(t.Context(), 0)
+				require.NoError(t, err)
+
+				return env.newProposal(t, 1, 0x02, false)
+			},
+			wantCodes: []FindingCode{},
+		},
+		{
+			name: "overridden root",
+			setup: func(t *testing.T, env preflightEnv) *Proposal {
+				t.Helper()
+				env.setRoot(t, env.newProposal(t, 0, 0x01, false))
+
+				return env.newProposal(t, 0, 0x02, true)
+			},
+			wantCodes: []FindingCode{FindingRootOverride},
+		},
+		{
+			name: "overridden executed root",
+			setup: func(t *testing.T, env preflightEnv) *Proposal {
+				t.Helper()
+				executable := env.setRoot(t, env.newProposal(t, 0, 0x01, false))
+				_, err := executable.Execute(t.Context(), 0)
+				require.NoError(t, err)
+
+				return env.newProposal(t, 1, 0x02, true)
+			},
+			wantCodes: []FindingCode{},
+		},
+		{
+			name: "missing config",
+			setup: func(t *testing.T, env preflightEnv) *Proposal {
+				t.Helper()
+				proposal := env.newProposal(t, 0, 0x01, false)
+				proposal.ChainMetadata[chaintest.Chain1Selector] = types.ChainMetadata{
+					MCMAddress: env.chain.DeployMCM(common.Address{}).Hex(),
+				}
+
+				return proposal
+			},
+			wantCodes: []FindingCode{FindingMissingConfig},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newPreflightEnv(t)
+			proposal := tt.setup(t, env)
+
+			report, err := proposal.Preflight(t.Context(), env.inspectors())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCodes, findingCodes(report))
+			assert.Equal(t, tt.wantErr, report.HasErrors())
+			if tt.wantErr {
+				require.Error(t, report.Err())
+			} else {
+				require.NoError(t, report.Err())
+			}
+		})
+	}
+}
+
+func TestProposal_Preflight_MissingInspector(t *testing.T) {
+	t.Parallel()
+
+	env := newPreflightEnv(t)
+	proposal := env.newProposal(t, 0, 0x01, false)
+
+	_, err := proposal.Preflight(t.Context(), map[types.ChainSelector]sdk.Inspector{})
+	require.EqualError(t, err, "inspector not found for chain 3379446385462418246")
+}
+
+func TestProposal_Preflight_UnknownPostOpCount(t *testing.T) {
+	t.Parallel()
+
+	env := newPreflightEnv(t)
+	proposal := env.newProposal(t, 0, 0x01, false)
+
+	// Without the post-op count, a set root is taken as unfinished
+	inspector := mocks.NewInspector(t)
+	inspector.EXPECT().GetConfig(mock.Anything, env.mcm.Hex()).Return(&types.Config{Quorum: 1}, nil)
+	inspector.EXPECT().GetOpCount(mock.Anything, env.mcm.Hex()).Return(uint64(0), nil)
+	inspector.EXPECT().GetRoot(mock.Anything, env.mcm.Hex()).Return(common.HexToHash("0x01"), uint32(0), nil)
+
+	report, err := proposal.Preflight(t.Context(), map[types.ChainSelector]sdk.Inspector{chaintest.Chain1Selector: inspector})
+	require.NoError(t, err)
+	assert.Equal(t, []FindingCode{FindingPendingRoot}, findingCodes(report))
+	require.True(t, report.HasErrors())
+}
+
+func TestTimelockProposal_Preflight(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		action    types.TimelockAction
+		delay     time.Duration
+		wantCodes []FindingCode
+	}{
+		{
+			name:      "no findings",
+			action:    types.TimelockActionSchedule,
+			delay:     time.Hour,
+			wantCodes: []FindingCode{},
+		},
+		{
+			name:      "delay below min delay",
+			action:    types.TimelockActionSchedule,
+			delay:     time.Minute,
+			wantCodes: []FindingCode{FindingDelayBelowMinDelay},
+		},
+		{
+			name:      "cancel",
+			action:    types.TimelockActionCancel,
+			wantCodes: []FindingCode{},
+		},
+		{
+			name:      "missing bypasser role",
+			action:    types.TimelockActionBypass,
+			wantCodes: []FindingCode{FindingMissingTimelockRole},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newPreflightEnv(t)
+			proposal, err := NewTimelockProposalBuilder().
+				SetVersion("v1").
+				SetValidUntil(uint32(time.Now().Add(time.Hour).Unix())). //nolint:gosec // test time
+				AddChainMetadata(chaintest.Chain1Selector, types.ChainMetadata{MCMAddress: env.mcm.Hex()}).
+				AddTimelockAddress(chaintest.Chain1Selector, env.timelock.Hex()).
+				SetAction(tt.action).
+				SetDelay(types.NewDuration(tt.delay)).
+				AddOperation(types.BatchOperation{
+					ChainSelector: chaintest.Chain1Selector,
+					Transactions: []types.Transaction{
+						evm.NewTransaction(common.HexToAddress("0x1000"), []byte{0x01}, big.NewInt(0), "Target", nil),
+					},
+				}).
+				Build()
+			require.NoError(t, err)
+
+			report, err := proposal.Preflight(t.Context(), env.inspectors(), env.timelockInspectors())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCodes, findingCodes(report))
+		})
+	}
+}
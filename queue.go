@@ -0,0 +1,305 @@
+package mcms
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// MCMInstance identifies an MCM contract on a chain. Addresses are compared as is, so they must be
+// written the same way in all the proposals of a queue.
+type MCMInstance struct {
+	ChainSelector types.ChainSelector `json:"chainSelector"`
+	MCMAddress    string              `json:"mcmAddress"`
+}
+
+// OpCountRange is the half-open range [Start, End) of the op counts used by a proposal on an MCM.
+type OpCountRange struct {
+	Start uint64 `json:"start"`
+	End   uint64 `json:"end"`
+}
+
+// Overlaps reports whether both ranges share an op count.
+func (r OpCountRange) Overlaps(other OpCountRange) bool {
+	return r.Start < other.End && other.Start < r.End
+}
+
+// QueuedProposal is a proposal held by a ProposalQueue.
+type QueuedProposal struct {
+	// ID identifies the proposal in the queue, e.g. its file name.
+	ID       string
+	Proposal ProposalInterface
+	// Ranges are the op counts used by the proposal on each of its MCMs.
+	Ranges map[MCMInstance]OpCountRange
+}
+
+// ProposalQueue tracks the pending proposals targeting the same MCMs, in the order they are meant
+// to be executed, like the predecessors given to WithPredecessors. It verifies that the op count
+// ranges of the proposals follow each other on every MCM, and dequeues proposals as the on-chain
+// op counts move past them.
+//
+// The queue attributes the executed op counts of an MCM to its proposals: operations executed by
+// a proposal which is not in the queue make the queued proposals stale, see Proposal.Rebase.
+//
+// A ProposalQueue is safe for concurrent use.
+type ProposalQueue struct {
+	mu        sync.Mutex
+	proposals []*QueuedProposal // in insertion order
+	opCounts  map[MCMInstance]uint64
+}
+
+// NewProposalQueue creates an empty ProposalQueue.
+func NewProposalQueue() *ProposalQueue {
+	return &ProposalQueue{opCounts: make(map[MCMInstance]uint64)}
+}
+
+// Add computes the op count ranges of the proposal and adds it to the queue. The ranges of
+// timelock proposals are computed from their converted form, which is what their MCMs execute.
+// Conflicting ranges are reported by Verify rather than rejected, so that they can be fixed by
+// replacing the proposals.
+func (q *ProposalQueue) Add(ctx context.Context, id string, proposal ProposalInterface) error {
+	ranges, err := proposalOpCountRanges(ctx, proposal)
+	if err != nil {
+		return fmt.Errorf("failed to compute op count ranges of proposal %q: %w", id, err)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if slices.ContainsFunc(q.proposals, func(p *QueuedProposal) bool { return p.ID == id }) {
+		return fmt.Errorf("proposal %q is already queued", id)
+	}
+	q.proposals = append(q.proposals, &QueuedProposal{ID: id, Proposal: proposal, Ranges: ranges})
+
+	return nil
+}
+
+// Remove removes the proposal from the queue, returning false if it was not queued.
+func (q *ProposalQueue) Remove(id string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n := len(q.proposals)
+	q.proposals = slices.DeleteFunc(q.proposals, func(p *QueuedProposal) bool { return p.ID == id })
+
+	return len(q.proposals) != n
+}
+
+// Instances returns the MCMs targeted by the queued proposals, ordered by chain selector and
+// address.
+func (q *ProposalQueue) Instances() []MCMInstance {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.instances()
+}
+
+// Proposals returns the queued proposals targeting the MCM, ordered by starting op count.
+func (q *ProposalQueue) Proposals(instance MCMInstance) []QueuedProposal {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	queued := q.queue(instance)
+	proposals := make([]QueuedProposal, len(queued))
+	for i, p := range queued {
+		proposals[i] = *p
+	}
+
+	return proposals
+}
+
+// OpCount returns the last known on-chain op count of the MCM, and false if it is unknown.
+func (q *ProposalQueue) OpCount(instance MCMInstance) (uint64, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	opCount, ok := q.opCounts[instance]
+
+	return opCount, ok
+}
+
+// NextOpCount returns the starting op count of the next proposal to queue on the MCM: the end of
+// the last queued proposal, or the on-chain op count if it is ahead.
+func (q *ProposalQueue) NextOpCount(instance MCMInstance) uint64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	next := q.opCounts[instance]
+	for _, p := range q.queue(instance) {
+		next = max(next, p.Ranges[instance].End)
+	}
+
+	return next
+}
+
+// Conflicts returns the overlaps and gaps between the op count ranges of the queued proposals on
+// every MCM, ordered by MCM. The first proposal of an MCM must start at its on-chain op count, or
+// before it if the proposal is being executed, when the op count is known.
+func (q *ProposalQueue) Conflicts() []*OpCountConflictError {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var conflicts []*OpCountConflictError
+	for _, instance := range q.instances() {
+		previous := ""
+		expected, known := q.opCounts[instance]
+		for _, p := range q.queue(instance) {
+			r := p.Ranges[instance]
+			switch {
+			case !known:
+				// The first proposal defines where the queue starts
+			case r.Start < expected && previous != "":
+				conflicts = append(conflicts, &OpCountConflictError{
+					Instance: instance, Kind: OpCountConflictOverlap, Previous: previous, Next: p.ID, Expected: expected, Start: r.Start,
+				})
+			case r.Start > expected:
+				conflicts = append(conflicts, &OpCountConflictError{
+					Instance: instance, Kind: OpCountConflictGap, Previous: previous, Next: p.ID, Expected: expected, Start: r.Start,
+				})
+			}
+
+			if !known || r.End > expected {
+				previous = p.ID
+				expected = max(expected, r.End)
+			}
+			known = true
+		}
+	}
+
+	return conflicts
+}
+
+// Verify returns the conflicts of the queue joined in a single error, or nil if the op count
+// ranges of the queued proposals follow each other on every MCM.
+func (q *ProposalQueue) Verify() error {
+	conflicts := q.Conflicts()
+	errs := make([]error, len(conflicts))
+	for i, conflict := range conflicts {
+		errs[i] = conflict
+	}
+
+	return errors.Join(errs...)
+}
+
+// SetOpCount records the on-chain op count of the MCM, e.g. from an OpExecuted event, and
+// dequeues the proposals whose operations have all been executed. The dequeued proposals are
+// returned in queue order.
+func (q *ProposalQueue) SetOpCount(instance MCMInstance, opCount uint64) []QueuedProposal {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.opCounts[instance] = opCount
+
+	return q.dequeueExecuted()
+}
+
+// Advance reads the on-chain op count of every MCM of the queue with the given inspectors and
+// dequeues the proposals whose operations have all been executed. The dequeued proposals are
+// returned in queue order.
+func (q *ProposalQueue) Advance(ctx context.Context, inspectors map[types.ChainSelector]sdk.Inspector) ([]QueuedProposal, error) {
+	opCounts := make(map[MCMInstance]uint64)
+	for _, instance := range q.Instances() {
+		inspector, ok := inspectors[instance.ChainSelector]
+		if !ok {
+			return nil, fmt.Errorf("inspector not found for chain %d", instance.ChainSelector)
+		}
+
+		opCount, err := inspector.GetOpCount(ctx, instance.MCMAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get op count for chain %d: %w", instance.ChainSelector, err)
+		}
+		opCounts[instance] = opCount
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	maps.Copy(q.opCounts, opCounts)
+
+	return q.dequeueExecuted(), nil
+}
+
+// dequeueExecuted removes and returns the proposals executed on all their MCMs.
+func (q *ProposalQueue) dequeueExecuted() []QueuedProposal {
+	var executed []QueuedProposal
+	q.proposals = slices.DeleteFunc(q.proposals, func(p *QueuedProposal) bool {
+		for instance, r := range p.Ranges {
+			if opCount, ok := q.opCounts[instance]; !ok || opCount < r.End {
+				return false
+			}
+		}
+		executed = append(executed, *p)
+
+		return true
+	})
+
+	return executed
+}
+
+func (q *ProposalQueue) instances() []MCMInstance {
+	set := make(map[MCMInstance]struct{})
+	for _, p := range q.proposals {
+		for instance := range p.Ranges {
+			set[instance] = struct{}{}
+		}
+	}
+
+	return slices.SortedFunc(maps.Keys(set), func(a, b MCMInstance) int {
+		return cmp.Or(cmp.Compare(a.ChainSelector, b.ChainSelector), cmp.Compare(a.MCMAddress, b.MCMAddress))
+	})
+}
+
+// queue returns the proposals targeting the MCM, ordered by starting op count and then by
+// insertion order.
+func (q *ProposalQueue) queue(instance MCMInstance) []*QueuedProposal {
+	var queued []*QueuedProposal
+	for _, p := range q.proposals {
+		if _, ok := p.Ranges[instance]; ok {
+			queued = append(queued, p)
+		}
+	}
+	slices.SortStableFunc(queued, func(a, b *QueuedProposal) int {
+		return cmp.Compare(a.Ranges[instance].Start, b.Ranges[instance].Start)
+	})
+
+	return queued
+}
+
+// proposalOpCountRanges returns the op count ranges of the proposal on each MCM it has operations
+// on.
+func proposalOpCountRanges(ctx context.Context, proposal ProposalInterface) (map[MCMInstance]OpCountRange, error) {
+	counts, err := proposalOpCounts(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	ranges := make(map[MCMInstance]OpCountRange, len(counts))
+	for selector, metadata := range proposal.ChainMetadatas() {
+		count := counts[selector]
+		if count == 0 {
+			continue
+		}
+
+		instance := MCMInstance{ChainSelector: selector, MCMAddress: metadata.MCMAddress}
+		ranges[instance] = OpCountRange{Start: metadata.StartingOpCount, End: metadata.StartingOpCount + count}
+	}
+
+	return ranges, nil
+}
+
+// proposalOpCounts returns the number of MCMS operations of the proposal on each chain: its
+// transactions for a Proposal, or its operations after conversion for a TimelockProposal.
+func proposalOpCounts(ctx context.Context, proposal ProposalInterface) (map[types.ChainSelector]uint64, error) {
+	if timelock, ok := proposal.(*TimelockProposal); ok {
+		return timelock.OperationCounts(ctx)
+	}
+
+	return proposal.TransactionCounts(), nil
+}
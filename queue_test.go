@@ -0,0 +1,219 @@
+package mcms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var (
+	queueInstance1 = MCMInstance{ChainSelector: chaintest.Chain1Selector, MCMAddress: "0x1"}
+	queueInstance2 = MCMInstance{ChainSelector: chaintest.Chain2Selector, MCMAddress: "0x2"}
+)
+
+// newQueueTestProposal returns a proposal with two operations on each chain, starting at the
+// given op counts of chain 1 and 2.
+func newQueueTestProposal(start1, start2 uint64) *Proposal {
+	proposal := orchestratorTestProposal(chaintest.Chain1Selector, chaintest.Chain2Selector)
+	for selector, start := range map[types.ChainSelector]uint64{chaintest.Chain1Selector: start1, chaintest.Chain2Selector: start2} {
+		metadata := proposal.ChainMetadata[selector]
+		metadata.StartingOpCount = start
+		proposal.ChainMetadata[selector] = metadata
+	}
+
+	return proposal
+}
+
+func TestOpCountRange_Overlaps(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a, b OpCountRange
+		want bool
+	}{
+		{name: "adjacent", a: OpCountRange{Start: 0, End: 2}, b: OpCountRange{Start: 2, End: 4}, want: false},
+		{name: "disjoint", a: OpCountRange{Start: 5, End: 6}, b: OpCountRange{Start: 0, End: 2}, want: false},
+		{name: "overlapping", a: OpCountRange{Start: 0, End: 3}, b: OpCountRange{Start: 2, End: 4}, want: true},
+		{name: "contained", a: OpCountRange{Start: 0, End: 4}, b: OpCountRange{Start: 1, End: 2}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, tt.a.Overlaps(tt.b))
+			require.Equal(t, tt.want, tt.b.Overlaps(tt.a))
+		})
+	}
+}
+
+func TestProposalQueue_Conflicts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		// starts are the starting op counts of chain 1 and 2 of the queued proposals, named by
+		// their index
+		starts   [][2]uint64
+		opCounts map[MCMInstance]uint64
+		want     []*OpCountConflictError
+		wantErr  string
+	}{
+		{
+			name:   "success: contiguous proposals",
+			starts: [][2]uint64{{4, 0}, {6, 2}, {8, 4}},
+		},
+		{
+			name:     "success: first proposal being executed",
+			starts:   [][2]uint64{{0, 0}, {2, 2}},
+			opCounts: map[MCMInstance]uint64{queueInstance1: 1, queueInstance2: 0},
+		},
+		{
+			name:   "failure: overlap",
+			starts: [][2]uint64{{0, 0}, {1, 2}},
+			want: []*OpCountConflictError{{
+				Instance: queueInstance1, Kind: OpCountConflictOverlap, Previous: "0", Next: "1", Expected: 2, Start: 1,
+			}},
+			wantErr: `proposal "1" has an op count overlap with proposal "0" on MCM 0x1 of chain 3379446385462418246: ` +
+				"starts at 1, expected 2",
+		},
+		{
+			name:   "failure: gap",
+			starts: [][2]uint64{{0, 0}, {2, 3}},
+			want: []*OpCountConflictError{{
+				Instance: queueInstance2, Kind: OpCountConflictGap, Previous: "0", Next: "1", Expected: 2, Start: 3,
+			}},
+			wantErr: `proposal "1" has an op count gap with proposal "0" on MCM 0x2 of chain 16015286601757825753: ` +
+				"starts at 3, expected 2",
+		},
+		{
+			name:     "failure: gap with the on-chain op count",
+			starts:   [][2]uint64{{3, 0}},
+			opCounts: map[MCMInstance]uint64{queueInstance1: 1},
+			want: []*OpCountConflictError{{
+				Instance: queueInstance1, Kind: OpCountConflictGap, Next: "0", Expected: 1, Start: 3,
+			}},
+			wantErr: `proposal "0" has an op count gap with the on-chain op count on MCM 0x1 of chain 3379446385462418246: ` +
+				"starts at 3, expected 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			queue := NewProposalQueue()
+			for instance, opCount := range tt.opCounts {
+				require.Empty(t, queue.SetOpCount(instance, opCount))
+			}
+			for i, starts := range tt.starts {
+				require.NoError(t, queue.Add(t.Context(), string(rune('0'+i)), newQueueTestProposal(starts[0], starts[1])))
+			}
+
+			require.Equal(t, tt.want, queue.Conflicts())
+			if tt.wantErr != "" {
+				require.EqualError(t, queue.Verify(), tt.wantErr)
+			} else {
+				require.NoError(t, queue.Verify())
+			}
+		})
+	}
+}
+
+func TestProposalQueue_SetOpCount(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	queue := NewProposalQueue()
+	require.NoError(t, queue.Add(ctx, "second", newQueueTestProposal(2, 2)))
+	require.NoError(t, queue.Add(ctx, "first", newQueueTestProposal(0, 0)))
+	require.EqualError(t, queue.Add(ctx, "first", newQueueTestProposal(4, 4)), `proposal "first" is already queued`)
+
+	require.Equal(t, []MCMInstance{queueInstance1, queueInstance2}, queue.Instances())
+	require.Equal(t, uint64(4), queue.NextOpCount(queueInstance1))
+
+	proposals := queue.Proposals(queueInstance1)
+	require.Len(t, proposals, 2)
+	require.Equal(t, "first", proposals[0].ID)
+	require.Equal(t, map[MCMInstance]OpCountRange{
+		queueInstance1: {Start: 0, End: 2},
+		queueInstance2: {Start: 0, End: 2},
+	}, proposals[0].Ranges)
+
+	// A proposal is dequeued once executed on all its MCMs
+	require.Empty(t, queue.SetOpCount(queueInstance1, 2))
+	executed := queue.SetOpCount(queueInstance2, 3)
+	require.Len(t, executed, 1)
+	require.Equal(t, "first", executed[0].ID)
+
+	opCount, ok := queue.OpCount(queueInstance2)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), opCount)
+	require.NoError(t, queue.Verify())
+
+	// The op count moving past the queue leaves the next proposal at the on-chain op count
+	require.Empty(t, queue.SetOpCount(queueInstance1, 5))
+	require.Equal(t, uint64(5), queue.NextOpCount(queueInstance1))
+
+	require.True(t, queue.Remove("second"))
+	require.False(t, queue.Remove("second"))
+	require.Empty(t, queue.Instances())
+}
+
+func TestProposalQueue_Add_Timelock(t *testing.T) {
+	t.Parallel()
+
+	queue := NewProposalQueue()
+	require.NoError(t, queue.Add(t.Context(), "timelock", newSplitTestProposal(t)))
+
+	// The ranges count the operations of the converted proposal, one per batch operation on EVM
+	proposals := queue.Proposals(MCMInstance{
+		ChainSelector: chaintest.Chain2Selector,
+		MCMAddress:    "0x0000000000000000000000000000000000000012",
+	})
+	require.Len(t, proposals, 1)
+	require.Equal(t, map[MCMInstance]OpCountRange{
+		{ChainSelector: chaintest.Chain1Selector, MCMAddress: "0x0000000000000000000000000000000000000011"}: {Start: 5, End: 8},
+		{ChainSelector: chaintest.Chain2Selector, MCMAddress: "0x0000000000000000000000000000000000000012"}: {Start: 1, End: 3},
+	}, proposals[0].Ranges)
+}
+
+func TestProposalQueue_Advance(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newMemchainEnv(t)
+	instance := MCMInstance{ChainSelector: chaintest.Chain1Selector, MCMAddress: env.mcm.Hex()}
+
+	first := env.newProposal(t, 0, 0x01, false)
+	second := env.newProposal(t, 1, 0x02, false)
+	queue := NewProposalQueue()
+	require.NoError(t, queue.Add(ctx, "first", first))
+	require.NoError(t, queue.Add(ctx, "second", second))
+	require.NoError(t, queue.Verify())
+
+	executed, err := queue.Advance(ctx, env.inspectors())
+	require.NoError(t, err)
+	require.Empty(t, executed)
+
+	executable := env.newExecutable(t, first)
+	_, err = executable.SetRoot(ctx, chaintest.Chain1Selector)
+	require.NoError(t, err)
+	_, err = executable.Execute(ctx, 0)
+	require.NoError(t, err)
+
+	executed, err = queue.Advance(ctx, env.inspectors())
+	require.NoError(t, err)
+	require.Len(t, executed, 1)
+	require.Equal(t, "first", executed[0].ID)
+	require.Equal(t, uint64(2), queue.NextOpCount(instance))
+	require.NoError(t, queue.Verify())
+
+	_, err = queue.Advance(ctx, map[types.ChainSelector]sdk.Inspector{})
+	require.EqualError(t, err, "inspector not found for chain 3379446385462418246")
+}
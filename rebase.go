@@ -0,0 +1,100 @@
+package mcms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// RebasedChain is a chain whose starting op count was rewritten by a rebase.
+type RebasedChain struct {
+	ChainSelector      types.ChainSelector `json:"chainSelector"`
+	OldStartingOpCount uint64              `json:"oldStartingOpCount"`
+	NewStartingOpCount uint64              `json:"newStartingOpCount"`
+}
+
+// RebaseResult reports the changes made to a proposal by a rebase.
+type RebaseResult struct {
+	// Chains are the rebased chains, ordered by chain selector. It is empty when the proposal was
+	// already based on the on-chain op counts.
+	Chains []RebasedChain `json:"chains"`
+	// InvalidatedSignatures is the number of signatures removed from the proposal, which must be
+	// collected again.
+	InvalidatedSignatures int `json:"invalidatedSignatures"`
+}
+
+// SigningHashChanged reports whether the rebase changed the signing hash of the proposal, in
+// which case the proposal must be signed again.
+func (r *RebaseResult) SigningHashChanged() bool {
+	return len(r.Chains) > 0
+}
+
+// Rebase rewrites the StartingOpCount of every chain of the proposal to the current op count of
+// its MCM, read with the given inspectors, e.g. after another proposal was executed first. As
+// the signing hash changes with the op counts, the signatures are removed from the rebased
+// proposal.
+//
+// The proposal is left unchanged if it cannot be rebased, e.g. because its root is already set on
+// a chain. Proposals queued behind other pending proposals must not be rebased, as their starting
+// op counts are ahead of the on-chain op counts on purpose.
+func (p *Proposal) Rebase(ctx context.Context, inspectors map[types.ChainSelector]sdk.Inspector) (*RebaseResult, error) {
+	chains, err := p.chainStatuses(ctx, inspectors)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.rebase(chains)
+}
+
+// Rebase rewrites the StartingOpCount of every chain of the timelock proposal to the current op
+// count of its MCM, as Proposal.Rebase. The root of the proposal is checked in its converted form,
+// which is what is set on chain.
+func (m *TimelockProposal) Rebase(ctx context.Context, inspectors map[types.ChainSelector]sdk.Inspector) (*RebaseResult, error) {
+	converted, err := m.convertForSigning(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chains, err := converted.chainStatuses(ctx, inspectors)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.rebase(chains)
+}
+
+// rebase rewrites the starting op counts to the op counts of the chain statuses.
+func (p *BaseProposal) rebase(chains []ChainStatus) (*RebaseResult, error) {
+	result := &RebaseResult{Chains: []RebasedChain{}}
+	for _, chain := range chains {
+		metadata := p.ChainMetadata[chain.ChainSelector]
+		if chain.OpCount == metadata.StartingOpCount {
+			continue
+		}
+		if chain.RootSet {
+			return nil, fmt.Errorf("cannot rebase chain %d: the proposal root is already set and its op count moved from %d to %d",
+				chain.ChainSelector, metadata.StartingOpCount, chain.OpCount)
+		}
+
+		result.Chains = append(result.Chains, RebasedChain{
+			ChainSelector:      chain.ChainSelector,
+			OldStartingOpCount: metadata.StartingOpCount,
+			NewStartingOpCount: chain.OpCount,
+		})
+	}
+
+	for _, chain := range result.Chains {
+		metadata := p.ChainMetadata[chain.ChainSelector]
+		metadata.StartingOpCount = chain.NewStartingOpCount
+		p.ChainMetadata[chain.ChainSelector] = metadata
+	}
+
+	if result.SigningHashChanged() {
+		result.InvalidatedSignatures = len(p.Signatures)
+		p.Signatures = nil
+	}
+
+	return result, nil
+}
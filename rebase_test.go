@@ -0,0 +1,131 @@
+package mcms
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestProposal_Rebase(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newMemchainEnv(t)
+
+	// Both proposals start at op count 0 and the first one is executed first
+	first := env.newProposal(t, 0, 0x01, false)
+	second := env.newProposal(t, 0, 0x02, false)
+	signable, err := NewSignable(second, env.inspectors())
+	require.NoError(t, err)
+	_, err = signable.SignAndAppend(NewPrivateKeySigner(env.signer.Key))
+	require.NoError(t, err)
+
+	executable := env.newExecutable(t, first)
+	_, err = executable.SetRoot(ctx, chaintest.Chain1Selector)
+	require.NoError(t, err)
+	_, err = executable.Execute(ctx, 0)
+	require.NoError(t, err)
+
+	result, err := second.Rebase(ctx, env.inspectors())
+	require.NoError(t, err)
+	require.Equal(t, &RebaseResult{
+		Chains: []RebasedChain{{
+			ChainSelector:      chaintest.Chain1Selector,
+			OldStartingOpCount: 0,
+			NewStartingOpCount: 1,
+		}},
+		InvalidatedSignatures: 1,
+	}, result)
+	require.True(t, result.SigningHashChanged())
+	require.Equal(t, uint64(1), second.ChainMetadata[chaintest.Chain1Selector].StartingOpCount)
+	require.Empty(t, second.Signatures)
+
+	// The rebased proposal is up to date, and can be signed and executed again
+	result, err = second.Rebase(ctx, env.inspectors())
+	require.NoError(t, err)
+	require.Empty(t, result.Chains)
+	require.False(t, result.SigningHashChanged())
+
+	executable = env.newExecutable(t, second)
+	_, err = executable.SetRoot(ctx, chaintest.Chain1Selector)
+	require.NoError(t, err)
+	_, err = executable.Execute(ctx, 0)
+	require.NoError(t, err)
+
+	// A proposal whose root is set is not rebased
+	_, err = second.Rebase(ctx, env.inspectors())
+	require.EqualError(t, err, "cannot rebase chain 3379446385462418246: the proposal root is already set "+
+		"and its op count moved from 1 to 2")
+	require.Equal(t, uint64(1), second.ChainMetadata[chaintest.Chain1Selector].StartingOpCount)
+
+	_, err = second.Rebase(ctx, map[types.ChainSelector]sdk.Inspector{})
+	require.EqualError(t, err, "inspector not found for chain 3379446385462418246")
+}
+
+func TestTimelockProposal_Rebase(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newMemchainEnv(t)
+
+	proposal, err := NewTimelockProposalBuilder().
+		SetVersion("v1").
+		SetValidUntil(uint32(time.Now().Add(time.Hour).Unix())). //nolint:gosec // test time
+		AddChainMetadata(chaintest.Chain1Selector, types.ChainMetadata{StartingOpCount: 0, MCMAddress: env.mcm.Hex()}).
+		AddTimelockAddress(chaintest.Chain1Selector, env.timelock.Hex()).
+		SetAction(types.TimelockActionSchedule).
+		SetDelay(types.NewDuration(time.Hour)).
+		AddOperation(types.BatchOperation{
+			ChainSelector: chaintest.Chain1Selector,
+			Transactions: []types.Transaction{
+				evm.NewTransaction(common.HexToAddress("0x1000"), []byte{0x01}, big.NewInt(0), "Target", nil),
+			},
+		}).
+		Build()
+	require.NoError(t, err)
+	request, err := proposal.SigningRequest(ctx)
+	require.NoError(t, err)
+	envelope, err := request.Sign(NewPrivateKeySigner(env.signer.Key))
+	require.NoError(t, err)
+	require.NoError(t, proposal.MergeSignatures(ctx, envelope))
+
+	// Another proposal executes two operations first
+	other := env.newProposal(t, 0, 0x02, false)
+	other.Operations = append(other.Operations, types.Operation{
+		ChainSelector: chaintest.Chain1Selector,
+		Transaction:   evm.NewTransaction(common.HexToAddress("0x1000"), []byte{0x03}, big.NewInt(0), "Target", nil),
+	})
+	executable := env.newExecutable(t, other)
+	_, err = executable.SetRoot(ctx, chaintest.Chain1Selector)
+	require.NoError(t, err)
+	for i := range 2 {
+		_, err = executable.Execute(ctx, i)
+		require.NoError(t, err)
+	}
+
+	result, err := proposal.Rebase(ctx, env.inspectors())
+	require.NoError(t, err)
+	require.Equal(t, []RebasedChain{{
+		ChainSelector:      chaintest.Chain1Selector,
+		OldStartingOpCount: 0,
+		NewStartingOpCount: 2,
+	}}, result.Chains)
+	require.Equal(t, 1, result.InvalidatedSignatures)
+	require.Empty(t, proposal.Signatures)
+
+	// The converted proposal starts at the on-chain op count
+	rebased, err := proposal.SigningRequest(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, request.SigningHash, rebased.SigningHash)
+	converted, err := proposal.convertForSigning(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), converted.ChainMetadata[chaintest.Chain1Selector].StartingOpCount)
+}
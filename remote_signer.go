@@ -0,0 +1,101 @@
+package mcms
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// remoteSignTimeout bounds a signing request, which may wait for an operator to approve it.
+const remoteSignTimeout = 5 * time.Minute
+
+// RemoteSignMethod is the JSON-RPC method used by a RemoteSigner. All of them apply the EIP 191
+// prefix to the payload before signing.
+type RemoteSignMethod string
+
+const (
+	// RemoteSignMethodEthSign calls eth_sign(address, data), as served by Web3Signer and by geth
+	// nodes backed by Clef.
+	RemoteSignMethodEthSign RemoteSignMethod = "eth_sign"
+	// RemoteSignMethodPersonalSign calls personal_sign(data, address).
+	RemoteSignMethodPersonalSign RemoteSignMethod = "personal_sign"
+	// RemoteSignMethodAccountSignData calls account_signData("text/plain", address, data), as
+	// served by the external API of Clef.
+	RemoteSignMethodAccountSignData RemoteSignMethod = "account_signData"
+)
+
+var _ Signer = &RemoteSigner{}
+
+// RemoteSigner signs payloads with an account of a remote signer daemon, such as Clef or
+// Web3Signer, over JSON-RPC.
+type RemoteSigner struct {
+	client  *rpc.Client
+	address common.Address
+	method  RemoteSignMethod
+}
+
+// NewRemoteSigner creates a new RemoteSigner signing with the account address of the signer
+// daemon at url. The caller is responsible for closing the signer.
+func NewRemoteSigner(ctx context.Context, url string, address common.Address, method RemoteSignMethod) (*RemoteSigner, error) {
+	switch method {
+	case RemoteSignMethodEthSign, RemoteSignMethodPersonalSign, RemoteSignMethodAccountSignData:
+	default:
+		return nil, fmt.Errorf("unsupported remote sign method %q", method)
+	}
+
+	client, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer: %w", err)
+	}
+
+	return &RemoteSigner{client: client, address: address, method: method}, nil
+}
+
+// Sign signs the payload with the remote account.
+// The payload here should be without the EIP 191 prefix,
+// and the remote signer will add it before signing.
+// The returned signature is checked to recover to the account address.
+func (s *RemoteSigner) Sign(payload []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignTimeout)
+	defer cancel()
+
+	var args []any
+	switch s.method {
+	case RemoteSignMethodEthSign:
+		args = []any{s.address, hexutil.Bytes(payload)}
+	case RemoteSignMethodPersonalSign:
+		args = []any{hexutil.Bytes(payload), s.address}
+	case RemoteSignMethodAccountSignData:
+		args = []any{"text/plain", s.address, hexutil.Bytes(payload)}
+	}
+
+	var sig hexutil.Bytes
+	if err := s.client.CallContext(ctx, &sig, string(s.method), args...); err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", s.method, err)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length from %s: got %d bytes, want %d", s.method, len(sig), crypto.SignatureLength)
+	}
+
+	// The daemons return V as 27/28, recoverableSignature normalizes it back to 0/1.
+	r := new(big.Int).SetBytes(sig[:32])
+	sv := new(big.Int).SetBytes(sig[32:64])
+
+	return recoverableSignature(toEthSignedMessageHash(payload), r, sv, s.address)
+}
+
+// GetAddress returns the address of the remote account.
+func (s *RemoteSigner) GetAddress() (common.Address, error) {
+	return s.address, nil
+}
+
+// Close closes the connection to the signer daemon.
+func (s *RemoteSigner) Close() {
+	s.client.Close()
+}
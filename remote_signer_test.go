@@ -0,0 +1,174 @@
+package mcms
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// Accounts of the stub remote signer returning invalid signatures
+	truncatingAccount  = common.HexToAddress("0x5678")
+	wrongSignerAccount = common.HexToAddress("0x9abc")
+)
+
+// stubRemoteSigner serves the signing methods of the signer daemons with the test private key,
+// returning V as 27/28 like they do.
+type stubRemoteSigner struct {
+	signer  *PrivateKeySigner
+	address common.Address
+}
+
+func (s *stubRemoteSigner) sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	switch address {
+	case s.address, truncatingAccount, wrongSignerAccount:
+	default:
+		return nil, errors.New("unknown account")
+	}
+	sig, err := s.signer.Sign(data)
+	if err != nil {
+		return nil, err
+	}
+	sig[64] += 27
+
+	if address == truncatingAccount {
+		return sig[:64], nil
+	}
+
+	return sig, nil
+}
+
+// ethService serves eth_sign.
+type ethService struct{ *stubRemoteSigner }
+
+func (s ethService) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return s.sign(address, data)
+}
+
+// personalService serves personal_sign.
+type personalService struct{ *stubRemoteSigner }
+
+func (s personalService) Sign(data hexutil.Bytes, address common.Address) (hexutil.Bytes, error) {
+	return s.sign(address, data)
+}
+
+// accountService serves account_signData.
+type accountService struct{ *stubRemoteSigner }
+
+func (s accountService) SignData(contentType string, address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if contentType != "text/plain" {
+		return nil, errors.New("unsupported content type")
+	}
+
+	return s.sign(address, data)
+}
+
+func newStubRemoteSignerServer(t *testing.T) (string, common.Address) {
+	t.Helper()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	stub := &stubRemoteSigner{signer: NewPrivateKeySigner(privKey), address: crypto.PubkeyToAddress(privKey.PublicKey)}
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", ethService{stub}))
+	require.NoError(t, server.RegisterName("personal", personalService{stub}))
+	require.NoError(t, server.RegisterName("account", accountService{stub}))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+
+	return httpServer.URL, stub.address
+}
+
+func TestRemoteSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	url, address := newStubRemoteSignerServer(t)
+	payload := []byte("0x000000000000000000000000000000")
+
+	tests := []struct {
+		name    string
+		address common.Address
+		method  RemoteSignMethod
+		wantErr string
+	}{
+		{
+			name:    "success: eth_sign",
+			address: address,
+			method:  RemoteSignMethodEthSign,
+		},
+		{
+			name:    "success: personal_sign",
+			address: address,
+			method:  RemoteSignMethodPersonalSign,
+		},
+		{
+			name:    "success: account_signData",
+			address: address,
+			method:  RemoteSignMethodAccountSignData,
+		},
+		{
+			name:    "failure: unknown account",
+			address: common.HexToAddress("0x1234"),
+			method:  RemoteSignMethodEthSign,
+			wantErr: "failed to call eth_sign: unknown account",
+		},
+		{
+			name:    "failure: invalid signature length",
+			address: truncatingAccount,
+			method:  RemoteSignMethodPersonalSign,
+			wantErr: "invalid signature length from personal_sign: got 64 bytes, want 65",
+		},
+		{
+			name:    "failure: signature of another account",
+			address: wrongSignerAccount,
+			method:  RemoteSignMethodEthSign,
+			wantErr: "signature does not recover to " + wrongSignerAccount.Hex(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			signer, err := NewRemoteSigner(t.Context(), url, tt.address, tt.method)
+			require.NoError(t, err)
+			defer signer.Close()
+
+			got, err := signer.Sign(payload)
+
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, got, 65)
+
+				gotAddress, err := signer.GetAddress()
+				require.NoError(t, err)
+				require.Equal(t, address, gotAddress)
+
+				// The signature recovers to the account like the ones of a PrivateKeySigner.
+				request := SigningRequest{SigningHash: toEthSignedMessageHash(payload), SigningMessage: common.BytesToHash(payload)}
+				envelope, err := request.Sign(signer)
+				require.NoError(t, err)
+				require.Equal(t, address, envelope.Signer)
+			}
+		})
+	}
+}
+
+func TestNewRemoteSigner_UnsupportedMethod(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewRemoteSigner(t.Context(), "http://localhost:8550", common.Address{}, "eth_signTypedData")
+	require.EqualError(t, err, `unsupported remote sign method "eth_signTypedData"`)
+}
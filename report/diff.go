@@ -0,0 +1,547 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/mcms"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// ChangeKind is the kind of change of a chain, operation or transaction between two proposals.
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+)
+
+// Diff is the semantic difference between two versions of a proposal, e.g. before and after it was
+// regenerated. Only changes are listed: chains are sorted by chain selector and operations keep
+// the order of the proposals.
+type Diff struct {
+	Kind types.ProposalKind
+	// Fields are the changed proposal fields. For timelock proposals they include the action,
+	// delay and salt.
+	Fields []FieldChange
+
+	OldSigningHash common.Hash
+	NewSigningHash common.Hash
+	// InvalidatedSignatures is the number of signatures of the old proposal which are not valid
+	// for the new proposal. All of them are invalidated when the signing hash changed.
+	InvalidatedSignatures int
+
+	// DecodeError is set when decoders were provided but the operations of either proposal could
+	// not be decoded. The transactions of that proposal are then compared on their raw data.
+	DecodeError string
+
+	Chains []ChainDiff
+}
+
+// FieldChange is a field whose value changed. The value is empty on the side where the field is
+// absent.
+type FieldChange struct {
+	Name string
+	Old  string
+	New  string
+}
+
+// ChainDiff is the difference between the two proposals on a single chain.
+type ChainDiff struct {
+	ChainSelector types.ChainSelector
+	ChainName     string
+	Change        ChangeKind
+	// Fields are the changed chain metadata and timelock address.
+	Fields     []FieldChange
+	Operations []OperationDiff
+}
+
+// OperationDiff is an added, removed or modified operation. Operations are matched per chain on
+// their content, so that inserting an operation does not show the following ones as modified.
+type OperationDiff struct {
+	Change ChangeKind
+	// OldIndex and NewIndex are the indexes of the operation in the old and new proposals, -1 when
+	// the operation was added or removed.
+	OldIndex int
+	NewIndex int
+	Calls    []CallDiff
+}
+
+// CallDiff is an added, removed or modified transaction of an operation.
+type CallDiff struct {
+	Change ChangeKind
+	// OldIndex and NewIndex are the indexes of the transaction in the old and new operations, -1
+	// when the transaction was added or removed.
+	OldIndex int
+	NewIndex int
+	// Old and New are nil when the transaction was added or removed.
+	Old *CallReport
+	New *CallReport
+	// Fields are the changed transaction fields and Args the changed decoded arguments of a
+	// modified transaction. The raw data is only compared when either side was not decoded.
+	Fields []FieldChange
+	Args   []FieldChange
+}
+
+// SigningHashChanged reports whether the signing hash of the proposal changed, in which case the
+// proposal must be signed again.
+func (d *Diff) SigningHashChanged() bool {
+	return d.OldSigningHash != d.NewSigningHash
+}
+
+// Empty reports whether the proposals are semantically identical.
+func (d *Diff) Empty() bool {
+	return len(d.Fields) == 0 && len(d.Chains) == 0 && !d.SigningHashChanged()
+}
+
+// diffProposal is one side of a diff. The operations of MCMS proposals are batches of a single
+// transaction, so that both kinds of proposals are compared the same way.
+type diffProposal struct {
+	base              mcms.BaseProposal
+	signingHash       common.Hash
+	timelockAddresses map[types.ChainSelector]string
+	operations        []types.BatchOperation
+	// decoded is nil when the operations were not decoded.
+	decoded     [][]sdk.DecodedOperation
+	decodeError string
+}
+
+// NewProposalDiff compares two versions of an MCMS proposal. WithDecoders compares the decoded
+// calls of the modified transactions, other options are ignored.
+func NewProposalDiff(oldProposal, newProposal *mcms.Proposal, opts ...Option) (*Diff, error) {
+	o := applyOptions(opts)
+
+	oldSide, err := newMCMSDiffProposal(oldProposal, o)
+	if err != nil {
+		return nil, fmt.Errorf("old proposal: %w", err)
+	}
+	newSide, err := newMCMSDiffProposal(newProposal, o)
+	if err != nil {
+		return nil, fmt.Errorf("new proposal: %w", err)
+	}
+
+	return newDiff(types.KindProposal, oldSide, newSide, nil), nil
+}
+
+// NewTimelockProposalDiff compares two versions of a timelock proposal. The signing hashes are
+// the ones of the converted proposals, which is what signers sign.
+func NewTimelockProposalDiff(
+	ctx context.Context, oldProposal, newProposal *mcms.TimelockProposal, opts ...Option,
+) (*Diff, error) {
+	o := applyOptions(opts)
+
+	oldSide, err := newTimelockDiffProposal(ctx, oldProposal, o)
+	if err != nil {
+		return nil, fmt.Errorf("old proposal: %w", err)
+	}
+	newSide, err := newTimelockDiffProposal(ctx, newProposal, o)
+	if err != nil {
+		return nil, fmt.Errorf("new proposal: %w", err)
+	}
+
+	var fields []FieldChange
+	fields = appendChange(fields, "Timelock action", string(oldProposal.Action), string(newProposal.Action))
+	fields = appendChange(fields, "Delay", oldProposal.Delay.String(), newProposal.Delay.String())
+	oldSalt, newSalt := oldProposal.Salt(), newProposal.Salt()
+	fields = appendChange(fields, "Salt", hexutil.Encode(oldSalt[:]), hexutil.Encode(newSalt[:]))
+
+	return newDiff(types.KindTimelockProposal, oldSide, newSide, fields), nil
+}
+
+func newMCMSDiffProposal(proposal *mcms.Proposal, o options) (*diffProposal, error) {
+	request, err := proposal.SigningRequest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute signing hash: %w", err)
+	}
+
+	side := &diffProposal{
+		base:        proposal.BaseProposal,
+		signingHash: request.SigningHash,
+		operations:  make([]types.BatchOperation, len(proposal.Operations)),
+	}
+	for i, op := range proposal.Operations {
+		side.operations[i] = types.BatchOperation{
+			ChainSelector: op.ChainSelector,
+			Transactions:  []types.Transaction{op.Transaction},
+		}
+	}
+
+	if o.decoders != nil {
+		decoded, derr := proposal.Decode(o.decoders, o.contractInterfaces)
+		if derr != nil {
+			side.decodeError = derr.Error()
+			return side, nil
+		}
+		side.decoded = make([][]sdk.DecodedOperation, len(decoded))
+		for i, op := range decoded {
+			side.decoded[i] = []sdk.DecodedOperation{op}
+		}
+	}
+
+	return side, nil
+}
+
+func newTimelockDiffProposal(ctx context.Context, proposal *mcms.TimelockProposal, o options) (*diffProposal, error) {
+	request, err := proposal.SigningRequest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute signing hash: %w", err)
+	}
+
+	side := &diffProposal{
+		base:              proposal.BaseProposal,
+		signingHash:       request.SigningHash,
+		timelockAddresses: proposal.TimelockAddresses,
+		operations:        proposal.Operations,
+	}
+
+	if o.decoders != nil {
+		side.decoded, err = proposal.Decode(o.decoders, o.contractInterfaces)
+		if err != nil {
+			side.decodeError = err.Error()
+			side.decoded = nil
+		}
+	}
+
+	return side, nil
+}
+
+// newDiff compares the two sides, extraFields being the changed fields specific to the kind of
+// proposal.
+func newDiff(kind types.ProposalKind, oldSide, newSide *diffProposal, extraFields []FieldChange) *Diff {
+	diff := &Diff{
+		Kind:           kind,
+		OldSigningHash: oldSide.signingHash,
+		NewSigningHash: newSide.signingHash,
+	}
+	if diff.SigningHashChanged() {
+		diff.InvalidatedSignatures = len(oldSide.base.Signatures)
+	}
+
+	var decodeErrors []string
+	if oldSide.decodeError != "" {
+		decodeErrors = append(decodeErrors, "old proposal: "+oldSide.decodeError)
+	}
+	if newSide.decodeError != "" {
+		decodeErrors = append(decodeErrors, "new proposal: "+newSide.decodeError)
+	}
+	diff.DecodeError = strings.Join(decodeErrors, "; ")
+
+	oldBase, newBase := oldSide.base, newSide.base
+	diff.Fields = appendChange(diff.Fields, "Version", oldBase.Version, newBase.Version)
+	diff.Fields = appendChange(diff.Fields, "Description", oldBase.Description, newBase.Description)
+	diff.Fields = appendChange(diff.Fields, "Valid until",
+		formatTime(time.Unix(int64(oldBase.ValidUntil), 0).UTC()), formatTime(time.Unix(int64(newBase.ValidUntil), 0).UTC()))
+	diff.Fields = appendChange(diff.Fields, "Override previous root",
+		strconv.FormatBool(oldBase.OverridePreviousRoot), strconv.FormatBool(newBase.OverridePreviousRoot))
+	diff.Fields = appendChange(diff.Fields, "Metadata", formatJSON(oldBase.Metadata), formatJSON(newBase.Metadata))
+	diff.Fields = append(diff.Fields, extraFields...)
+
+	for _, selector := range diffChainSelectors(oldSide, newSide) {
+		if chain, changed := newChainDiff(selector, oldSide, newSide); changed {
+			diff.Chains = append(diff.Chains, chain)
+		}
+	}
+
+	return diff
+}
+
+// diffChainSelectors returns the sorted chain selectors of both proposals.
+func diffChainSelectors(oldSide, newSide *diffProposal) []types.ChainSelector {
+	selectors := make(map[types.ChainSelector]struct{})
+	for _, side := range []*diffProposal{oldSide, newSide} {
+		for selector := range side.base.ChainMetadata {
+			selectors[selector] = struct{}{}
+		}
+		for _, op := range side.operations {
+			selectors[op.ChainSelector] = struct{}{}
+		}
+	}
+
+	return slices.Sorted(maps.Keys(selectors))
+}
+
+// newChainDiff compares the proposals on a single chain, reporting whether anything changed.
+func newChainDiff(selector types.ChainSelector, oldSide, newSide *diffProposal) (ChainDiff, bool) {
+	oldMetadata, inOld := oldSide.base.ChainMetadata[selector]
+	newMetadata, inNew := newSide.base.ChainMetadata[selector]
+
+	chain := ChainDiff{
+		ChainSelector: selector,
+		ChainName:     chainName(selector),
+		Change:        ChangeModified,
+	}
+	switch {
+	case !inOld:
+		chain.Change = ChangeAdded
+	case !inNew:
+		chain.Change = ChangeRemoved
+	}
+
+	oldFields, newFields := chainFields(oldMetadata, inOld), chainFields(newMetadata, inNew)
+	for i, name := range []string{"MCM address", "Starting op count", "Additional fields"} {
+		chain.Fields = appendChange(chain.Fields, name, oldFields[i], newFields[i])
+	}
+	chain.Fields = appendChange(chain.Fields, "Timelock address",
+		oldSide.timelockAddresses[selector], newSide.timelockAddresses[selector])
+
+	oldOps, newOps := oldSide.chainOperations(selector), newSide.chainOperations(selector)
+	oldKeys, newKeys := make([]string, len(oldOps)), make([]string, len(newOps))
+	for i, op := range oldOps {
+		oldKeys[i] = batchKey(oldSide.operations[op])
+	}
+	for i, op := range newOps {
+		newKeys[i] = batchKey(newSide.operations[op])
+	}
+
+	for _, pair := range changedPairs(oldKeys, newKeys) {
+		op := OperationDiff{OldIndex: -1, NewIndex: -1}
+		if pair.old >= 0 {
+			op.OldIndex = oldOps[pair.old]
+		}
+		if pair.new >= 0 {
+			op.NewIndex = newOps[pair.new]
+		}
+		op.Change, op.Calls = diffCalls(oldSide, op.OldIndex, newSide, op.NewIndex)
+		chain.Operations = append(chain.Operations, op)
+	}
+
+	changed := chain.Change != ChangeModified || len(chain.Fields) > 0 || len(chain.Operations) > 0
+
+	return chain, changed
+}
+
+// chainFields returns the MCM address, starting op count and additional fields of the chain
+// metadata, which are empty when the chain is not part of the proposal.
+func chainFields(metadata types.ChainMetadata, ok bool) [3]string {
+	if !ok {
+		return [3]string{}
+	}
+
+	return [3]string{
+		metadata.MCMAddress,
+		strconv.FormatUint(metadata.StartingOpCount, 10),
+		string(metadata.AdditionalFields),
+	}
+}
+
+// chainOperations returns the indexes of the operations of the chain.
+func (p *diffProposal) chainOperations(selector types.ChainSelector) []int {
+	var indexes []int
+	for i, op := range p.operations {
+		if op.ChainSelector == selector {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+// callReport returns the report of a transaction of an operation.
+func (p *diffProposal) callReport(op, tx int) *CallReport {
+	var decoded sdk.DecodedOperation
+	if p.decoded != nil {
+		decoded = p.decoded[op][tx]
+	}
+	call := newCallReport(p.operations[op].Transactions[tx], decoded)
+
+	return &call
+}
+
+// diffCalls compares the transactions of two matched operations, either of which may be absent
+// (index -1).
+func diffCalls(oldSide *diffProposal, oldOp int, newSide *diffProposal, newOp int) (ChangeKind, []CallDiff) {
+	var oldKeys, newKeys []string
+	if oldOp >= 0 {
+		for _, tx := range oldSide.operations[oldOp].Transactions {
+			oldKeys = append(oldKeys, transactionKey(tx))
+		}
+	}
+	if newOp >= 0 {
+		for _, tx := range newSide.operations[newOp].Transactions {
+			newKeys = append(newKeys, transactionKey(tx))
+		}
+	}
+
+	var calls []CallDiff
+	for _, pair := range changedPairs(oldKeys, newKeys) {
+		call := CallDiff{OldIndex: pair.old, NewIndex: pair.new}
+		switch {
+		case pair.old < 0:
+			call.Change = ChangeAdded
+			call.New = newSide.callReport(newOp, pair.new)
+		case pair.new < 0:
+			call.Change = ChangeRemoved
+			call.Old = oldSide.callReport(oldOp, pair.old)
+		default:
+			call.Change = ChangeModified
+			call.Old = oldSide.callReport(oldOp, pair.old)
+			call.New = newSide.callReport(newOp, pair.new)
+			call.Fields, call.Args = diffCall(
+				oldSide.operations[oldOp].Transactions[pair.old], *call.Old,
+				newSide.operations[newOp].Transactions[pair.new], *call.New)
+		}
+		calls = append(calls, call)
+	}
+
+	switch {
+	case oldOp < 0:
+		return ChangeAdded, calls
+	case newOp < 0:
+		return ChangeRemoved, calls
+	default:
+		return ChangeModified, calls
+	}
+}
+
+// diffCall returns the changed fields and decoded arguments of a modified transaction.
+func diffCall(oldTx types.Transaction, oldCall CallReport, newTx types.Transaction, newCall CallReport) ([]FieldChange, []FieldChange) {
+	var fields []FieldChange
+	fields = appendChange(fields, "To", oldCall.To, newCall.To)
+	fields = appendChange(fields, "Contract type", oldCall.ContractType, newCall.ContractType)
+	fields = appendChange(fields, "Tags", strings.Join(oldCall.Tags, ", "), strings.Join(newCall.Tags, ", "))
+	fields = appendChange(fields, "Additional fields", string(oldTx.AdditionalFields), string(newTx.AdditionalFields))
+
+	if oldCall.Method == "" || newCall.Method == "" {
+		return appendChange(fields, "Data", oldCall.Data, newCall.Data), nil
+	}
+	fields = appendChange(fields, "Method", oldCall.Method, newCall.Method)
+
+	// Arguments are matched by name, in the order of the old then the new call
+	oldArgs := make(map[string]string, len(oldCall.Args))
+	for _, arg := range oldCall.Args {
+		oldArgs[arg.Name] = arg.Value
+	}
+	newArgs := make(map[string]string, len(newCall.Args))
+	for _, arg := range newCall.Args {
+		newArgs[arg.Name] = arg.Value
+	}
+
+	var args []FieldChange
+	for _, arg := range oldCall.Args {
+		args = appendChange(args, arg.Name, arg.Value, newArgs[arg.Name])
+	}
+	for _, arg := range newCall.Args {
+		if _, ok := oldArgs[arg.Name]; !ok {
+			args = appendChange(args, arg.Name, "", arg.Value)
+		}
+	}
+
+	return fields, args
+}
+
+// appendChange appends the field to changes if its value changed.
+func appendChange(changes []FieldChange, name, oldValue, newValue string) []FieldChange {
+	if oldValue == newValue {
+		return changes
+	}
+
+	return append(changes, FieldChange{Name: name, Old: oldValue, New: newValue})
+}
+
+// formatJSON formats the proposal metadata as JSON, empty when unset.
+func formatJSON(v map[string]any) string {
+	if len(v) == 0 {
+		return ""
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
+
+// transactionKey identifies a transaction by its content.
+func transactionKey(tx types.Transaction) string {
+	return strings.Join([]string{
+		tx.To,
+		hexutil.Encode(tx.Data),
+		string(tx.AdditionalFields),
+		tx.ContractType,
+		strings.Join(tx.Tags, ","),
+	}, "\x00")
+}
+
+// batchKey identifies an operation by the content of its transactions.
+func batchKey(op types.BatchOperation) string {
+	keys := make([]string, len(op.Transactions))
+	for i, tx := range op.Transactions {
+		keys[i] = transactionKey(tx)
+	}
+
+	return strings.Join(keys, "\x01")
+}
+
+// pair is an item of the old sequence matched with an item of the new sequence. An index is -1
+// when the item was added or removed.
+type pair struct {
+	old int
+	new int
+}
+
+// changedPairs aligns two sequences on their longest common subsequence and returns the items
+// which are not part of it. Removed and added items found between the same common items are
+// paired in order, as modifications, and the remaining ones are returned unpaired.
+func changedPairs(oldKeys, newKeys []string) []pair {
+	n, m := len(oldKeys), len(newKeys)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if oldKeys[i] == newKeys[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var pairs []pair
+	var removed, added []int
+	flush := func() {
+		for k := range max(len(removed), len(added)) {
+			p := pair{old: -1, new: -1}
+			if k < len(removed) {
+				p.old = removed[k]
+			}
+			if k < len(added) {
+				p.new = added[k]
+			}
+			pairs = append(pairs, p)
+		}
+		removed, added = nil, nil
+	}
+
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && oldKeys[i] == newKeys[j]:
+			flush()
+			i++
+			j++
+		case j < m && (i == n || lcs[i][j+1] >= lcs[i+1][j]):
+			added = append(added, j)
+			j++
+		default:
+			removed = append(removed, i)
+			i++
+		}
+	}
+	flush()
+
+	return pairs
+}
@@ -0,0 +1,282 @@
+package report
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms"
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func newTestTimelockProposal(t *testing.T) *mcms.TimelockProposal {
+	t.Helper()
+
+	return &mcms.TimelockProposal{
+		BaseProposal: mcms.BaseProposal{
+			Version:    "v1",
+			Kind:       types.KindTimelockProposal,
+			ValidUntil: 2004259681,
+			ChainMetadata: map[types.ChainSelector]types.ChainMetadata{
+				chaintest.Chain1Selector: {MCMAddress: "0x0000000000000000000000000000000000000001"},
+			},
+		},
+		Action:            types.TimelockActionSchedule,
+		Delay:             types.MustParseDuration("1h"),
+		TimelockAddresses: map[types.ChainSelector]string{chaintest.Chain1Selector: "0x0000000000000000000000000000000000000002"},
+		Operations: []types.BatchOperation{{
+			ChainSelector: chaintest.Chain1Selector,
+			Transactions: []types.Transaction{
+				evm.NewTransaction(common.HexToAddress("0x1234"),
+					transferData(t, common.HexToAddress("0xabcd"), 1), big.NewInt(0), "Token", nil),
+			},
+		}},
+	}
+}
+
+func TestNewProposalDiff(t *testing.T) {
+	t.Parallel()
+
+	oldProposal := newTestProposal(t)
+	request, err := oldProposal.SigningRequest()
+	require.NoError(t, err)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	envelope, err := request.Sign(mcms.NewPrivateKeySigner(key))
+	require.NoError(t, err)
+	require.NoError(t, oldProposal.MergeSignatures(envelope))
+
+	newProposal := newTestProposal(t)
+	newProposal.Description = "Transfer more tokens"
+	newProposal.ChainMetadata[chaintest.Chain2Selector] = types.ChainMetadata{
+		MCMAddress: "0x0000000000000000000000000000000000000002", StartingOpCount: 5,
+	}
+	newProposal.Operations[2].Transaction = evm.NewTransaction(common.HexToAddress("0x1234"),
+		transferData(t, common.HexToAddress("0xabcd"), 301), big.NewInt(0), "Token", nil)
+	newProposal.Operations = append(newProposal.Operations, types.Operation{
+		ChainSelector: chaintest.Chain1Selector,
+		Transaction: evm.NewTransaction(common.HexToAddress("0x1234"),
+			transferData(t, common.HexToAddress("0xabcd"), 400), big.NewInt(0), "Token", nil),
+	})
+
+	diff, err := NewProposalDiff(oldProposal, newProposal, newTestDecoders())
+	require.NoError(t, err)
+
+	require.Equal(t, types.KindProposal, diff.Kind)
+	require.True(t, diff.SigningHashChanged())
+	require.False(t, diff.Empty())
+	require.Equal(t, request.SigningHash, diff.OldSigningHash)
+	require.Equal(t, 1, diff.InvalidatedSignatures)
+	require.Empty(t, diff.DecodeError)
+	require.Equal(t, []FieldChange{
+		{Name: "Description", Old: "Transfer tokens", New: "Transfer more tokens"},
+	}, diff.Fields)
+
+	require.Len(t, diff.Chains, 2)
+	chain1, chain2 := diff.Chains[0], diff.Chains[1]
+
+	// The unchanged operation of chain 1 is not listed
+	require.Equal(t, chaintest.Chain1Selector, chain1.ChainSelector)
+	require.Equal(t, ChangeModified, chain1.Change)
+	require.Empty(t, chain1.Fields)
+	require.Len(t, chain1.Operations, 1)
+	added := chain1.Operations[0]
+	require.Equal(t, ChangeAdded, added.Change)
+	require.Equal(t, -1, added.OldIndex)
+	require.Equal(t, 3, added.NewIndex)
+	require.Len(t, added.Calls, 1)
+	require.Equal(t, ChangeAdded, added.Calls[0].Change)
+	require.Nil(t, added.Calls[0].Old)
+	require.Equal(t, Arg{Name: "amount", Value: "400"}, added.Calls[0].New.Args[1])
+
+	require.Equal(t, "ethereum-testnet-sepolia", chain2.ChainName)
+	require.Equal(t, []FieldChange{{Name: "Starting op count", Old: "3", New: "5"}}, chain2.Fields)
+	require.Len(t, chain2.Operations, 1)
+	modified := chain2.Operations[0]
+	require.Equal(t, ChangeModified, modified.Change)
+	require.Equal(t, 2, modified.OldIndex)
+	require.Equal(t, 2, modified.NewIndex)
+	require.Len(t, modified.Calls, 1)
+	call := modified.Calls[0]
+	require.Equal(t, ChangeModified, call.Change)
+	require.Empty(t, call.Fields)
+	require.Equal(t, []FieldChange{{Name: "amount", Old: "300", New: "301"}}, call.Args)
+}
+
+func TestNewProposalDiff_Unchanged(t *testing.T) {
+	t.Parallel()
+
+	diff, err := NewProposalDiff(newTestProposal(t), newTestProposal(t))
+	require.NoError(t, err)
+
+	require.True(t, diff.Empty())
+	require.False(t, diff.SigningHashChanged())
+	require.Zero(t, diff.InvalidatedSignatures)
+	require.Empty(t, diff.Chains)
+}
+
+func TestNewProposalDiff_RawData(t *testing.T) {
+	t.Parallel()
+
+	oldProposal := newTestProposal(t)
+	newProposal := newTestProposal(t)
+	newProposal.Operations[1].Transaction = evm.NewTransaction(common.HexToAddress("0x5678"),
+		[]byte{0x01}, big.NewInt(1), "Other", []string{"tag"})
+
+	// The new transaction cannot be decoded, so the raw data is compared
+	diff, err := NewProposalDiff(oldProposal, newProposal, newTestDecoders())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(diff.DecodeError, "new proposal: no contract interfaces found"), diff.DecodeError)
+
+	require.Len(t, diff.Chains, 1)
+	require.Equal(t, chaintest.Chain1Selector, diff.Chains[0].ChainSelector)
+	call := diff.Chains[0].Operations[0].Calls[0]
+	require.Empty(t, call.Args)
+	names := make([]string, len(call.Fields))
+	for i, field := range call.Fields {
+		names[i] = field.Name
+	}
+	require.Equal(t, []string{"To", "Contract type", "Tags", "Additional fields", "Data"}, names)
+	require.Equal(t, FieldChange{Name: "Data", Old: call.Old.Data, New: "0x01"}, call.Fields[4])
+}
+
+func TestNewTimelockProposalDiff(t *testing.T) {
+	t.Parallel()
+
+	oldProposal := newTestTimelockProposal(t)
+	oldSalt := oldProposal.Salt()
+	newProposal := newTestTimelockProposal(t)
+	newProposal.Delay = types.MustParseDuration("2h")
+	salt := common.HexToHash("0x01")
+	newProposal.SaltOverride = &salt
+	newProposal.TimelockAddresses[chaintest.Chain1Selector] = "0x0000000000000000000000000000000000000003"
+	newProposal.Operations[0].Transactions = append(newProposal.Operations[0].Transactions,
+		evm.NewTransaction(common.HexToAddress("0x1234"),
+			transferData(t, common.HexToAddress("0xabcd"), 2), big.NewInt(0), "Token", nil))
+
+	diff, err := NewTimelockProposalDiff(t.Context(), oldProposal, newProposal, newTestDecoders())
+	require.NoError(t, err)
+
+	require.Equal(t, types.KindTimelockProposal, diff.Kind)
+	require.True(t, diff.SigningHashChanged())
+	require.Zero(t, diff.InvalidatedSignatures)
+	require.Equal(t, []FieldChange{
+		{Name: "Delay", Old: "1h0m0s", New: "2h0m0s"},
+		{Name: "Salt", Old: common.Hash(oldSalt).Hex(), New: salt.Hex()},
+	}, diff.Fields)
+
+	require.Len(t, diff.Chains, 1)
+	chain := diff.Chains[0]
+	require.Equal(t, []FieldChange{{
+		Name: "Timelock address",
+		Old:  "0x0000000000000000000000000000000000000002",
+		New:  "0x0000000000000000000000000000000000000003",
+	}}, chain.Fields)
+
+	// The existing transaction of the batch is kept, the new one is added
+	require.Len(t, chain.Operations, 1)
+	op := chain.Operations[0]
+	require.Equal(t, ChangeModified, op.Change)
+	require.Len(t, op.Calls, 1)
+	require.Equal(t, ChangeAdded, op.Calls[0].Change)
+	require.Equal(t, 1, op.Calls[0].NewIndex)
+	require.Equal(t, "transfer", op.Calls[0].New.Method)
+}
+
+func TestNewTimelockProposalDiff_Chains(t *testing.T) {
+	t.Parallel()
+
+	oldProposal := newTestTimelockProposal(t)
+	newProposal := newTestTimelockProposal(t)
+	newProposal.ChainMetadata = map[types.ChainSelector]types.ChainMetadata{
+		chaintest.Chain2Selector: {MCMAddress: "0x0000000000000000000000000000000000000001"},
+	}
+	newProposal.TimelockAddresses = map[types.ChainSelector]string{
+		chaintest.Chain2Selector: "0x0000000000000000000000000000000000000002",
+	}
+	newProposal.Operations[0].ChainSelector = chaintest.Chain2Selector
+
+	diff, err := NewTimelockProposalDiff(t.Context(), oldProposal, newProposal)
+	require.NoError(t, err)
+	require.Empty(t, diff.Fields)
+
+	require.Len(t, diff.Chains, 2)
+	require.Equal(t, ChangeRemoved, diff.Chains[0].Change)
+	require.Equal(t, FieldChange{Name: "MCM address", Old: "0x0000000000000000000000000000000000000001"},
+		diff.Chains[0].Fields[0])
+	require.Equal(t, ChangeRemoved, diff.Chains[0].Operations[0].Change)
+	require.Equal(t, ChangeRemoved, diff.Chains[0].Operations[0].Calls[0].Change)
+	require.Equal(t, ChangeAdded, diff.Chains[1].Change)
+	require.Equal(t, ChangeAdded, diff.Chains[1].Operations[0].Change)
+}
+
+func TestNewProposalDiff_InvalidProposal(t *testing.T) {
+	t.Parallel()
+
+	invalid := newTestProposal(t)
+	invalid.ChainMetadata = map[types.ChainSelector]types.ChainMetadata{}
+
+	_, err := NewProposalDiff(newTestProposal(t), invalid, WithDecoders(map[types.ChainSelector]sdk.Decoder{}, nil))
+	require.ErrorContains(t, err, "new proposal: failed to compute signing hash")
+}
+
+func TestChangedPairs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		oldKeys []string
+		newKeys []string
+		want    []pair
+	}{
+		{
+			name:    "unchanged",
+			oldKeys: []string{"a", "b"},
+			newKeys: []string{"a", "b"},
+		},
+		{
+			name:    "inserted",
+			oldKeys: []string{"a", "b", "c"},
+			newKeys: []string{"a", "x", "b", "c"},
+			want:    []pair{{old: -1, new: 1}},
+		},
+		{
+			name:    "removed",
+			oldKeys: []string{"a", "b", "c"},
+			newKeys: []string{"a", "c"},
+			want:    []pair{{old: 1, new: -1}},
+		},
+		{
+			name:    "modified",
+			oldKeys: []string{"a", "b", "c"},
+			newKeys: []string{"a", "x", "c"},
+			want:    []pair{{old: 1, new: 1}},
+		},
+		{
+			name:    "modified and appended",
+			oldKeys: []string{"a", "b"},
+			newKeys: []string{"x", "y", "b", "z"},
+			want:    []pair{{old: 0, new: 0}, {old: -1, new: 1}, {old: -1, new: 3}},
+		},
+		{
+			name:    "all removed",
+			oldKeys: []string{"a", "b"},
+			want:    []pair{{old: 0, new: -1}, {old: 1, new: -1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, changedPairs(tt.oldKeys, tt.newKeys))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package report
+
+import (
+	"html/template"
+	"io"
+)
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Kind}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin: 1em 0; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; vertical-align: top; }
+code { word-break: break-all; }
+.warning { color: #b00020; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>{{.Kind}}</h1>
+{{- with .Description}}
+<p>{{.}}</p>
+{{- end}}
+<table>
+{{- range .SummaryRows}}
+<tr><th>{{index . 0}}</th><td>{{index . 1}}</td></tr>
+{{- end}}
+</table>
+<h2>Signatures</h2>
+{{- if .Signers}}
+<ul>
+{{- range .Signers}}
+<li><code>{{.Hex}}</code></li>
+{{- end}}
+</ul>
+{{- else}}
+<p>No valid signatures.</p>
+{{- end}}
+{{- if .InvalidSignatures}}
+<p class="warning">{{.InvalidSignatures}} signature(s) could not be recovered.</p>
+{{- end}}
+<h2>Chains</h2>
+<table>
+<tr><th>Chain</th><th>Selector</th><th>Family</th><th>Operations</th><th>Transactions</th><th>Quorum</th></tr>
+{{- range .Chains}}
+<tr><td>{{.ChainName}}</td><td>{{.ChainSelector}}</td><td>{{.ChainFamily}}</td><td>{{.OpCount}}</td><td>{{.TransactionCount}}</td><td>{{.QuorumStatus}}</td></tr>
+{{- end}}
+</table>
+{{- with .DecodeError}}
+<p class="warning">Operations could not be decoded: {{.}}</p>
+{{- end}}
+{{- range .Chains}}
+<section>
+<h2>{{.ChainName}} ({{.ChainSelector}})</h2>
+<table>
+{{- range .SummaryRows}}
+<tr><th>{{index . 0}}</th><td>{{index . 1}}</td></tr>
+{{- end}}
+</table>
+{{- range .Operations}}
+<h3>Operation {{.Index}}</h3>
+{{- $multiple := gt (len .Calls) 1}}
+{{- range $i, $call := .Calls}}
+{{- if $multiple}}
+<h4>Transaction {{$i}}</h4>
+{{- end}}
+<table>
+<tr><th>To</th><td><code>{{.To}}</code></td></tr>
+{{- with .ContractType}}
+<tr><th>Contract type</th><td>{{.}}</td></tr>
+{{- end}}
+{{- with .Tags}}
+<tr><th>Tags</th><td>{{range $j, $tag := .}}{{if $j}}, {{end}}{{$tag}}{{end}}</td></tr>
+{{- end}}
+{{- if .Method}}
+<tr><th>Method</th><td><code>{{.Method}}</code></td></tr>
+{{- range .Args}}
+<tr><th>{{.Name}}</th><td><code>{{.Value}}</code></td></tr>
+{{- end}}
+{{- else}}
+<tr><th>Data</th><td><code>{{.Data}}</code></td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- end}}
+</section>
+{{- end}}
+</body>
+</html>
+`))
+
+// htmlReport exposes the computed rows of the report to the HTML template.
+type htmlReport struct {
+	*Report
+	SummaryRows [][2]string
+	Chains      []htmlChainReport
+}
+
+type htmlChainReport struct {
+	ChainReport
+	SummaryRows  [][2]string
+	QuorumStatus string
+}
+
+// WriteHTML renders the report as a standalone HTML document. All values are escaped.
+func (r *Report) WriteHTML(w io.Writer) error {
+	data := htmlReport{
+		Report:      r,
+		SummaryRows: r.summaryRows(),
+		Chains:      make([]htmlChainReport, len(r.Chains)),
+	}
+	for i, chain := range r.Chains {
+		data.Chains[i] = htmlChainReport{
+			ChainReport:  chain,
+			SummaryRows:  chain.summaryRows(),
+			QuorumStatus: chain.quorumStatus(),
+		}
+	}
+
+	return htmlTemplate.Execute(w, data)
+}
@@ -0,0 +1,36 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport_WriteHTML(t *testing.T) {
+	t.Parallel()
+
+	report := newTestReport()
+	report.DecodeError = "<script>alert(1)</script>"
+
+	var b strings.Builder
+	require.NoError(t, report.WriteHTML(&b))
+	html := b.String()
+
+	require.True(t, strings.HasPrefix(html, "<!DOCTYPE html>"))
+	require.Contains(t, html, "<h1>TimelockProposal</h1>")
+	require.Contains(t, html, "<tr><th>Delay</th><td>1h0m0s</td></tr>")
+	require.Contains(t, html, "<li><code>0x00000000000000000000000000000000000000AA</code></li>")
+	require.Contains(t, html, "1 signature(s) could not be recovered.")
+	require.Contains(t, html, "<tr><td>ethereum-testnet-sepolia</td><td>16015286601757825753</td><td>evm</td>"+
+		"<td>1</td><td>2</td><td>not reached</td></tr>")
+	require.Contains(t, html, "<h2>ethereum-testnet-sepolia (16015286601757825753)</h2>")
+	require.Contains(t, html, "<h4>Transaction 1</h4>")
+	require.Contains(t, html, "<tr><th>Method</th><td><code>setRamp</code></td></tr>")
+	require.Contains(t, html, "<tr><th>ramp</th><td><code>0x04</code></td></tr>")
+	require.Contains(t, html, "<tr><th>Data</th><td><code>0x1234</code></td></tr>")
+
+	// Values are escaped
+	require.Contains(t, html, "&lt;script&gt;alert(1)&lt;/script&gt;")
+	require.NotContains(t, html, "<script>")
+}
@@ -0,0 +1,212 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteMarkdown renders the report as Markdown.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", r.Kind)
+	if r.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", r.Description)
+	}
+
+	b.WriteString("| Field | Value |\n| --- | --- |\n")
+	for _, row := range r.summaryRows() {
+		fmt.Fprintf(&b, "| %s | %s |\n", row[0], markdownCell(row[1]))
+	}
+
+	b.WriteString("\n## Signatures\n\n")
+	if len(r.Signers) == 0 {
+		b.WriteString("No valid signatures.\n")
+	}
+	for _, signer := range r.Signers {
+		fmt.Fprintf(&b, "- `%s`\n", signer.Hex())
+	}
+	if r.InvalidSignatures > 0 {
+		fmt.Fprintf(&b, "\n**%d signature(s) could not be recovered.**\n", r.InvalidSignatures)
+	}
+
+	b.WriteString("\n## Chains\n\n")
+	b.WriteString("| Chain | Selector | Family | Operations | Transactions | Quorum |\n")
+	b.WriteString("| --- | --- | --- | --- | --- | --- |\n")
+	for _, chain := range r.Chains {
+		fmt.Fprintf(&b, "| %s | %d | %s | %d | %d | %s |\n",
+			markdownCell(chain.ChainName), chain.ChainSelector, chain.ChainFamily,
+			chain.OpCount, chain.TransactionCount, chain.quorumStatus())
+	}
+
+	if r.DecodeError != "" {
+		fmt.Fprintf(&b, "\n**Operations could not be decoded:** %s\n", r.DecodeError)
+	}
+
+	for _, chain := range r.Chains {
+		fmt.Fprintf(&b, "\n## %s (%d)\n\n", chain.ChainName, chain.ChainSelector)
+		b.WriteString("| Field | Value |\n| --- | --- |\n")
+		for _, row := range chain.summaryRows() {
+			fmt.Fprintf(&b, "| %s | %s |\n", row[0], markdownCell(row[1]))
+		}
+
+		for _, op := range chain.Operations {
+			fmt.Fprintf(&b, "\n### Operation %d\n", op.Index)
+			for i, call := range op.Calls {
+				if len(op.Calls) > 1 {
+					fmt.Fprintf(&b, "\n#### Transaction %d\n", i)
+				}
+				b.WriteString("\n")
+				writeMarkdownCall(&b, call)
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func writeMarkdownCall(b *strings.Builder, call CallReport) {
+	fmt.Fprintf(b, "- To: `%s`\n", call.To)
+	if call.ContractType != "" {
+		fmt.Fprintf(b, "- Contract type: %s\n", call.ContractType)
+	}
+	if len(call.Tags) > 0 {
+		fmt.Fprintf(b, "- Tags: %s\n", strings.Join(call.Tags, ", "))
+	}
+
+	if call.Method == "" {
+		fmt.Fprintf(b, "- Data: `%s`\n", call.Data)
+		return
+	}
+
+	fmt.Fprintf(b, "- Method: `%s`\n", call.Method)
+	if len(call.Args) == 0 {
+		return
+	}
+
+	b.WriteString("\n| Argument | Value |\n| --- | --- |\n")
+	for _, arg := range call.Args {
+		fmt.Fprintf(b, "| %s | `%s` |\n", markdownCell(arg.Name), markdownCell(arg.Value))
+	}
+}
+
+// WriteMarkdown renders the diff as Markdown.
+func (d *Diff) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s diff\n\n", d.Kind)
+	switch {
+	case !d.SigningHashChanged():
+		b.WriteString("The signing hash did not change: existing signatures remain valid.\n")
+	case d.InvalidatedSignatures > 0:
+		fmt.Fprintf(&b, "**The signing hash changed: the %d existing signature(s) are no longer valid.**\n",
+			d.InvalidatedSignatures)
+	default:
+		b.WriteString("**The signing hash changed.**\n")
+	}
+
+	fields := d.Fields
+	if d.SigningHashChanged() {
+		fields = append([]FieldChange{{Name: "Signing hash", Old: d.OldSigningHash.Hex(), New: d.NewSigningHash.Hex()}}, fields...)
+	}
+	if len(fields) > 0 {
+		b.WriteString("\n")
+		writeMarkdownChanges(&b, "Field", fields)
+	}
+
+	if d.DecodeError != "" {
+		fmt.Fprintf(&b, "\n**Operations could not be decoded:** %s\n", d.DecodeError)
+	}
+
+	if d.Empty() {
+		b.WriteString("\nNo changes.\n")
+	}
+
+	for _, chain := range d.Chains {
+		fmt.Fprintf(&b, "\n## %s (%d): %s\n", chain.ChainName, chain.ChainSelector, chain.Change)
+		if len(chain.Fields) > 0 {
+			b.WriteString("\n")
+			writeMarkdownChanges(&b, "Field", chain.Fields)
+		}
+
+		for _, op := range chain.Operations {
+			fmt.Fprintf(&b, "\n### Operation %s: %s\n", indexLabel(op.OldIndex, op.NewIndex), op.Change)
+			for _, call := range op.Calls {
+				if len(op.Calls) > 1 || op.Change == ChangeModified {
+					fmt.Fprintf(&b, "\n#### Transaction %s: %s\n", indexLabel(call.OldIndex, call.NewIndex), call.Change)
+				}
+				b.WriteString("\n")
+				writeMarkdownCallDiff(&b, call)
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func writeMarkdownCallDiff(b *strings.Builder, call CallDiff) {
+	switch call.Change {
+	case ChangeAdded:
+		writeMarkdownCall(b, *call.New)
+		return
+	case ChangeRemoved:
+		writeMarkdownCall(b, *call.Old)
+		return
+	}
+
+	fmt.Fprintf(b, "- To: `%s`\n", call.New.To)
+	if call.New.Method != "" {
+		fmt.Fprintf(b, "- Method: `%s`\n", call.New.Method)
+	}
+	if len(call.Fields) > 0 {
+		b.WriteString("\n")
+		writeMarkdownChanges(b, "Field", call.Fields)
+	}
+	if len(call.Args) > 0 {
+		b.WriteString("\n")
+		writeMarkdownChanges(b, "Argument", call.Args)
+	}
+}
+
+func writeMarkdownChanges(b *strings.Builder, header string, changes []FieldChange) {
+	fmt.Fprintf(b, "| %s | Old | New |\n| --- | --- | --- |\n", header)
+	for _, change := range changes {
+		fmt.Fprintf(b, "| %s | %s | %s |\n",
+			markdownCell(change.Name), markdownValue(change.Old), markdownValue(change.New))
+	}
+}
+
+// markdownValue formats a value of a change as inline code, or a dash when it is absent.
+func markdownValue(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return "`" + markdownCell(s) + "`"
+}
+
+// indexLabel formats the old and new indexes of a diffed item, omitting the missing one.
+func indexLabel(oldIndex, newIndex int) string {
+	switch {
+	case oldIndex < 0:
+		return strconv.Itoa(newIndex)
+	case newIndex < 0 || oldIndex == newIndex:
+		return strconv.Itoa(oldIndex)
+	default:
+		return fmt.Sprintf("%d → %d", oldIndex, newIndex)
+	}
+}
+
+// markdownCell escapes a value so that it fits in a single Markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", "<br>")
+
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
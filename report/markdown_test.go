@@ -0,0 +1,209 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func newTestReport() *Report {
+	reached := false
+
+	return &Report{
+		Kind:              types.KindTimelockProposal,
+		Description:       "Upgrade | router",
+		ValidUntil:        time.Unix(2004259681, 0).UTC(),
+		SigningHash:       common.HexToHash("0x01"),
+		Action:            types.TimelockActionSchedule,
+		Delay:             "1h0m0s",
+		Signers:           []common.Address{common.HexToAddress("0xaa")},
+		InvalidSignatures: 1,
+		Chains: []ChainReport{{
+			ChainSelector:    chaintest.Chain2Selector,
+			ChainName:        "ethereum-testnet-sepolia",
+			ChainFamily:      "evm",
+			MCMAddress:       "0x01",
+			TimelockAddress:  "0x02",
+			StartingOpCount:  4,
+			OpCount:          1,
+			TransactionCount: 2,
+			Quorum:           &reached,
+			Operations: []OperationReport{{
+				Index: 0,
+				Calls: []CallReport{
+					{
+						To:           "0x03",
+						ContractType: "Router",
+						Tags:         []string{"upgrade"},
+						Method:       "setRamp",
+						Args:         []Arg{{Name: "ramp", Value: "0x04"}},
+						Data:         "0xdeadbeef",
+					},
+					{To: "0x05", Data: "0x1234"},
+				},
+			}},
+		}},
+	}
+}
+
+func TestReport_WriteMarkdown(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	require.NoError(t, newTestReport().WriteMarkdown(&b))
+
+	require.Equal(t, "# TimelockProposal\n"+
+		"\n"+
+		"Upgrade | router\n"+
+		"\n"+
+		"| Field | Value |\n"+
+		"| --- | --- |\n"+
+		"| Kind | TimelockProposal |\n"+
+		"| Valid until | 2033-07-06T10:48:01Z (2004259681) |\n"+
+		"| Signing hash | 0x0000000000000000000000000000000000000000000000000000000000000001 |\n"+
+		"| Override previous root | false |\n"+
+		"| Timelock action | schedule |\n"+
+		"| Delay | 1h0m0s |\n"+
+		"\n"+
+		"## Signatures\n"+
+		"\n"+
+		"- `0x00000000000000000000000000000000000000AA`\n"+
+		"\n"+
+		"**1 signature(s) could not be recovered.**\n"+
+		"\n"+
+		"## Chains\n"+
+		"\n"+
+		"| Chain | Selector | Family | Operations | Transactions | Quorum |\n"+
+		"| --- | --- | --- | --- | --- | --- |\n"+
+		"| ethereum-testnet-sepolia | 16015286601757825753 | evm | 1 | 2 | not reached |\n"+
+		"\n"+
+		"## ethereum-testnet-sepolia (16015286601757825753)\n"+
+		"\n"+
+		"| Field | Value |\n"+
+		"| --- | --- |\n"+
+		"| Chain family | evm |\n"+
+		"| MCM address | 0x01 |\n"+
+		"| Timelock address | 0x02 |\n"+
+		"| Starting op count | 4 |\n"+
+		"| Operations | 1 |\n"+
+		"| Transactions | 2 |\n"+
+		"| Quorum | not reached |\n"+
+		"\n"+
+		"### Operation 0\n"+
+		"\n"+
+		"#### Transaction 0\n"+
+		"\n"+
+		"- To: `0x03`\n"+
+		"- Contract type: Router\n"+
+		"- Tags: upgrade\n"+
+		"- Method: `setRamp`\n"+
+		"\n"+
+		"| Argument | Value |\n"+
+		"| --- | --- |\n"+
+		"| ramp | `0x04` |\n"+
+		"\n"+
+		"#### Transaction 1\n"+
+		"\n"+
+		"- To: `0x05`\n"+
+		"- Data: `0x1234`\n", b.String())
+}
+
+func TestMarkdownCell(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, `a \| b<br>c<br>d`, markdownCell("a | b\nc\r\nd"))
+}
+
+func TestDiff_WriteMarkdown(t *testing.T) {
+	t.Parallel()
+
+	diff := &Diff{
+		Kind:                  types.KindProposal,
+		Fields:                []FieldChange{{Name: "Description", Old: "Transfer", New: "Transfer | more"}},
+		OldSigningHash:        common.HexToHash("0x01"),
+		NewSigningHash:        common.HexToHash("0x02"),
+		InvalidatedSignatures: 2,
+		Chains: []ChainDiff{{
+			ChainSelector: chaintest.Chain2Selector,
+			ChainName:     "ethereum-testnet-sepolia",
+			Change:        ChangeModified,
+			Fields:        []FieldChange{{Name: "Starting op count", Old: "3", New: "5"}},
+			Operations: []OperationDiff{
+				{
+					Change:   ChangeModified,
+					OldIndex: 1,
+					NewIndex: 2,
+					Calls: []CallDiff{{
+						Change:   ChangeModified,
+						OldIndex: 0,
+						NewIndex: 0,
+						Old:      &CallReport{To: "0x03", Method: "transfer"},
+						New:      &CallReport{To: "0x03", Method: "transfer"},
+						Args:     []FieldChange{{Name: "amount", Old: "300", New: "301"}},
+					}},
+				},
+				{
+					Change:   ChangeAdded,
+					OldIndex: -1,
+					NewIndex: 3,
+					Calls: []CallDiff{{
+						Change:   ChangeAdded,
+						OldIndex: -1,
+						NewIndex: 0,
+						New:      &CallReport{To: "0x05", Data: "0x1234"},
+					}},
+				},
+			},
+		}},
+	}
+
+	var b strings.Builder
+	require.NoError(t, diff.WriteMarkdown(&b))
+
+	require.Equal(t, "# Proposal diff\n"+
+		"\n"+
+		"**The signing hash changed: the 2 existing signature(s) are no longer valid.**\n"+
+		"\n"+
+		"| Field | Old | New |\n"+
+		"| --- | --- | --- |\n"+
+		"| Signing hash | `0x0000000000000000000000000000000000000000000000000000000000000001` | "+
+		"`0x0000000000000000000000000000000000000000000000000000000000000002` |\n"+
+		"| Description | `Transfer` | `Transfer \\| more` |\n"+
+		"\n"+
+		"## ethereum-testnet-sepolia (16015286601757825753): modified\n"+
+		"\n"+
+		"| Field | Old | New |\n"+
+		"| --- | --- | --- |\n"+
+		"| Starting op count | `3` | `5` |\n"+
+		"\n"+
+		"### Operation 1 → 2: modified\n"+
+		"\n"+
+		"#### Transaction 0: modified\n"+
+		"\n"+
+		"- To: `0x03`\n"+
+		"- Method: `transfer`\n"+
+		"\n"+
+		"| Argument | Old | New |\n"+
+		"| --- | --- | --- |\n"+
+		"| amount | `300` | `301` |\n"+
+		"\n"+
+		"### Operation 3: added\n"+
+		"\n"+
+		"- To: `0x05`\n"+
+		"- Data: `0x1234`\n", b.String())
+
+	// Unchanged proposals keep their signatures
+	b.Reset()
+	require.NoError(t, (&Diff{Kind: types.KindProposal}).WriteMarkdown(&b))
+	require.Equal(t, "# Proposal diff\n"+
+		"\n"+
+		"The signing hash did not change: existing signatures remain valid.\n"+
+		"\n"+
+		"No changes.\n", b.String())
+}
@@ -0,0 +1,369 @@
+// Package report renders MCMS proposals into human-readable Markdown and HTML reports that can be
+// reviewed before signing and attached to change-management tickets.
+package report
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// unknownChainName is displayed for chain selectors that are not known to chain-selectors.
+const unknownChainName = "unknown"
+
+// Report is the human-readable representation of a proposal. Chains are sorted by chain selector
+// and operations keep the order of the proposal, so that the rendered layout is stable.
+type Report struct {
+	Kind                 types.ProposalKind
+	Description          string
+	ValidUntil           time.Time
+	OverridePreviousRoot bool
+	SigningHash          common.Hash
+
+	// Action and Delay are only set for timelock proposals.
+	Action types.TimelockAction
+	Delay  string
+
+	// Signers are the addresses recovered from the proposal signatures, in signature order.
+	Signers []common.Address
+	// InvalidSignatures is the number of signatures whose signer could not be recovered.
+	InvalidSignatures int
+
+	// DecodeError is set when decoders were provided but the operations could not be decoded. The
+	// report then falls back to the raw transaction data.
+	DecodeError string
+
+	Chains []ChainReport
+}
+
+// ChainReport is the section of the report for a single chain.
+type ChainReport struct {
+	ChainSelector   types.ChainSelector
+	ChainName       string
+	ChainFamily     string
+	MCMAddress      string
+	TimelockAddress string
+	StartingOpCount uint64
+	// OpCount is the number of proposal operations on the chain. For timelock proposals these are
+	// batch operations.
+	OpCount          uint64
+	TransactionCount uint64
+	// Quorum is nil when the quorum was not checked.
+	Quorum     *bool
+	Operations []OperationReport
+}
+
+// OperationReport is a single proposal operation. Operations of MCMS proposals hold a single call.
+type OperationReport struct {
+	// Index is the index of the operation in the proposal.
+	Index int
+	Calls []CallReport
+}
+
+// CallReport is a single transaction of an operation.
+type CallReport struct {
+	To           string
+	ContractType string
+	Tags         []string
+	// Method and Args are set when the transaction was decoded.
+	Method string
+	Args   []Arg
+	// Data is the hex encoded transaction data.
+	Data string
+}
+
+// Arg is a decoded input argument.
+type Arg struct {
+	Name  string
+	Value string
+}
+
+// Option configures the report built from a proposal.
+type Option func(*options)
+
+type options struct {
+	decoders           map[types.ChainSelector]sdk.Decoder
+	contractInterfaces map[string]string
+	quorum             map[types.ChainSelector]bool
+}
+
+// WithDecoders decodes the proposal transactions with the given decoders and contract interfaces,
+// keyed by contract type.
+func WithDecoders(decoders map[types.ChainSelector]sdk.Decoder, contractInterfaces map[string]string) Option {
+	return func(o *options) {
+		o.decoders = decoders
+		o.contractInterfaces = contractInterfaces
+	}
+}
+
+// WithQuorum adds the result of the quorum checks, e.g. from Signable.CheckQuorum, to the report.
+func WithQuorum(quorum map[types.ChainSelector]bool) Option {
+	return func(o *options) {
+		o.quorum = quorum
+	}
+}
+
+// NewProposalReport builds the report of an MCMS proposal.
+func NewProposalReport(proposal *mcms.Proposal, opts ...Option) (*Report, error) {
+	o := applyOptions(opts)
+
+	request, err := proposal.SigningRequest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute signing hash: %w", err)
+	}
+
+	report := newReport(proposal.BaseProposal, request.SigningHash)
+
+	var decoded []sdk.DecodedOperation
+	if o.decoders != nil {
+		decoded, err = proposal.Decode(o.decoders, o.contractInterfaces)
+		if err != nil {
+			report.DecodeError = err.Error()
+			decoded = nil
+		}
+	}
+
+	counts := proposal.TransactionCounts()
+	chains := make(map[types.ChainSelector]*ChainReport, len(proposal.ChainMetadata))
+	for selector, metadata := range proposal.ChainMetadata {
+		chains[selector] = newChainReport(selector, metadata, "", counts[selector], o)
+	}
+
+	for i, op := range proposal.Operations {
+		chain, ok := chains[op.ChainSelector]
+		if !ok {
+			return nil, fmt.Errorf("missing chain metadata for chain selector %d", op.ChainSelector)
+		}
+
+		var decodedOp sdk.DecodedOperation
+		if decoded != nil {
+			decodedOp = decoded[i]
+		}
+
+		chain.OpCount++
+		chain.Operations = append(chain.Operations, OperationReport{
+			Index: i,
+			Calls: []CallReport{newCallReport(op.Transaction, decodedOp)},
+		})
+	}
+
+	report.Chains = sortedChains(chains)
+
+	return report, nil
+}
+
+// NewTimelockProposalReport builds the report of a timelock proposal. The signing hash is the one
+// of the converted proposal, which is what signers sign.
+func NewTimelockProposalReport(ctx context.Context, proposal *mcms.TimelockProposal, opts ...Option) (*Report, error) {
+	o := applyOptions(opts)
+
+	request, err := proposal.SigningRequest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute signing hash: %w", err)
+	}
+
+	report := newReport(proposal.BaseProposal, request.SigningHash)
+	report.Action = proposal.Action
+	report.Delay = proposal.Delay.String()
+
+	var decoded [][]sdk.DecodedOperation
+	if o.decoders != nil {
+		decoded, err = proposal.Decode(o.decoders, o.contractInterfaces)
+		if err != nil {
+			report.DecodeError = err.Error()
+			decoded = nil
+		}
+	}
+
+	counts := proposal.TransactionCounts()
+	chains := make(map[types.ChainSelector]*ChainReport, len(proposal.ChainMetadata))
+	for selector, metadata := range proposal.ChainMetadata {
+		chains[selector] = newChainReport(selector, metadata, proposal.TimelockAddresses[selector], counts[selector], o)
+	}
+
+	for i, op := range proposal.Operations {
+		chain, ok := chains[op.ChainSelector]
+		if !ok {
+			return nil, fmt.Errorf("missing chain metadata for chain selector %d", op.ChainSelector)
+		}
+
+		calls := make([]CallReport, len(op.Transactions))
+		for j, tx := range op.Transactions {
+			var decodedOp sdk.DecodedOperation
+			if decoded != nil {
+				decodedOp = decoded[i][j]
+			}
+			calls[j] = newCallReport(tx, decodedOp)
+		}
+
+		chain.OpCount++
+		chain.Operations = append(chain.Operations, OperationReport{Index: i, Calls: calls})
+	}
+
+	report.Chains = sortedChains(chains)
+
+	return report, nil
+}
+
+func applyOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+func newReport(base mcms.BaseProposal, signingHash common.Hash) *Report {
+	signers, failures := mcms.RecoverSigningAddresses(signingHash, base.Signatures)
+
+	return &Report{
+		Kind:                 base.Kind,
+		Description:          base.Description,
+		ValidUntil:           time.Unix(int64(base.ValidUntil), 0).UTC(),
+		OverridePreviousRoot: base.OverridePreviousRoot,
+		SigningHash:          signingHash,
+		Signers:              signers,
+		InvalidSignatures:    len(failures),
+	}
+}
+
+func newChainReport(
+	selector types.ChainSelector, metadata types.ChainMetadata, timelockAddress string, txCount uint64, o options,
+) *ChainReport {
+	family, err := types.GetChainSelectorFamily(selector)
+	if err != nil {
+		family = unknownChainName
+	}
+
+	chain := &ChainReport{
+		ChainSelector:    selector,
+		ChainName:        chainName(selector),
+		ChainFamily:      family,
+		MCMAddress:       metadata.MCMAddress,
+		TimelockAddress:  timelockAddress,
+		StartingOpCount:  metadata.StartingOpCount,
+		TransactionCount: txCount,
+	}
+	if quorum, ok := o.quorum[selector]; ok {
+		chain.Quorum = &quorum
+	}
+
+	return chain
+}
+
+// chainName returns the chain-selectors name of the chain, or unknownChainName.
+func chainName(selector types.ChainSelector) string {
+	name, err := chainsel.GetChainNameFromSelector(uint64(selector))
+	if err != nil || name == "" {
+		return unknownChainName
+	}
+
+	return name
+}
+
+func newCallReport(tx types.Transaction, decoded sdk.DecodedOperation) CallReport {
+	call := CallReport{
+		To:           tx.To,
+		ContractType: tx.ContractType,
+		Tags:         tx.Tags,
+		Data:         hexutil.Encode(tx.Data),
+	}
+
+	if decoded != nil {
+		call.Method = decoded.MethodName()
+		args := decoded.Args()
+		for i, key := range decoded.Keys() {
+			var value any
+			if i < len(args) {
+				value = args[i]
+			}
+			call.Args = append(call.Args, Arg{Name: key, Value: formatArg(value)})
+		}
+	}
+
+	return call
+}
+
+// formatArg formats a decoded argument, encoding byte values as hex.
+func formatArg(value any) string {
+	switch v := value.(type) {
+	case []byte:
+		return hexutil.Encode(v)
+	case [32]byte:
+		return hexutil.Encode(v[:])
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func sortedChains(chains map[types.ChainSelector]*ChainReport) []ChainReport {
+	sorted := make([]ChainReport, 0, len(chains))
+	for _, selector := range slices.Sorted(maps.Keys(chains)) {
+		sorted = append(sorted, *chains[selector])
+	}
+
+	return sorted
+}
+
+// summaryRows returns the field/value rows of the proposal summary.
+func (r *Report) summaryRows() [][2]string {
+	rows := [][2]string{
+		{"Kind", string(r.Kind)},
+		{"Valid until", formatTime(r.ValidUntil)},
+		{"Signing hash", r.SigningHash.Hex()},
+		{"Override previous root", strconv.FormatBool(r.OverridePreviousRoot)},
+	}
+	if r.Action != "" {
+		rows = append(rows, [2]string{"Timelock action", string(r.Action)}, [2]string{"Delay", r.Delay})
+	}
+
+	return rows
+}
+
+// summaryRows returns the field/value rows of the chain summary.
+func (c *ChainReport) summaryRows() [][2]string {
+	rows := [][2]string{
+		{"Chain family", c.ChainFamily},
+		{"MCM address", c.MCMAddress},
+	}
+	if c.TimelockAddress != "" {
+		rows = append(rows, [2]string{"Timelock address", c.TimelockAddress})
+	}
+
+	return append(rows,
+		[2]string{"Starting op count", strconv.FormatUint(c.StartingOpCount, 10)},
+		[2]string{"Operations", strconv.FormatUint(c.OpCount, 10)},
+		[2]string{"Transactions", strconv.FormatUint(c.TransactionCount, 10)},
+		[2]string{"Quorum", c.quorumStatus()},
+	)
+}
+
+// formatTime formats a timestamp as RFC 3339 followed by its unix time.
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339) + " (" + strconv.FormatInt(t.Unix(), 10) + ")"
+}
+
+func (c *ChainReport) quorumStatus() string {
+	switch {
+	case c.Quorum == nil:
+		return "not checked"
+	case *c.Quorum:
+		return "reached"
+	default:
+		return "not reached"
+	}
+}
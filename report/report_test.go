@@ -0,0 +1,193 @@
+package report
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms"
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+const testTokenABI = `[{"type":"function","name":"transfer","inputs":[` +
+	`{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]}]`
+
+func transferData(t *testing.T, to common.Address, amount int64) []byte {
+	t.Helper()
+
+	parsed, err := abi.JSON(strings.NewReader(testTokenABI))
+	require.NoError(t, err)
+	data, err := parsed.Pack("transfer", to, big.NewInt(amount))
+	require.NoError(t, err)
+
+	return data
+}
+
+func newTestProposal(t *testing.T) *mcms.Proposal {
+	t.Helper()
+
+	return &mcms.Proposal{
+		BaseProposal: mcms.BaseProposal{
+			Version:     "v1",
+			Kind:        types.KindProposal,
+			ValidUntil:  2004259681,
+			Description: "Transfer tokens",
+			ChainMetadata: map[types.ChainSelector]types.ChainMetadata{
+				chaintest.Chain2Selector: {MCMAddress: "0x0000000000000000000000000000000000000002", StartingOpCount: 3},
+				chaintest.Chain1Selector: {MCMAddress: "0x0000000000000000000000000000000000000001"},
+			},
+		},
+		Operations: []types.Operation{
+			{
+				ChainSelector: chaintest.Chain2Selector,
+				Transaction: evm.NewTransaction(common.HexToAddress("0x1234"),
+					transferData(t, common.HexToAddress("0xabcd"), 100), big.NewInt(0), "Token", []string{"tag1", "tag2"}),
+			},
+			{
+				ChainSelector: chaintest.Chain1Selector,
+				Transaction: evm.NewTransaction(common.HexToAddress("0x1234"),
+					transferData(t, common.HexToAddress("0xabcd"), 200), big.NewInt(0), "Token", nil),
+			},
+			{
+				ChainSelector: chaintest.Chain2Selector,
+				Transaction: evm.NewTransaction(common.HexToAddress("0x1234"),
+					transferData(t, common.HexToAddress("0xabcd"), 300), big.NewInt(0), "Token", nil),
+			},
+		},
+	}
+}
+
+func newTestDecoders() Option {
+	return WithDecoders(map[types.ChainSelector]sdk.Decoder{
+		chaintest.Chain1Selector: evm.NewDecoder(),
+		chaintest.Chain2Selector: evm.NewDecoder(),
+	}, map[string]string{"Token": testTokenABI})
+}
+
+func TestNewProposalReport(t *testing.T) {
+	t.Parallel()
+
+	proposal := newTestProposal(t)
+	request, err := proposal.SigningRequest()
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	envelope, err := request.Sign(mcms.NewPrivateKeySigner(key))
+	require.NoError(t, err)
+	require.NoError(t, proposal.MergeSignatures(envelope))
+
+	report, err := NewProposalReport(proposal, newTestDecoders(), WithQuorum(map[types.ChainSelector]bool{
+		chaintest.Chain1Selector: true,
+	}))
+	require.NoError(t, err)
+
+	require.Equal(t, types.KindProposal, report.Kind)
+	require.Equal(t, "Transfer tokens", report.Description)
+	require.Equal(t, time.Unix(2004259681, 0).UTC(), report.ValidUntil)
+	require.Equal(t, request.SigningHash, report.SigningHash)
+	require.Equal(t, []common.Address{envelope.Signer}, report.Signers)
+	require.Zero(t, report.InvalidSignatures)
+	require.Empty(t, report.DecodeError)
+	require.Empty(t, report.Action)
+
+	// Chains are sorted by selector
+	require.Len(t, report.Chains, 2)
+	chain1, chain2 := report.Chains[0], report.Chains[1]
+	require.Equal(t, chaintest.Chain1Selector, chain1.ChainSelector)
+	require.Equal(t, chaintest.Chain2Selector, chain2.ChainSelector)
+
+	require.Equal(t, "ethereum-testnet-sepolia", chain2.ChainName)
+	require.Equal(t, "evm", chain2.ChainFamily)
+	require.Equal(t, uint64(3), chain2.StartingOpCount)
+	require.Equal(t, uint64(2), chain2.OpCount)
+	require.Equal(t, uint64(2), chain2.TransactionCount)
+	require.Nil(t, chain2.Quorum)
+	require.NotNil(t, chain1.Quorum)
+	require.True(t, *chain1.Quorum)
+
+	require.Equal(t, []int{0, 2}, []int{chain2.Operations[0].Index, chain2.Operations[1].Index})
+	require.Equal(t, CallReport{
+		To:           "0x0000000000000000000000000000000000001234",
+		ContractType: "Token",
+		Tags:         []string{"tag1", "tag2"},
+		Method:       "transfer",
+		Args: []Arg{
+			{Name: "to", Value: "0x000000000000000000000000000000000000ABcD"},
+			{Name: "amount", Value: "100"},
+		},
+		Data: chain2.Operations[0].Calls[0].Data,
+	}, chain2.Operations[0].Calls[0])
+}
+
+func TestNewProposalReport_DecodeFailure(t *testing.T) {
+	t.Parallel()
+
+	proposal := newTestProposal(t)
+	report, err := NewProposalReport(proposal, WithDecoders(map[types.ChainSelector]sdk.Decoder{}, nil))
+	require.NoError(t, err)
+	require.Equal(t, "no decoder found for chain selector 16015286601757825753", report.DecodeError)
+
+	call := report.Chains[1].Operations[0].Calls[0]
+	require.Empty(t, call.Method)
+	require.Equal(t, "0x", call.Data[:2])
+
+	// Without decoders nothing is decoded and no error is reported
+	report, err = NewProposalReport(proposal)
+	require.NoError(t, err)
+	require.Empty(t, report.DecodeError)
+	require.Empty(t, report.Signers)
+}
+
+func TestNewTimelockProposalReport(t *testing.T) {
+	t.Parallel()
+
+	proposal := &mcms.TimelockProposal{
+		BaseProposal: mcms.BaseProposal{
+			Version:    "v1",
+			Kind:       types.KindTimelockProposal,
+			ValidUntil: 2004259681,
+			ChainMetadata: map[types.ChainSelector]types.ChainMetadata{
+				chaintest.Chain1Selector: {MCMAddress: "0x0000000000000000000000000000000000000001"},
+			},
+		},
+		Action:            types.TimelockActionSchedule,
+		Delay:             types.MustParseDuration("1h"),
+		TimelockAddresses: map[types.ChainSelector]string{chaintest.Chain1Selector: "0x0000000000000000000000000000000000000002"},
+		Operations: []types.BatchOperation{{
+			ChainSelector: chaintest.Chain1Selector,
+			Transactions: []types.Transaction{
+				evm.NewTransaction(common.HexToAddress("0x1234"),
+					transferData(t, common.HexToAddress("0xabcd"), 1), big.NewInt(0), "Token", nil),
+				evm.NewTransaction(common.HexToAddress("0x1234"),
+					transferData(t, common.HexToAddress("0xabcd"), 2), big.NewInt(0), "Token", nil),
+			},
+		}},
+	}
+
+	report, err := NewTimelockProposalReport(t.Context(), proposal, newTestDecoders())
+	require.NoError(t, err)
+
+	request, err := proposal.SigningRequest(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, request.SigningHash, report.SigningHash)
+	require.Equal(t, types.TimelockActionSchedule, report.Action)
+	require.Equal(t, "1h0m0s", report.Delay)
+
+	require.Len(t, report.Chains, 1)
+	chain := report.Chains[0]
+	require.Equal(t, "0x0000000000000000000000000000000000000002", chain.TimelockAddress)
+	require.Equal(t, uint64(1), chain.OpCount)
+	require.Equal(t, uint64(2), chain.TransactionCount)
+	require.Len(t, chain.Operations[0].Calls, 2)
+	require.Equal(t, Arg{Name: "amount", Value: "2"}, chain.Operations[0].Calls[1].Args[1])
+}
@@ -0,0 +1,238 @@
+package aptos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aptos-labs/aptos-go-sdk"
+	"github.com/aptos-labs/aptos-go-sdk/api"
+	"github.com/ethereum/go-ethereum/common"
+
+	module_mcms "github.com/smartcontractkit/chainlink-aptos/bindings/mcms/mcms"
+	"github.com/smartcontractkit/chainlink-aptos/relayer/codec"
+
+	"github.com/smartcontractkit/mcms/internal/utils/safecast"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.EventFilterer = (*EventFilterer)(nil)
+
+// EventFilterer is an EventFilterer implementation for Aptos. The node API cannot query module
+// events, so the blocks of the queried range are scanned for the events emitted by the MCMS module.
+//
+// Aptos has no RoleGranted event, and as the MCMS module holds the multisigs of all roles, only
+// the NewRoot, OpExecuted and ConfigSet events of the role of the filterer are returned.
+type EventFilterer struct {
+	client        aptos.AptosRpcClient
+	chainSelector types.ChainSelector
+	role          TimelockRole
+	moduleName    string
+}
+
+// NewEventFilterer creates a new EventFilterer that filters the events of a standard MCMS contract.
+func NewEventFilterer(client aptos.AptosRpcClient, chainSelector types.ChainSelector, role TimelockRole) *EventFilterer {
+	return NewEventFiltererWithMCMSType(client, chainSelector, role, MCMSTypeRegular)
+}
+
+// NewEventFiltererWithMCMSType creates an EventFilterer that filters the events of either a
+// standard MCMS or CurseMCMS contract depending on mcmsType.
+func NewEventFiltererWithMCMSType(
+	client aptos.AptosRpcClient, chainSelector types.ChainSelector, role TimelockRole, mcmsType MCMSType,
+) *EventFilterer {
+	moduleName := "mcms"
+	if mcmsType.IsCurseMCMS() {
+		moduleName = "curse_mcms"
+	}
+
+	return &EventFilterer{
+		client:        client,
+		chainSelector: chainSelector,
+		role:          role,
+		moduleName:    moduleName,
+	}
+}
+
+// LatestBlock returns the height of the latest block.
+func (f *EventFilterer) LatestBlock(_ context.Context) (uint64, error) {
+	info, err := f.client.Info()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get node info: %w", err)
+	}
+
+	return info.BlockHeight(), nil
+}
+
+// FilterEvents returns the events of the query, ordered by block height and transaction version.
+func (f *EventFilterer) FilterEvents(ctx context.Context, query types.EventQuery) ([]types.Event, error) {
+	var mcmAddress, timelockAddress *aptos.AccountAddress
+	if query.MCMAddress != "" {
+		address, err := hexToAddress(query.MCMAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse MCMS address: %w", err)
+		}
+		mcmAddress = &address
+	}
+	if query.TimelockAddress != "" {
+		address, err := hexToAddress(query.TimelockAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse timelock address: %w", err)
+		}
+		timelockAddress = &address
+	}
+
+	var events []types.Event
+	for height := query.FromBlock; height <= query.ToBlock; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		block, err := f.client.BlockByHeight(height, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get block %d: %w", height, err)
+		}
+
+		for _, tx := range block.Transactions {
+			userTx, err := tx.UserTransaction()
+			if err != nil || !userTx.Success {
+				// Only successful user transactions can call the MCMS module.
+				continue
+			}
+
+			for index, txEvent := range userTx.Events {
+				address, name, ok := f.parseEventType(txEvent.Type)
+				if !ok {
+					continue
+				}
+
+				var event *types.Event
+				eventAddress := query.MCMAddress
+				if mcmAddress != nil && address == *mcmAddress {
+					event, err = f.decodeMCMEvent(name, txEvent)
+				}
+				if event == nil && err == nil && timelockAddress != nil && address == *timelockAddress {
+					event, err = decodeTimelockEvent(name, txEvent)
+					eventAddress = query.TimelockAddress
+				}
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode %s event of transaction %s: %w", name, userTx.Hash, err)
+				}
+				if event == nil {
+					continue
+				}
+
+				event.ChainSelector = f.chainSelector
+				event.Address = eventAddress
+				event.Block = block.BlockHeight
+				event.TxHash = userTx.Hash
+				event.Index = uint(index)
+				events = append(events, *event)
+			}
+		}
+	}
+
+	return events, nil
+}
+
+// parseEventType splits the type of a module event, e.g. 0x1::mcms::NewRoot, into the address
+// and the event name. ok is false for the events of other modules.
+func (f *EventFilterer) parseEventType(eventType string) (address aptos.AccountAddress, name string, ok bool) {
+	parts := strings.Split(eventType, "::")
+	if len(parts) != 3 || parts[1] != f.moduleName {
+		return aptos.AccountAddress{}, "", false
+	}
+	address, err := hexToAddress(parts[0])
+	if err != nil {
+		return aptos.AccountAddress{}, "", false
+	}
+
+	return address, parts[2], true
+}
+
+// decodeMCMEvent decodes the NewRoot, OpExecuted and ConfigSet events of the role of the filterer.
+func (f *EventFilterer) decodeMCMEvent(name string, txEvent *api.Event) (*types.Event, error) {
+	switch name {
+	case "NewRoot":
+		var data module_mcms.NewRoot
+		if err := codec.DecodeAptosJsonValue(txEvent.Data, &data); err != nil {
+			return nil, err
+		}
+		if data.Role != f.role.Byte() {
+			return nil, nil
+		}
+
+		validUntil, err := safecast.Uint64ToUint32(data.ValidUntil)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.Event{
+			Kind:       types.EventKindNewRoot,
+			Root:       common.BytesToHash(data.Root),
+			ValidUntil: validUntil,
+			RawData:    data,
+		}, nil
+	case "OpExecuted":
+		var data module_mcms.OpExecuted
+		if err := codec.DecodeAptosJsonValue(txEvent.Data, &data); err != nil {
+			return nil, err
+		}
+		if data.Role != f.role.Byte() {
+			return nil, nil
+		}
+
+		return &types.Event{Kind: types.EventKindOpExecuted, Nonce: data.Nonce, RawData: data}, nil
+	case "ConfigSet":
+		var data module_mcms.ConfigSet
+		if err := codec.DecodeAptosJsonValue(txEvent.Data, &data); err != nil {
+			return nil, err
+		}
+		if data.Role != f.role.Byte() {
+			return nil, nil
+		}
+
+		return &types.Event{Kind: types.EventKindConfigSet, IsRootCleared: data.IsRootCleared, RawData: data}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// decodeTimelockEvent decodes the CallScheduled, CallExecuted and Cancelled events.
+func decodeTimelockEvent(name string, txEvent *api.Event) (*types.Event, error) {
+	switch name {
+	case "CallScheduled":
+		var data module_mcms.CallScheduled
+		if err := codec.DecodeAptosJsonValue(txEvent.Data, &data); err != nil {
+			return nil, err
+		}
+
+		return &types.Event{
+			Kind:        types.EventKindCallScheduled,
+			OperationID: common.BytesToHash(data.Id),
+			CallIndex:   data.Index,
+			RawData:     data,
+		}, nil
+	case "CallExecuted":
+		var data module_mcms.CallExecuted
+		if err := codec.DecodeAptosJsonValue(txEvent.Data, &data); err != nil {
+			return nil, err
+		}
+
+		return &types.Event{
+			Kind:        types.EventKindCallExecuted,
+			OperationID: common.BytesToHash(data.Id),
+			CallIndex:   data.Index,
+			RawData:     data,
+		}, nil
+	case "Cancelled":
+		var data module_mcms.Cancelled
+		if err := codec.DecodeAptosJsonValue(txEvent.Data, &data); err != nil {
+			return nil, err
+		}
+
+		return &types.Event{Kind: types.EventKindCancelled, OperationID: common.BytesToHash(data.Id), RawData: data}, nil
+	default:
+		return nil, nil
+	}
+}
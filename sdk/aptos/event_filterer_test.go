@@ -0,0 +1,114 @@
+package aptos
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aptos-labs/aptos-go-sdk"
+	"github.com/aptos-labs/aptos-go-sdk/api"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	module_mcms "github.com/smartcontractkit/chainlink-aptos/bindings/mcms/mcms"
+
+	mock_aptossdk "github.com/smartcontractkit/mcms/sdk/aptos/mocks/aptos"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestEventFilterer_LatestBlock(t *testing.T) {
+	t.Parallel()
+
+	client := mock_aptossdk.NewAptosRpcClient(t)
+	filterer := NewEventFilterer(client, 1, TimelockRoleProposer)
+
+	client.EXPECT().Info().Return(aptos.NodeInfo{BlockHeightStr: "42"}, nil).Once()
+	height, err := filterer.LatestBlock(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), height)
+
+	client.EXPECT().Info().Return(aptos.NodeInfo{}, errors.New("rpc error")).Once()
+	_, err = filterer.LatestBlock(t.Context())
+	require.EqualError(t, err, "failed to get node info: rpc error")
+}
+
+func TestEventFilterer_FilterEvents(t *testing.T) {
+	t.Parallel()
+
+	const address = "0x123"
+	mcmsAddress := mustHexToAddress(address)
+	eventType := func(module, name string) string { return mcmsAddress.StringLong() + "::" + module + "::" + name }
+
+	client := mock_aptossdk.NewAptosRpcClient(t)
+	client.EXPECT().BlockByHeight(uint64(10), true).Return(&api.Block{
+		BlockHeight: 10,
+		Transactions: []*api.CommittedTransaction{
+			userTransaction("0xaa", true,
+				&api.Event{Type: "0x1::coin::WithdrawEvent", Data: map[string]any{"amount": "1"}},
+				&api.Event{Type: eventType("mcms", "NewRoot"), Data: map[string]any{
+					"role": float64(TimelockRoleProposer), "root": "0x01", "valid_until": "100",
+				}},
+				&api.Event{Type: eventType("mcms", "NewRoot"), Data: map[string]any{
+					"role": float64(TimelockRoleBypasser), "root": "0x02", "valid_until": "100",
+				}},
+			),
+			userTransaction("0xbb", false,
+				&api.Event{Type: eventType("mcms", "Cancelled"), Data: map[string]any{"id": "0x01"}},
+			),
+		},
+	}, nil).Once()
+	client.EXPECT().BlockByHeight(uint64(11), true).Return(&api.Block{
+		BlockHeight: 11,
+		Transactions: []*api.CommittedTransaction{
+			{Type: api.TransactionVariantBlockMetadata, Inner: &api.BlockMetadataTransaction{}},
+			userTransaction("0xcc", true,
+				&api.Event{Type: eventType("curse_mcms", "OpExecuted"), Data: map[string]any{"role": float64(TimelockRoleProposer), "nonce": "1"}},
+				&api.Event{Type: eventType("mcms", "OpExecuted"), Data: map[string]any{"role": float64(TimelockRoleProposer), "nonce": "7"}},
+				&api.Event{Type: eventType("mcms", "CallScheduled"), Data: map[string]any{"id": "0xab", "index": "2"}},
+			),
+		},
+	}, nil).Once()
+
+	filterer := NewEventFilterer(client, 1, TimelockRoleProposer)
+	events, err := filterer.FilterEvents(t.Context(), types.EventQuery{
+		MCMAddress:      address,
+		TimelockAddress: address,
+		FromBlock:       10,
+		ToBlock:         11,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, events, 3)
+	require.Equal(t, types.Event{
+		Kind: types.EventKindNewRoot, ChainSelector: 1, Address: address, Block: 10, TxHash: "0xaa", Index: 1,
+		Root: common.BytesToHash([]byte{0x01}), ValidUntil: 100,
+		RawData: module_mcms.NewRoot{Role: TimelockRoleProposer.Byte(), Root: []byte{0x01}, ValidUntil: 100},
+	}, events[0])
+	require.Equal(t, types.EventKindOpExecuted, events[1].Kind)
+	require.Equal(t, uint64(11), events[1].Block)
+	require.Equal(t, uint(1), events[1].Index)
+	require.Equal(t, uint64(7), events[1].Nonce)
+	require.Equal(t, types.EventKindCallScheduled, events[2].Kind)
+	require.Equal(t, common.BytesToHash([]byte{0xab}), events[2].OperationID)
+	require.Equal(t, uint64(2), events[2].CallIndex)
+}
+
+func TestEventFilterer_FilterEvents_Errors(t *testing.T) {
+	t.Parallel()
+
+	client := mock_aptossdk.NewAptosRpcClient(t)
+	filterer := NewEventFilterer(client, 1, TimelockRoleProposer)
+
+	_, err := filterer.FilterEvents(t.Context(), types.EventQuery{MCMAddress: "invalid"})
+	require.ErrorContains(t, err, "failed to parse MCMS address")
+
+	client.EXPECT().BlockByHeight(uint64(5), true).Return(nil, errors.New("rpc error")).Once()
+	_, err = filterer.FilterEvents(t.Context(), types.EventQuery{MCMAddress: "0x123", FromBlock: 5, ToBlock: 5})
+	require.EqualError(t, err, "failed to get block 5: rpc error")
+}
+
+func userTransaction(hash string, success bool, events ...*api.Event) *api.CommittedTransaction {
+	return &api.CommittedTransaction{
+		Type:  api.TransactionVariantUser,
+		Inner: &api.UserTransaction{Hash: hash, Success: success, Events: events},
+	}
+}
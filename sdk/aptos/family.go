@@ -0,0 +1,250 @@
+package aptos
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aptos-labs/aptos-go-sdk"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func init() {
+	if err := sdk.RegisterFamily(sdk.Family{
+		Name: chainsel.FamilyAptos,
+		NewEncoder: func(selector types.ChainSelector, txCount uint64, overridePreviousRoot bool, _ bool) sdk.Encoder {
+			return NewEncoder(selector, txCount, overridePreviousRoot)
+		},
+		NewTimelockConverter:     buildAptosConverter,
+		NewExecutor:              buildAptosExecutor,
+		NewSimulator:             buildAptosSimulator,
+		NewInspector:             buildAptosInspector,
+		NewTimelockExecutor:      buildAptosTimelockExecutor,
+		NewTimelockInspector:     buildAptosTimelockInspector,
+		NewTimelockConfigurer:    buildAptosTimelockConfigurer,
+		NewEventFilterer:         buildAptosEventFilterer,
+		NewDecoder:               func() sdk.Decoder { return NewDecoder() },
+		OperationID:              OperationID,
+		ValidateAdditionalFields: ValidateAdditionalFields,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func buildAptosConverter(selector types.ChainSelector, metadata types.ChainMetadata) (sdk.TimelockConverter, error) {
+	converter, err := buildAptosTimelockConverter(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Aptos converter for selector %d: %w", selector, err)
+	}
+
+	return converter, nil
+}
+
+func buildAptosTimelockConverter(metadata types.ChainMetadata) (sdk.TimelockConverter, error) {
+	if len(metadata.AdditionalFields) == 0 {
+		return NewTimelockConverter(), nil
+	}
+
+	var af AdditionalFieldsMetadata
+	if err := json.Unmarshal(metadata.AdditionalFields, &af); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal Aptos additional fields: %w", err)
+	}
+
+	if af.MCMSType.IsCurseMCMS() {
+		return NewCurseTimelockConverter(), nil
+	}
+
+	return NewTimelockConverter(), nil
+}
+
+func buildAptosExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, action types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.Executor, error) {
+	executor, err := newAptosExecutor(chains, chainSelector, encoder, action, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return executor, nil
+}
+
+func newAptosExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, action types.TimelockAction, metadata types.ChainMetadata,
+) (*Executor, error) {
+	rawSelector := uint64(chainSelector)
+	aptosEncoder, ok := encoder.(*Encoder)
+	if !ok {
+		return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
+	}
+	role, err := AptosRoleFromAction(action)
+	if err != nil {
+		return nil, fmt.Errorf("error getting aptos role from proposal: %w", err)
+	}
+	client, ok := aptosClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing aptos chain client for selector %d", chainSelector)
+	}
+	signer, ok := aptosSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing aptos chain signer for selector %d", chainSelector)
+	}
+
+	var mcmsType MCMSType
+	if len(metadata.AdditionalFields) > 0 {
+		var afm AdditionalFieldsMetadata
+		if err := json.Unmarshal(metadata.AdditionalFields, &afm); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal aptos additional fields metadata for selector %d: %w", rawSelector, err)
+		}
+		mcmsType = afm.MCMSType
+	}
+
+	return NewExecutorWithMCMSType(client, signer, aptosEncoder, role, mcmsType), nil
+}
+
+// buildAptosSimulator simulates the transactions of the executor.
+func buildAptosSimulator(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, action types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.Simulator, error) {
+	executor, err := newAptosExecutor(chains, chainSelector, encoder, action, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSimulator(executor), nil
+}
+
+func buildAptosInspector(
+	chains sdk.ChainAccessor, selector types.ChainSelector, action types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.Inspector, error) {
+	rawSelector := uint64(selector)
+	client, ok := aptosClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Aptos chain client for selector %d", rawSelector)
+	}
+	role, err := AptosRoleFromAction(action)
+	if err != nil {
+		return nil, fmt.Errorf("error determining aptos role: %w", err)
+	}
+	var afm AdditionalFieldsMetadata
+	if len(metadata.AdditionalFields) > 0 {
+		if err = json.Unmarshal(metadata.AdditionalFields, &afm); err != nil {
+			return nil, fmt.Errorf("error parsing aptos metadata: %w", err)
+		}
+	}
+
+	return NewInspectorWithMCMSType(client, role, afm.MCMSType), nil
+}
+
+func buildAptosTimelockExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, _ types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.TimelockExecutor, error) {
+	rawSelector := uint64(chainSelector)
+	client, ok := aptosClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing aptos chain client for selector %d", chainSelector)
+	}
+	signer, ok := aptosSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing aptos chain signer for selector %d", chainSelector)
+	}
+
+	mcmsType := MCMSTypeRegular
+	if len(metadata.AdditionalFields) > 0 {
+		var afm AdditionalFieldsMetadata
+		if err := json.Unmarshal(metadata.AdditionalFields, &afm); err != nil {
+			return nil, fmt.Errorf("failed to parse Aptos metadata for selector %d: %w", rawSelector, err)
+		}
+		mcmsType = afm.MCMSType
+	}
+
+	return NewTimelockExecutorWithMCMSType(client, signer, mcmsType), nil
+}
+
+func buildAptosTimelockInspector(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, metadata types.ChainMetadata,
+) (sdk.TimelockInspector, error) {
+	rawSelector := uint64(chainSelector)
+	client, ok := aptosClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Aptos chain client for selector %d", rawSelector)
+	}
+
+	mcmsType := MCMSTypeRegular
+	if len(metadata.AdditionalFields) > 0 {
+		var afm AdditionalFieldsMetadata
+		if unmarshalErr := json.Unmarshal(metadata.AdditionalFields, &afm); unmarshalErr != nil {
+			return nil, fmt.Errorf("parse aptos metadata for selector %d: %w", rawSelector, unmarshalErr)
+		}
+		mcmsType = afm.MCMSType
+	}
+
+	return NewTimelockInspectorWithMCMSType(client, mcmsType), nil
+}
+
+func buildAptosTimelockConfigurer(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.TimelockConfigurer, error) {
+	rawSelector := uint64(selector)
+	client, ok := aptosClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Aptos chain client for selector %d", rawSelector)
+	}
+	var afm AdditionalFieldsMetadata
+	if len(metadata.AdditionalFields) > 0 {
+		if err := json.Unmarshal(metadata.AdditionalFields, &afm); err != nil {
+			return nil, fmt.Errorf("error parsing aptos metadata: %w", err)
+		}
+	}
+
+	return NewTimelockConfigurerWithMCMSType(client, afm.MCMSType), nil
+}
+
+func buildAptosEventFilterer(
+	chains sdk.ChainAccessor, selector types.ChainSelector, action types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.EventFilterer, error) {
+	rawSelector := uint64(selector)
+	client, ok := aptosClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Aptos chain client for selector %d", rawSelector)
+	}
+	role, err := AptosRoleFromAction(action)
+	if err != nil {
+		return nil, fmt.Errorf("error determining aptos role: %w", err)
+	}
+	var afm AdditionalFieldsMetadata
+	if len(metadata.AdditionalFields) > 0 {
+		if err = json.Unmarshal(metadata.AdditionalFields, &afm); err != nil {
+			return nil, fmt.Errorf("error parsing aptos metadata: %w", err)
+		}
+	}
+
+	return NewEventFiltererWithMCMSType(client, selector, role, afm.MCMSType), nil
+}
+
+// ChainAccessor is implemented by a sdk.ChainAccessor to serve Aptos chains.
+type ChainAccessor interface {
+	AptosClient(selector uint64) (aptos.AptosRpcClient, bool)
+	AptosSigner(selector uint64) (aptos.TransactionSigner, bool)
+}
+
+// aptosClient returns the Aptos client of the selector if the ChainAccessor serves Aptos chains.
+func aptosClient(chains sdk.ChainAccessor, selector uint64) (aptos.AptosRpcClient, bool) {
+	aptosChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return aptosChains.AptosClient(selector)
+}
+
+// aptosSigner returns the Aptos signer of the selector if the ChainAccessor serves Aptos chains.
+func aptosSigner(chains sdk.ChainAccessor, selector uint64) (aptos.TransactionSigner, bool) {
+	aptosChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return aptosChains.AptosSigner(selector)
+}
@@ -78,6 +78,7 @@ func (c *curseMcmsViewer) GetRootMetadata(opts *bind.CallOpts, role byte) (modul
 }
 
 var _ sdk.Inspector = &Inspector{}
+var _ sdk.PostOpCountInspector = &Inspector{}
 
 type Inspector struct {
 	ConfigTransformer
@@ -181,3 +182,20 @@ func (i Inspector) GetRootMetadata(ctx context.Context, mcmsAddr string) (types.
 		MCMAddress:      rootMetadata.Multisig.StringLong(),
 	}, nil
 }
+
+// GetRootPostOpCount returns the post-op count of the root held by the MCM for the role of the
+// inspector.
+func (i Inspector) GetRootPostOpCount(ctx context.Context, mcmsAddr string) (uint64, error) {
+	mcmsAddress, err := hexToAddress(mcmsAddr)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse MCMS address: %w", err)
+	}
+	viewer := i.viewerFn(mcmsAddress, i.client)
+
+	rootMetadata, err := viewer.GetRootMetadata(nil, i.role.Byte())
+	if err != nil {
+		return 0, fmt.Errorf("get root metadata: %w", err)
+	}
+
+	return rootMetadata.PostOpCount, nil
+}
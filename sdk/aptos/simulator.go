@@ -0,0 +1,389 @@
+package aptos
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+
+	"github.com/aptos-labs/aptos-go-sdk"
+	"github.com/aptos-labs/aptos-go-sdk/api"
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/aptos-labs/aptos-go-sdk/crypto"
+	"github.com/ethereum/go-ethereum/common"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-aptos/bindings/bind"
+	module_mcms_registry "github.com/smartcontractkit/chainlink-aptos/bindings/mcms/mcms_registry"
+	"github.com/smartcontractkit/chainlink-aptos/relayer/txm"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// ErrCallNotSimulated is returned by SimulateOperation for the operations whose calls the timelock
+// dispatches to functions private to the MCMS package, which a simulated transaction can't call.
+var ErrCallNotSimulated = errors.New("the timelock dispatch of the operation can't be simulated")
+
+const (
+	mcmsModuleName                       = "mcms"
+	timelockScheduleBatchFunction        = "timelock_schedule_batch"
+	timelockBypasserExecuteBatchFunction = "timelock_bypasser_execute_batch"
+)
+
+// typeParamRegex matches the generic type parameters in the parameter types of a function ABI
+var typeParamRegex = regexp.MustCompile(`\bT\d+\b`)
+
+var _ sdk.Simulator = &Simulator{}
+
+// Simulator dry-runs MCMS transactions using the Aptos transaction simulation API. Nothing is
+// submitted to the chain, and the simulated transactions are sent from the executor account.
+type Simulator struct {
+	executor *Executor
+}
+
+func NewSimulator(executor *Executor) *Simulator {
+	return &Simulator{executor: executor}
+}
+
+func (s *Simulator) SimulateSetRoot(
+	ctx context.Context, _ string,
+	metadata types.ChainMetadata, proof []common.Hash, root [32]byte,
+	validUntil uint32, sortedSignatures []types.Signature,
+) error {
+	mcmsAddress, err := hexToAddress(metadata.MCMAddress)
+	if err != nil {
+		return fmt.Errorf("failed to parse MCMS address %q: %w", metadata.MCMAddress, err)
+	}
+	var additionalFieldsMetadata AdditionalFieldsMetadata
+	if len(metadata.AdditionalFields) > 0 {
+		if err = json.Unmarshal(metadata.AdditionalFields, &additionalFieldsMetadata); err != nil {
+			return fmt.Errorf("failed to unmarshal additional fields metadata: %w", err)
+		}
+	}
+	chainID, err := chainsel.AptosChainIdFromSelector(uint64(s.executor.ChainSelector))
+	if err != nil {
+		return err
+	}
+	chainIDBig := (&big.Int{}).SetUint64(chainID)
+
+	proofBytes := make([][]byte, len(proof))
+	for i, hash := range proof {
+		proofBytes[i] = hash.Bytes()
+	}
+	signatures := encodeSignatures(sortedSignatures)
+
+	var (
+		module   bind.ModuleInformation
+		function string
+		argTypes []aptos.TypeTag
+		args     [][]byte
+	)
+	if s.executor.mcmsType.IsCurseMCMS() {
+		binding := s.executor.curseMcmsBindFn(mcmsAddress, s.executor.client)
+		module, function, argTypes, args, err = binding.CurseMCMS().Encoder().SetRoot(
+			additionalFieldsMetadata.Role.Byte(),
+			root[:],
+			uint64(validUntil),
+			chainIDBig,
+			mcmsAddress,
+			metadata.StartingOpCount,
+			metadata.StartingOpCount+s.executor.TxCount,
+			s.executor.OverridePreviousRoot,
+			proofBytes,
+			signatures,
+		)
+	} else {
+		binding := s.executor.bindingFn(mcmsAddress, s.executor.client)
+		module, function, argTypes, args, err = binding.MCMS().Encoder().SetRoot(
+			additionalFieldsMetadata.Role.Byte(),
+			root[:],
+			uint64(validUntil),
+			chainIDBig,
+			mcmsAddress,
+			metadata.StartingOpCount,
+			metadata.StartingOpCount+s.executor.TxCount,
+			s.executor.OverridePreviousRoot,
+			proofBytes,
+			signatures,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("encoding SetRoot call on Aptos mcms contract: %w", err)
+	}
+
+	return s.simulate(s.executor.auth, module.Address, module.ModuleName, function, argTypes, args)
+}
+
+// SimulateOperation simulates the calls of the batch scheduled or bypassed by the operation, as the
+// timelock dispatches them. The timelock calls the target package of each call with the signer of
+// the owner registered for the package by the MCMS registry, so each call is simulated as a
+// transaction of the target entry function sent by the owner, without authentication. The merkle
+// proof, nonce and timelock checks of the execute call are not simulated. The operation data is
+// split into the function arguments using the ABI of the target module, and the type arguments of
+// the calls are taken from the internal type args of the operation.
+//
+// It returns ErrCallNotSimulated for cancellations, calls of the MCMS package itself and the
+// operations of a curse MCMS, which the timelock dispatches to functions private to its package.
+func (s *Simulator) SimulateOperation(
+	ctx context.Context, metadata types.ChainMetadata, operation types.Operation,
+) error {
+	if s.executor.mcmsType.IsCurseMCMS() {
+		return fmt.Errorf("%w: calls of a curse MCMS are dispatched by its package", ErrCallNotSimulated)
+	}
+	mcmsAddress, err := hexToAddress(metadata.MCMAddress)
+	if err != nil {
+		return fmt.Errorf("failed to parse MCMS address %q: %w", metadata.MCMAddress, err)
+	}
+	toAddress, err := hexToAddress(operation.Transaction.To)
+	if err != nil {
+		return fmt.Errorf("failed to parse To address %q: %w", operation.Transaction.To, err)
+	}
+	var additionalFields AdditionalFields
+	if err = json.Unmarshal(operation.Transaction.AdditionalFields, &additionalFields); err != nil {
+		return fmt.Errorf("failed to unmarshal additional fields: %w", err)
+	}
+	if toAddress != mcmsAddress || additionalFields.ModuleName != mcmsModuleName {
+		return fmt.Errorf("operation calls %s::%s, but the MCMS only executes operations of its timelock",
+			toAddress.StringLong(), additionalFields.ModuleName)
+	}
+	if additionalFields.Function != timelockScheduleBatchFunction && additionalFields.Function != timelockBypasserExecuteBatchFunction {
+		return fmt.Errorf("%w: %s has no calls to dispatch", ErrCallNotSimulated, additionalFields.Function)
+	}
+
+	calls, err := decodeTimelockCalls(operation.Transaction.Data)
+	if err != nil {
+		return fmt.Errorf("failed to decode calls of %s: %w", additionalFields.Function, err)
+	}
+	if len(additionalFields.InternalTypeArgs) > 0 && len(additionalFields.InternalTypeArgs) != len(calls) {
+		return fmt.Errorf("got internal type args for %d calls, but the operation has %d calls",
+			len(additionalFields.InternalTypeArgs), len(calls))
+	}
+
+	registry := s.executor.bindingFn(mcmsAddress, s.executor.client).MCMSRegistry()
+	for i, call := range calls {
+		if call.target == mcmsAddress {
+			return fmt.Errorf("%w: call %d is a call of the MCMS package", ErrCallNotSimulated, i)
+		}
+		var typeArgs []string
+		if len(additionalFields.InternalTypeArgs) > 0 {
+			typeArgs = additionalFields.InternalTypeArgs[i]
+		}
+		if err = s.simulateCall(registry, call, typeArgs); err != nil {
+			return fmt.Errorf("call %d to %s::%s::%s: %w", i, call.target.StringLong(), call.moduleName, call.function, err)
+		}
+	}
+
+	return nil
+}
+
+// simulateCall simulates a call of the timelock, sent by the owner registered for its target.
+func (s *Simulator) simulateCall(registry module_mcms_registry.MCMSRegistryInterface, call timelockCall, typeArgs []string) error {
+	owner, err := registry.GetRegisteredOwnerAddress(&bind.CallOpts{}, call.target)
+	if err != nil {
+		return fmt.Errorf("failed to get registered owner: %w", err)
+	}
+
+	argTypes := make([]aptos.TypeTag, len(typeArgs))
+	for i, typeArg := range typeArgs {
+		typeTag, err := aptos.ParseTypeTag(typeArg)
+		if err != nil {
+			return fmt.Errorf("failed to parse type argument %q: %w", typeArg, err)
+		}
+		argTypes[i] = *typeTag
+	}
+
+	args, err := s.splitArgs(call.target, call.moduleName, call.function, typeArgs, call.data)
+	if err != nil {
+		return err
+	}
+
+	// The registered owners are objects, which have no sequence number to fetch
+	return s.simulate(ownerSigner{address: owner}, call.target, call.moduleName, call.function, argTypes, args,
+		aptos.SequenceNumber(0))
+}
+
+// splitArgs splits the concatenated BCS arguments of a call into the separate arguments of the
+// target entry function, skipping the leading signer parameters. The generic type parameters of the
+// function are substituted by the type arguments of the call.
+func (s *Simulator) splitArgs(to aptos.AccountAddress, moduleName, functionName string, typeArgs []string, data []byte) ([][]byte, error) {
+	module, err := s.executor.client.AccountModule(to, moduleName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get module %s::%s: %w", to.StringLong(), moduleName, err)
+	}
+	if module.Abi == nil {
+		return nil, fmt.Errorf("module %s::%s has no ABI", to.StringLong(), moduleName)
+	}
+
+	var function *api.MoveFunction
+	for _, f := range module.Abi.ExposedFunctions {
+		if f.Name == functionName {
+			function = f
+			break
+		}
+	}
+	if function == nil || !function.IsEntry {
+		return nil, fmt.Errorf("entry function %s not found in module %s::%s", functionName, to.StringLong(), moduleName)
+	}
+	if len(function.GenericTypeParams) != len(typeArgs) {
+		return nil, fmt.Errorf("function %s takes %d type arguments, got %d", functionName, len(function.GenericTypeParams), len(typeArgs))
+	}
+
+	var typeTags []aptos.TypeTag
+	for _, param := range function.Params {
+		if param == "signer" || param == "&signer" {
+			continue
+		}
+		param = typeParamRegex.ReplaceAllStringFunc(param, func(typeParam string) string {
+			index, _ := strconv.Atoi(typeParam[1:])
+			if index >= len(typeArgs) {
+				return typeParam
+			}
+
+			return typeArgs[index]
+		})
+		typeTag, err := txm.CreateTypeTag(param)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create type tag: %w", err)
+		}
+		typeTags = append(typeTags, typeTag)
+	}
+
+	values, err := txm.GetBcsValues(data, typeTags...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bcs values: %w", err)
+	}
+	args := make([][]byte, len(values))
+	for i, value := range values {
+		if args[i], err = txm.CreateBcsValue(typeTags[i], value); err != nil {
+			return nil, fmt.Errorf("failed to encode argument %d: %w", i, err)
+		}
+	}
+	if encoded := len(ArgsToData(args)); encoded != len(data) {
+		return nil, fmt.Errorf("%d unexpected trailing bytes in data of function %s", len(data)-encoded, functionName)
+	}
+
+	return args, nil
+}
+
+func (s *Simulator) simulate(
+	sender aptos.TransactionSigner, address aptos.AccountAddress, moduleName, function string,
+	argTypes []aptos.TypeTag, args [][]byte, options ...any,
+) error {
+	payload := aptos.TransactionPayload{Payload: &aptos.EntryFunction{
+		Module: aptos.ModuleId{
+			Address: address,
+			Name:    moduleName,
+		},
+		Function: function,
+		ArgTypes: argTypes,
+		Args:     args,
+	}}
+
+	rawTx, err := s.executor.client.BuildTransaction(sender.AccountAddress(), payload, options...)
+	if err != nil {
+		return fmt.Errorf("failed to build transaction: %w", err)
+	}
+	results, err := s.executor.client.SimulateTransaction(rawTx, sender)
+	if err != nil {
+		return fmt.Errorf("failed to simulate transaction: %w", err)
+	}
+	if len(results) == 0 {
+		return errors.New("simulation did not return a transaction")
+	}
+	if !results[0].Success {
+		return SimulateError{results[0]}
+	}
+
+	return nil
+}
+
+// timelockCall is a call of a batch scheduled or bypassed on the timelock.
+type timelockCall struct {
+	target     aptos.AccountAddress
+	moduleName string
+	function   string
+	data       []byte
+}
+
+// decodeTimelockCalls decodes the calls of the timelock_schedule_batch and
+// timelock_bypasser_execute_batch arguments, which start with the targets, module names, function
+// names and datas of the calls.
+func decodeTimelockCalls(data []byte) ([]timelockCall, error) {
+	des := bcs.NewDeserializer(data)
+	targets := bcs.DeserializeSequence[aptos.AccountAddress](des)
+	moduleNames := bcs.DeserializeSequenceWithFunction(des, func(des *bcs.Deserializer, out *string) {
+		*out = des.ReadString()
+	})
+	functionNames := bcs.DeserializeSequenceWithFunction(des, func(des *bcs.Deserializer, out *string) {
+		*out = des.ReadString()
+	})
+	datas := bcs.DeserializeSequenceWithFunction(des, func(des *bcs.Deserializer, out *[]byte) {
+		*out = des.ReadBytes()
+	})
+	if err := des.Error(); err != nil {
+		return nil, err
+	}
+	if len(moduleNames) != len(targets) || len(functionNames) != len(targets) || len(datas) != len(targets) {
+		return nil, errors.New("mismatched lengths of targets, module names, function names and datas")
+	}
+
+	calls := make([]timelockCall, len(targets))
+	for i, target := range targets {
+		calls[i] = timelockCall{target: target, moduleName: moduleNames[i], function: functionNames[i], data: datas[i]}
+	}
+
+	return calls, nil
+}
+
+// ownerSigner is the sender of the simulated calls of the timelock. It only provides the
+// authenticator of simulations, as the registered owners have no keys.
+type ownerSigner struct {
+	address aptos.AccountAddress
+}
+
+func (s ownerSigner) AccountAddress() aptos.AccountAddress {
+	return s.address
+}
+
+func (s ownerSigner) Sign([]byte) (*crypto.AccountAuthenticator, error) {
+	return nil, errors.New("registered owners can't sign transactions")
+}
+
+func (s ownerSigner) SignMessage([]byte) (crypto.Signature, error) {
+	return nil, errors.New("registered owners can't sign messages")
+}
+
+func (s ownerSigner) SimulationAuthenticator() *crypto.AccountAuthenticator {
+	return crypto.NoAccountAuthenticator()
+}
+
+func (s ownerSigner) AuthKey() *crypto.AuthenticationKey {
+	return nil
+}
+
+func (s ownerSigner) PubKey() crypto.PublicKey {
+	return nil
+}
+
+type SimulateError struct {
+	result *api.UserTransaction
+}
+
+func (e SimulateError) Error() string {
+	return e.result.VmStatus
+}
+
+// Logs returns the events emitted by the simulated transaction, one per line.
+func (e SimulateError) Logs() []string {
+	logs := make([]string, len(e.result.Events))
+	for i, event := range e.result.Events {
+		logs[i] = fmt.Sprintf("%s %v", event.Type, event.Data)
+	}
+
+	return logs
+}
@@ -0,0 +1,351 @@
+package aptos
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aptos-labs/aptos-go-sdk"
+	"github.com/aptos-labs/aptos-go-sdk/api"
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	mock_aptossdk "github.com/smartcontractkit/mcms/sdk/aptos/mocks/aptos"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestSimulator_SimulateSetRoot(t *testing.T) {
+	t.Parallel()
+
+	sender := Must(hexToAddress("0xabc"))
+	metadata := types.ChainMetadata{
+		MCMAddress:       "0x123",
+		StartingOpCount:  5,
+		AdditionalFields: Must(json.Marshal(AdditionalFieldsMetadata{Role: TimelockRoleProposer})),
+	}
+	proof := []common.Hash{common.HexToHash("0x1")}
+	root := [32]byte(common.HexToHash("0x1234"))
+	signatures := []types.Signature{{R: common.HexToHash("0x3"), S: common.HexToHash("0x4"), V: 0}}
+
+	tests := []struct {
+		name      string
+		mcmsType  MCMSType
+		mockSetup func(client *mock_aptossdk.AptosRpcClient, signer *mock_aptossdk.TransactionSigner)
+		wantErr   string
+		wantLogs  []string
+	}{
+		{
+			name:     "success",
+			mcmsType: MCMSTypeRegular,
+			mockSetup: func(client *mock_aptossdk.AptosRpcClient, signer *mock_aptossdk.TransactionSigner) {
+				signer.EXPECT().AccountAddress().Return(sender)
+				client.EXPECT().BuildTransaction(sender, mock.MatchedBy(func(payload aptos.TransactionPayload) bool {
+					entry, ok := payload.Payload.(*aptos.EntryFunction)
+					return ok && entry.Module.Address == Must(hexToAddress("0x123")) &&
+						entry.Module.Name == "mcms" && entry.Function == "set_root"
+				})).Return(&aptos.RawTransaction{}, nil)
+				client.EXPECT().SimulateTransaction(&aptos.RawTransaction{}, signer).
+					Return([]*api.UserTransaction{{Success: true, VmStatus: "Executed successfully"}}, nil)
+			},
+		},
+		{
+			name:     "success - curse mcms",
+			mcmsType: MCMSTypeCurse,
+			mockSetup: func(client *mock_aptossdk.AptosRpcClient, signer *mock_aptossdk.TransactionSigner) {
+				signer.EXPECT().AccountAddress().Return(sender)
+				client.EXPECT().BuildTransaction(sender, mock.MatchedBy(func(payload aptos.TransactionPayload) bool {
+					entry, ok := payload.Payload.(*aptos.EntryFunction)
+					return ok && entry.Module.Name == "curse_mcms" && entry.Function == "set_root"
+				})).Return(&aptos.RawTransaction{}, nil)
+				client.EXPECT().SimulateTransaction(&aptos.RawTransaction{}, signer).
+					Return([]*api.UserTransaction{{Success: true}}, nil)
+			},
+		},
+		{
+			name:     "failure - simulation reverted",
+			mcmsType: MCMSTypeRegular,
+			mockSetup: func(client *mock_aptossdk.AptosRpcClient, signer *mock_aptossdk.TransactionSigner) {
+				signer.EXPECT().AccountAddress().Return(sender)
+				client.EXPECT().BuildTransaction(sender, mock.Anything).Return(&aptos.RawTransaction{}, nil)
+				client.EXPECT().SimulateTransaction(&aptos.RawTransaction{}, signer).Return([]*api.UserTransaction{{
+					Success:  false,
+					VmStatus: "Move abort in 0x123::mcms: E_INVALID_SIGNER(0x1000b): ",
+					Events:   []*api.Event{{Type: "0x1::transaction_fee::FeeStatement", Data: map[string]any{"total_charge_gas_units": "8"}}},
+				}}, nil)
+			},
+			wantErr:  "Move abort in 0x123::mcms: E_INVALID_SIGNER(0x1000b): ",
+			wantLogs: []string{"0x1::transaction_fee::FeeStatement map[total_charge_gas_units:8]"},
+		},
+		{
+			name:     "failure - simulation request failed",
+			mcmsType: MCMSTypeRegular,
+			mockSetup: func(client *mock_aptossdk.AptosRpcClient, signer *mock_aptossdk.TransactionSigner) {
+				signer.EXPECT().AccountAddress().Return(sender)
+				client.EXPECT().BuildTransaction(sender, mock.Anything).Return(&aptos.RawTransaction{}, nil)
+				client.EXPECT().SimulateTransaction(&aptos.RawTransaction{}, signer).Return(nil, errors.New("error during simulation"))
+			},
+			wantErr: "failed to simulate transaction: error during simulation",
+		},
+		{
+			name:     "failure - build transaction",
+			mcmsType: MCMSTypeRegular,
+			mockSetup: func(client *mock_aptossdk.AptosRpcClient, signer *mock_aptossdk.TransactionSigner) {
+				signer.EXPECT().AccountAddress().Return(sender)
+				client.EXPECT().BuildTransaction(sender, mock.Anything).Return(nil, errors.New("sequence number unavailable"))
+			},
+			wantErr: "failed to build transaction: sequence number unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := mock_aptossdk.NewAptosRpcClient(t)
+			signer := mock_aptossdk.NewTransactionSigner(t)
+			tt.mockSetup(client, signer)
+
+			encoder := NewEncoder(chaintest.Chain5Selector, 2, false)
+			executor := NewExecutorWithMCMSType(client, signer, encoder, TimelockRoleProposer, tt.mcmsType)
+			err := NewSimulator(executor).SimulateSetRoot(t.Context(), "", metadata, proof, root, 2082758400, signatures)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tt.wantErr)
+			if tt.wantLogs != nil {
+				var simErr SimulateError
+				require.ErrorAs(t, err, &simErr)
+				require.Equal(t, tt.wantLogs, simErr.Logs())
+			}
+		})
+	}
+}
+
+func TestSimulator_SimulateOperation(t *testing.T) {
+	t.Parallel()
+
+	mcmsAddress := Must(hexToAddress("0x123"))
+	target := Must(hexToAddress("0x456"))
+	owner := Must(hexToAddress("0x789"))
+	typeArg := "0x1::aptos_coin::AptosCoin"
+
+	ser := &bcs.Serializer{}
+	ser.WriteString("hello")
+	ser.WriteBytes([]byte{0x01, 0x02})
+	data := ser.ToBytes()
+
+	module := &api.MoveBytecode{Abi: &api.MoveModule{ExposedFunctions: []*api.MoveFunction{
+		{Name: "function_one", IsEntry: true, Params: []string{"&signer", "0x1::string::String", "vector<u8>"}},
+		{
+			Name: "generic_one", IsEntry: true, GenericTypeParams: []*api.GenericTypeParam{{}},
+			Params: []string{"&signer", "0x1::string::String", "vector<u8>"},
+		},
+		{Name: "view_one", IsView: true, Params: []string{"u64"}},
+	}}}
+
+	// operation converts a call of the target into a timelock operation of the MCMS
+	operation := func(t *testing.T, action types.TimelockAction, to string, function string, data []byte, typeArgs []string) types.Operation {
+		t.Helper()
+
+		additionalFields := Must(json.Marshal(AdditionalFields{
+			PackageName: "package", ModuleName: "module", Function: function, TypeArgs: typeArgs,
+		}))
+		bop := types.BatchOperation{
+			ChainSelector: chaintest.Chain5Selector,
+			Transactions:  []types.Transaction{{To: to, Data: data, AdditionalFields: additionalFields}},
+		}
+		ops, _, err := NewTimelockConverter().ConvertBatchToChainOperations(t.Context(), types.ChainMetadata{}, bop, "",
+			mcmsAddress.StringLong(), types.NewDuration(time.Minute), action, common.Hash{}, common.Hash{})
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+
+		return ops[0]
+	}
+	expectOwner := func(client *mock_aptossdk.AptosRpcClient) {
+		client.EXPECT().View(mock.MatchedBy(func(payload *aptos.ViewPayload) bool {
+			return payload.Module.Address == mcmsAddress && payload.Module.Name == "mcms_registry" &&
+				payload.Function == "get_registered_owner_address"
+		})).Return([]any{owner.StringLong()}, nil)
+	}
+	sentByOwner := mock.MatchedBy(func(signer aptos.TransactionSigner) bool {
+		return signer.AccountAddress() == owner
+	})
+
+	tests := []struct {
+		name      string
+		mcmsType  MCMSType
+		operation func(t *testing.T) types.Operation
+		mockSetup func(client *mock_aptossdk.AptosRpcClient)
+		wantErr   string
+		wantErrIs error
+	}{
+		{
+			name: "success - owner-gated call sent by the registered owner",
+			operation: func(t *testing.T) types.Operation {
+				t.Helper()
+				return operation(t, types.TimelockActionBypass, "0x456", "function_one", data, nil)
+			},
+			mockSetup: func(client *mock_aptossdk.AptosRpcClient) {
+				expectOwner(client)
+				client.EXPECT().AccountModule(target, "module").Return(module, nil)
+				client.EXPECT().BuildTransaction(owner, aptos.TransactionPayload{Payload: &aptos.EntryFunction{
+					Module:   aptos.ModuleId{Address: target, Name: "module"},
+					Function: "function_one",
+					ArgTypes: []aptos.TypeTag{},
+					Args:     [][]byte{data[:6], data[6:]},
+				}}, aptos.SequenceNumber(0)).Return(&aptos.RawTransaction{}, nil)
+				client.EXPECT().SimulateTransaction(&aptos.RawTransaction{}, sentByOwner).
+					Return([]*api.UserTransaction{{Success: true}}, nil)
+			},
+		},
+		{
+			name: "success - scheduled generic call",
+			operation: func(t *testing.T) types.Operation {
+				t.Helper()
+				return operation(t, types.TimelockActionSchedule, "0x456", "generic_one", data, []string{typeArg})
+			},
+			mockSetup: func(client *mock_aptossdk.AptosRpcClient) {
+				expectOwner(client)
+				client.EXPECT().AccountModule(target, "module").Return(module, nil)
+				client.EXPECT().BuildTransaction(owner, aptos.TransactionPayload{Payload: &aptos.EntryFunction{
+					Module:   aptos.ModuleId{Address: target, Name: "module"},
+					Function: "generic_one",
+					ArgTypes: []aptos.TypeTag{*Must(aptos.ParseTypeTag(typeArg))},
+					Args:     [][]byte{data[:6], data[6:]},
+				}}, aptos.SequenceNumber(0)).Return(&aptos.RawTransaction{}, nil)
+				client.EXPECT().SimulateTransaction(&aptos.RawTransaction{}, sentByOwner).
+					Return([]*api.UserTransaction{{Success: true}}, nil)
+			},
+		},
+		{
+			name: "failure - call reverted",
+			operation: func(t *testing.T) types.Operation {
+				t.Helper()
+				return operation(t, types.TimelockActionBypass, "0x456", "function_one", data, nil)
+			},
+			mockSetup: func(client *mock_aptossdk.AptosRpcClient) {
+				expectOwner(client)
+				client.EXPECT().AccountModule(target, "module").Return(module, nil)
+				client.EXPECT().BuildTransaction(owner, mock.Anything, aptos.SequenceNumber(0)).Return(&aptos.RawTransaction{}, nil)
+				client.EXPECT().SimulateTransaction(&aptos.RawTransaction{}, sentByOwner).
+					Return([]*api.UserTransaction{{VmStatus: "Move abort in 0x456::module: E_UNAUTHORIZED(0x50001): "}}, nil)
+			},
+			wantErr: "call 0 to " + target.StringLong() + "::module::function_one: Move abort in 0x456::module: E_UNAUTHORIZED(0x50001): ",
+		},
+		{
+			name: "failure - type args count",
+			operation: func(t *testing.T) types.Operation {
+				t.Helper()
+				return operation(t, types.TimelockActionBypass, "0x456", "generic_one", data, nil)
+			},
+			mockSetup: func(client *mock_aptossdk.AptosRpcClient) {
+				expectOwner(client)
+				client.EXPECT().AccountModule(target, "module").Return(module, nil)
+			},
+			wantErr: "call 0 to " + target.StringLong() + "::module::generic_one: function generic_one takes 1 type arguments, got 0",
+		},
+		{
+			name: "failure - trailing data",
+			operation: func(t *testing.T) types.Operation {
+				t.Helper()
+				return operation(t, types.TimelockActionBypass, "0x456", "function_one", append(append([]byte{}, data...), 0xff), nil)
+			},
+			mockSetup: func(client *mock_aptossdk.AptosRpcClient) {
+				expectOwner(client)
+				client.EXPECT().AccountModule(target, "module").Return(module, nil)
+			},
+			wantErr: "call 0 to " + target.StringLong() + "::module::function_one: 1 unexpected trailing bytes in data of function function_one",
+		},
+		{
+			name: "failure - not an entry function",
+			operation: func(t *testing.T) types.Operation {
+				t.Helper()
+				return operation(t, types.TimelockActionBypass, "0x456", "view_one", data, nil)
+			},
+			mockSetup: func(client *mock_aptossdk.AptosRpcClient) {
+				expectOwner(client)
+				client.EXPECT().AccountModule(target, "module").Return(module, nil)
+			},
+			wantErr: "call 0 to " + target.StringLong() + "::module::view_one: entry function view_one not found in module " + target.StringLong() + "::module",
+		},
+		{
+			name: "failure - target not registered",
+			operation: func(t *testing.T) types.Operation {
+				t.Helper()
+				return operation(t, types.TimelockActionBypass, "0x456", "function_one", data, nil)
+			},
+			mockSetup: func(client *mock_aptossdk.AptosRpcClient) {
+				client.EXPECT().View(mock.Anything).Return(nil, errors.New("E_ADDRESS_NOT_REGISTERED"))
+			},
+			wantErr: "call 0 to " + target.StringLong() + "::module::function_one: failed to get registered owner: E_ADDRESS_NOT_REGISTERED",
+		},
+		{
+			name: "failure - call of the MCMS package",
+			operation: func(t *testing.T) types.Operation {
+				t.Helper()
+				return operation(t, types.TimelockActionBypass, "0x123", "timelock_update_min_delay", data, nil)
+			},
+			wantErrIs: ErrCallNotSimulated,
+		},
+		{
+			name: "failure - cancellation",
+			operation: func(t *testing.T) types.Operation {
+				t.Helper()
+				return operation(t, types.TimelockActionCancel, "0x456", "function_one", data, nil)
+			},
+			wantErrIs: ErrCallNotSimulated,
+		},
+		{
+			name:     "failure - curse mcms",
+			mcmsType: MCMSTypeCurse,
+			operation: func(t *testing.T) types.Operation {
+				t.Helper()
+				return operation(t, types.TimelockActionBypass, "0x456", "function_one", data, nil)
+			},
+			wantErrIs: ErrCallNotSimulated,
+		},
+		{
+			name: "failure - not a timelock operation",
+			operation: func(*testing.T) types.Operation {
+				return types.Operation{
+					ChainSelector: chaintest.Chain5Selector,
+					Transaction: types.Transaction{
+						To:               "0x456",
+						Data:             data,
+						AdditionalFields: []byte(`{"package_name":"package","module_name":"module","function":"function_one"}`),
+					},
+				}
+			},
+			wantErr: "operation calls " + target.StringLong() + "::module, but the MCMS only executes operations of its timelock",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := mock_aptossdk.NewAptosRpcClient(t)
+			signer := mock_aptossdk.NewTransactionSigner(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(client)
+			}
+
+			executor := NewExecutorWithMCMSType(client, signer, NewEncoder(chaintest.Chain5Selector, 1, false), TimelockRoleProposer, tt.mcmsType)
+			err := NewSimulator(executor).SimulateOperation(t.Context(), types.ChainMetadata{MCMAddress: "0x123"}, tt.operation(t))
+			switch {
+			case tt.wantErrIs != nil:
+				require.ErrorIs(t, err, tt.wantErrIs)
+			case tt.wantErr != "":
+				require.EqualError(t, err, tt.wantErr)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
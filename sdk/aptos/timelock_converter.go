@@ -70,6 +70,8 @@ func (t *TimelockConverter) ConvertBatchToChainOperations(
 	moduleNames := make([]string, len(bop.Transactions))
 	functionNames := make([]string, len(bop.Transactions))
 	datas := make([][]byte, len(bop.Transactions))
+	typeArgs := make([][]string, len(bop.Transactions))
+	hasTypeArgs := false
 	tags := make([]string, 0, len(bop.Transactions))
 
 	for i, tx := range bop.Transactions {
@@ -87,6 +89,8 @@ func (t *TimelockConverter) ConvertBatchToChainOperations(
 		moduleNames[i] = additionalFields.ModuleName
 		functionNames[i] = additionalFields.Function
 		datas[i] = tx.Data
+		typeArgs[i] = additionalFields.TypeArgs
+		hasTypeArgs = hasTypeArgs || len(additionalFields.TypeArgs) > 0
 		tags = append(tags, tx.Tags...)
 	}
 
@@ -130,6 +134,19 @@ func (t *TimelockConverter) ConvertBatchToChainOperations(
 	if err != nil {
 		return nil, common.Hash{}, fmt.Errorf("failed to create transaction: %w", err)
 	}
+	// The type args of the calls are only needed to simulate them, and are left out of the
+	// operations of batches without generic calls
+	if hasTypeArgs {
+		tx.AdditionalFields, err = json.Marshal(AdditionalFields{
+			PackageName:      module.PackageName,
+			ModuleName:       module.ModuleName,
+			Function:         function,
+			InternalTypeArgs: typeArgs,
+		})
+		if err != nil {
+			return nil, common.Hash{}, fmt.Errorf("failed to marshal additional fields: %w", err)
+		}
+	}
 
 	op := types.Operation{
 		ChainSelector: bop.ChainSelector,
@@ -162,6 +162,7 @@ func TestTimelockConverter_ConvertBatchToChainOperations(t *testing.T) {
 								PackageName: "package2",
 								ModuleName:  "module2",
 								Function:    "function_two",
+								TypeArgs:    []string{"0x1::aptos_coin::AptosCoin"},
 							})),
 							OperationMetadata: types.OperationMetadata{
 								Tags: []string{"tag3", "tag4"},
@@ -203,9 +204,10 @@ func TestTimelockConverter_ConvertBatchToChainOperations(t *testing.T) {
 						To:   mustHexToAddress("0x123").StringLong(),
 						Data: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
 						AdditionalFields: Must(json.Marshal(AdditionalFields{
-							PackageName: "mcms",
-							ModuleName:  "mcms_timelock",
-							Function:    "timelock_bypasser_execute_batch",
+							PackageName:      "mcms",
+							ModuleName:       "mcms_timelock",
+							Function:         "timelock_bypasser_execute_batch",
+							InternalTypeArgs: [][]string{nil, {"0x1::aptos_coin::AptosCoin"}},
 						})),
 					},
 				},
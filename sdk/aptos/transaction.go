@@ -21,9 +21,11 @@ func ValidateAdditionalFields(additionalFields json.RawMessage) error {
 }
 
 type AdditionalFields struct {
-	PackageName string `json:"package_name"`
-	ModuleName  string `json:"module_name"`
-	Function    string `json:"function"`
+	PackageName      string     `json:"package_name"`
+	ModuleName       string     `json:"module_name"`
+	Function         string     `json:"function"`
+	TypeArgs         []string   `json:"type_args,omitempty"`          // Needed for generic functions
+	InternalTypeArgs [][]string `json:"internal_type_args,omitempty"` // Type args of each call of a timelock batch, set by the converter
 }
 
 func (af AdditionalFields) Validate() error {
@@ -36,6 +38,11 @@ func (af AdditionalFields) Validate() error {
 	if len(af.Function) <= 0 || len(af.Function) > 64 {
 		return errors.New("function length must be between 1 and 64 characters")
 	}
+	for _, typeArg := range af.TypeArgs {
+		if _, err := aptos.ParseTypeTag(typeArg); err != nil {
+			return fmt.Errorf("invalid type argument %q: %w", typeArg, err)
+		}
+	}
 
 	return nil
 }
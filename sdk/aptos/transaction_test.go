@@ -67,6 +67,10 @@ func TestValidateAdditionalFields(t *testing.T) {
 			name:             "failure - invalid function",
 			additionalFields: []byte(`{"package_name":"package","module_name":"module","function":"thisfunctionnameisdefinitelywaytolongasitislongerthansixtyfourcharacters"}`),
 			wantErr:          AssertErrorContains("function"),
+		}, {
+			name:             "failure - invalid type argument",
+			additionalFields: []byte(`{"package_name":"package","module_name":"module","function":"function","type_args":["0x1::coin::Coin<"]}`),
+			wantErr:          AssertErrorContains("invalid type argument"),
 		},
 	}
 	for _, tt := range tests {
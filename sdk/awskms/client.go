@@ -0,0 +1,76 @@
+// Package awskms adapts the AWS KMS client to the mcms.KMSClient interface, to sign proposals with
+// an AWS KMS key of the ECC_SECG_P256K1 key spec through the mcms.KMSSigner.
+package awskms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/samber/lo"
+
+	"github.com/smartcontractkit/mcms"
+)
+
+// API is the subset of the AWS KMS API used by the Client, implemented by *kms.Client.
+type API interface {
+	Sign(ctx context.Context, params *kms.SignInput, optFns ...func(*kms.Options)) (*kms.SignOutput, error)
+	GetPublicKey(
+		ctx context.Context, params *kms.GetPublicKeyInput, optFns ...func(*kms.Options),
+	) (*kms.GetPublicKeyOutput, error)
+}
+
+var _ API = (*kms.Client)(nil)
+
+var _ mcms.KMSClient = (*Client)(nil)
+
+// Client signs with a single AWS KMS key.
+type Client struct {
+	api   API
+	keyID string
+}
+
+// NewClient creates a new Client for the key identified by keyID, which can be a key ID, a key ARN,
+// an alias name or an alias ARN.
+func NewClient(api API, keyID string) (*Client, error) {
+	if lo.IsNil(api) {
+		return nil, errors.New("AWS KMS API client is nil")
+	}
+	if keyID == "" {
+		return nil, errors.New("AWS KMS key ID is empty")
+	}
+
+	return &Client{api: api, keyID: keyID}, nil
+}
+
+// Sign signs the 32 byte digest with the ECDSA_SHA_256 algorithm and returns the ASN.1 DER encoded
+// signature.
+func (c *Client) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	out, err := c.api.Sign(ctx, &kms.SignInput{
+		KeyId:            aws.String(c.keyID),
+		Message:          digest,
+		MessageType:      types.MessageTypeDigest,
+		SigningAlgorithm: types.SigningAlgorithmSpecEcdsaSha256,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with AWS KMS key %s: %w", c.keyID, err)
+	}
+
+	return out.Signature, nil
+}
+
+// PublicKey returns the DER encoded SubjectPublicKeyInfo of the key.
+func (c *Client) PublicKey(ctx context.Context) ([]byte, error) {
+	out, err := c.api.GetPublicKey(ctx, &kms.GetPublicKeyInput{KeyId: aws.String(c.keyID)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key of AWS KMS key %s: %w", c.keyID, err)
+	}
+	if out.KeySpec != "" && out.KeySpec != types.KeySpecEccSecgP256k1 {
+		return nil, fmt.Errorf("unsupported AWS KMS key spec %s, want %s", out.KeySpec, types.KeySpecEccSecgP256k1)
+	}
+
+	return out.PublicKey, nil
+}
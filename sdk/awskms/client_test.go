@@ -0,0 +1,151 @@
+package awskms
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAPI records the requests made to the AWS KMS API and returns fixed responses.
+type fakeAPI struct {
+	signInput         *kms.SignInput
+	getPublicKeyInput *kms.GetPublicKeyInput
+	keySpec           types.KeySpec
+	err               error
+}
+
+func (a *fakeAPI) Sign(_ context.Context, params *kms.SignInput, _ ...func(*kms.Options)) (*kms.SignOutput, error) {
+	a.signInput = params
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	return &kms.SignOutput{Signature: []byte{0x30, 0x00}}, nil
+}
+
+func (a *fakeAPI) GetPublicKey(
+	_ context.Context, params *kms.GetPublicKeyInput, _ ...func(*kms.Options),
+) (*kms.GetPublicKeyOutput, error) {
+	a.getPublicKeyInput = params
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	return &kms.GetPublicKeyOutput{PublicKey: []byte{0x30, 0x01}, KeySpec: a.keySpec}, nil
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(nil, "alias/mcms")
+	require.EqualError(t, err, "AWS KMS API client is nil")
+
+	_, err = NewClient(&fakeAPI{}, "")
+	require.EqualError(t, err, "AWS KMS key ID is empty")
+
+	_, err = NewClient((*kms.Client)(nil), "alias/mcms")
+	require.EqualError(t, err, "AWS KMS API client is nil")
+}
+
+func TestClient_Sign(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		api     *fakeAPI
+		want    []byte
+		wantErr string
+	}{
+		{
+			name: "success",
+			api:  &fakeAPI{},
+			want: []byte{0x30, 0x00},
+		},
+		{
+			name:    "failure: KMS error",
+			api:     &fakeAPI{err: errors.New("access denied")},
+			wantErr: "failed to sign with AWS KMS key alias/mcms: access denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewClient(tt.api, "alias/mcms")
+			require.NoError(t, err)
+
+			digest := make([]byte, 32)
+			got, err := client.Sign(t.Context(), digest)
+
+			require.Equal(t, &kms.SignInput{
+				KeyId:            aws.String("alias/mcms"),
+				Message:          digest,
+				MessageType:      types.MessageTypeDigest,
+				SigningAlgorithm: types.SigningAlgorithmSpecEcdsaSha256,
+			}, tt.api.signInput)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_PublicKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		api     *fakeAPI
+		want    []byte
+		wantErr string
+	}{
+		{
+			name: "success",
+			api:  &fakeAPI{keySpec: types.KeySpecEccSecgP256k1},
+			want: []byte{0x30, 0x01},
+		},
+		{
+			name: "success: no key spec",
+			api:  &fakeAPI{},
+			want: []byte{0x30, 0x01},
+		},
+		{
+			name:    "failure: unsupported key spec",
+			api:     &fakeAPI{keySpec: types.KeySpecEccNistP256},
+			wantErr: "unsupported AWS KMS key spec ECC_NIST_P256, want ECC_SECG_P256K1",
+		},
+		{
+			name:    "failure: KMS error",
+			api:     &fakeAPI{err: errors.New("not found")},
+			wantErr: "failed to get public key of AWS KMS key alias/mcms: not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewClient(tt.api, "alias/mcms")
+			require.NoError(t, err)
+
+			got, err := client.PublicKey(t.Context())
+
+			require.Equal(t, &kms.GetPublicKeyInput{KeyId: aws.String("alias/mcms")}, tt.api.getPublicKeyInput)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
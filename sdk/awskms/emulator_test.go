@@ -0,0 +1,54 @@
+//go:build kmsemulator
+
+package awskms
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms"
+)
+
+// TestKMSSigner_Emulator signs with a key created in a local AWS KMS emulator, such as
+// nsmithuk/local-kms, listening on AWS_KMS_ENDPOINT (http://localhost:8080 by default).
+func TestKMSSigner_Emulator(t *testing.T) {
+	t.Parallel()
+
+	endpoint := os.Getenv("AWS_KMS_ENDPOINT")
+	if endpoint == "" {
+		endpoint = "http://localhost:8080"
+	}
+	api := kms.New(kms.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(endpoint),
+		Credentials:  aws.AnonymousCredentials{},
+	})
+
+	key, err := api.CreateKey(t.Context(), &kms.CreateKeyInput{
+		KeySpec:  types.KeySpecEccSecgP256k1,
+		KeyUsage: types.KeyUsageTypeSignVerify,
+	})
+	require.NoError(t, err)
+
+	client, err := NewClient(api, *key.KeyMetadata.KeyId)
+	require.NoError(t, err)
+	signer := mcms.NewKMSSigner(client)
+
+	addr, err := signer.GetAddress()
+	require.NoError(t, err)
+
+	payload := []byte("0x000000000000000000000000000000")
+	sig, err := signer.Sign(payload)
+	require.NoError(t, err)
+
+	recovered, err := crypto.SigToPub(accounts.TextHash(payload), sig)
+	require.NoError(t, err)
+	require.Equal(t, addr, crypto.PubkeyToAddress(*recovered))
+}
@@ -2,12 +2,17 @@ package canton
 
 import (
 	apiv2 "github.com/digital-asset/dazl-client/v8/go/api/com/daml/ledger/api/v2"
+	"github.com/digital-asset/dazl-client/v8/go/api/com/daml/ledger/api/v2/interactive"
 )
 
 // LedgerServices holds the ledger API clients required by MCMS Canton integrations.
 type LedgerServices struct {
 	State   apiv2.StateServiceClient
 	Command apiv2.CommandServiceClient
+	// Interactive is only required to simulate commands (prepare-submission).
+	Interactive interactive.InteractiveSubmissionServiceClient
+	// Update is only required to filter events (the ledger update stream).
+	Update apiv2.UpdateServiceClient
 }
 
 // Participant is a Canton ledger participant used by MCMS.
@@ -0,0 +1,385 @@
+package canton
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	apiv2 "github.com/digital-asset/dazl-client/v8/go/api/com/daml/ledger/api/v2"
+	"github.com/ethereum/go-ethereum/common"
+	mcmsapi "github.com/smartcontractkit/chainlink-canton/bindings/generated/latest/mcms/api"
+	mcmscore "github.com/smartcontractkit/chainlink-canton/bindings/generated/latest/mcms/core"
+	"github.com/smartcontractkit/chainlink-canton/contracts"
+	"github.com/smartcontractkit/go-daml/pkg/service/ledger"
+
+	"github.com/smartcontractkit/mcms/internal/utils/safecast"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.EventFilterer = (*EventFilterer)(nil)
+
+// EventFilterer is an EventFilterer implementation for Canton. The MCMS contract emits no events,
+// so they are derived from the MCMS choices exercised in the transactions of the ledger update
+// stream, and blocks are ledger offsets.
+//
+// The MCMS contract is also the timelock, and holds the multisigs of all roles: as on Aptos, only
+// the NewRoot, OpExecuted and ConfigSet events of the role of the filterer are returned. Canton has
+// no RoleGranted event.
+type EventFilterer struct {
+	updateClient  apiv2.UpdateServiceClient
+	stateClient   apiv2.StateServiceClient
+	mcmsParties   []string
+	chainSelector types.ChainSelector
+	role          TimelockRole
+}
+
+// NewEventFilterer creates a new EventFilterer reading the updates visible to the MCMS parties.
+func NewEventFilterer(
+	updateClient apiv2.UpdateServiceClient,
+	stateClient apiv2.StateServiceClient,
+	mcmsParties []string,
+	chainSelector types.ChainSelector,
+	role TimelockRole,
+) *EventFilterer {
+	return &EventFilterer{
+		updateClient:  updateClient,
+		stateClient:   stateClient,
+		mcmsParties:   mcmsParties,
+		chainSelector: chainSelector,
+		role:          role,
+	}
+}
+
+// LatestBlock returns the ledger end offset.
+func (f *EventFilterer) LatestBlock(ctx context.Context) (uint64, error) {
+	resp, err := f.stateClient.GetLedgerEnd(ctx, &apiv2.GetLedgerEndRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ledger end: %w", err)
+	}
+
+	return safecast.Int64ToUint64(resp.GetOffset())
+}
+
+// FilterEvents returns the events of the query, ordered by offset. MCMAddress and TimelockAddress
+// are InstanceAddress hex strings, usually of the same MCMS contract.
+func (f *EventFilterer) FilterEvents(ctx context.Context, query types.EventQuery) ([]types.Event, error) {
+	if query.ToBlock < query.FromBlock {
+		return nil, nil
+	}
+
+	var mcmAddress, timelockAddress *contracts.InstanceAddress
+	if query.MCMAddress != "" {
+		address := contracts.HexToInstanceAddress(query.MCMAddress)
+		mcmAddress = &address
+	}
+	if query.TimelockAddress != "" {
+		address := contracts.HexToInstanceAddress(query.TimelockAddress)
+		timelockAddress = &address
+	}
+
+	req, err := f.updatesRequest(query)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := f.updateClient.GetUpdates(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updates: %w", err)
+	}
+	defer func() { _ = stream.CloseSend() }()
+
+	var events []types.Event
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, fmt.Errorf("failed to receive updates: %w", err)
+		}
+
+		tx := resp.GetTransaction()
+		if tx == nil {
+			continue
+		}
+
+		txEvents, err := f.transactionEvents(tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode transaction %s: %w", tx.GetUpdateId(), err)
+		}
+		offset, err := safecast.Int64ToUint64(tx.GetOffset())
+		if err != nil {
+			return nil, err
+		}
+
+		var index uint
+		for _, event := range txEvents {
+			switch {
+			case event.isMCM && mcmAddress != nil && event.instance == *mcmAddress:
+				event.Address = query.MCMAddress
+			case !event.isMCM && timelockAddress != nil && event.instance == *timelockAddress:
+				event.Address = query.TimelockAddress
+			default:
+				continue
+			}
+
+			event.ChainSelector = f.chainSelector
+			event.Block = offset
+			event.TxHash = tx.GetUpdateId()
+			event.Index = index
+			events = append(events, event.Event)
+			index++
+		}
+	}
+
+	return events, nil
+}
+
+// updatesRequest requests the transactions of the offsets of the query with the exercised MCMS
+// choices, visible to any of the MCMS parties.
+func (f *EventFilterer) updatesRequest(query types.EventQuery) (*apiv2.GetUpdatesRequest, error) {
+	beginExclusive, err := safecast.Uint64ToInt64(max(query.FromBlock, 1) - 1)
+	if err != nil {
+		return nil, err
+	}
+	endInclusive, err := safecast.Uint64ToInt64(query.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	packageID, moduleName, entityName, err := ParseTemplateIDFromString(mcmscore.MCMS{}.GetTemplateID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template ID: %w", err)
+	}
+	filtersByParty := make(map[string]*apiv2.Filters, len(f.mcmsParties))
+	for _, party := range f.mcmsParties {
+		filtersByParty[party] = &apiv2.Filters{
+			Cumulative: []*apiv2.CumulativeFilter{{
+				IdentifierFilter: &apiv2.CumulativeFilter_TemplateFilter{
+					TemplateFilter: &apiv2.TemplateFilter{
+						TemplateId: &apiv2.Identifier{
+							PackageId:  packageID,
+							ModuleName: moduleName,
+							EntityName: entityName,
+						},
+					},
+				},
+			}},
+		}
+	}
+
+	return &apiv2.GetUpdatesRequest{
+		BeginExclusive: beginExclusive,
+		EndInclusive:   &endInclusive,
+		UpdateFormat: &apiv2.UpdateFormat{
+			IncludeTransactions: &apiv2.TransactionFormat{
+				EventFormat: &apiv2.EventFormat{
+					FiltersByParty: filtersByParty,
+					Verbose:        true,
+				},
+				// Ledger effects include the exercised events, not only the created and archived contracts.
+				TransactionShape: apiv2.TransactionShape_TRANSACTION_SHAPE_LEDGER_EFFECTS,
+			},
+		},
+	}, nil
+}
+
+// choiceEvent is an event of an MCMS choice, with the instance address of the MCMS contract and
+// whether it is an MCM or a timelock event.
+type choiceEvent struct {
+	types.Event
+	instance contracts.InstanceAddress
+	isMCM    bool
+}
+
+// transactionEvents returns the events of the MCMS choices exercised in the transaction. Every
+// consuming MCMS choice recreates the contract, and the instance address of the exercised contract
+// is read from the MCMS contract created by the choice.
+func (f *EventFilterer) transactionEvents(tx *apiv2.Transaction) ([]choiceEvent, error) {
+	var events []choiceEvent
+	for _, txEvent := range tx.GetEvents() {
+		exercised := txEvent.GetExercised()
+		if exercised == nil || !exercised.GetConsuming() ||
+			NormalizeTemplateKey(FormatTemplateID(exercised.GetTemplateId())) != MCMSTemplateKey {
+			continue
+		}
+
+		instance, ok := recreatedInstanceAddress(tx, exercised)
+		if !ok {
+			continue
+		}
+
+		decoded, err := f.decodeChoice(exercised)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s choice: %w", exercised.GetChoice(), err)
+		}
+		for _, event := range decoded {
+			event.instance = instance
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+// recreatedInstanceAddress returns the instance address of the MCMS contract created by the
+// exercised choice.
+func recreatedInstanceAddress(tx *apiv2.Transaction, exercised *apiv2.ExercisedEvent) (contracts.InstanceAddress, bool) {
+	for _, txEvent := range tx.GetEvents() {
+		created := txEvent.GetCreated()
+		if created == nil || created.GetNodeId() <= exercised.GetNodeId() ||
+			created.GetNodeId() > exercised.GetLastDescendantNodeId() ||
+			NormalizeTemplateKey(FormatTemplateID(created.GetTemplateId())) != MCMSTemplateKey {
+			continue
+		}
+
+		return createdInstanceAddress(created)
+	}
+
+	return contracts.InstanceAddress{}, false
+}
+
+// decodeChoice decodes the events of an exercised MCMS choice. ExecuteOp also returns the
+// timelock events of the ScheduleBatch and CancelBatch operations dispatched to the MCMS itself.
+func (f *EventFilterer) decodeChoice(exercised *apiv2.ExercisedEvent) ([]choiceEvent, error) {
+	record := exercised.GetChoiceArgument().GetRecord()
+
+	switch exercised.GetChoice() {
+	case "SetRoot":
+		var data mcmscore.SetRoot
+		if err := ledger.RecordToStruct(record, &data); err != nil {
+			return nil, err
+		}
+		if !f.isRole(data.TargetRole) {
+			return nil, nil
+		}
+
+		validUntil, err := safecast.Int64ToUint32(time.Time(data.ValidUntil).Unix())
+		if err != nil {
+			return nil, err
+		}
+
+		return []choiceEvent{{isMCM: true, Event: types.Event{
+			Kind:       types.EventKindNewRoot,
+			Root:       common.HexToHash(string(data.NewRoot)),
+			ValidUntil: validUntil,
+			RawData:    data,
+		}}}, nil
+	case "ExecuteOp":
+		var data mcmscore.ExecuteOp
+		if err := ledger.RecordToStruct(record, &data); err != nil {
+			return nil, err
+		}
+
+		events, err := dispatchedTimelockEvents(data.Op)
+		if err != nil {
+			return nil, err
+		}
+		if !f.isRole(data.TargetRole) {
+			return events, nil
+		}
+
+		nonce, err := safecast.Int64ToUint64(int64(data.Op.Nonce))
+		if err != nil {
+			return nil, err
+		}
+
+		return append(events, choiceEvent{isMCM: true, Event: types.Event{
+			Kind:    types.EventKindOpExecuted,
+			Nonce:   nonce,
+			RawData: data,
+		}}), nil
+	case "SetConfig":
+		var data mcmscore.SetConfig
+		if err := ledger.RecordToStruct(record, &data); err != nil {
+			return nil, err
+		}
+		if !f.isRole(data.TargetRole) {
+			return nil, nil
+		}
+
+		return []choiceEvent{{isMCM: true, Event: types.Event{
+			Kind:          types.EventKindConfigSet,
+			IsRootCleared: bool(data.ClearRoot),
+			RawData:       data,
+		}}}, nil
+	case "ExecuteScheduledBatch":
+		var data mcmscore.ExecuteScheduledBatch
+		if err := ledger.RecordToStruct(record, &data); err != nil {
+			return nil, err
+		}
+
+		operationID := common.HexToHash(string(data.OpId))
+		events := make([]choiceEvent, 0, len(data.Calls))
+		for index := range data.Calls {
+			events = append(events, choiceEvent{Event: types.Event{
+				Kind:        types.EventKindCallExecuted,
+				OperationID: operationID,
+				CallIndex:   uint64(index),
+				RawData:     data,
+			}})
+		}
+
+		return events, nil
+	default:
+		return nil, nil
+	}
+}
+
+// dispatchedTimelockEvents returns the CallScheduled events of a ScheduleBatch operation and the
+// Cancelled event of a CancelBatch operation.
+func dispatchedTimelockEvents(op mcmsapi.Op) ([]choiceEvent, error) {
+	switch op.FunctionName {
+	case "ScheduleBatch":
+		var params mcmsapi.ScheduleBatchParams
+		if err := params.UnmarshalHex(string(op.OperationData)); err != nil {
+			return nil, fmt.Errorf("failed to decode ScheduleBatch parameters: %w", err)
+		}
+
+		callsForHash := make([]timelockCallForHash, 0, len(params.Calls))
+		for _, call := range params.Calls {
+			callsForHash = append(callsForHash, timelockCallForHash{
+				TargetInstanceAddress: string(call.TargetInstanceAddress),
+				FunctionName:          string(call.FunctionName),
+				OperationData:         string(call.OperationData),
+			})
+		}
+		opID, err := hashTimelockOpID(callsForHash, string(params.Predecessor), string(params.Salt))
+		if err != nil {
+			return nil, err
+		}
+
+		operationID := common.HexToHash(opID)
+		events := make([]choiceEvent, 0, len(params.Calls))
+		for index := range params.Calls {
+			events = append(events, choiceEvent{Event: types.Event{
+				Kind:        types.EventKindCallScheduled,
+				OperationID: operationID,
+				CallIndex:   uint64(index),
+				RawData:     params,
+			}})
+		}
+
+		return events, nil
+	case "CancelBatch":
+		var params mcmsapi.CancelBatchParams
+		if err := params.UnmarshalHex(string(op.OperationData)); err != nil {
+			return nil, fmt.Errorf("failed to decode CancelBatch parameters: %w", err)
+		}
+
+		return []choiceEvent{{Event: types.Event{
+			Kind:        types.EventKindCancelled,
+			OperationID: common.HexToHash(string(params.OpId)),
+			RawData:     params,
+		}}}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (f *EventFilterer) isRole(role mcmsapi.Role) bool {
+	return string(role) == f.role.String()
+}
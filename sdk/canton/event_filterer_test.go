@@ -0,0 +1,295 @@
+package canton
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	apiv2 "github.com/digital-asset/dazl-client/v8/go/api/com/daml/ledger/api/v2"
+	"github.com/ethereum/go-ethereum/common"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	mcmsapi "github.com/smartcontractkit/chainlink-canton/bindings/generated/latest/mcms/api"
+	mcmscore "github.com/smartcontractkit/chainlink-canton/bindings/generated/latest/mcms/core"
+	"github.com/smartcontractkit/go-daml/pkg/service/ledger"
+	damltypes "github.com/smartcontractkit/go-daml/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// fakeUpdateClient serves the given updates and records the request
+type fakeUpdateClient struct {
+	apiv2.UpdateServiceClient
+	updates []*apiv2.GetUpdatesResponse
+	err     error
+
+	req *apiv2.GetUpdatesRequest
+}
+
+func (f *fakeUpdateClient) GetUpdates(_ context.Context, req *apiv2.GetUpdatesRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[apiv2.GetUpdatesResponse], error) {
+	f.req = req
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &fakeUpdatesStream{responses: f.updates}, nil
+}
+
+type fakeUpdatesStream struct {
+	grpc.ClientStream
+	responses []*apiv2.GetUpdatesResponse
+}
+
+func (f *fakeUpdatesStream) Recv() (*apiv2.GetUpdatesResponse, error) {
+	if len(f.responses) == 0 {
+		return nil, io.EOF
+	}
+	resp := f.responses[0]
+	f.responses = f.responses[1:]
+
+	return resp, nil
+}
+
+func (f *fakeUpdatesStream) CloseSend() error {
+	return nil
+}
+
+var testMCMSIdentifier = &apiv2.Identifier{PackageId: "pkg", ModuleName: "MCMS.Main", EntityName: "MCMS"}
+
+// choiceTransaction returns a transaction exercising a consuming MCMS choice that recreates the contract.
+func choiceTransaction(offset int64, contract mcmscore.MCMS, choice string, argument any) *apiv2.GetUpdatesResponse {
+	return &apiv2.GetUpdatesResponse{Update: &apiv2.GetUpdatesResponse_Transaction{Transaction: &apiv2.Transaction{
+		UpdateId: "update-" + choice,
+		Offset:   offset,
+		Events: []*apiv2.Event{
+			{Event: &apiv2.Event_Exercised{Exercised: &apiv2.ExercisedEvent{
+				Offset:               offset,
+				NodeId:               0,
+				ContractId:           testMCMSContractID,
+				TemplateId:           testMCMSIdentifier,
+				Choice:               choice,
+				ChoiceArgument:       ledger.MapToValue(argument),
+				Consuming:            true,
+				LastDescendantNodeId: 1,
+			}}},
+			{Event: &apiv2.Event_Created{Created: &apiv2.CreatedEvent{
+				Offset:          offset,
+				NodeId:          1,
+				ContractId:      "00new-mcms-contract-id",
+				TemplateId:      testMCMSIdentifier,
+				CreateArguments: ledger.MapToValue(contract.CreateCommand().Arguments).GetRecord(),
+				Signatories:     []string{string(contract.Owner)},
+			}}},
+		},
+	}}}
+}
+
+func TestEventFilterer_LatestBlock(t *testing.T) {
+	t.Parallel()
+
+	filterer := NewEventFilterer(&fakeUpdateClient{}, &fakeStateClient{}, []string{testParty}, 1, TimelockRoleProposer)
+
+	block, err := filterer.LatestBlock(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), block)
+}
+
+func TestEventFilterer_FilterEvents(t *testing.T) {
+	t.Parallel()
+
+	contract := testMCMSContract(mcmsInstanceIDCCIP, nil)
+	otherContract := testMCMSContract(mcmsInstanceIDCCV, nil)
+	address := testMCMSInstanceAddress(contract)
+	selector := types.ChainSelector(chainsel.CANTON_TESTNET.Selector)
+
+	calls := []mcmsapi.TimelockCall{
+		{TargetInstanceAddress: "target-1@" + testParty, FunctionName: "SetFee", OperationData: "0a"},
+		{TargetInstanceAddress: "target-2@" + testParty, FunctionName: "SetFee", OperationData: "0b"},
+	}
+	predecessor := common.Hash{}.Hex()[2:]
+	salt := common.HexToHash("0x01").Hex()[2:]
+	functionName, scheduleData, err := scheduleActionData(calls, predecessor, salt, types.NewDuration(time.Hour))
+	require.NoError(t, err)
+	opID, err := hashTimelockOpID([]timelockCallForHash{
+		{TargetInstanceAddress: "target-1@" + testParty, FunctionName: "SetFee", OperationData: "0a"},
+		{TargetInstanceAddress: "target-2@" + testParty, FunctionName: "SetFee", OperationData: "0b"},
+	}, predecessor, salt)
+	require.NoError(t, err)
+	operationID := common.HexToHash(opID)
+	_, cancelData, err := cancelActionData(opID)
+	require.NoError(t, err)
+
+	root := common.HexToHash("0x0102")
+	validUntil := time.Unix(1_700_000_000, 0)
+
+	updates := []*apiv2.GetUpdatesResponse{
+		choiceTransaction(3, contract, "SetRoot", mcmscore.SetRoot{
+			TargetRole: mcmsapi.RoleProposer,
+			Submitter:  damltypes.PARTY(testParty),
+			NewRoot:    damltypes.TEXT(root.Hex()[2:]),
+			ValidUntil: damltypes.TIMESTAMP(validUntil),
+		}),
+		{Update: &apiv2.GetUpdatesResponse_OffsetCheckpoint{OffsetCheckpoint: &apiv2.OffsetCheckpoint{Offset: 3}}},
+		choiceTransaction(4, contract, "ExecuteOp", mcmscore.ExecuteOp{
+			TargetRole: mcmsapi.RoleProposer,
+			Submitter:  damltypes.PARTY(testParty),
+			Op: mcmsapi.Op{
+				Nonce:         damltypes.INT64(7),
+				FunctionName:  damltypes.TEXT(functionName),
+				OperationData: damltypes.TEXT(scheduleData),
+			},
+		}),
+		// the config of another role is skipped
+		choiceTransaction(5, contract, "SetConfig", mcmscore.SetConfig{
+			TargetRole: mcmsapi.RoleCanceller,
+			ClearRoot:  true,
+		}),
+		choiceTransaction(6, contract, "SetConfig", mcmscore.SetConfig{
+			TargetRole: mcmsapi.RoleProposer,
+			ClearRoot:  true,
+		}),
+		// the operation of another role only returns its timelock event
+		choiceTransaction(7, contract, "ExecuteOp", mcmscore.ExecuteOp{
+			TargetRole: mcmsapi.RoleCanceller,
+			Submitter:  damltypes.PARTY(testParty),
+			Op: mcmsapi.Op{
+				Nonce:         damltypes.INT64(0),
+				FunctionName:  damltypes.TEXT("CancelBatch"),
+				OperationData: damltypes.TEXT(cancelData),
+			},
+		}),
+		choiceTransaction(8, contract, "ExecuteScheduledBatch", mcmscore.ExecuteScheduledBatch{
+			Submitter: damltypes.PARTY(testParty),
+			OpId:      damltypes.TEXT(opID),
+			Calls:     calls,
+		}),
+		// the events of another MCMS instance are skipped
+		choiceTransaction(9, otherContract, "SetConfig", mcmscore.SetConfig{
+			TargetRole: mcmsapi.RoleProposer,
+		}),
+	}
+
+	tests := []struct {
+		name      string
+		query     types.EventQuery
+		wantKinds []types.EventKind
+	}{
+		{
+			name:  "success: mcm and timelock events",
+			query: types.EventQuery{MCMAddress: address, TimelockAddress: address, FromBlock: 3, ToBlock: 9},
+			wantKinds: []types.EventKind{
+				types.EventKindNewRoot,
+				types.EventKindCallScheduled,
+				types.EventKindCallScheduled,
+				types.EventKindOpExecuted,
+				types.EventKindConfigSet,
+				types.EventKindCancelled,
+				types.EventKindCallExecuted,
+				types.EventKindCallExecuted,
+			},
+		},
+		{
+			name:  "success: mcm events only",
+			query: types.EventQuery{MCMAddress: address, FromBlock: 3, ToBlock: 9},
+			wantKinds: []types.EventKind{
+				types.EventKindNewRoot,
+				types.EventKindOpExecuted,
+				types.EventKindConfigSet,
+			},
+		},
+		{
+			name:      "success: empty range",
+			query:     types.EventQuery{MCMAddress: address, TimelockAddress: address, FromBlock: 9, ToBlock: 3},
+			wantKinds: []types.EventKind{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &fakeUpdateClient{updates: updates}
+			filterer := NewEventFilterer(client, &fakeStateClient{}, []string{testParty}, selector, TimelockRoleProposer)
+
+			events, err := filterer.FilterEvents(t.Context(), tt.query)
+			require.NoError(t, err)
+
+			kinds := make([]types.EventKind, 0, len(events))
+			for _, event := range events {
+				require.Equal(t, selector, event.ChainSelector)
+				kinds = append(kinds, event.Kind)
+			}
+			require.Equal(t, tt.wantKinds, kinds)
+			if len(events) == 0 {
+				return
+			}
+
+			require.Equal(t, int64(tt.query.FromBlock)-1, client.req.GetBeginExclusive())
+			require.Equal(t, int64(tt.query.ToBlock), client.req.GetEndInclusive())
+			require.Equal(t, apiv2.TransactionShape_TRANSACTION_SHAPE_LEDGER_EFFECTS,
+				client.req.GetUpdateFormat().GetIncludeTransactions().GetTransactionShape())
+
+			newRoot := events[0]
+			require.Equal(t, root, newRoot.Root)
+			require.Equal(t, uint32(validUntil.Unix()), newRoot.ValidUntil)
+			require.Equal(t, uint64(3), newRoot.Block)
+			require.Equal(t, "update-SetRoot", newRoot.TxHash)
+			require.Equal(t, address, newRoot.Address)
+
+			for _, event := range events {
+				switch event.Kind {
+				case types.EventKindOpExecuted:
+					require.Equal(t, uint64(7), event.Nonce)
+				case types.EventKindConfigSet:
+					require.True(t, event.IsRootCleared)
+					require.Equal(t, uint64(6), event.Block)
+				case types.EventKindCallScheduled, types.EventKindCallExecuted, types.EventKindCancelled:
+					require.Equal(t, operationID, event.OperationID)
+				}
+			}
+		})
+	}
+}
+
+func TestEventFilterer_FilterEvents_Errors(t *testing.T) {
+	t.Parallel()
+
+	contract := testMCMSContract(mcmsInstanceIDCCIP, nil)
+	address := testMCMSInstanceAddress(contract)
+
+	tests := []struct {
+		name    string
+		client  *fakeUpdateClient
+		wantErr string
+	}{
+		{
+			name:    "failure: updates request rejected",
+			client:  &fakeUpdateClient{err: errors.New("PERMISSION_DENIED")},
+			wantErr: "failed to get updates: PERMISSION_DENIED",
+		},
+		{
+			name: "failure: invalid operation data",
+			client: &fakeUpdateClient{updates: []*apiv2.GetUpdatesResponse{
+				choiceTransaction(4, contract, "ExecuteOp", mcmscore.ExecuteOp{
+					TargetRole: mcmsapi.RoleProposer,
+					Op:         mcmsapi.Op{FunctionName: "ScheduleBatch", OperationData: "zz"},
+				}),
+			}},
+			wantErr: "failed to decode transaction update-ExecuteOp: failed to decode ExecuteOp choice: failed to decode ScheduleBatch parameters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filterer := NewEventFilterer(tt.client, &fakeStateClient{}, []string{testParty}, 1, TimelockRoleProposer)
+
+			_, err := filterer.FilterEvents(t.Context(), types.EventQuery{MCMAddress: address, FromBlock: 1, ToBlock: 10})
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
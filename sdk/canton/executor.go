@@ -58,45 +58,77 @@ func (e Executor) ExecuteOperation(
 	proof []common.Hash,
 	op types.Operation,
 ) (types.TransactionResult, error) {
-	// Extract Canton-specific operation fields from AdditionalFields
-	var cantonOpFields AdditionalFields
-	if len(op.Transaction.AdditionalFields) > 0 {
-		if err := json.Unmarshal(op.Transaction.AdditionalFields, &cantonOpFields); err != nil {
-			return types.TransactionResult{}, fmt.Errorf("failed to unmarshal operation additional fields: %w", err)
+	command, mcmsContractID, err := e.executeOpCommand(ctx, metadata, nonce, proof, op)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	commandID := uuid.NewString()
+	submitResp, err := e.client.SubmitAndWaitForTransaction(ctx, &apiv2.SubmitAndWaitForTransactionRequest{
+		Commands: &apiv2.Commands{
+			WorkflowId: "mcms-execute-op",
+			CommandId:  commandID,
+			ActAs:      []string{e.submittingParty},
+			ReadAs:     e.mcmsParties,
+			Commands:   []*apiv2.Command{command},
+		},
+	})
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to execute operation: %w", err)
+	}
+
+	// Extract NEW MCMS CID from Created event (for RawData only; proposal keeps InstanceAddress)
+	newMCMSContractID := ""
+	newMCMSTemplateID := ""
+	transaction := submitResp.GetTransaction()
+	for _, ev := range transaction.GetEvents() {
+		if createdEv := ev.GetCreated(); createdEv != nil {
+			templateID := FormatTemplateID(createdEv.GetTemplateId())
+			normalized := NormalizeTemplateKey(templateID)
+			if normalized == MCMSTemplateKey {
+				newMCMSContractID = createdEv.GetContractId()
+				newMCMSTemplateID = templateID
+
+				break
+			}
 		}
 	}
 
+	if newMCMSContractID == "" {
+		return types.TransactionResult{}, fmt.Errorf("execute-op tx had no Created MCMS event; refusing to continue with old CID=%s", mcmsContractID)
+	}
+
+	return types.TransactionResult{
+		Hash:        transactionResultHash(transaction, commandID),
+		ChainFamily: cselectors.FamilyCanton,
+		RawData:     rawDataFromMCMSTx(newMCMSContractID, newMCMSTemplateID, submitResp),
+	}, nil
+}
+
+// executeOpCommand builds the ExecuteOp exercise command on the current MCMS contract, returning it
+// along with the resolved MCMS contract ID.
+func (e Executor) executeOpCommand(
+	ctx context.Context,
+	metadata types.ChainMetadata,
+	nonce uint32,
+	proof []common.Hash,
+	op types.Operation,
+) (*apiv2.Command, string, error) {
 	// Resolve MCMAddress (InstanceAddress hex) to current contract ID before submitting
 	mcmsContractID, err := ResolveMCMSContractID(ctx, e.StateServiceClient(), e.mcmsParties, metadata.MCMAddress)
 	if err != nil {
-		return types.TransactionResult{}, fmt.Errorf("failed to resolve MCMS contract ID: %w", err)
+		return nil, "", fmt.Errorf("failed to resolve MCMS contract ID: %w", err)
 	}
 
-	// Validate required Canton fields
-	if cantonOpFields.TargetInstanceAddress == "" {
-		return types.TransactionResult{}, errors.New("targetInstanceAddress is required in operation additional fields")
-	}
-	if cantonOpFields.FunctionName == "" {
-		return types.TransactionResult{}, errors.New("functionName is required in operation additional fields")
-	}
-	if cantonOpFields.TargetCid == "" {
-		if cantonOpFields.TargetTemplateID == "" {
-			return types.TransactionResult{}, errors.New("targetCid or targetTemplateId+targetInstanceAddress is required in operation additional fields")
-		}
-		resolved, resolveErr := ResolveTargetContractID(ctx, e.StateServiceClient(), e.mcmsParties, cantonOpFields.TargetInstanceAddress, cantonOpFields.TargetTemplateID)
-		if resolveErr != nil {
-			return types.TransactionResult{}, fmt.Errorf("resolve target contract ID: %w", resolveErr)
-		}
-		cantonOpFields.TargetCid = resolved
-		if len(cantonOpFields.ContractIds) == 0 {
-			cantonOpFields.ContractIds = []string{resolved}
-		}
+	cantonOpFields, targetCids, err := e.operationTarget(ctx, op)
+	if err != nil {
+		return nil, "", err
 	}
 
 	// Resolve chain metadata (chainId, multisigId) for the Canton Op payload.
 	fields, err := e.ToRootMetadata(metadata)
 	if err != nil {
-		return types.TransactionResult{}, fmt.Errorf("resolve canton root metadata: %w", err)
+		return nil, "", fmt.Errorf("resolve canton root metadata: %w", err)
 	}
 
 	// Build Canton Op struct
@@ -115,18 +147,6 @@ func (e Executor) ExecuteOperation(
 		opProof[i] = cantontypes.TEXT(hex.EncodeToString(p[:]))
 	}
 
-	// Resolve InstanceAddress hex values to current contract IDs before submitting.
-	stateClient := e.StateServiceClient()
-	targetCids := make(map[cantontypes.TEXT]cantontypes.CONTRACT_ID)
-	for _, cid := range cantonOpFields.ContractIds {
-		resolved, resolveErr := ResolveContractIDIfInstanceAddress(ctx, stateClient, e.mcmsParties, cid)
-		if resolveErr != nil {
-			return types.TransactionResult{}, fmt.Errorf("resolve contract ID %q: %w", cid, resolveErr)
-		}
-		// Use the original instance address as key, resolved contract ID as value
-		targetCids[cantontypes.TEXT(cid)] = cantontypes.CONTRACT_ID(resolved)
-	}
-
 	// Build exercise command using generated bindings
 	mcmsContract := mcmscore.MCMS{}
 	var choice string
@@ -146,37 +166,102 @@ func (e Executor) ExecuteOperation(
 	// Parse template ID
 	packageID, moduleName, entityName, err := ParseTemplateIDFromString(mcmsContract.GetTemplateID())
 	if err != nil {
-		return types.TransactionResult{}, fmt.Errorf("failed to parse template ID: %w", err)
+		return nil, "", fmt.Errorf("failed to parse template ID: %w", err)
+	}
+
+	return &apiv2.Command{
+		Command: &apiv2.Command_Exercise{
+			Exercise: &apiv2.ExerciseCommand{
+				TemplateId: &apiv2.Identifier{
+					PackageId:  packageID,
+					ModuleName: moduleName,
+					EntityName: entityName,
+				},
+				ContractId:     mcmsContractID,
+				Choice:         choice,
+				ChoiceArgument: choiceArgument,
+			},
+		},
+	}, mcmsContractID, nil
+}
+
+// operationTarget validates the Canton fields of the operation and resolves the contract IDs of
+// its target and of the contracts passed to it, keyed by their original value.
+func (e Executor) operationTarget(
+	ctx context.Context, op types.Operation,
+) (AdditionalFields, map[cantontypes.TEXT]cantontypes.CONTRACT_ID, error) {
+	// Extract Canton-specific operation fields from AdditionalFields
+	var cantonOpFields AdditionalFields
+	if len(op.Transaction.AdditionalFields) > 0 {
+		if err := json.Unmarshal(op.Transaction.AdditionalFields, &cantonOpFields); err != nil {
+			return AdditionalFields{}, nil, fmt.Errorf("failed to unmarshal operation additional fields: %w", err)
+		}
+	}
+
+	// Validate required Canton fields
+	if cantonOpFields.TargetInstanceAddress == "" {
+		return AdditionalFields{}, nil, errors.New("targetInstanceAddress is required in operation additional fields")
+	}
+	if cantonOpFields.FunctionName == "" {
+		return AdditionalFields{}, nil, errors.New("functionName is required in operation additional fields")
+	}
+	stateClient := e.StateServiceClient()
+	if cantonOpFields.TargetCid == "" {
+		if cantonOpFields.TargetTemplateID == "" {
+			return AdditionalFields{}, nil, errors.New("targetCid or targetTemplateId+targetInstanceAddress is required in operation additional fields")
+		}
+		resolved, err := ResolveTargetContractID(ctx, stateClient, e.mcmsParties, cantonOpFields.TargetInstanceAddress, cantonOpFields.TargetTemplateID)
+		if err != nil {
+			return AdditionalFields{}, nil, fmt.Errorf("resolve target contract ID: %w", err)
+		}
+		cantonOpFields.TargetCid = resolved
+		if len(cantonOpFields.ContractIds) == 0 {
+			cantonOpFields.ContractIds = []string{resolved}
+		}
+	}
+
+	// Resolve InstanceAddress hex values to current contract IDs before submitting.
+	targetCids := make(map[cantontypes.TEXT]cantontypes.CONTRACT_ID)
+	for _, cid := range cantonOpFields.ContractIds {
+		resolved, err := ResolveContractIDIfInstanceAddress(ctx, stateClient, e.mcmsParties, cid)
+		if err != nil {
+			return AdditionalFields{}, nil, fmt.Errorf("resolve contract ID %q: %w", cid, err)
+		}
+		// Use the original instance address as key, resolved contract ID as value
+		targetCids[cantontypes.TEXT(cid)] = cantontypes.CONTRACT_ID(resolved)
+	}
+
+	return cantonOpFields, targetCids, nil
+}
+
+func (e Executor) SetRoot(
+	ctx context.Context,
+	metadata types.ChainMetadata,
+	proof []common.Hash,
+	root [32]byte,
+	validUntil uint32,
+	sortedSignatures []types.Signature,
+) (types.TransactionResult, error) {
+	command, mcmsContractID, err := e.setRootCommand(ctx, metadata, proof, root, validUntil, sortedSignatures)
+	if err != nil {
+		return types.TransactionResult{}, err
 	}
 
 	commandID := uuid.NewString()
 	submitResp, err := e.client.SubmitAndWaitForTransaction(ctx, &apiv2.SubmitAndWaitForTransactionRequest{
 		Commands: &apiv2.Commands{
-			WorkflowId: "mcms-execute-op",
+			WorkflowId: "mcms-set-root",
 			CommandId:  commandID,
 			ActAs:      []string{e.submittingParty},
 			ReadAs:     e.mcmsParties,
-			Commands: []*apiv2.Command{{
-				Command: &apiv2.Command_Exercise{
-					Exercise: &apiv2.ExerciseCommand{
-						TemplateId: &apiv2.Identifier{
-							PackageId:  packageID,
-							ModuleName: moduleName,
-							EntityName: entityName,
-						},
-						ContractId:     mcmsContractID,
-						Choice:         choice,
-						ChoiceArgument: choiceArgument,
-					},
-				},
-			}},
+			Commands:   []*apiv2.Command{command},
 		},
 	})
 	if err != nil {
-		return types.TransactionResult{}, fmt.Errorf("failed to execute operation: %w", err)
+		return types.TransactionResult{}, fmt.Errorf("failed to set root: %w", err)
 	}
 
-	// Extract NEW MCMS CID from Created event (for RawData only; proposal keeps InstanceAddress)
+	// Extract NEW MCMS CID from Created event
 	newMCMSContractID := ""
 	newMCMSTemplateID := ""
 	transaction := submitResp.GetTransaction()
@@ -194,7 +279,7 @@ func (e Executor) ExecuteOperation(
 	}
 
 	if newMCMSContractID == "" {
-		return types.TransactionResult{}, fmt.Errorf("execute-op tx had no Created MCMS event; refusing to continue with old CID=%s", mcmsContractID)
+		return types.TransactionResult{}, fmt.Errorf("set-root tx had no Created MCMS event; refusing to continue with old CID=%s", mcmsContractID)
 	}
 
 	return types.TransactionResult{
@@ -204,25 +289,27 @@ func (e Executor) ExecuteOperation(
 	}, nil
 }
 
-func (e Executor) SetRoot(
+// setRootCommand builds the SetRoot exercise command on the current MCMS contract, returning it
+// along with the resolved MCMS contract ID.
+func (e Executor) setRootCommand(
 	ctx context.Context,
 	metadata types.ChainMetadata,
 	proof []common.Hash,
 	root [32]byte,
 	validUntil uint32,
 	sortedSignatures []types.Signature,
-) (types.TransactionResult, error) {
+) (*apiv2.Command, string, error) {
 	// Resolve MCMAddress (InstanceAddress hex) to current contract ID before submitting
 	mcmsContractID, err := ResolveMCMSContractID(ctx, e.StateServiceClient(), e.mcmsParties, metadata.MCMAddress)
 	if err != nil {
-		return types.TransactionResult{}, fmt.Errorf("failed to resolve MCMS contract ID: %w", err)
+		return nil, "", fmt.Errorf("failed to resolve MCMS contract ID: %w", err)
 	}
 
 	rootHex := hex.EncodeToString(root[:])
 	// Recalculate msg hash to recover signers
 	inner, err := abi.Encode(SignMsgABI, root, validUntil)
 	if err != nil {
-		return types.TransactionResult{}, fmt.Errorf("failed to ABI-encode signing payload: %w", err)
+		return nil, "", fmt.Errorf("failed to ABI-encode signing payload: %w", err)
 	}
 	innerHash := crypto.Keccak256(inner)
 
@@ -236,7 +323,7 @@ func (e Executor) SetRoot(
 	for i, sig := range sortedSignatures {
 		pubKey, recoverErr := sig.RecoverPublicKey(cantonSignedHash)
 		if recoverErr != nil {
-			return types.TransactionResult{}, fmt.Errorf("failed to recover public key for signature %d: %w", i, recoverErr)
+			return nil, "", fmt.Errorf("failed to recover public key for signature %d: %w", i, recoverErr)
 		}
 
 		// Convert public key to hex string
@@ -251,16 +338,16 @@ func (e Executor) SetRoot(
 	// Resolve root metadata (EVM-style: enrich minimal proposal metadata at SetRoot time).
 	fields, err := e.ToRootMetadata(metadata)
 	if err != nil {
-		return types.TransactionResult{}, fmt.Errorf("resolve canton root metadata: %w", err)
+		return nil, "", fmt.Errorf("resolve canton root metadata: %w", err)
 	}
 
 	preOpCount, err := safecast.Uint64ToInt64(metadata.StartingOpCount)
 	if err != nil {
-		return types.TransactionResult{}, fmt.Errorf("preOpCount out of range: %w", err)
+		return nil, "", fmt.Errorf("preOpCount out of range: %w", err)
 	}
 	postOpCount, convErr := safecast.Uint64ToInt64(metadata.StartingOpCount + e.TxCount)
 	if convErr != nil {
-		return types.TransactionResult{}, fmt.Errorf("postOpCount out of range: %w", convErr)
+		return nil, "", fmt.Errorf("postOpCount out of range: %w", convErr)
 	}
 
 	rootMetadata := mcmsapi.RootMetadata{
@@ -296,63 +383,24 @@ func (e Executor) SetRoot(
 	// Parse template ID
 	packageID, moduleName, entityName, err := ParseTemplateIDFromString(mcmsContract.GetTemplateID())
 	if err != nil {
-		return types.TransactionResult{}, fmt.Errorf("failed to parse template ID: %w", err)
+		return nil, "", fmt.Errorf("failed to parse template ID: %w", err)
 	}
 
 	// Convert input to choice argument
 	choiceArgument := ledger.MapToValue(input)
 
-	commandID := uuid.NewString()
-	submitResp, err := e.client.SubmitAndWaitForTransaction(ctx, &apiv2.SubmitAndWaitForTransactionRequest{
-		Commands: &apiv2.Commands{
-			WorkflowId: "mcms-set-root",
-			CommandId:  commandID,
-			ActAs:      []string{e.submittingParty},
-			ReadAs:     e.mcmsParties,
-			Commands: []*apiv2.Command{{
-				Command: &apiv2.Command_Exercise{
-					Exercise: &apiv2.ExerciseCommand{
-						TemplateId: &apiv2.Identifier{
-							PackageId:  packageID,
-							ModuleName: moduleName,
-							EntityName: entityName,
-						},
-						ContractId:     mcmsContractID,
-						Choice:         exerciseCmd.Choice,
-						ChoiceArgument: choiceArgument,
-					},
+	return &apiv2.Command{
+		Command: &apiv2.Command_Exercise{
+			Exercise: &apiv2.ExerciseCommand{
+				TemplateId: &apiv2.Identifier{
+					PackageId:  packageID,
+					ModuleName: moduleName,
+					EntityName: entityName,
 				},
-			}},
+				ContractId:     mcmsContractID,
+				Choice:         exerciseCmd.Choice,
+				ChoiceArgument: choiceArgument,
+			},
 		},
-	})
-	if err != nil {
-		return types.TransactionResult{}, fmt.Errorf("failed to set root: %w", err)
-	}
-
-	// Extract NEW MCMS CID from Created event
-	newMCMSContractID := ""
-	newMCMSTemplateID := ""
-	transaction := submitResp.GetTransaction()
-	for _, ev := range transaction.GetEvents() {
-		if createdEv := ev.GetCreated(); createdEv != nil {
-			templateID := FormatTemplateID(createdEv.GetTemplateId())
-			normalized := NormalizeTemplateKey(templateID)
-			if normalized == MCMSTemplateKey {
-				newMCMSContractID = createdEv.GetContractId()
-				newMCMSTemplateID = templateID
-
-				break
-			}
-		}
-	}
-
-	if newMCMSContractID == "" {
-		return types.TransactionResult{}, fmt.Errorf("set-root tx had no Created MCMS event; refusing to continue with old CID=%s", mcmsContractID)
-	}
-
-	return types.TransactionResult{
-		Hash:        transactionResultHash(transaction, commandID),
-		ChainFamily: cselectors.FamilyCanton,
-		RawData:     rawDataFromMCMSTx(newMCMSContractID, newMCMSTemplateID, submitResp),
-	}, nil
+	}, mcmsContractID, nil
 }
@@ -0,0 +1,209 @@
+package canton
+
+import (
+	"fmt"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func init() {
+	if err := sdk.RegisterFamily(sdk.Family{
+		Name: chainsel.FamilyCanton,
+		NewEncoder: func(selector types.ChainSelector, txCount uint64, overridePreviousRoot bool, _ bool) sdk.Encoder {
+			return NewEncoder(selector, txCount, overridePreviousRoot)
+		},
+		NewTimelockConverter: func(types.ChainSelector, types.ChainMetadata) (sdk.TimelockConverter, error) {
+			return NewTimelockConverter(), nil
+		},
+		NewExecutor:              buildCantonExecutor,
+		NewSimulator:             buildCantonSimulator,
+		NewInspector:             buildCantonInspector,
+		NewTimelockExecutor:      buildCantonTimelockExecutor,
+		NewTimelockInspector:     buildCantonTimelockInspector,
+		NewTimelockConfigurer:    buildCantonTimelockConfigurer,
+		NewEventFilterer:         buildCantonEventFilterer,
+		NewDecoder:               func() sdk.Decoder { return NewDecoder() },
+		OperationID:              OperationID,
+		ValidateAdditionalFields: ValidateAdditionalFields,
+		ValidateChainMetadata:    ValidateChainMetadata,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// cantonParticipant returns the chain and its first participant, which submits the commands.
+func cantonParticipant(chains sdk.ChainAccessor, rawSelector uint64) (Chain, Participant, error) {
+	ch, ok := cantonChain(chains, rawSelector)
+	if !ok || len(ch.Participants) == 0 {
+		return Chain{}, Participant{}, fmt.Errorf("missing Canton chain participant for selector %d", rawSelector)
+	}
+
+	return ch, ch.Participants[0], nil
+}
+
+func buildCantonExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, action types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Executor, error) {
+	executor, err := newCantonExecutor(chains, chainSelector, encoder, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return executor, nil
+}
+
+func newCantonExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, action types.TimelockAction,
+) (*Executor, error) {
+	ch, participant, err := cantonParticipant(chains, uint64(chainSelector))
+	if err != nil {
+		return nil, err
+	}
+	mcmsParties := MCMSPartiesForChain(ch)
+	cantonEncoder, ok := encoder.(*Encoder)
+	if !ok {
+		return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
+	}
+	role, err := CantonRoleFromAction(action)
+	if err != nil {
+		return nil, fmt.Errorf("error getting canton role from proposal: %w", err)
+	}
+	inspector := NewInspector(participant.LedgerServices.State, mcmsParties, role)
+
+	return NewExecutor(
+		cantonEncoder,
+		inspector,
+		participant.LedgerServices.Command,
+		participant.PartyID,
+		mcmsParties,
+		role,
+	)
+}
+
+// buildCantonSimulator prepares the commands of the executor without submitting them.
+func buildCantonSimulator(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, action types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Simulator, error) {
+	rawSelector := uint64(chainSelector)
+	_, participant, err := cantonParticipant(chains, rawSelector)
+	if err != nil {
+		return nil, err
+	}
+	interactiveClient := participant.LedgerServices.Interactive
+	if interactiveClient == nil {
+		return nil, fmt.Errorf("missing Canton interactive submission client for selector %d", rawSelector)
+	}
+	executor, err := newCantonExecutor(chains, chainSelector, encoder, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSimulator(executor, interactiveClient), nil
+}
+
+func buildCantonInspector(
+	chains sdk.ChainAccessor, selector types.ChainSelector, action types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Inspector, error) {
+	ch, participant, err := cantonParticipant(chains, uint64(selector))
+	if err != nil {
+		return nil, err
+	}
+	role, err := CantonRoleFromAction(action)
+	if err != nil {
+		return nil, fmt.Errorf("error getting canton role from proposal: %w", err)
+	}
+
+	return NewInspector(participant.LedgerServices.State, MCMSPartiesForChain(ch), role), nil
+}
+
+func buildCantonTimelockExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.TimelockExecutor, error) {
+	ch, participant, err := cantonParticipant(chains, uint64(chainSelector))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTimelockExecutor(
+		participant.LedgerServices.Command,
+		participant.LedgerServices.State,
+		participant.PartyID,
+		MCMSPartiesForChain(ch),
+	), nil
+}
+
+func buildCantonTimelockInspector(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, _ types.ChainMetadata,
+) (sdk.TimelockInspector, error) {
+	ch, participant, err := cantonParticipant(chains, uint64(chainSelector))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTimelockInspector(
+		participant.LedgerServices.Command,
+		participant.LedgerServices.State,
+		participant.PartyID,
+		MCMSPartiesForChain(ch),
+	), nil
+}
+
+func buildCantonTimelockConfigurer(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.TimelockConfigurer, error) {
+	ch, participant, err := cantonParticipant(chains, uint64(selector))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTimelockConfigurer(
+		participant.LedgerServices.Command,
+		participant.LedgerServices.State,
+		MCMSPartiesForChain(ch),
+	), nil
+}
+
+// buildCantonEventFilterer derives the events from the MCMS choices of the ledger update stream.
+func buildCantonEventFilterer(
+	chains sdk.ChainAccessor, selector types.ChainSelector, action types.TimelockAction, _ types.ChainMetadata,
+) (sdk.EventFilterer, error) {
+	rawSelector := uint64(selector)
+	ch, participant, err := cantonParticipant(chains, rawSelector)
+	if err != nil {
+		return nil, err
+	}
+	updateClient := participant.LedgerServices.Update
+	if updateClient == nil {
+		return nil, fmt.Errorf("missing Canton update client for selector %d", rawSelector)
+	}
+	role, err := CantonRoleFromAction(action)
+	if err != nil {
+		return nil, fmt.Errorf("error getting canton role from proposal: %w", err)
+	}
+
+	return NewEventFilterer(
+		updateClient,
+		participant.LedgerServices.State,
+		MCMSPartiesForChain(ch),
+		selector,
+		role,
+	), nil
+}
+
+// ChainAccessor is implemented by a sdk.ChainAccessor to serve Canton chains.
+type ChainAccessor interface {
+	CantonChain(selector uint64) (Chain, bool)
+}
+
+// cantonChain returns the Canton chain of the selector if the ChainAccessor serves Canton chains.
+func cantonChain(chains sdk.ChainAccessor, selector uint64) (Chain, bool) {
+	cantonChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return Chain{}, false
+	}
+
+	return cantonChains.CantonChain(selector)
+}
@@ -20,6 +20,7 @@ import (
 )
 
 var _ sdk.Inspector = &Inspector{}
+var _ sdk.PostOpCountInspector = &Inspector{}
 
 type Inspector struct {
 	stateClient apiv2.StateServiceClient
@@ -129,6 +130,21 @@ func (i *Inspector) GetRootMetadata(ctx context.Context, mcmsAddr string) (types
 	return chainMetadataFromMCMSContract(mcmsContract, i.role, mcmsAddr)
 }
 
+// GetRootPostOpCount returns the post-op count of the root held by the MCM for the role of the
+// inspector.
+func (i *Inspector) GetRootPostOpCount(ctx context.Context, mcmsAddr string) (uint64, error) {
+	mcmsContract, err := GetMCMSContract(ctx, i.stateClient, i.mcmsParties, mcmsAddr)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get MCMS contract: %w", err)
+	}
+	rootMetadata, err := rootMetadataForRole(mcmsContract, i.role)
+	if err != nil {
+		return 0, err
+	}
+
+	return safecast.Int64ToUint64(int64(rootMetadata.PostOpCount))
+}
+
 func rootMetadataForRole(mcmsContract *mcmscore.MCMS, role TimelockRole) (mcmsapi.RootMetadata, error) {
 	switch role {
 	case TimelockRoleProposer:
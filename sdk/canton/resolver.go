@@ -98,29 +98,10 @@ func findActiveContractByInstanceAddress(ctx context.Context, stateService apiv2
 		}
 
 		if c, ok := activeContractResp.GetContractEntry().(*apiv2.GetActiveContractsResponse_ActiveContract); ok {
-			createArguments := c.ActiveContract.GetCreatedEvent().GetCreateArguments()
-			if createArguments == nil {
+			gotAddress, ok := createdInstanceAddress(c.ActiveContract.GetCreatedEvent())
+			if !ok {
 				continue
 			}
-
-			var contractInstanceID string
-			for _, field := range createArguments.GetFields() {
-				if field.GetLabel() == instanceIDFieldLabel {
-					contractInstanceID = field.GetValue().GetText()
-					break
-				}
-			}
-			if contractInstanceID == "" {
-				continue
-			}
-
-			instanceID := contracts.InstanceID(contractInstanceID)
-			signatories := c.ActiveContract.GetCreatedEvent().GetSignatories()
-			if len(signatories) != 1 {
-				continue
-			}
-			gotAddress := instanceID.RawInstanceAddress(cantontypes.PARTY(signatories[0])).InstanceAddress()
-
 			if instanceAddress != gotAddress {
 				continue
 			}
@@ -143,18 +124,48 @@ func findActiveContractByInstanceAddress(ctx context.Context, stateService apiv2
 	return activeContract, nil
 }
 
+// createdInstanceAddress returns the instance address of a created contract, computed from its
+// instanceId field and its single signatory.
+func createdInstanceAddress(created *apiv2.CreatedEvent) (contracts.InstanceAddress, bool) {
+	var contractInstanceID string
+	for _, field := range created.GetCreateArguments().GetFields() {
+		if field.GetLabel() == instanceIDFieldLabel {
+			contractInstanceID = field.GetValue().GetText()
+			break
+		}
+	}
+	if contractInstanceID == "" {
+		return contracts.InstanceAddress{}, false
+	}
+
+	signatories := created.GetSignatories()
+	if len(signatories) != 1 {
+		return contracts.InstanceAddress{}, false
+	}
+
+	return contracts.InstanceID(contractInstanceID).RawInstanceAddress(cantontypes.PARTY(signatories[0])).InstanceAddress(), true
+}
+
 // GetMCMSContract queries the active MCMS contract by InstanceAddress (hex).
 // mcmsAddr is the InstanceAddress hex string (may be prefixed with "0x").
 func GetMCMSContract(ctx context.Context, stateService apiv2.StateServiceClient, mcmsParties []string, mcmsAddr string) (*mcmscore.MCMS, error) {
+	mcmsContract, _, err := getMCMSActiveContract(ctx, stateService, mcmsParties, mcmsAddr)
+
+	return mcmsContract, err
+}
+
+// getMCMSActiveContract queries the active MCMS contract by InstanceAddress (hex) and returns it
+// along with its contract ID.
+func getMCMSActiveContract(ctx context.Context, stateService apiv2.StateServiceClient, mcmsParties []string, mcmsAddr string) (*mcmscore.MCMS, string, error) {
 	mcmsAddr = strings.TrimPrefix(mcmsAddr, "0x")
 	if mcmsAddr == "" {
-		return nil, fmt.Errorf("MCMS instance address is required")
+		return nil, "", fmt.Errorf("MCMS instance address is required")
 	}
 	addr := contracts.HexToInstanceAddress(mcmsAddr)
 	templateID := mcmscore.MCMS{}.GetTemplateID()
 	activeContract, err := findActiveContractByInstanceAddress(ctx, stateService, mcmsParties, templateID, addr)
 	if err != nil {
-		return nil, fmt.Errorf("MCMS contract for InstanceAddress %s: %w", mcmsAddr, err)
+		return nil, "", fmt.Errorf("MCMS contract for InstanceAddress %s: %w", mcmsAddr, err)
 	}
 
 	// Wrap for bindings unmarshal
@@ -162,10 +173,10 @@ func GetMCMSContract(ctx context.Context, stateService apiv2.StateServiceClient,
 
 	mcmsContract, err := bindings.UnmarshalActiveContract[mcmscore.MCMS](wrapped)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal MCMS contract: %w", err)
+		return nil, "", fmt.Errorf("failed to unmarshal MCMS contract: %w", err)
 	}
 
-	return mcmsContract, nil
+	return mcmsContract, activeContract.GetCreatedEvent().GetContractId(), nil
 }
 
 // ResolveTargetContractID resolves a target contract's active contract ID from a raw instance address
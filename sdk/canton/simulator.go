@@ -0,0 +1,166 @@
+package canton
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apiv2 "github.com/digital-asset/dazl-client/v8/go/api/com/daml/ledger/api/v2"
+	"github.com/digital-asset/dazl-client/v8/go/api/com/daml/ledger/api/v2/interactive"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	mcmsapi "github.com/smartcontractkit/chainlink-canton/bindings/generated/latest/mcms/api"
+	"github.com/smartcontractkit/chainlink-canton/contracts"
+	"github.com/smartcontractkit/go-daml/pkg/service/ledger"
+	cantontypes "github.com/smartcontractkit/go-daml/pkg/types"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// ErrOperationProofRequired is returned by SimulateOperation for the operations targeting the
+// MCMS contract itself, e.g. the timelock operations. They have no entrypoint outside of the
+// MCMS ExecuteOp choice, which requires the merkle proof of the operation.
+var ErrOperationProofRequired = errors.New("simulating a Canton operation on the MCMS contract requires its merkle proof, use SimulateExecuteOperation")
+
+// mcmsReceiverInterfaceID is the interface implemented by the targets of the MCMS operations.
+var mcmsReceiverInterfaceID = &apiv2.Identifier{
+	PackageId:  "#" + mcmsapi.PackageName,
+	ModuleName: "MCMS.MCMSReceiver",
+	EntityName: "MCMSReceiver",
+}
+
+var _ sdk.Simulator = (*Simulator)(nil)
+
+// Simulator previews the commands built by the Executor through the interactive submission
+// service. Preparing a submission interprets the commands against the current ledger state
+// without committing them.
+type Simulator struct {
+	executor *Executor
+	client   interactive.InteractiveSubmissionServiceClient
+}
+
+// NewSimulator creates a Simulator preparing the Executor commands with the given client.
+func NewSimulator(executor *Executor, client interactive.InteractiveSubmissionServiceClient) *Simulator {
+	return &Simulator{
+		executor: executor,
+		client:   client,
+	}
+}
+
+func (s *Simulator) SimulateSetRoot(
+	ctx context.Context,
+	_ string,
+	metadata types.ChainMetadata,
+	proof []common.Hash,
+	root [32]byte,
+	validUntil uint32,
+	sortedSignatures []types.Signature,
+) error {
+	command, _, err := s.executor.setRootCommand(ctx, metadata, proof, root, validUntil, sortedSignatures)
+	if err != nil {
+		return err
+	}
+
+	return s.prepare(ctx, s.executor.submittingParty, command)
+}
+
+// SimulateOperation previews the call that ExecuteOp dispatches to the target of the operation:
+// the MCMSReceiver_Entrypoint choice of the target, exercised as the owner of the MCMS contract,
+// which is its mcmsController. The root of the operation does not need to be set. Operations
+// targeting the MCMS contract itself return ErrOperationProofRequired.
+func (s *Simulator) SimulateOperation(
+	ctx context.Context,
+	metadata types.ChainMetadata,
+	op types.Operation,
+) error {
+	stateClient := s.executor.StateServiceClient()
+	mcmsContract, _, err := getMCMSActiveContract(ctx, stateClient, s.executor.mcmsParties, metadata.MCMAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get MCMS contract: %w", err)
+	}
+
+	fields, contractIDs, err := s.executor.operationTarget(ctx, op)
+	if err != nil {
+		return err
+	}
+	self := contracts.NewRawInstanceAddress(contracts.InstanceID(mcmsContract.InstanceId), mcmsContract.Owner)
+	if fields.TargetInstanceAddress == self.String() {
+		return ErrOperationProofRequired
+	}
+
+	targetCid, err := ResolveContractIDIfInstanceAddress(ctx, stateClient, s.executor.mcmsParties, fields.TargetCid)
+	if err != nil {
+		return fmt.Errorf("resolve target contract ID: %w", err)
+	}
+
+	input := mcmsapi.MCMSReceiverEntrypoint{
+		FunctionName:  cantontypes.TEXT(fields.FunctionName),
+		OperationData: cantontypes.TEXT(operationDataHex(op.Transaction.Data)),
+		ContractIds:   contractIDs,
+	}
+	command := &apiv2.Command{
+		Command: &apiv2.Command_Exercise{
+			Exercise: &apiv2.ExerciseCommand{
+				TemplateId:     mcmsReceiverInterfaceID,
+				ContractId:     targetCid,
+				Choice:         "MCMSReceiver_Entrypoint",
+				ChoiceArgument: ledger.MapToValue(input),
+			},
+		},
+	}
+
+	return s.prepare(ctx, string(mcmsContract.Owner), command)
+}
+
+// SimulateExecuteOperation previews the ExecuteOp command that ExecuteOperation would submit for
+// the operation, including the call dispatched to its target. The root of the operation must be
+// set on the ledger.
+func (s *Simulator) SimulateExecuteOperation(
+	ctx context.Context,
+	metadata types.ChainMetadata,
+	nonce uint32,
+	proof []common.Hash,
+	op types.Operation,
+) error {
+	command, _, err := s.executor.executeOpCommand(ctx, metadata, nonce, proof, op)
+	if err != nil {
+		return err
+	}
+
+	return s.prepare(ctx, s.executor.submittingParty, command)
+}
+
+func (s *Simulator) prepare(ctx context.Context, actAs string, command *apiv2.Command) error {
+	_, err := s.client.PrepareSubmission(ctx, &interactive.PrepareSubmissionRequest{
+		CommandId: uuid.NewString(),
+		ActAs:     []string{actAs},
+		ReadAs:    s.executor.mcmsParties,
+		Commands:  []*apiv2.Command{command},
+	})
+	if err != nil {
+		return SimulateError{err: fmt.Errorf("failed to prepare submission: %w", err)}
+	}
+
+	return nil
+}
+
+// SimulateError is returned when the ledger rejects the prepared commands, e.g. when a Daml
+// assertion fails during interpretation.
+type SimulateError struct {
+	err error
+}
+
+func (e SimulateError) Error() string {
+	return e.err.Error()
+}
+
+func (e SimulateError) Unwrap() error {
+	return e.err
+}
+
+// Logs returns the rejection reported by the participant, one line per entry.
+func (e SimulateError) Logs() []string {
+	return strings.Split(e.err.Error(), "\n")
+}
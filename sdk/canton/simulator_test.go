@@ -0,0 +1,218 @@
+package canton
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/digital-asset/dazl-client/v8/go/api/com/daml/ledger/api/v2/interactive"
+	"github.com/ethereum/go-ethereum/common"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	mcmsapi "github.com/smartcontractkit/chainlink-canton/bindings/generated/latest/mcms/api"
+	"github.com/smartcontractkit/go-daml/pkg/service/ledger"
+	damltypes "github.com/smartcontractkit/go-daml/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// fakeInteractiveClient records the prepared request
+type fakeInteractiveClient struct {
+	interactive.InteractiveSubmissionServiceClient
+	err error
+
+	req *interactive.PrepareSubmissionRequest
+}
+
+func (f *fakeInteractiveClient) PrepareSubmission(_ context.Context, req *interactive.PrepareSubmissionRequest, _ ...grpc.CallOption) (*interactive.PrepareSubmissionResponse, error) {
+	f.req = req
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &interactive.PrepareSubmissionResponse{}, nil
+}
+
+func newTestSimulator(t *testing.T, interactiveClient *fakeInteractiveClient) (*Simulator, *fakeCommandClient, types.ChainMetadata) {
+	t.Helper()
+
+	contract := testMCMSContract(mcmsInstanceIDCCIP, nil)
+	stateClient := &fakeStateClient{contract: contract}
+	commandClient := &fakeCommandClient{}
+	encoder := NewEncoder(types.ChainSelector(chainsel.CANTON_TESTNET.Selector), 2, false)
+	executor, err := NewExecutor(encoder, NewInspector(stateClient, []string{testParty}, TimelockRoleProposer),
+		commandClient, "submitter::1220", []string{testParty}, TimelockRoleProposer)
+	require.NoError(t, err)
+
+	metadata := types.ChainMetadata{
+		MCMAddress:       testMCMSInstanceAddress(contract),
+		StartingOpCount:  7,
+		AdditionalFields: []byte(`{"chainId":1,"multisigId":"` + string(contract.Proposer.RootMetadata.MultisigId) + `"}`),
+	}
+
+	return NewSimulator(executor, interactiveClient), commandClient, metadata
+}
+
+func TestSimulator_SimulateSetRoot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		prepareErr error
+		wantErr    string
+		wantLogs   []string
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:       "failure - interpretation error",
+			prepareErr: errors.New("DAML_INTERPRETATION_ERROR\nUser failure: invalid signature"),
+			wantErr:    "failed to prepare submission: DAML_INTERPRETATION_ERROR\nUser failure: invalid signature",
+			wantLogs:   []string{"failed to prepare submission: DAML_INTERPRETATION_ERROR", "User failure: invalid signature"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			interactiveClient := &fakeInteractiveClient{err: tt.prepareErr}
+			simulator, commandClient, metadata := newTestSimulator(t, interactiveClient)
+
+			err := simulator.SimulateSetRoot(t.Context(), "", metadata, []common.Hash{common.HexToHash("0x1")},
+				[32]byte{1, 2, 3}, 4130013354, nil)
+
+			// Nothing is ever submitted to the ledger
+			require.Nil(t, commandClient.req)
+			require.Equal(t, []string{"submitter::1220"}, interactiveClient.req.GetActAs())
+			require.Len(t, interactiveClient.req.GetCommands(), 1)
+			exercise := interactiveClient.req.GetCommands()[0].GetExercise()
+			require.Equal(t, "SetRoot", exercise.GetChoice())
+			require.Equal(t, testMCMSContractID, exercise.GetContractId())
+
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tt.wantErr)
+			var simErr SimulateError
+			require.ErrorAs(t, err, &simErr)
+			require.Equal(t, tt.wantLogs, simErr.Logs())
+		})
+	}
+}
+
+func TestSimulator_SimulateOperation(t *testing.T) {
+	t.Parallel()
+
+	selector := types.ChainSelector(chainsel.CANTON_TESTNET.Selector)
+	tests := []struct {
+		name             string
+		additionalFields string
+		prepareErr       error
+		wantErr          string
+		wantErrIs        error
+	}{
+		{
+			name:             "success",
+			additionalFields: `{"targetInstanceAddress":"counter@party","functionName":"Increment","targetCid":"00counter-cid","contractIds":["00counter-cid"]}`,
+		},
+		{
+			name:             "failure: rejected by the target",
+			additionalFields: `{"targetInstanceAddress":"counter@party","functionName":"Increment","targetCid":"00counter-cid"}`,
+			prepareErr:       errors.New("E_UNKNOWN_FUNCTION"),
+			wantErr:          "failed to prepare submission: E_UNKNOWN_FUNCTION",
+		},
+		{
+			name:             "failure: operation on the MCMS contract",
+			additionalFields: `{"targetInstanceAddress":"` + mcmsInstanceIDCCIP + `@` + testParty + `","functionName":"ScheduleBatch","targetCid":"00mcms-contract-id"}`,
+			wantErrIs:        ErrOperationProofRequired,
+		},
+		{
+			name:             "failure: missing function name",
+			additionalFields: `{"targetInstanceAddress":"counter@party","targetCid":"00counter-cid"}`,
+			wantErr:          "functionName is required in operation additional fields",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			interactiveClient := &fakeInteractiveClient{err: tt.prepareErr}
+			simulator, commandClient, metadata := newTestSimulator(t, interactiveClient)
+			op := types.Operation{
+				ChainSelector: selector,
+				Transaction: types.Transaction{
+					Data:             []byte{0xaa, 0xbb},
+					AdditionalFields: []byte(tt.additionalFields),
+				},
+			}
+
+			err := simulator.SimulateOperation(t.Context(), metadata, op)
+			require.Nil(t, commandClient.req)
+			switch {
+			case tt.wantErrIs != nil:
+				require.ErrorIs(t, err, tt.wantErrIs)
+				require.Nil(t, interactiveClient.req)
+
+				return
+			case tt.wantErr != "" && tt.prepareErr == nil:
+				require.EqualError(t, err, tt.wantErr)
+				require.Nil(t, interactiveClient.req)
+
+				return
+			case tt.wantErr != "":
+				require.EqualError(t, err, tt.wantErr)
+				var simErr SimulateError
+				require.ErrorAs(t, err, &simErr)
+			default:
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, []string{testParty}, interactiveClient.req.GetActAs())
+			exercise := interactiveClient.req.GetCommands()[0].GetExercise()
+			require.Equal(t, "MCMSReceiver_Entrypoint", exercise.GetChoice())
+			require.Equal(t, "MCMSReceiver", exercise.GetTemplateId().GetEntityName())
+			require.Equal(t, "00counter-cid", exercise.GetContractId())
+
+			var input mcmsapi.MCMSReceiverEntrypoint
+			require.NoError(t, ledger.RecordToStruct(exercise.GetChoiceArgument().GetRecord(), &input))
+			require.Equal(t, damltypes.TEXT("Increment"), input.FunctionName)
+			require.Equal(t, damltypes.TEXT("aabb"), input.OperationData)
+		})
+	}
+}
+
+func TestSimulator_SimulateExecuteOperation(t *testing.T) {
+	t.Parallel()
+
+	interactiveClient := &fakeInteractiveClient{}
+	simulator, commandClient, metadata := newTestSimulator(t, interactiveClient)
+
+	op := types.Operation{
+		ChainSelector: types.ChainSelector(chainsel.CANTON_TESTNET.Selector),
+		Transaction: types.Transaction{
+			Data:             []byte{0xaa, 0xbb},
+			AdditionalFields: []byte(`{"targetInstanceAddress":"counter@party","functionName":"Increment","targetCid":"00counter-cid"}`),
+		},
+	}
+
+	err := simulator.SimulateExecuteOperation(t.Context(), metadata, 7, []common.Hash{common.HexToHash("0x1")}, op)
+	require.NoError(t, err)
+	require.Nil(t, commandClient.req)
+
+	exercise := interactiveClient.req.GetCommands()[0].GetExercise()
+	require.Equal(t, "ExecuteOp", exercise.GetChoice())
+	require.Equal(t, testMCMSContractID, exercise.GetContractId())
+
+	// Invalid operations fail before reaching the ledger
+	op.Transaction.AdditionalFields = []byte(`{"functionName":"Increment"}`)
+	interactiveClient.req = nil
+	err = simulator.SimulateExecuteOperation(t.Context(), metadata, 7, nil, op)
+	require.EqualError(t, err, "targetInstanceAddress is required in operation additional fields")
+	require.Nil(t, interactiveClient.req)
+}
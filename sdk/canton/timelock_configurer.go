@@ -0,0 +1,180 @@
+package canton
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	apiv2 "github.com/digital-asset/dazl-client/v8/go/api/com/daml/ledger/api/v2"
+	"github.com/google/uuid"
+	cselectors "github.com/smartcontractkit/chain-selectors"
+	"github.com/smartcontractkit/go-daml/pkg/service/ledger"
+	cantontypes "github.com/smartcontractkit/go-daml/pkg/types"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// updateMinDelayFunctionName is the MCMS timelock function setting the min delay, dispatched by
+// the timelock to the MCMS contract itself.
+const updateMinDelayFunctionName = "UpdateMinDelay"
+
+var _ sdk.TimelockConfigurer = (*TimelockConfigurer)(nil)
+
+// TimelockConfigurer configures the timelock of a Canton MCMS contract.
+// timelockAddress parameters are InstanceAddress hex; they are resolved to contract ID when submitting.
+type TimelockConfigurer struct {
+	client      apiv2.CommandServiceClient
+	stateClient apiv2.StateServiceClient
+	// The parties that own the MCMS deployment.
+	mcmsParties []string
+}
+
+// NewTimelockConfigurer creates a TimelockConfigurer that submits commands via the given clients.
+func NewTimelockConfigurer(client apiv2.CommandServiceClient, stateClient apiv2.StateServiceClient, mcmsParties []string) *TimelockConfigurer {
+	return &TimelockConfigurer{
+		client:      client,
+		stateClient: stateClient,
+		mcmsParties: mcmsParties,
+	}
+}
+
+// UpdateDelay sets the timelock min delay (in seconds) of the MCMS contract. The MCMS template
+// has no choice for it outside of the timelock, so the owner archives the contract and recreates
+// it with the new delay in the same transaction. As with the consuming choices of the MCMS, the
+// contract ID changes while the instanceId and owner, hence the InstanceAddress, are unchanged;
+// the new contract ID is returned in the RawData. To update the delay through the timelock
+// instead, propose the call of NewUpdateMinDelayTransaction.
+func (c *TimelockConfigurer) UpdateDelay(
+	ctx context.Context, timelockAddress string, newDelay uint64,
+) (types.TransactionResult, error) {
+	if newDelay == 0 {
+		return types.TransactionResult{}, errors.New("delay must be positive")
+	}
+	if newDelay > math.MaxInt64/uint64(time.Second) {
+		return types.TransactionResult{}, fmt.Errorf("delay %d out of range", newDelay)
+	}
+
+	mcmsContract, mcmsContractID, err := getMCMSActiveContract(ctx, c.stateClient, c.mcmsParties, timelockAddress)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to get MCMS contract: %w", err)
+	}
+
+	packageID, moduleName, entityName, err := ParseTemplateIDFromString(mcmsContract.GetTemplateID())
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to parse template ID: %w", err)
+	}
+	templateID := &apiv2.Identifier{
+		PackageId:  packageID,
+		ModuleName: moduleName,
+		EntityName: entityName,
+	}
+
+	archiveCmd := mcmsContract.Archive(mcmsContractID)
+	mcmsContract.MinDelay = cantontypes.RELTIME(time.Duration(newDelay) * time.Second)
+	createArguments := ledger.MapToValue(mcmsContract.CreateCommand().Arguments).GetRecord()
+
+	commandID := uuid.NewString()
+	submitResp, err := c.client.SubmitAndWaitForTransaction(ctx, &apiv2.SubmitAndWaitForTransactionRequest{
+		Commands: &apiv2.Commands{
+			WorkflowId: "mcms-update-delay",
+			CommandId:  commandID,
+			ActAs:      []string{string(mcmsContract.Owner)},
+			ReadAs:     c.mcmsParties,
+			Commands: []*apiv2.Command{
+				{
+					Command: &apiv2.Command_Exercise{
+						Exercise: &apiv2.ExerciseCommand{
+							TemplateId:     templateID,
+							ContractId:     mcmsContractID,
+							Choice:         archiveCmd.Choice,
+							ChoiceArgument: ledger.MapToValue(archiveCmd.Arguments),
+						},
+					},
+				},
+				{
+					Command: &apiv2.Command_Create{
+						Create: &apiv2.CreateCommand{
+							TemplateId:      templateID,
+							CreateArguments: createArguments,
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to update delay: %w", err)
+	}
+
+	// Extract NEW MCMS CID from Created event
+	newMCMSContractID := ""
+	newMCMSTemplateID := ""
+	transaction := submitResp.GetTransaction()
+	for _, ev := range transaction.GetEvents() {
+		if createdEv := ev.GetCreated(); createdEv != nil {
+			templateID := FormatTemplateID(createdEv.GetTemplateId())
+			if NormalizeTemplateKey(templateID) == MCMSTemplateKey {
+				newMCMSContractID = createdEv.GetContractId()
+				newMCMSTemplateID = templateID
+
+				break
+			}
+		}
+	}
+	if newMCMSContractID == "" {
+		return types.TransactionResult{}, fmt.Errorf("update-delay tx had no Created MCMS event; refusing to continue with old CID=%s", mcmsContractID)
+	}
+
+	return types.TransactionResult{
+		Hash:        transactionResultHash(transaction, commandID),
+		ChainFamily: cselectors.FamilyCanton,
+		RawData:     rawDataFromMCMSTx(newMCMSContractID, newMCMSTemplateID, submitResp),
+	}, nil
+}
+
+// GrantRole is not supported on Canton. The timelock roles are the Proposer, Canceller and
+// Bypasser multisig configs of the MCMS contract, which has no choice granting a role to an
+// account; change the signers of a role with the Configurer instead.
+func (c *TimelockConfigurer) GrantRole(
+	_ context.Context,
+	_ string,
+	role sdk.TimelockRole,
+	_ string,
+) (types.TransactionResult, error) {
+	return types.TransactionResult{}, fmt.Errorf("granting the %s role on Canton: %w", role, errors.ErrUnsupported)
+}
+
+// NewUpdateMinDelayTransaction returns the UpdateMinDelay call of the MCMS contract at the
+// RawInstanceAddress mcmsTarget (instanceId@owner), setting the timelock min delay in seconds.
+// The call is dispatched by the timelock to the MCMS contract itself, so it must be part of a
+// timelock proposal of the MCMS.
+func NewUpdateMinDelayTransaction(mcmsTarget string, newDelay uint64) (types.Transaction, error) {
+	if newDelay == 0 {
+		return types.Transaction{}, errors.New("delay must be positive")
+	}
+	if newDelay > math.MaxInt64 {
+		return types.Transaction{}, fmt.Errorf("delay %d out of range", newDelay)
+	}
+
+	// The delay is passed as the raw bytes of its big-endian int64 encoding
+	data := binary.BigEndian.AppendUint64(nil, newDelay)
+	additionalFields, err := json.Marshal(AdditionalFields{
+		TargetInstanceAddress: mcmsTarget,
+		FunctionName:          updateMinDelayFunctionName,
+		ContractIds:           []string{},
+	})
+	if err != nil {
+		return types.Transaction{}, fmt.Errorf("failed to marshal additional fields: %w", err)
+	}
+
+	return types.Transaction{
+		To:               mcmsTarget,
+		Data:             data,
+		AdditionalFields: additionalFields,
+	}, nil
+}
@@ -0,0 +1,208 @@
+package canton
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	apiv2 "github.com/digital-asset/dazl-client/v8/go/api/com/daml/ledger/api/v2"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	mcmscore "github.com/smartcontractkit/chainlink-canton/bindings/generated/latest/mcms/core"
+	"github.com/smartcontractkit/chainlink-canton/contracts"
+	"github.com/smartcontractkit/go-daml/pkg/service/ledger"
+	damltypes "github.com/smartcontractkit/go-daml/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/smartcontractkit/mcms/sdk"
+)
+
+const testMCMSContractID = "00mcms-contract-id"
+
+// fakeStateClient serves a single active MCMS contract
+type fakeStateClient struct {
+	apiv2.StateServiceClient
+	contract mcmscore.MCMS
+}
+
+func (f *fakeStateClient) GetLedgerEnd(_ context.Context, _ *apiv2.GetLedgerEndRequest, _ ...grpc.CallOption) (*apiv2.GetLedgerEndResponse, error) {
+	return &apiv2.GetLedgerEndResponse{Offset: 10}, nil
+}
+
+func (f *fakeStateClient) GetActiveContracts(_ context.Context, _ *apiv2.GetActiveContractsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[apiv2.GetActiveContractsResponse], error) {
+	return &fakeActiveContractsStream{responses: []*apiv2.GetActiveContractsResponse{{
+		ContractEntry: &apiv2.GetActiveContractsResponse_ActiveContract{ActiveContract: &apiv2.ActiveContract{
+			CreatedEvent: &apiv2.CreatedEvent{
+				ContractId:      testMCMSContractID,
+				CreateArguments: ledger.MapToValue(f.contract.CreateCommand().Arguments).GetRecord(),
+				Signatories:     []string{string(f.contract.Owner)},
+			},
+		}},
+	}}}, nil
+}
+
+type fakeActiveContractsStream struct {
+	grpc.ClientStream
+	responses []*apiv2.GetActiveContractsResponse
+}
+
+func (f *fakeActiveContractsStream) Recv() (*apiv2.GetActiveContractsResponse, error) {
+	if len(f.responses) == 0 {
+		return nil, io.EOF
+	}
+	resp := f.responses[0]
+	f.responses = f.responses[1:]
+
+	return resp, nil
+}
+
+func (f *fakeActiveContractsStream) CloseSend() error {
+	return nil
+}
+
+// fakeCommandClient records the submitted request and returns a transaction creating a new MCMS
+type fakeCommandClient struct {
+	apiv2.CommandServiceClient
+	err error
+
+	req *apiv2.SubmitAndWaitForTransactionRequest
+}
+
+func (f *fakeCommandClient) SubmitAndWaitForTransaction(_ context.Context, req *apiv2.SubmitAndWaitForTransactionRequest, _ ...grpc.CallOption) (*apiv2.SubmitAndWaitForTransactionResponse, error) {
+	f.req = req
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &apiv2.SubmitAndWaitForTransactionResponse{Transaction: &apiv2.Transaction{
+		Events: []*apiv2.Event{{Event: &apiv2.Event_Created{Created: &apiv2.CreatedEvent{
+			ContractId: "00new-mcms-contract-id",
+			TemplateId: &apiv2.Identifier{PackageId: "pkg", ModuleName: "MCMS.Main", EntityName: "MCMS"},
+		}}}},
+	}}, nil
+}
+
+func testMCMSInstanceAddress(contract mcmscore.MCMS) string {
+	return contracts.InstanceID(contract.InstanceId).RawInstanceAddress(contract.Owner).InstanceAddress().Hex()
+}
+
+func TestTimelockConfigurer_UpdateDelay(t *testing.T) {
+	t.Parallel()
+
+	contract := testMCMSContract(mcmsInstanceIDCCIP, nil)
+
+	tests := []struct {
+		name      string
+		address   string
+		newDelay  uint64
+		submitErr error
+		wantErr   string
+	}{
+		{
+			name:     "success",
+			address:  testMCMSInstanceAddress(contract),
+			newDelay: 7200,
+		},
+		{
+			name:     "failure - zero delay",
+			address:  testMCMSInstanceAddress(contract),
+			newDelay: 0,
+			wantErr:  "delay must be positive",
+		},
+		{
+			name:     "failure - delay out of range",
+			address:  testMCMSInstanceAddress(contract),
+			newDelay: 1 << 62,
+			wantErr:  "delay 4611686018427387904 out of range",
+		},
+		{
+			name:     "failure - unknown MCMS",
+			address:  "",
+			newDelay: 7200,
+			wantErr:  "failed to get MCMS contract: MCMS instance address is required",
+		},
+		{
+			name:      "failure - submission rejected",
+			address:   testMCMSInstanceAddress(contract),
+			newDelay:  7200,
+			submitErr: errors.New("permission denied"),
+			wantErr:   "failed to update delay: permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			commandClient := &fakeCommandClient{err: tt.submitErr}
+			configurer := NewTimelockConfigurer(commandClient, &fakeStateClient{contract: contract}, []string{testParty})
+
+			result, err := configurer.UpdateDelay(t.Context(), tt.address, tt.newDelay)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, chainsel.FamilyCanton, result.ChainFamily)
+			require.NotEmpty(t, result.Hash)
+			rawData, ok := result.RawData.(map[string]any)
+			require.True(t, ok)
+			require.Equal(t, "00new-mcms-contract-id", rawData[rawDataKeyNewMCMSContractID])
+
+			// The owner archives the MCMS contract and recreates it with the new delay
+			commands := commandClient.req.GetCommands()
+			require.Equal(t, []string{testParty}, commands.GetActAs())
+			require.Len(t, commands.GetCommands(), 2)
+			archive := commands.GetCommands()[0].GetExercise()
+			require.Equal(t, testMCMSContractID, archive.GetContractId())
+			require.Equal(t, "Archive", archive.GetChoice())
+
+			var minDelay *apiv2.Value
+			for _, field := range commands.GetCommands()[1].GetCreate().GetCreateArguments().GetFields() {
+				if field.GetLabel() == "minDelay" {
+					minDelay = field.GetValue()
+				}
+			}
+			wantMinDelay := ledger.MapToValue(map[string]any{"minDelay": damltypes.RELTIME(2 * time.Hour)}).GetRecord().GetFields()[0].GetValue()
+			require.Equal(t, wantMinDelay.String(), minDelay.String())
+		})
+	}
+}
+
+func TestTimelockConfigurer_GrantRole(t *testing.T) {
+	t.Parallel()
+
+	contract := testMCMSContract(mcmsInstanceIDCCIP, nil)
+	configurer := NewTimelockConfigurer(&fakeCommandClient{}, &fakeStateClient{contract: contract}, []string{testParty})
+
+	_, err := configurer.GrantRole(t.Context(), testMCMSInstanceAddress(contract), sdk.TimelockRoleProposer,
+		"0x0000000000000000000000000000000000000022")
+	require.ErrorIs(t, err, errors.ErrUnsupported)
+}
+
+func TestNewUpdateMinDelayTransaction(t *testing.T) {
+	t.Parallel()
+
+	target := mcmsInstanceIDCCIP + "@" + testParty
+
+	tx, err := NewUpdateMinDelayTransaction(target, 7200)
+	require.NoError(t, err)
+	require.Equal(t, target, tx.To)
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0x1c, 0x20}, tx.Data)
+	require.NoError(t, ValidateAdditionalFields(tx.AdditionalFields))
+
+	var fields AdditionalFields
+	require.NoError(t, json.Unmarshal(tx.AdditionalFields, &fields))
+	require.Equal(t, AdditionalFields{
+		TargetInstanceAddress: target,
+		FunctionName:          "UpdateMinDelay",
+		ContractIds:           []string{},
+	}, fields)
+
+	_, err = NewUpdateMinDelayTransaction(target, 0)
+	require.EqualError(t, err, "delay must be positive")
+}
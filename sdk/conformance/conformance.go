@@ -0,0 +1,481 @@
+// Package conformance implements a test suite asserting the behaviour shared by the sdk
+// implementations of every chain family. A family runs the suite against a local backend by
+// supplying a Harness with its constructors.
+package conformance
+
+import (
+	"crypto/rand"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms"
+	"github.com/smartcontractkit/mcms/internal/testutils"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// DefaultDelay is the delay of the timelock proposals of the suite when the Harness does not set
+// one.
+const DefaultDelay = time.Hour
+
+const (
+	numSigners = 3
+	quorum     = 2
+
+	// clockMargin is waited on top of the delay by backends following the wall clock, whose block
+	// time may lag behind it.
+	clockMargin = 2 * time.Second
+)
+
+// Harness supplies the suite with a local backend of a chain family and the constructors of its
+// sdk implementations. The transactions of every constructed implementation are sent from the
+// same account.
+type Harness struct {
+	// ChainSelector is the selector of the chain of the backend, usually one of the chaintest
+	// selectors.
+	ChainSelector types.ChainSelector
+
+	// DeployMCM deploys an MCM contract without a config, owned by the account of the Configurer,
+	// and returns its address.
+	DeployMCM func(t *testing.T) string
+	// DeployTimelock deploys a timelock contract with a min delay of at most Delay, and returns its
+	// address. The MCM is granted the proposer, canceller and bypasser roles, and the account of the
+	// TimelockExecutor the executor role. Families with a timelock built into the MCM return the
+	// address of the MCM.
+	DeployTimelock func(t *testing.T, mcmAddress string) string
+
+	NewExecutor          func(t *testing.T, encoder sdk.Encoder) sdk.Executor
+	NewInspector         func(t *testing.T) sdk.Inspector
+	NewConfigurer        func(t *testing.T) sdk.Configurer
+	NewTimelockConverter func(t *testing.T) sdk.TimelockConverter
+	NewTimelockExecutor  func(t *testing.T) sdk.TimelockExecutor
+	NewTimelockInspector func(t *testing.T) sdk.TimelockInspector
+
+	// NewTransaction returns a transaction which succeeds when executed by the MCM. Each call
+	// returns a distinct transaction.
+	NewTransaction func(t *testing.T, mcmAddress string) types.Transaction
+	// NewTimelockTransaction returns a transaction which succeeds when executed by the timelock of
+	// the MCM. It is optional, NewTransaction is used when it is nil.
+	NewTimelockTransaction func(t *testing.T, mcmAddress string) types.Transaction
+	// ChainMetadata returns the chain metadata of the proposals for the MCM. It is optional for
+	// families without additional fields.
+	ChainMetadata func(t *testing.T, mcmAddress string, opCount uint64) types.ChainMetadata
+	// RootMetadataAddress returns the MCMAddress reported by GetRootMetadata for the MCM. It is
+	// optional for families reporting the address of the MCM.
+	RootMetadataAddress func(t *testing.T, mcmAddress string) string
+	// TimelockRoleMember returns the account of the MCM listed by the role getters of the
+	// TimelockInspector. It is optional, the role getters are not checked when it is nil, for
+	// families which do not list the role members.
+	TimelockRoleMember func(t *testing.T, mcmAddress string) string
+
+	// Delay is the delay of the timelock proposals of the suite, DefaultDelay when zero. The min
+	// delay of the timelocks deployed by the Harness must not exceed it.
+	Delay time.Duration
+	// AdvanceTime moves the block time of the backend forward by d. It is optional for backends
+	// following the wall clock, which wait for d instead.
+	AdvanceTime func(t *testing.T, d time.Duration)
+	// Confirm waits for the inclusion of a sent transaction and returns an error if it reverted.
+	// It is optional for backends applying transactions as they are sent.
+	Confirm func(t *testing.T, result types.TransactionResult) error
+}
+
+// Run runs the conformance suite against the harness. The subtests share the backend, so they
+// are run sequentially.
+func Run(t *testing.T, h Harness) {
+	t.Helper()
+
+	s := &suite{h: h}
+	t.Run("Configurer", s.testConfigurer)
+	t.Run("Executor/SetRoot", s.testSetRoot)
+	t.Run("Executor/SetRoot insufficient signatures", s.testSetRootInsufficientSignatures)
+	t.Run("Executor/ExecuteOperation", s.testExecuteOperation)
+	t.Run("Executor/ExecuteOperation wrong nonce", s.testExecuteOperationWrongNonce)
+	t.Run("TimelockInspector", s.testTimelockInspector)
+	t.Run("TimelockExecutor", s.testTimelockExecutor)
+}
+
+// ScheduleTransaction returns a transaction of the MCM scheduling tx on its timelock without delay,
+// under a random salt. It serves as NewTransaction for families whose MCM only calls its built-in
+// timelock.
+func ScheduleTransaction(
+	t *testing.T, converter sdk.TimelockConverter, selector types.ChainSelector, metadata types.ChainMetadata,
+	timelockAddress string, tx types.Transaction,
+) types.Transaction {
+	t.Helper()
+
+	var salt common.Hash
+	_, err := rand.Read(salt[:])
+	require.NoError(t, err)
+
+	bop := types.BatchOperation{ChainSelector: selector, Transactions: []types.Transaction{tx}}
+	ops, _, err := converter.ConvertBatchToChainOperations(t.Context(), metadata, bop, timelockAddress,
+		metadata.MCMAddress, types.NewDuration(0), types.TimelockActionSchedule, common.Hash{}, salt)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+
+	return ops[0].Transaction
+}
+
+type suite struct {
+	h Harness
+}
+
+// mcmEnv is an MCM configured with a quorum of its signers.
+type mcmEnv struct {
+	address string
+	config  *types.Config
+	signers []testutils.ECDSASigner
+}
+
+// confirm returns the error of a sent transaction, confirming it with the harness if it was sent.
+func (s *suite) confirm(t *testing.T, result types.TransactionResult, err error) error {
+	t.Helper()
+
+	if err != nil || s.h.Confirm == nil {
+		return err
+	}
+
+	return s.h.Confirm(t, result)
+}
+
+func (s *suite) delay() time.Duration {
+	if s.h.Delay == 0 {
+		return DefaultDelay
+	}
+
+	return s.h.Delay
+}
+
+func (s *suite) advanceTime(t *testing.T, d time.Duration) {
+	t.Helper()
+
+	if s.h.AdvanceTime == nil {
+		time.Sleep(d + clockMargin)
+		return
+	}
+	s.h.AdvanceTime(t, d)
+}
+
+// chainMetadata returns the chain metadata of a proposal for the MCM, starting at its current op
+// count.
+func (s *suite) chainMetadata(t *testing.T, env mcmEnv) types.ChainMetadata {
+	t.Helper()
+
+	opCount, err := s.h.NewInspector(t).GetOpCount(t.Context(), env.address)
+	require.NoError(t, err)
+	if s.h.ChainMetadata == nil {
+		return types.ChainMetadata{StartingOpCount: opCount, MCMAddress: env.address}
+	}
+
+	return s.h.ChainMetadata(t, env.address, opCount)
+}
+
+func (s *suite) newTimelockTransaction(t *testing.T, env mcmEnv) types.Transaction {
+	t.Helper()
+
+	if s.h.NewTimelockTransaction == nil {
+		return s.h.NewTransaction(t, env.address)
+	}
+
+	return s.h.NewTimelockTransaction(t, env.address)
+}
+
+func (s *suite) deployMCM(t *testing.T) mcmEnv {
+	t.Helper()
+
+	signers := testutils.MakeNewECDSASigners(numSigners)
+	cfg := &types.Config{Quorum: quorum, Signers: make([]common.Address, 0, numSigners)}
+	for _, signer := range signers {
+		cfg.Signers = append(cfg.Signers, signer.Address())
+	}
+
+	address := s.h.DeployMCM(t)
+	result, err := s.h.NewConfigurer(t).SetConfig(t.Context(), address, cfg, false)
+	require.NoError(t, s.confirm(t, result, err))
+
+	return mcmEnv{address: address, config: cfg, signers: signers}
+}
+
+// newProposal builds a proposal of n transactions for the MCM, starting at its current op count.
+func (s *suite) newProposal(t *testing.T, env mcmEnv, n int) *mcms.Proposal {
+	t.Helper()
+
+	builder := mcms.NewProposalBuilder().
+		SetVersion("v1").
+		SetValidUntil(validUntil()).
+		AddChainMetadata(s.h.ChainSelector, s.chainMetadata(t, env))
+	for range n {
+		builder.AddOperation(types.Operation{ChainSelector: s.h.ChainSelector, Transaction: s.h.NewTransaction(t, env.address)})
+	}
+	proposal, err := builder.Build()
+	require.NoError(t, err)
+
+	return proposal
+}
+
+func (s *suite) sign(t *testing.T, proposal *mcms.Proposal, signers ...testutils.ECDSASigner) {
+	t.Helper()
+
+	signable, err := mcms.NewSignable(proposal, map[types.ChainSelector]sdk.Inspector{
+		s.h.ChainSelector: s.h.NewInspector(t),
+	})
+	require.NoError(t, err)
+	for _, signer := range signers {
+		_, err = signable.SignAndAppend(mcms.NewPrivateKeySigner(signer.Key))
+		require.NoError(t, err)
+	}
+}
+
+func (s *suite) newExecutable(t *testing.T, proposal *mcms.Proposal) *mcms.Executable {
+	t.Helper()
+
+	encoders, err := proposal.GetEncoders()
+	require.NoError(t, err)
+	executable, err := mcms.NewExecutable(proposal, map[types.ChainSelector]sdk.Executor{
+		s.h.ChainSelector: s.h.NewExecutor(t, encoders[s.h.ChainSelector]),
+	})
+	require.NoError(t, err)
+
+	return executable
+}
+
+// setRoot signs the proposal with a quorum of the signers of the MCM and sets its root.
+func (s *suite) setRoot(t *testing.T, env mcmEnv, proposal *mcms.Proposal) *mcms.Executable {
+	t.Helper()
+
+	s.sign(t, proposal, env.signers[:quorum]...)
+	executable := s.newExecutable(t, proposal)
+	result, err := executable.SetRoot(t.Context(), s.h.ChainSelector)
+	require.NoError(t, s.confirm(t, result, err))
+
+	return executable
+}
+
+func (s *suite) requireOpCount(t *testing.T, env mcmEnv, want uint64) {
+	t.Helper()
+
+	opCount, err := s.h.NewInspector(t).GetOpCount(t.Context(), env.address)
+	require.NoError(t, err)
+	require.Equal(t, want, opCount)
+}
+
+func (s *suite) testConfigurer(t *testing.T) {
+	env := s.deployMCM(t)
+
+	cfg, err := s.h.NewInspector(t).GetConfig(t.Context(), env.address)
+	require.NoError(t, err)
+	assert.Truef(t, env.config.Equals(cfg), "got config %+v, want %+v", cfg, env.config)
+}
+
+func (s *suite) testSetRoot(t *testing.T) {
+	ctx := t.Context()
+	env := s.deployMCM(t)
+	proposal := s.newProposal(t, env, 2)
+	s.setRoot(t, env, proposal)
+
+	tree, err := proposal.MerkleTree()
+	require.NoError(t, err)
+
+	inspector := s.h.NewInspector(t)
+	root, gotValidUntil, err := inspector.GetRoot(ctx, env.address)
+	require.NoError(t, err)
+	assert.Equal(t, tree.Root, root)
+	assert.Equal(t, proposal.ValidUntil, gotValidUntil)
+
+	metadata, err := inspector.GetRootMetadata(ctx, env.address)
+	require.NoError(t, err)
+	want := proposal.ChainMetadata[s.h.ChainSelector]
+	wantAddress := want.MCMAddress
+	if s.h.RootMetadataAddress != nil {
+		wantAddress = s.h.RootMetadataAddress(t, env.address)
+	}
+	assert.Equal(t, want.StartingOpCount, metadata.StartingOpCount)
+	assert.Equal(t, wantAddress, metadata.MCMAddress)
+
+	s.requireOpCount(t, env, want.StartingOpCount)
+}
+
+func (s *suite) testSetRootInsufficientSignatures(t *testing.T) {
+	env := s.deployMCM(t)
+	proposal := s.newProposal(t, env, 1)
+	s.sign(t, proposal, env.signers[:quorum-1]...)
+
+	result, err := s.newExecutable(t, proposal).SetRoot(t.Context(), s.h.ChainSelector)
+	require.Error(t, s.confirm(t, result, err))
+
+	root, _, err := s.h.NewInspector(t).GetRoot(t.Context(), env.address)
+	require.NoError(t, err)
+	assert.Equal(t, common.Hash{}, root)
+}
+
+func (s *suite) testExecuteOperation(t *testing.T) {
+	env := s.deployMCM(t)
+	proposal := s.newProposal(t, env, 2)
+	executable := s.setRoot(t, env, proposal)
+
+	for i := range proposal.Operations {
+		result, err := executable.Execute(t.Context(), i)
+		require.NoError(t, s.confirm(t, result, err))
+		assert.NotEmpty(t, result.Hash)
+
+		s.requireOpCount(t, env, uint64(i+1)) //nolint:gosec // small index
+	}
+}
+
+func (s *suite) testExecuteOperationWrongNonce(t *testing.T) {
+	env := s.deployMCM(t)
+	proposal := s.newProposal(t, env, 2)
+	executable := s.setRoot(t, env, proposal)
+
+	result, err := executable.Execute(t.Context(), 1)
+	require.Error(t, s.confirm(t, result, err))
+
+	s.requireOpCount(t, env, 0)
+}
+
+func (s *suite) testTimelockInspector(t *testing.T) {
+	ctx := t.Context()
+	env := s.deployMCM(t)
+	timelockAddress := s.h.DeployTimelock(t, env.address)
+	inspector := s.h.NewTimelockInspector(t)
+
+	minDelay, err := inspector.GetMinDelay(ctx, timelockAddress)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, minDelay, uint64(s.delay()/time.Second))
+
+	if s.h.TimelockRoleMember != nil {
+		member := s.h.TimelockRoleMember(t, env.address)
+		roles := map[string]func() ([]string, error){
+			"proposers":  func() ([]string, error) { return inspector.GetProposers(ctx, timelockAddress) },
+			"cancellers": func() ([]string, error) { return inspector.GetCancellers(ctx, timelockAddress) },
+			"bypassers":  func() ([]string, error) { return inspector.GetBypassers(ctx, timelockAddress) },
+		}
+		for role, getMembers := range roles {
+			members, err := getMembers()
+			require.NoError(t, err)
+			assert.Truef(t, containsAddress(members, member), "MCM %s not in %s %v", member, role, members)
+		}
+		executors, err := inspector.GetExecutors(ctx, timelockAddress)
+		require.NoError(t, err)
+		assert.NotEmpty(t, executors)
+	}
+
+	var unknownID [32]byte
+	requireOperationState(t, inspector, timelockAddress, unknownID, operationState{})
+}
+
+func (s *suite) testTimelockExecutor(t *testing.T) {
+	ctx := t.Context()
+	env := s.deployMCM(t)
+	timelockAddress := s.h.DeployTimelock(t, env.address)
+
+	timelockProposal, err := mcms.NewTimelockProposalBuilder().
+		SetVersion("v1").
+		SetValidUntil(validUntil()).
+		AddChainMetadata(s.h.ChainSelector, s.chainMetadata(t, env)).
+		AddTimelockAddress(s.h.ChainSelector, timelockAddress).
+		SetAction(types.TimelockActionSchedule).
+		SetDelay(types.NewDuration(s.delay())).
+		AddOperation(types.BatchOperation{
+			ChainSelector: s.h.ChainSelector,
+			Transactions:  []types.Transaction{s.newTimelockTransaction(t, env)},
+		}).
+		AddOperation(types.BatchOperation{
+			ChainSelector: s.h.ChainSelector,
+			Transactions:  []types.Transaction{s.newTimelockTransaction(t, env), s.newTimelockTransaction(t, env)},
+		}).
+		Build()
+	require.NoError(t, err)
+
+	proposal, convertedPredecessors, err := timelockProposal.Convert(ctx, map[types.ChainSelector]sdk.TimelockConverter{
+		s.h.ChainSelector: s.h.NewTimelockConverter(t),
+	})
+	require.NoError(t, err)
+
+	// The operation IDs returned by the converter match the operation IDs of the family
+	opIDs, predecessors, err := timelockProposal.OperationIDs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, predecessors, convertedPredecessors)
+
+	// Schedule the operations through the MCM
+	executable := s.setRoot(t, env, &proposal)
+	for i := range proposal.Operations {
+		result, execErr := executable.Execute(ctx, i)
+		require.NoError(t, s.confirm(t, result, execErr))
+	}
+
+	inspector := s.h.NewTimelockInspector(t)
+	for _, opID := range opIDs {
+		requireOperationState(t, inspector, timelockAddress, opID, operationState{isOperation: true, pending: true})
+	}
+
+	executor := s.h.NewTimelockExecutor(t)
+	salt := common.Hash(timelockProposal.Salt())
+	result, err := executor.Execute(ctx, timelockProposal.Operations[0], timelockAddress, predecessors[0], salt)
+	require.Error(t, s.confirm(t, result, err), "operation executed before its delay")
+
+	s.advanceTime(t, s.delay())
+	for _, opID := range opIDs {
+		requireOperationState(t, inspector, timelockAddress, opID, operationState{isOperation: true, pending: true, ready: true})
+	}
+
+	result, err = executor.Execute(ctx, timelockProposal.Operations[1], timelockAddress, predecessors[1], salt)
+	require.Error(t, s.confirm(t, result, err), "operation executed before its predecessor")
+
+	for i, bop := range timelockProposal.Operations {
+		result, err = executor.Execute(ctx, bop, timelockAddress, predecessors[i], salt)
+		require.NoError(t, s.confirm(t, result, err))
+		assert.NotEmpty(t, result.Hash)
+
+		requireOperationState(t, inspector, timelockAddress, opIDs[i], operationState{isOperation: true, done: true})
+	}
+}
+
+// operationState is the state of a timelock operation as reported by a TimelockInspector.
+type operationState struct {
+	isOperation bool
+	pending     bool
+	ready       bool
+	done        bool
+}
+
+// requireOperationState checks the state of the operation, and that it is consistent: an
+// operation is either pending or done, and only pending operations are ready.
+func requireOperationState(
+	t *testing.T, inspector sdk.TimelockInspector, address string, opID [32]byte, want operationState,
+) {
+	t.Helper()
+
+	ctx := t.Context()
+	var (
+		got operationState
+		err error
+	)
+	got.isOperation, err = inspector.IsOperation(ctx, address, opID)
+	require.NoError(t, err)
+	got.pending, err = inspector.IsOperationPending(ctx, address, opID)
+	require.NoError(t, err)
+	got.ready, err = inspector.IsOperationReady(ctx, address, opID)
+	require.NoError(t, err)
+	got.done, err = inspector.IsOperationDone(ctx, address, opID)
+	require.NoError(t, err)
+
+	require.Equal(t, got.isOperation, got.pending || got.done, "an operation must be either pending or done")
+	require.False(t, got.pending && got.done, "an operation cannot be both pending and done")
+	require.False(t, got.ready && !got.pending, "a ready operation must be pending")
+	require.Equalf(t, want, got, "state of operation %x", opID)
+}
+
+func containsAddress(addresses []string, address string) bool {
+	return slices.ContainsFunc(addresses, func(a string) bool { return strings.EqualFold(a, address) })
+}
+
+func validUntil() uint32 {
+	return uint32(time.Now().Add(24 * time.Hour).Unix()) //nolint:gosec // fits until 2106
+}
@@ -0,0 +1,17 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// EventFilterer is an interface for querying the events emitted by the MCMS and timelock
+// contracts of a chain.
+type EventFilterer interface {
+	// LatestBlock returns the latest block of the chain whose events can be queried, in the units
+	// of types.Event.Block.
+	LatestBlock(ctx context.Context) (uint64, error)
+	// FilterEvents returns the events selected by the query, ordered as they were emitted.
+	FilterEvents(ctx context.Context, query types.EventQuery) ([]types.Event, error)
+}
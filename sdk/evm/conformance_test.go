@@ -0,0 +1,91 @@
+package evm_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/internal/testutils/evmsim"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/conformance"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	sim := evmsim.NewSimulatedChain(t, 1)
+	signer := sim.Signers[0]
+	client := sim.Backend.Client()
+	// Calls to an account without code succeed
+	target := common.HexToAddress("0x1000")
+
+	conformance.Run(t, conformance.Harness{
+		ChainSelector: chaintest.Chain1Selector,
+		DeployMCM: func(t *testing.T) string {
+			t.Helper()
+
+			mcmC, _ := sim.DeployMCMContract(t, signer)
+
+			return mcmC.Address().Hex()
+		},
+		DeployTimelock: func(t *testing.T, mcmAddress string) string {
+			t.Helper()
+
+			mcm := []common.Address{common.HexToAddress(mcmAddress)}
+			timelockC, _ := sim.DeployRBACTimelock(t, signer, signer.Address(t), mcm, []common.Address{signer.Address(t)}, mcm, mcm)
+
+			return timelockC.Address().Hex()
+		},
+		NewExecutor: func(t *testing.T, encoder sdk.Encoder) sdk.Executor {
+			t.Helper()
+
+			evmEncoder, ok := encoder.(*evm.Encoder)
+			require.True(t, ok)
+
+			return evm.NewExecutor(evmEncoder, client, signer.NewTransactOpts(t))
+		},
+		NewInspector: func(*testing.T) sdk.Inspector { return evm.NewInspector(client) },
+		NewConfigurer: func(t *testing.T) sdk.Configurer {
+			t.Helper()
+
+			return evm.NewConfigurer(client, signer.NewTransactOpts(t))
+		},
+		NewTimelockConverter: func(*testing.T) sdk.TimelockConverter { return evm.NewTimelockConverter() },
+		NewTimelockExecutor: func(t *testing.T) sdk.TimelockExecutor {
+			t.Helper()
+
+			return evm.NewTimelockExecutor(client, signer.NewTransactOpts(t))
+		},
+		NewTimelockInspector: func(*testing.T) sdk.TimelockInspector { return evm.NewTimelockInspector(client) },
+		NewTransaction: func(*testing.T, string) types.Transaction {
+			return evm.NewTransaction(target, []byte{0x01}, big.NewInt(0), "Target", nil)
+		},
+		AdvanceTime: func(t *testing.T, d time.Duration) {
+			t.Helper()
+
+			require.NoError(t, sim.Backend.AdjustTime(d))
+		},
+		Confirm: func(t *testing.T, result types.TransactionResult) error {
+			t.Helper()
+
+			sim.Backend.Commit()
+			tx, ok := result.RawData.(*gethTypes.Transaction)
+			require.True(t, ok)
+			receipt, err := client.TransactionReceipt(t.Context(), tx.Hash())
+			require.NoError(t, err)
+			if receipt.Status != gethTypes.ReceiptStatusSuccessful {
+				return fmt.Errorf("transaction %s reverted", tx.Hash().Hex())
+			}
+
+			return nil
+		},
+	})
+}
@@ -0,0 +1,220 @@
+package evm
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.EventFilterer = (*EventFilterer)(nil)
+
+// EventFilterer is an EventFilterer implementation for EVM chains, filtering the logs of the
+// ManyChainMultiSig and RBACTimelock contracts with their bindings.
+type EventFilterer struct {
+	client        ContractDeployBackend
+	chainSelector types.ChainSelector
+}
+
+// NewEventFilterer creates a new EventFilterer for EVM chains
+func NewEventFilterer(client ContractDeployBackend, chainSelector types.ChainSelector) *EventFilterer {
+	return &EventFilterer{
+		client:        client,
+		chainSelector: chainSelector,
+	}
+}
+
+// LatestBlock returns the number of the latest block.
+func (f *EventFilterer) LatestBlock(ctx context.Context) (uint64, error) {
+	header, err := f.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest block header: %w", err)
+	}
+
+	return header.Number.Uint64(), nil
+}
+
+// FilterEvents returns the events of the query, ordered by block number and log index.
+func (f *EventFilterer) FilterEvents(ctx context.Context, query types.EventQuery) ([]types.Event, error) {
+	opts := &bind.FilterOpts{Start: query.FromBlock, End: &query.ToBlock, Context: ctx}
+
+	var events []types.Event
+	if query.MCMAddress != "" {
+		mcmEvents, err := f.filterMCMEvents(opts, query.MCMAddress)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, mcmEvents...)
+	}
+	if query.TimelockAddress != "" {
+		timelockEvents, err := f.filterTimelockEvents(opts, query.TimelockAddress)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, timelockEvents...)
+	}
+
+	slices.SortStableFunc(events, func(a, b types.Event) int {
+		return cmp.Or(cmp.Compare(a.Block, b.Block), cmp.Compare(a.Index, b.Index))
+	})
+
+	return events, nil
+}
+
+func (f *EventFilterer) filterMCMEvents(opts *bind.FilterOpts, address string) ([]types.Event, error) {
+	mcm, err := bindings.NewManyChainMultiSigFilterer(common.HexToAddress(address), f.client)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []types.Event
+
+	newRoots, err := mcm.FilterNewRoot(opts, nil)
+	err = collectEvents(&events, newRoots, err, func() (types.Event, error) {
+		event := f.newEvent(types.EventKindNewRoot, address, newRoots.Event.Raw, newRoots.Event)
+		event.Root = newRoots.Event.Root
+		event.ValidUntil = newRoots.Event.ValidUntil
+
+		return event, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter NewRoot events: %w", err)
+	}
+
+	opsExecuted, err := mcm.FilterOpExecuted(opts, nil)
+	err = collectEvents(&events, opsExecuted, err, func() (types.Event, error) {
+		if !opsExecuted.Event.Nonce.IsUint64() {
+			return types.Event{}, fmt.Errorf("nonce %s overflows uint64", opsExecuted.Event.Nonce)
+		}
+		event := f.newEvent(types.EventKindOpExecuted, address, opsExecuted.Event.Raw, opsExecuted.Event)
+		event.Nonce = opsExecuted.Event.Nonce.Uint64()
+
+		return event, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter OpExecuted events: %w", err)
+	}
+
+	configsSet, err := mcm.FilterConfigSet(opts)
+	err = collectEvents(&events, configsSet, err, func() (types.Event, error) {
+		event := f.newEvent(types.EventKindConfigSet, address, configsSet.Event.Raw, configsSet.Event)
+		event.IsRootCleared = configsSet.Event.IsRootCleared
+
+		return event, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter ConfigSet events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (f *EventFilterer) filterTimelockEvents(opts *bind.FilterOpts, address string) ([]types.Event, error) {
+	timelock, err := bindings.NewRBACTimelockFilterer(common.HexToAddress(address), f.client)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []types.Event
+
+	callsScheduled, err := timelock.FilterCallScheduled(opts, nil, nil)
+	err = collectEvents(&events, callsScheduled, err, func() (types.Event, error) {
+		if !callsScheduled.Event.Index.IsUint64() {
+			return types.Event{}, fmt.Errorf("call index %s overflows uint64", callsScheduled.Event.Index)
+		}
+		event := f.newEvent(types.EventKindCallScheduled, address, callsScheduled.Event.Raw, callsScheduled.Event)
+		event.OperationID = callsScheduled.Event.Id
+		event.CallIndex = callsScheduled.Event.Index.Uint64()
+
+		return event, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter CallScheduled events: %w", err)
+	}
+
+	callsExecuted, err := timelock.FilterCallExecuted(opts, nil, nil)
+	err = collectEvents(&events, callsExecuted, err, func() (types.Event, error) {
+		if !callsExecuted.Event.Index.IsUint64() {
+			return types.Event{}, fmt.Errorf("call index %s overflows uint64", callsExecuted.Event.Index)
+		}
+		event := f.newEvent(types.EventKindCallExecuted, address, callsExecuted.Event.Raw, callsExecuted.Event)
+		event.OperationID = callsExecuted.Event.Id
+		event.CallIndex = callsExecuted.Event.Index.Uint64()
+
+		return event, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter CallExecuted events: %w", err)
+	}
+
+	cancelled, err := timelock.FilterCancelled(opts, nil)
+	err = collectEvents(&events, cancelled, err, func() (types.Event, error) {
+		event := f.newEvent(types.EventKindCancelled, address, cancelled.Event.Raw, cancelled.Event)
+		event.OperationID = cancelled.Event.Id
+
+		return event, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter Cancelled events: %w", err)
+	}
+
+	rolesGranted, err := timelock.FilterRoleGranted(opts, nil, nil, nil)
+	err = collectEvents(&events, rolesGranted, err, func() (types.Event, error) {
+		event := f.newEvent(types.EventKindRoleGranted, address, rolesGranted.Event.Raw, rolesGranted.Event)
+		event.Role = rolesGranted.Event.Role
+		event.Account = rolesGranted.Event.Account.Hex()
+
+		return event, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter RoleGranted events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (f *EventFilterer) newEvent(kind types.EventKind, address string, log gethtypes.Log, rawData any) types.Event {
+	return types.Event{
+		Kind:          kind,
+		ChainSelector: f.chainSelector,
+		Address:       address,
+		Block:         log.BlockNumber,
+		TxHash:        log.TxHash.Hex(),
+		Index:         log.Index,
+		RawData:       rawData,
+	}
+}
+
+// eventIterator is implemented by the event iterators of the bindings.
+type eventIterator interface {
+	Next() bool
+	Error() error
+	Close() error
+}
+
+// collectEvents drains the iterator returned by a bindings filter call with filterErr, appending
+// the event built by toEvent for its current log to events.
+func collectEvents(events *[]types.Event, it eventIterator, filterErr error, toEvent func() (types.Event, error)) error {
+	if filterErr != nil {
+		return filterErr
+	}
+	defer it.Close()
+
+	for it.Next() {
+		event, err := toEvent()
+		if err != nil {
+			return err
+		}
+		*events = append(*events, event)
+	}
+
+	return it.Error()
+}
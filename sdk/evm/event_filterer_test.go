@@ -0,0 +1,94 @@
+package evm_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/internal/testutils/evmsim"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/evm/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestEventFilterer(t *testing.T) {
+	t.Parallel()
+
+	sim := evmsim.NewSimulatedChain(t, 1)
+	signer := sim.Signers[0]
+	client := sim.Backend.Client()
+
+	mcmC, _ := sim.DeployMCMContract(t, signer)
+	mcm := []common.Address{mcmC.Address()}
+	timelockC, _ := sim.DeployRBACTimelock(t, signer, signer.Address(t), mcm, []common.Address{signer.Address(t)}, nil, mcm)
+	configTx := sim.SetMCMSConfig(t, signer, mcmC)
+
+	filterer := evm.NewEventFilterer(client, chaintest.Chain1Selector)
+
+	latest, err := filterer.LatestBlock(t.Context())
+	require.NoError(t, err)
+	header, err := client.HeaderByNumber(t.Context(), nil)
+	require.NoError(t, err)
+	require.Equal(t, header.Number.Uint64(), latest)
+
+	query := types.EventQuery{
+		MCMAddress:      mcmC.Address().Hex(),
+		TimelockAddress: timelockC.Address().Hex(),
+		ToBlock:         latest,
+	}
+	events, err := filterer.FilterEvents(t.Context(), query)
+	require.NoError(t, err)
+
+	proposerRole, err := timelockC.PROPOSERROLE(nil)
+	require.NoError(t, err)
+	executorRole, err := timelockC.EXECUTORROLE(nil)
+	require.NoError(t, err)
+
+	var granted []types.Event
+	for _, event := range events {
+		require.Equal(t, chaintest.Chain1Selector, event.ChainSelector)
+		if event.Kind == types.EventKindRoleGranted {
+			require.Equal(t, timelockC.Address().Hex(), event.Address)
+			granted = append(granted, event)
+		}
+	}
+	requireRoleGranted(t, granted, proposerRole, mcmC.Address().Hex())
+	requireRoleGranted(t, granted, executorRole, signer.Address(t).Hex())
+
+	// The config is set after the timelock is deployed, so it is the last event.
+	configSet := events[len(events)-1]
+	require.Equal(t, types.EventKindConfigSet, configSet.Kind)
+	require.Equal(t, mcmC.Address().Hex(), configSet.Address)
+	require.Equal(t, latest, configSet.Block)
+	require.Equal(t, configTx.Hash().Hex(), configSet.TxHash)
+	require.False(t, configSet.IsRootCleared)
+	require.IsType(t, &bindings.ManyChainMultiSigConfigSet{}, configSet.RawData)
+
+	// Blocks outside the range are not filtered.
+	query.ToBlock = latest - 1
+	events, err = filterer.FilterEvents(t.Context(), query)
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	for _, event := range events {
+		require.NotEqual(t, types.EventKindConfigSet, event.Kind)
+	}
+
+	// The events of an omitted contract are not filtered.
+	events, err = filterer.FilterEvents(t.Context(), types.EventQuery{MCMAddress: mcmC.Address().Hex(), ToBlock: latest})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventKindConfigSet, events[0].Kind)
+}
+
+func requireRoleGranted(t *testing.T, events []types.Event, role [32]byte, account string) {
+	t.Helper()
+
+	for _, event := range events {
+		if event.Role == role && event.Account == account {
+			return
+		}
+	}
+	require.Failf(t, "role not granted", "no RoleGranted event for account %s", account)
+}
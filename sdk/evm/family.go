@@ -0,0 +1,182 @@
+package evm
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func init() {
+	if err := sdk.RegisterFamily(sdk.Family{
+		Name: chainsel.FamilyEVM,
+		NewEncoder: func(selector types.ChainSelector, txCount uint64, overridePreviousRoot bool, isSim bool) sdk.Encoder {
+			return NewEncoder(selector, txCount, overridePreviousRoot, isSim)
+		},
+		NewTimelockConverter: func(types.ChainSelector, types.ChainMetadata) (sdk.TimelockConverter, error) {
+			return NewTimelockConverter(), nil
+		},
+		NewExecutor:              buildEVMExecutor,
+		NewSimulator:             buildEVMSimulator,
+		NewInspector:             buildEVMInspector,
+		NewTimelockExecutor:      buildEVMTimelockExecutor,
+		NewTimelockInspector:     buildEVMTimelockInspector,
+		NewTimelockConfigurer:    buildEVMTimelockConfigurer,
+		NewEventFilterer:         buildEVMEventFilterer,
+		NewDecoder:               func() sdk.Decoder { return NewDecoder() },
+		OperationID:              OperationID,
+		ValidateAdditionalFields: ValidateAdditionalFields,
+		TimelockRoleMember: func(mcmAddress string) (string, error) {
+			return common.HexToAddress(mcmAddress).Hex(), nil
+		},
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func buildEVMExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, _ types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.Executor, error) {
+	rawSelector := uint64(chainSelector)
+	evmEncoder, ok := encoder.(*Encoder)
+	if !ok {
+		return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
+	}
+	client, ok := evmClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing evm chain client for selector %d", chainSelector)
+	}
+	auth, ok := evmSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing evm signer for selector %d", rawSelector)
+	}
+
+	evmChainMetadata, err := ParseChainMetadata(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse EVM chain metadata for selector %d: %w", rawSelector, err)
+	}
+	auth.GasPrice = evmChainMetadata.GasPrice
+	auth.GasLimit = evmChainMetadata.GasLimit
+
+	return NewExecutor(evmEncoder, client, auth), nil
+}
+
+func buildEVMSimulator(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Simulator, error) {
+	rawSelector := uint64(chainSelector)
+	evmEncoder, ok := encoder.(*Encoder)
+	if !ok {
+		return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
+	}
+	client, ok := evmClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing evm chain client for selector %d", chainSelector)
+	}
+
+	return NewSimulator(evmEncoder, client)
+}
+
+func buildEVMInspector(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Inspector, error) {
+	rawSelector := uint64(selector)
+	client, ok := evmClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing EVM chain client for selector %d", rawSelector)
+	}
+
+	return NewInspector(client), nil
+}
+
+func buildEVMTimelockExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, _ types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.TimelockExecutor, error) {
+	rawSelector := uint64(chainSelector)
+	client, ok := evmClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing evm chain client for selector %d", chainSelector)
+	}
+	auth, ok := evmSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing evm signer for selector %d", rawSelector)
+	}
+
+	evmChainMetadata, err := ParseChainMetadata(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse EVM chain metadata for selector %d: %w", rawSelector, err)
+	}
+	auth.GasPrice = evmChainMetadata.GasPrice
+	auth.GasLimit = evmChainMetadata.GasLimit
+
+	return NewTimelockExecutor(client, auth), nil
+}
+
+func buildEVMTimelockInspector(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, _ types.ChainMetadata,
+) (sdk.TimelockInspector, error) {
+	rawSelector := uint64(chainSelector)
+	client, ok := evmClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing EVM chain client for selector %d", rawSelector)
+	}
+
+	return NewTimelockInspector(client), nil
+}
+
+func buildEVMTimelockConfigurer(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.TimelockConfigurer, error) {
+	rawSelector := uint64(selector)
+	client, ok := evmClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing EVM chain client for selector %d", rawSelector)
+	}
+	signer, ok := evmSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing EVM chain signer for selector %d", rawSelector)
+	}
+
+	return NewTimelockConfigurer(client, signer), nil
+}
+
+func buildEVMEventFilterer(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.EventFilterer, error) {
+	rawSelector := uint64(selector)
+	client, ok := evmClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing EVM chain client for selector %d", rawSelector)
+	}
+
+	return NewEventFilterer(client, selector), nil
+}
+
+// ChainAccessor is implemented by a sdk.ChainAccessor to serve EVM chains.
+type ChainAccessor interface {
+	EVMClient(selector uint64) (ContractDeployBackend, bool)
+	EVMSigner(selector uint64) (*TransactOpts, bool)
+}
+
+// evmClient returns the EVM client of the selector if the ChainAccessor serves EVM chains.
+func evmClient(chains sdk.ChainAccessor, selector uint64) (ContractDeployBackend, bool) {
+	evmChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return evmChains.EVMClient(selector)
+}
+
+// evmSigner returns the EVM signer of the selector if the ChainAccessor serves EVM chains.
+func evmSigner(chains sdk.ChainAccessor, selector uint64) (*TransactOpts, bool) {
+	evmChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return evmChains.EVMSigner(selector)
+}
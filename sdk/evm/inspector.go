@@ -12,6 +12,7 @@ import (
 )
 
 var _ sdk.Inspector = (*Inspector)(nil)
+var _ sdk.PostOpCountInspector = (*Inspector)(nil)
 
 // Inspector is an Inspector implementation for EVM chains, giving access to the state of the MCMS contract
 type Inspector struct {
@@ -85,3 +86,18 @@ func (e *Inspector) GetRootMetadata(ctx context.Context, address string) (types.
 		MCMAddress:      address,
 	}, nil
 }
+
+// GetRootPostOpCount returns the post-op count of the root held by the MCM.
+func (e *Inspector) GetRootPostOpCount(ctx context.Context, address string) (uint64, error) {
+	mcmsObj, err := bindings.NewManyChainMultiSig(common.HexToAddress(address), e.client)
+	if err != nil {
+		return 0, err
+	}
+
+	metadata, err := mcmsObj.GetRootMetadata(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, err
+	}
+
+	return metadata.PostOpCount.Uint64(), nil
+}
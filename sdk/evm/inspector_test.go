@@ -353,3 +353,26 @@ func TestInspector_GetRootMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestInspector_GetRootPostOpCount(t *testing.T) {
+	t.Parallel()
+
+	address := "0x1234567890abcdef1234567890abcdef12345678"
+	parsedABI, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	require.NoError(t, err)
+	encodedMetadata, err := parsedABI.Methods["getRootMetadata"].Outputs.Pack(bindings.ManyChainMultiSigRootMetadata{
+		ChainId:     big.NewInt(1),
+		MultiSig:    common.HexToAddress(address),
+		PreOpCount:  big.NewInt(123),
+		PostOpCount: big.NewInt(456),
+	})
+	require.NoError(t, err)
+
+	mockClient := evm_mocks.NewContractDeployBackend(t)
+	mockClient.EXPECT().CallContract(mock.Anything, mock.IsType(ethereum.CallMsg{}), mock.IsType(&big.Int{})).
+		Return(encodedMetadata, nil)
+
+	got, err := NewInspector(mockClient).GetRootPostOpCount(t.Context(), address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(456), got)
+}
@@ -0,0 +1,57 @@
+package evm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/mcms/sdk"
+)
+
+var _ sdk.TransactionChecker = (*TransactionChecker)(nil)
+
+// TransactionReader is the subset of ethclient.Client used by the TransactionChecker.
+type TransactionReader interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*gethtypes.Receipt, error)
+	TransactionByHash(ctx context.Context, txHash common.Hash) (*gethtypes.Transaction, bool, error)
+}
+
+// TransactionChecker looks up the state of EVM transactions from their receipt, falling back to
+// the transaction pool of the node for transactions which are not mined yet.
+type TransactionChecker struct {
+	client TransactionReader
+}
+
+// NewTransactionChecker creates a new TransactionChecker.
+func NewTransactionChecker(client TransactionReader) *TransactionChecker {
+	return &TransactionChecker{client: client}
+}
+
+func (c *TransactionChecker) TransactionState(ctx context.Context, txHash string) (sdk.TransactionState, error) {
+	hash := common.HexToHash(txHash)
+
+	receipt, err := c.client.TransactionReceipt(ctx, hash)
+	if err == nil {
+		if receipt.Status != gethtypes.ReceiptStatusSuccessful {
+			return sdk.TransactionStateFailed, nil
+		}
+
+		return sdk.TransactionStateSucceeded, nil
+	}
+	if !errors.Is(err, ethereum.NotFound) {
+		return "", err
+	}
+
+	_, _, err = c.client.TransactionByHash(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return sdk.TransactionStateNotFound, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return sdk.TransactionStatePending, nil
+}
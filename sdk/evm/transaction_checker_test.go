@@ -0,0 +1,84 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk"
+)
+
+type fakeTransactionReader struct {
+	receipt    *gethtypes.Receipt
+	receiptErr error
+	txErr      error
+}
+
+func (r fakeTransactionReader) TransactionReceipt(context.Context, common.Hash) (*gethtypes.Receipt, error) {
+	return r.receipt, r.receiptErr
+}
+
+func (r fakeTransactionReader) TransactionByHash(context.Context, common.Hash) (*gethtypes.Transaction, bool, error) {
+	return nil, true, r.txErr
+}
+
+func TestTransactionChecker_TransactionState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		reader  fakeTransactionReader
+		want    sdk.TransactionState
+		wantErr string
+	}{
+		{
+			name:   "success: succeeded",
+			reader: fakeTransactionReader{receipt: &gethtypes.Receipt{Status: gethtypes.ReceiptStatusSuccessful}},
+			want:   sdk.TransactionStateSucceeded,
+		},
+		{
+			name:   "success: reverted",
+			reader: fakeTransactionReader{receipt: &gethtypes.Receipt{Status: gethtypes.ReceiptStatusFailed}},
+			want:   sdk.TransactionStateFailed,
+		},
+		{
+			name:   "success: pending",
+			reader: fakeTransactionReader{receiptErr: ethereum.NotFound},
+			want:   sdk.TransactionStatePending,
+		},
+		{
+			name:   "success: dropped",
+			reader: fakeTransactionReader{receiptErr: ethereum.NotFound, txErr: ethereum.NotFound},
+			want:   sdk.TransactionStateNotFound,
+		},
+		{
+			name:    "failure: receipt error",
+			reader:  fakeTransactionReader{receiptErr: errors.New("rpc down")},
+			wantErr: "rpc down",
+		},
+		{
+			name:    "failure: transaction error",
+			reader:  fakeTransactionReader{receiptErr: ethereum.NotFound, txErr: errors.New("rpc down")},
+			wantErr: "rpc down",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, err := NewTransactionChecker(tt.reader).TransactionState(t.Context(), "0x01")
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, state)
+		})
+	}
+}
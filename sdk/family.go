@@ -0,0 +1,189 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// ChainAccessor serves the clients and signers of the chains to the Family hooks. Each family
+// package declares the interface serving its chains, e.g. evm.ChainAccessor, and its hooks
+// type-assert the ChainAccessor to it.
+type ChainAccessor interface {
+	Selectors() []uint64
+}
+
+// FamilyChainAccessor is optionally implemented by a ChainAccessor to serve the chains of
+// families registered out of this repository, which have no dedicated accessor interface. The
+// hooks of such a family type-assert the ChainAccessor, then the returned client and signer.
+type FamilyChainAccessor interface {
+	FamilyClient(selector uint64) (any, bool)
+	FamilySigner(selector uint64) (any, bool)
+}
+
+// EncoderFactory creates the Encoder of a chain. isSim is only honored by families supporting
+// simulated chains.
+type EncoderFactory func(selector types.ChainSelector, txCount uint64, overridePreviousRoot bool, isSim bool) Encoder
+
+// ConverterFactory creates the TimelockConverter of a chain. The metadata selects the converter
+// variant where a family has several (e.g. curse_mcms on Aptos).
+type ConverterFactory func(selector types.ChainSelector, metadata types.ChainMetadata) (TimelockConverter, error)
+
+// ExecutorFactory creates the Executor of a chain from the ChainAccessor clients.
+type ExecutorFactory func(
+	chains ChainAccessor, selector types.ChainSelector, encoder Encoder, action types.TimelockAction, metadata types.ChainMetadata,
+) (Executor, error)
+
+// SimulatorFactory creates the Simulator of a chain from the ChainAccessor clients.
+type SimulatorFactory func(
+	chains ChainAccessor, selector types.ChainSelector, encoder Encoder, action types.TimelockAction, metadata types.ChainMetadata,
+) (Simulator, error)
+
+// InspectorFactory creates the Inspector of a chain from the ChainAccessor clients.
+type InspectorFactory func(
+	chains ChainAccessor, selector types.ChainSelector, action types.TimelockAction, metadata types.ChainMetadata,
+) (Inspector, error)
+
+// TimelockExecutorFactory creates the TimelockExecutor of a chain from the ChainAccessor clients.
+type TimelockExecutorFactory func(
+	chains ChainAccessor, selector types.ChainSelector, action types.TimelockAction, metadata types.ChainMetadata,
+) (TimelockExecutor, error)
+
+// TimelockInspectorFactory creates the TimelockInspector of a chain from the ChainAccessor clients.
+type TimelockInspectorFactory func(
+	chains ChainAccessor, selector types.ChainSelector, metadata types.ChainMetadata,
+) (TimelockInspector, error)
+
+// TimelockConfigurerFactory creates the TimelockConfigurer of a chain from the ChainAccessor clients.
+type TimelockConfigurerFactory func(
+	chains ChainAccessor, selector types.ChainSelector, action types.TimelockAction, metadata types.ChainMetadata,
+) (TimelockConfigurer, error)
+
+// EventFiltererFactory creates the EventFilterer of a chain from the ChainAccessor clients. The
+// action selects the MCMS role on families filtering the events of a single role.
+type EventFiltererFactory func(
+	chains ChainAccessor, selector types.ChainSelector, action types.TimelockAction, metadata types.ChainMetadata,
+) (EventFilterer, error)
+
+// Family bundles everything MCMS needs to know about a chain family. Family packages register
+// it from their init function, and the chainwrappers Build* functions, the proposal encoders and
+// the proposal validation look it up by the chain-selectors family of a selector.
+//
+// Hooks left nil mark the capability as unsupported by the family.
+type Family struct {
+	// Name is the chain-selectors family name, e.g. chainsel.FamilyEVM.
+	Name string
+
+	NewEncoder            EncoderFactory
+	NewTimelockConverter  ConverterFactory
+	NewExecutor           ExecutorFactory
+	NewSimulator          SimulatorFactory
+	NewInspector          InspectorFactory
+	NewTimelockExecutor   TimelockExecutorFactory
+	NewTimelockInspector  TimelockInspectorFactory
+	NewTimelockConfigurer TimelockConfigurerFactory
+	NewEventFilterer      EventFiltererFactory
+	NewDecoder            func() Decoder
+
+	// OperationID computes the timelock operation ID of a batch operation.
+	OperationID OperationID
+
+	// ValidateAdditionalFields validates the additional fields of a transaction. Additional
+	// fields are not validated when nil.
+	ValidateAdditionalFields func(additionalFields json.RawMessage) error
+	// ValidateChainMetadata validates the chain metadata of a proposal. Chain metadata is not
+	// validated when nil.
+	ValidateChainMetadata func(metadata types.ChainMetadata) error
+
+	// TimelockRoleMember returns the account holding the timelock roles on behalf of the MCM at
+	// mcmAddress, as listed by the TimelockInspector. The timelock roles of the MCM are not
+	// preflight checked when nil.
+	TimelockRoleMember func(mcmAddress string) (string, error)
+}
+
+// registry holds the registered chain families keyed by name.
+type registry struct {
+	mu       sync.RWMutex
+	families map[string]Family
+}
+
+func newRegistry() *registry {
+	return &registry{families: map[string]Family{}}
+}
+
+func (r *registry) register(family Family) error {
+	if family.Name == "" {
+		return fmt.Errorf("chain family name is required")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.families[family.Name]; ok {
+		return fmt.Errorf("chain family %s is already registered", family.Name)
+	}
+	r.families[family.Name] = family
+
+	return nil
+}
+
+func (r *registry) replace(family Family) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.families[family.Name]; !ok {
+		return fmt.Errorf("chain family %s is not registered", family.Name)
+	}
+	r.families[family.Name] = family
+
+	return nil
+}
+
+func (r *registry) lookup(name string) (Family, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	family, ok := r.families[name]
+
+	return family, ok
+}
+
+func (r *registry) names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.families))
+	for name := range r.families {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+var families = newRegistry()
+
+// RegisterFamily makes a chain family available to MCMS. It returns an error if the name is
+// empty or a family with the same name is already registered, see ReplaceFamily.
+func RegisterFamily(family Family) error {
+	return families.register(family)
+}
+
+// ReplaceFamily replaces the hooks of a registered chain family, e.g. to serve some of its chains
+// through another stack. It returns an error if no family with the same name is registered.
+func ReplaceFamily(family Family) error {
+	return families.replace(family)
+}
+
+// LookupFamily returns the registered chain family with the given chain-selectors family name.
+func LookupFamily(name string) (Family, bool) {
+	return families.lookup(name)
+}
+
+// RegisteredFamilies returns the sorted names of the registered chain families.
+func RegisteredFamilies() []string {
+	return families.names()
+}
@@ -0,0 +1,42 @@
+package sdk
+
+import (
+	"testing"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	r := newRegistry()
+
+	require.EqualError(t, r.register(Family{}), "chain family name is required")
+
+	require.NoError(t, r.register(Family{Name: chainsel.FamilyStarknet}))
+	require.NoError(t, r.register(Family{Name: chainsel.FamilyEVM}))
+	require.EqualError(t, r.register(Family{Name: chainsel.FamilyEVM}), "chain family evm is already registered")
+
+	_, ok := r.lookup(chainsel.FamilyStarknet)
+	require.True(t, ok)
+	_, ok = r.lookup(chainsel.FamilyTron)
+	require.False(t, ok)
+
+	require.Equal(t, []string{chainsel.FamilyEVM, chainsel.FamilyStarknet}, r.names())
+}
+
+func TestRegistry_Replace(t *testing.T) {
+	t.Parallel()
+
+	r := newRegistry()
+
+	require.EqualError(t, r.replace(Family{Name: chainsel.FamilyTron}), "chain family tron is not registered")
+
+	require.NoError(t, r.register(Family{Name: chainsel.FamilyTron}))
+	require.NoError(t, r.replace(Family{Name: chainsel.FamilyTron, NewDecoder: func() Decoder { return nil }}))
+
+	family, ok := r.lookup(chainsel.FamilyTron)
+	require.True(t, ok)
+	require.NotNil(t, family.NewDecoder)
+}
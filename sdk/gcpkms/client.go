@@ -0,0 +1,98 @@
+// Package gcpkms adapts the GCP Cloud KMS client to the mcms.KMSClient interface, to sign proposals
+// with a GCP KMS key version of the EC_SIGN_SECP256K1_SHA256 algorithm through the mcms.KMSSigner.
+package gcpkms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+
+	kms "cloud.google.com/go/kms/apiv1"
+	"cloud.google.com/go/kms/apiv1/kmspb"
+	"github.com/googleapis/gax-go/v2"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/smartcontractkit/mcms"
+)
+
+// API is the subset of the GCP KMS API used by the Client, implemented by *kms.KeyManagementClient.
+type API interface {
+	AsymmetricSign(
+		ctx context.Context, req *kmspb.AsymmetricSignRequest, opts ...gax.CallOption,
+	) (*kmspb.AsymmetricSignResponse, error)
+	GetPublicKey(ctx context.Context, req *kmspb.GetPublicKeyRequest, opts ...gax.CallOption) (*kmspb.PublicKey, error)
+}
+
+var _ API = (*kms.KeyManagementClient)(nil)
+
+var _ mcms.KMSClient = (*Client)(nil)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// Client signs with a single GCP KMS key version.
+type Client struct {
+	api        API
+	keyVersion string
+}
+
+// NewClient creates a new Client for the key version with the given resource name, of the form
+// projects/*/locations/*/keyRings/*/cryptoKeys/*/cryptoKeyVersions/*.
+func NewClient(api API, keyVersion string) (*Client, error) {
+	if lo.IsNil(api) {
+		return nil, errors.New("GCP KMS API client is nil")
+	}
+	if keyVersion == "" {
+		return nil, errors.New("GCP KMS key version is empty")
+	}
+
+	return &Client{api: api, keyVersion: keyVersion}, nil
+}
+
+// Sign signs the 32 byte digest and returns the ASN.1 DER encoded signature.
+//
+// The CRC32C checksum of the digest is sent for the KMS to verify, and the checksum of the
+// signature is verified when the response has one.
+func (c *Client) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	resp, err := c.api.AsymmetricSign(ctx, &kmspb.AsymmetricSignRequest{
+		Name:         c.keyVersion,
+		Digest:       &kmspb.Digest{Digest: &kmspb.Digest_Sha256{Sha256: digest}},
+		DigestCrc32C: checksum(digest),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with GCP KMS key %s: %w", c.keyVersion, err)
+	}
+	if !validChecksum(resp.GetSignature(), resp.GetSignatureCrc32C()) {
+		return nil, fmt.Errorf("invalid signature checksum from GCP KMS key %s", c.keyVersion)
+	}
+
+	return resp.GetSignature(), nil
+}
+
+// PublicKey returns the PEM encoded SubjectPublicKeyInfo of the key version.
+func (c *Client) PublicKey(ctx context.Context) ([]byte, error) {
+	resp, err := c.api.GetPublicKey(ctx, &kmspb.GetPublicKeyRequest{Name: c.keyVersion})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key of GCP KMS key %s: %w", c.keyVersion, err)
+	}
+	algorithm := kmspb.CryptoKeyVersion_EC_SIGN_SECP256K1_SHA256
+	if resp.GetAlgorithm() != algorithm {
+		return nil, fmt.Errorf("unsupported GCP KMS key algorithm %s, want %s", resp.GetAlgorithm(), algorithm)
+	}
+	pem := []byte(resp.GetPem())
+	if !validChecksum(pem, resp.GetPemCrc32C()) {
+		return nil, fmt.Errorf("invalid public key checksum from GCP KMS key %s", c.keyVersion)
+	}
+
+	return pem, nil
+}
+
+func checksum(b []byte) *wrapperspb.Int64Value {
+	return wrapperspb.Int64(int64(crc32.Checksum(b, crc32c)))
+}
+
+// validChecksum reports whether b matches the CRC32C checksum, if any.
+func validChecksum(b []byte, crc *wrapperspb.Int64Value) bool {
+	return crc == nil || crc.GetValue() == checksum(b).GetValue()
+}
@@ -0,0 +1,221 @@
+package gcpkms
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"testing"
+
+	kms "cloud.google.com/go/kms/apiv1"
+	"cloud.google.com/go/kms/apiv1/kmspb"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/smartcontractkit/mcms"
+)
+
+const (
+	testPrivateKeyHex = "b17c4c6a409cebce4b39977689180900d9009d5c55a57ff9fd9cb962b24ae99d"
+	testKeyVersion    = "projects/mcms/locations/global/keyRings/mcms/cryptoKeys/signer/cryptoKeyVersions/1"
+)
+
+// fakeKMSServer implements the GCP KMS gRPC service with a local key, encoding its signatures and
+// public key as GCP KMS does.
+type fakeKMSServer struct {
+	kmspb.UnimplementedKeyManagementServiceServer
+
+	pk           *ecdsa.PrivateKey
+	algorithm    kmspb.CryptoKeyVersion_CryptoKeyVersionAlgorithm
+	badChecksums bool
+	err          error
+}
+
+func (s *fakeKMSServer) AsymmetricSign(
+	_ context.Context, req *kmspb.AsymmetricSignRequest,
+) (*kmspb.AsymmetricSignResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if req.GetName() != testKeyVersion {
+		return nil, errors.New("key version not found")
+	}
+	digest := req.GetDigest().GetSha256()
+	if req.GetDigestCrc32C().GetValue() != checksum(digest).GetValue() {
+		return nil, errors.New("digest checksum mismatch")
+	}
+
+	sig, err := crypto.Sign(digest, s.pk)
+	if err != nil {
+		return nil, err
+	}
+	der, err := asn1.Marshal(struct{ R, S *big.Int }{
+		new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &kmspb.AsymmetricSignResponse{
+		Name:                 req.GetName(),
+		Signature:            der,
+		SignatureCrc32C:      s.checksum(der),
+		VerifiedDigestCrc32C: true,
+	}, nil
+}
+
+func (s *fakeKMSServer) GetPublicKey(_ context.Context, req *kmspb.GetPublicKeyRequest) (*kmspb.PublicKey, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if req.GetName() != testKeyVersion {
+		return nil, errors.New("key version not found")
+	}
+
+	point := crypto.FromECDSAPub(&s.pk.PublicKey)
+	der, err := asn1.Marshal(struct {
+		Algorithm struct {
+			Algorithm  asn1.ObjectIdentifier
+			Parameters asn1.ObjectIdentifier
+		}
+		PublicKey asn1.BitString
+	}{
+		Algorithm: struct {
+			Algorithm  asn1.ObjectIdentifier
+			Parameters asn1.ObjectIdentifier
+		}{asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}, asn1.ObjectIdentifier{1, 3, 132, 0, 10}},
+		PublicKey: asn1.BitString{Bytes: point, BitLength: 8 * len(point)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	return &kmspb.PublicKey{
+		Name:      req.GetName(),
+		Pem:       string(pemKey),
+		PemCrc32C: s.checksum(pemKey),
+		Algorithm: s.algorithm,
+	}, nil
+}
+
+func (s *fakeKMSServer) checksum(b []byte) *wrapperspb.Int64Value {
+	if s.badChecksums {
+		return wrapperspb.Int64(0)
+	}
+
+	return checksum(b)
+}
+
+// newTestClient serves the fake server in memory and returns a GCP KMS client connected to it.
+func newTestClient(t *testing.T, server *fakeKMSServer) *kms.KeyManagementClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	kmspb.RegisterKeyManagementServiceServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	client, err := kms.NewKeyManagementClient(t.Context(), option.WithGRPCConn(conn))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(nil, testKeyVersion)
+	require.EqualError(t, err, "GCP KMS API client is nil")
+
+	_, err = NewClient((*kms.KeyManagementClient)(nil), testKeyVersion)
+	require.EqualError(t, err, "GCP KMS API client is nil")
+
+	_, err = NewClient(&kms.KeyManagementClient{}, "")
+	require.EqualError(t, err, "GCP KMS key version is empty")
+}
+
+func TestClient_KMSSigner(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	payload := []byte("0x000000000000000000000000000000")
+	want, err := mcms.NewPrivateKeySigner(privKey).Sign(payload)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		server  *fakeKMSServer
+		wantErr string
+	}{
+		{
+			name:   "success: signs the proposal",
+			server: &fakeKMSServer{pk: privKey, algorithm: kmspb.CryptoKeyVersion_EC_SIGN_SECP256K1_SHA256},
+		},
+		{
+			name:    "failure: unsupported algorithm",
+			server:  &fakeKMSServer{pk: privKey, algorithm: kmspb.CryptoKeyVersion_EC_SIGN_P256_SHA256},
+			wantErr: "failed to get KMS public key: unsupported GCP KMS key algorithm EC_SIGN_P256_SHA256, want EC_SIGN_SECP256K1_SHA256",
+		},
+		{
+			name: "failure: invalid checksum",
+			server: &fakeKMSServer{
+				pk: privKey, algorithm: kmspb.CryptoKeyVersion_EC_SIGN_SECP256K1_SHA256, badChecksums: true,
+			},
+			wantErr: "failed to get KMS public key: invalid public key checksum from GCP KMS key " + testKeyVersion,
+		},
+		{
+			name:    "failure: KMS error",
+			server:  &fakeKMSServer{pk: privKey, err: errors.New("permission denied")},
+			wantErr: "failed to get KMS public key: failed to get public key of GCP KMS key " + testKeyVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewClient(newTestClient(t, tt.server), testKeyVersion)
+			require.NoError(t, err)
+
+			got, err := mcms.NewKMSSigner(client).Sign(payload)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestClient_Sign_InvalidChecksum(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	server := &fakeKMSServer{pk: privKey, badChecksums: true}
+	client, err := NewClient(newTestClient(t, server), testKeyVersion)
+	require.NoError(t, err)
+
+	_, err = client.Sign(t.Context(), make([]byte, 32))
+	require.EqualError(t, err, "invalid signature checksum from GCP KMS key "+testKeyVersion)
+}
@@ -0,0 +1,54 @@
+//go:build kmsemulator
+
+package gcpkms
+
+import (
+	"os"
+	"testing"
+
+	kms "cloud.google.com/go/kms/apiv1"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/smartcontractkit/mcms"
+)
+
+// TestKMSSigner_Emulator signs with the EC_SIGN_SECP256K1_SHA256 key version GCP_KMS_KEY_VERSION
+// of a local GCP KMS emulator listening on GCP_KMS_ENDPOINT (localhost:9010 by default).
+func TestKMSSigner_Emulator(t *testing.T) {
+	t.Parallel()
+
+	endpoint := os.Getenv("GCP_KMS_ENDPOINT")
+	if endpoint == "" {
+		endpoint = "localhost:9010"
+	}
+	keyVersion := os.Getenv("GCP_KMS_KEY_VERSION")
+	require.NotEmpty(t, keyVersion, "GCP_KMS_KEY_VERSION must be set")
+
+	api, err := kms.NewKeyManagementClient(t.Context(),
+		option.WithEndpoint(endpoint),
+		option.WithoutAuthentication(),
+		option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = api.Close() })
+
+	client, err := NewClient(api, keyVersion)
+	require.NoError(t, err)
+	signer := mcms.NewKMSSigner(client)
+
+	addr, err := signer.GetAddress()
+	require.NoError(t, err)
+
+	payload := []byte("0x000000000000000000000000000000")
+	sig, err := signer.Sign(payload)
+	require.NoError(t, err)
+
+	recovered, err := crypto.SigToPub(accounts.TextHash(payload), sig)
+	require.NoError(t, err)
+	require.Equal(t, addr, crypto.PubkeyToAddress(*recovered))
+}
@@ -15,3 +15,9 @@ type Inspector interface {
 	GetRoot(ctx context.Context, mcmAddr string) (common.Hash, uint32, error)
 	GetRootMetadata(ctx context.Context, mcmAddr string) (types.ChainMetadata, error)
 }
+
+// PostOpCountInspector is optionally implemented by an Inspector to read the post-op count of the
+// root held by an MCM, the op count reached once all the operations of the root are executed.
+type PostOpCountInspector interface {
+	GetRootPostOpCount(ctx context.Context, mcmAddr string) (uint64, error)
+}
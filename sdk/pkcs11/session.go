@@ -0,0 +1,229 @@
+// Package pkcs11 adapts github.com/miekg/pkcs11 to the mcms.PKCS11Session interface, to sign
+// proposals with a secp256k1 key pair held in a PKCS#11 token, such as an HSM or SoftHSM, through
+// the mcms.PKCS11Signer.
+package pkcs11
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	p11 "github.com/miekg/pkcs11"
+
+	"github.com/smartcontractkit/mcms"
+)
+
+// module is the subset of the PKCS#11 API used by the Session, implemented by *p11.Ctx.
+type module interface {
+	Initialize(opts ...p11.InitializeOption) error
+	Finalize() error
+	Destroy()
+	GetSlotList(tokenPresent bool) ([]uint, error)
+	GetTokenInfo(slotID uint) (p11.TokenInfo, error)
+	OpenSession(slotID uint, flags uint) (p11.SessionHandle, error)
+	CloseSession(sh p11.SessionHandle) error
+	Login(sh p11.SessionHandle, userType uint, pin string) error
+	Logout(sh p11.SessionHandle) error
+	FindObjectsInit(sh p11.SessionHandle, temp []*p11.Attribute) error
+	FindObjects(sh p11.SessionHandle, maxObjects int) ([]p11.ObjectHandle, bool, error)
+	FindObjectsFinal(sh p11.SessionHandle) error
+	SignInit(sh p11.SessionHandle, m []*p11.Mechanism, o p11.ObjectHandle) error
+	Sign(sh p11.SessionHandle, message []byte) ([]byte, error)
+	GetAttributeValue(sh p11.SessionHandle, o p11.ObjectHandle, a []*p11.Attribute) ([]*p11.Attribute, error)
+}
+
+var _ module = (*p11.Ctx)(nil)
+
+var _ mcms.PKCS11Session = (*Session)(nil)
+
+// Config identifies a key pair in a PKCS#11 token.
+type Config struct {
+	// ModulePath is the path of the PKCS#11 module (shared library) of the token,
+	// e.g. /usr/lib/softhsm/libsofthsm2.so.
+	ModulePath string
+	// TokenLabel is the label of the token holding the key pair.
+	TokenLabel string
+	// PIN is the user PIN of the token.
+	PIN string
+	// KeyLabel is the CKA_LABEL of the private and public key objects.
+	KeyLabel string
+}
+
+// Session signs with a secp256k1 key pair in a logged in PKCS#11 session.
+//
+// PKCS#11 sessions must not be used concurrently, so the Session serializes its calls.
+type Session struct {
+	mu         sync.Mutex
+	module     module
+	session    p11.SessionHandle
+	privateKey p11.ObjectHandle
+	publicKey  p11.ObjectHandle
+
+	// owned is set when the Session opened the module and session, and closes them on Close.
+	owned bool
+}
+
+// NewSession creates a new Session on an already open and logged in session of the module,
+// with the handles of the private and public key objects. Closing the session remains up to the
+// caller.
+func NewSession(ctx *p11.Ctx, session p11.SessionHandle, privateKey, publicKey p11.ObjectHandle) *Session {
+	return &Session{module: ctx, session: session, privateKey: privateKey, publicKey: publicKey}
+}
+
+// Open loads the PKCS#11 module, opens a session on the token and logs in, and finds the key pair
+// given by the config. The returned Session must be closed with Close.
+//
+// PKCS#11 modules are initialized once per process, so only one Session can be opened with Open
+// on a module at a time; use NewSession to share an initialized module between sessions.
+func Open(cfg Config) (*Session, error) {
+	ctx := p11.New(cfg.ModulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("failed to load PKCS#11 module %s", cfg.ModulePath)
+	}
+
+	return open(ctx, cfg)
+}
+
+func open(m module, cfg Config) (*Session, error) {
+	if err := m.Initialize(); err != nil {
+		m.Destroy()
+
+		return nil, fmt.Errorf("failed to initialize PKCS#11 module: %w", err)
+	}
+
+	s := &Session{module: m, owned: true}
+	if err := s.open(cfg); err != nil {
+		return nil, errors.Join(err, s.Close())
+	}
+
+	return s, nil
+}
+
+func (s *Session) open(cfg Config) error {
+	slot, err := s.findSlot(cfg.TokenLabel)
+	if err != nil {
+		return err
+	}
+
+	s.session, err = s.module.OpenSession(slot, p11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return fmt.Errorf("failed to open PKCS#11 session: %w", err)
+	}
+	err = s.module.Login(s.session, p11.CKU_USER, cfg.PIN)
+	if err != nil && !errors.Is(err, p11.Error(p11.CKR_USER_ALREADY_LOGGED_IN)) {
+		return fmt.Errorf("failed to log in to PKCS#11 token %s: %w", cfg.TokenLabel, err)
+	}
+
+	s.privateKey, err = s.findKey(p11.CKO_PRIVATE_KEY, cfg.KeyLabel)
+	if err != nil {
+		return err
+	}
+	s.publicKey, err = s.findKey(p11.CKO_PUBLIC_KEY, cfg.KeyLabel)
+
+	return err
+}
+
+func (s *Session) findSlot(tokenLabel string) (uint, error) {
+	slots, err := s.module.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list PKCS#11 slots: %w", err)
+	}
+	for _, slot := range slots {
+		info, ierr := s.module.GetTokenInfo(slot)
+		if ierr != nil {
+			return 0, fmt.Errorf("failed to get PKCS#11 token info of slot %d: %w", slot, ierr)
+		}
+		if info.Label == tokenLabel {
+			return slot, nil
+		}
+	}
+
+	return 0, fmt.Errorf("PKCS#11 token %s not found", tokenLabel)
+}
+
+func (s *Session) findKey(class uint, label string) (p11.ObjectHandle, error) {
+	template := []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, class),
+		p11.NewAttribute(p11.CKA_KEY_TYPE, p11.CKK_EC),
+		p11.NewAttribute(p11.CKA_LABEL, label),
+	}
+	if err := s.module.FindObjectsInit(s.session, template); err != nil {
+		return 0, fmt.Errorf("failed to find PKCS#11 key %s: %w", label, err)
+	}
+	objects, _, err := s.module.FindObjects(s.session, 2)
+	if ferr := s.module.FindObjectsFinal(s.session); err == nil {
+		err = ferr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to find PKCS#11 key %s: %w", label, err)
+	}
+
+	switch len(objects) {
+	case 0:
+		return 0, fmt.Errorf("PKCS#11 key %s not found", label)
+	case 1:
+		return objects[0], nil
+	default:
+		return 0, fmt.Errorf("multiple PKCS#11 keys labeled %s", label)
+	}
+}
+
+// SignDigest signs the 32 byte digest with the CKM_ECDSA mechanism and returns the 64 byte
+// R || S signature.
+func (s *Session) SignDigest(digest []byte) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mechanism := []*p11.Mechanism{p11.NewMechanism(p11.CKM_ECDSA, nil)}
+	if err := s.module.SignInit(s.session, mechanism, s.privateKey); err != nil {
+		return nil, fmt.Errorf("failed to initialize PKCS#11 signing: %w", err)
+	}
+
+	return s.module.Sign(s.session, digest)
+}
+
+// ECPoint returns the CKA_EC_POINT attribute of the public key.
+func (s *Session) ECPoint() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attrs, err := s.module.GetAttributeValue(s.session, s.publicKey, []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(attrs) != 1 {
+		return nil, errors.New("missing CKA_EC_POINT attribute")
+	}
+
+	return attrs[0].Value, nil
+}
+
+// Close logs out, closes the session and unloads the module if they were opened by Open, and is a
+// no-op otherwise.
+func (s *Session) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.owned {
+		return nil
+	}
+	s.owned = false
+
+	var errs []error
+	if s.session != 0 {
+		if err := s.module.Logout(s.session); err != nil && !errors.Is(err, p11.Error(p11.CKR_USER_NOT_LOGGED_IN)) {
+			errs = append(errs, fmt.Errorf("failed to log out of PKCS#11 token: %w", err))
+		}
+		if err := s.module.CloseSession(s.session); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close PKCS#11 session: %w", err))
+		}
+	}
+	if err := s.module.Finalize(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to finalize PKCS#11 module: %w", err))
+	}
+	s.module.Destroy()
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,258 @@
+package pkcs11
+
+import (
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	p11 "github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms"
+)
+
+const (
+	testPrivateKeyHex = "b17c4c6a409cebce4b39977689180900d9009d5c55a57ff9fd9cb962b24ae99d"
+
+	testSession    p11.SessionHandle = 7
+	testPrivateKey p11.ObjectHandle  = 1
+	testPublicKey  p11.ObjectHandle  = 2
+)
+
+var testConfig = Config{TokenLabel: "mcms", PIN: "1234", KeyLabel: "signer"}
+
+// fakeModule implements the module interface with a single token in slot 3, holding a local
+// key pair labeled signer.
+type fakeModule struct {
+	pk            *ecdsa.PrivateKey
+	initializeErr error
+
+	found    []p11.ObjectHandle
+	signing  bool
+	loggedIn bool
+	calls    []string
+}
+
+func (m *fakeModule) Initialize(...p11.InitializeOption) error {
+	m.calls = append(m.calls, "Initialize")
+
+	return m.initializeErr
+}
+
+func (m *fakeModule) Finalize() error {
+	m.calls = append(m.calls, "Finalize")
+
+	return nil
+}
+
+func (m *fakeModule) Destroy() {
+	m.calls = append(m.calls, "Destroy")
+}
+
+func (m *fakeModule) GetSlotList(bool) ([]uint, error) {
+	return []uint{1, 3}, nil
+}
+
+func (m *fakeModule) GetTokenInfo(slotID uint) (p11.TokenInfo, error) {
+	if slotID == 3 {
+		return p11.TokenInfo{Label: "mcms"}, nil
+	}
+
+	return p11.TokenInfo{Label: "other"}, nil
+}
+
+func (m *fakeModule) OpenSession(slotID uint, _ uint) (p11.SessionHandle, error) {
+	if slotID != 3 {
+		return 0, p11.Error(p11.CKR_SLOT_ID_INVALID)
+	}
+	m.calls = append(m.calls, "OpenSession")
+
+	return testSession, nil
+}
+
+func (m *fakeModule) CloseSession(p11.SessionHandle) error {
+	m.calls = append(m.calls, "CloseSession")
+
+	return nil
+}
+
+func (m *fakeModule) Login(_ p11.SessionHandle, _ uint, pin string) error {
+	if pin != testConfig.PIN {
+		return p11.Error(p11.CKR_PIN_INCORRECT)
+	}
+	m.loggedIn = true
+
+	return nil
+}
+
+func (m *fakeModule) Logout(p11.SessionHandle) error {
+	m.calls = append(m.calls, "Logout")
+	if !m.loggedIn {
+		return p11.Error(p11.CKR_USER_NOT_LOGGED_IN)
+	}
+
+	return nil
+}
+
+func (m *fakeModule) FindObjectsInit(_ p11.SessionHandle, temp []*p11.Attribute) error {
+	var class uint
+	var label string
+	for _, attr := range temp {
+		switch attr.Type {
+		case p11.CKA_CLASS:
+			class = uint(attr.Value[0])
+		case p11.CKA_LABEL:
+			label = string(attr.Value)
+		}
+	}
+
+	m.found = nil
+	if label == "signer" {
+		if class == p11.CKO_PRIVATE_KEY {
+			m.found = []p11.ObjectHandle{testPrivateKey}
+		} else {
+			m.found = []p11.ObjectHandle{testPublicKey}
+		}
+	}
+
+	return nil
+}
+
+func (m *fakeModule) FindObjects(p11.SessionHandle, int) ([]p11.ObjectHandle, bool, error) {
+	return m.found, false, nil
+}
+
+func (m *fakeModule) FindObjectsFinal(p11.SessionHandle) error {
+	return nil
+}
+
+func (m *fakeModule) SignInit(sh p11.SessionHandle, mechanism []*p11.Mechanism, o p11.ObjectHandle) error {
+	if sh != testSession || o != testPrivateKey || len(mechanism) != 1 || mechanism[0].Mechanism != p11.CKM_ECDSA {
+		return p11.Error(p11.CKR_ARGUMENTS_BAD)
+	}
+	m.signing = true
+
+	return nil
+}
+
+func (m *fakeModule) Sign(_ p11.SessionHandle, message []byte) ([]byte, error) {
+	if !m.signing {
+		return nil, p11.Error(p11.CKR_OPERATION_NOT_INITIALIZED)
+	}
+	m.signing = false
+
+	sig, err := crypto.Sign(message, m.pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return sig[:64], nil
+}
+
+func (m *fakeModule) GetAttributeValue(
+	_ p11.SessionHandle, o p11.ObjectHandle, a []*p11.Attribute,
+) ([]*p11.Attribute, error) {
+	if o != testPublicKey || len(a) != 1 || a[0].Type != p11.CKA_EC_POINT {
+		return nil, p11.Error(p11.CKR_ATTRIBUTE_TYPE_INVALID)
+	}
+	point, err := asn1.Marshal(crypto.FromECDSAPub(&m.pk.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return []*p11.Attribute{p11.NewAttribute(p11.CKA_EC_POINT, point)}, nil
+}
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	payload := []byte("0x000000000000000000000000000000")
+	want, err := mcms.NewPrivateKeySigner(privKey).Sign(payload)
+	require.NoError(t, err)
+
+	m := &fakeModule{pk: privKey}
+	session, err := open(m, testConfig)
+	require.NoError(t, err)
+
+	got, err := mcms.NewPKCS11Signer(session).Sign(payload)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	require.NoError(t, session.Close())
+	require.NoError(t, session.Close())
+	require.Equal(t, []string{"Initialize", "OpenSession", "Logout", "CloseSession", "Finalize", "Destroy"}, m.calls)
+}
+
+func TestOpen_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		module    *fakeModule
+		config    Config
+		wantErr   string
+		wantCalls []string
+	}{
+		{
+			name:      "failure: initialize error",
+			module:    &fakeModule{initializeErr: p11.Error(p11.CKR_GENERAL_ERROR)},
+			config:    testConfig,
+			wantErr:   "failed to initialize PKCS#11 module: pkcs11: 0x5: CKR_GENERAL_ERROR",
+			wantCalls: []string{"Initialize", "Destroy"},
+		},
+		{
+			name:      "failure: token not found",
+			module:    &fakeModule{},
+			config:    Config{TokenLabel: "missing", PIN: "1234", KeyLabel: "signer"},
+			wantErr:   "PKCS#11 token missing not found",
+			wantCalls: []string{"Initialize", "Finalize", "Destroy"},
+		},
+		{
+			name:      "failure: incorrect PIN",
+			module:    &fakeModule{},
+			config:    Config{TokenLabel: "mcms", PIN: "0000", KeyLabel: "signer"},
+			wantErr:   "failed to log in to PKCS#11 token mcms: pkcs11: 0xA0: CKR_PIN_INCORRECT",
+			wantCalls: []string{"Initialize", "OpenSession", "Logout", "CloseSession", "Finalize", "Destroy"},
+		},
+		{
+			name:      "failure: key not found",
+			module:    &fakeModule{},
+			config:    Config{TokenLabel: "mcms", PIN: "1234", KeyLabel: "missing"},
+			wantErr:   "PKCS#11 key missing not found",
+			wantCalls: []string{"Initialize", "OpenSession", "Logout", "CloseSession", "Finalize", "Destroy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := open(tt.module, tt.config)
+			require.EqualError(t, err, tt.wantErr)
+			require.Equal(t, tt.wantCalls, tt.module.calls)
+		})
+	}
+}
+
+func TestNewSession(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	m := &fakeModule{pk: privKey}
+	session := &Session{module: m, session: testSession, privateKey: testPrivateKey, publicKey: testPublicKey}
+
+	addr, err := mcms.NewPKCS11Signer(session).GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey), addr)
+
+	// Sessions opened by the caller are left open.
+	require.NoError(t, session.Close())
+	require.Empty(t, m.calls)
+
+	_, err = (&Session{module: m, session: testSession, publicKey: testPrivateKey}).ECPoint()
+	require.ErrorIs(t, err, p11.Error(p11.CKR_ATTRIBUTE_TYPE_INVALID))
+}
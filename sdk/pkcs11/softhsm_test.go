@@ -0,0 +1,116 @@
+//go:build softhsm
+
+package pkcs11
+
+import (
+	"encoding/asn1"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	p11 "github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms"
+)
+
+// oidSecp256k1 is the object identifier of the secp256k1 curve, the CKA_EC_PARAMS of the keys.
+var oidSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+
+// softHSMModule returns the SoftHSM module path, from SOFTHSM2_MODULE or the Debian default.
+func softHSMModule() string {
+	if module := os.Getenv("SOFTHSM2_MODULE"); module != "" {
+		return module
+	}
+
+	return "/usr/lib/softhsm/libsofthsm2.so"
+}
+
+// initSoftHSMToken initializes a SoftHSM token in a temporary token directory and generates a
+// secp256k1 key pair in it.
+func initSoftHSMToken(t *testing.T, cfg Config) {
+	t.Helper()
+
+	dir := t.TempDir()
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.WriteFile(conf, []byte("directories.tokendir = "+dir+"\n"), 0o600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	ctx := p11.New(cfg.ModulePath)
+	require.NotNil(t, ctx)
+	defer ctx.Destroy()
+	require.NoError(t, ctx.Initialize())
+	defer func() { require.NoError(t, ctx.Finalize()) }()
+
+	slots, err := ctx.GetSlotList(true)
+	require.NoError(t, err)
+	require.NotEmpty(t, slots)
+	require.NoError(t, ctx.InitToken(slots[0], "5678", cfg.TokenLabel))
+
+	// Initializing the token moves it to a new slot
+	slots, err = ctx.GetSlotList(true)
+	require.NoError(t, err)
+	var slot uint
+	for _, s := range slots {
+		info, ierr := ctx.GetTokenInfo(s)
+		require.NoError(t, ierr)
+		if info.Label == cfg.TokenLabel {
+			slot = s
+		}
+	}
+
+	session, err := ctx.OpenSession(slot, p11.CKF_SERIAL_SESSION|p11.CKF_RW_SESSION)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ctx.CloseSession(session)) }()
+	require.NoError(t, ctx.Login(session, p11.CKU_SO, "5678"))
+	require.NoError(t, ctx.InitPIN(session, cfg.PIN))
+	require.NoError(t, ctx.Logout(session))
+	require.NoError(t, ctx.Login(session, p11.CKU_USER, cfg.PIN))
+	defer func() { require.NoError(t, ctx.Logout(session)) }()
+
+	ecParams, err := asn1.Marshal(oidSecp256k1)
+	require.NoError(t, err)
+	_, _, err = ctx.GenerateKeyPair(session,
+		[]*p11.Mechanism{p11.NewMechanism(p11.CKM_EC_KEY_PAIR_GEN, nil)},
+		[]*p11.Attribute{
+			p11.NewAttribute(p11.CKA_TOKEN, true),
+			p11.NewAttribute(p11.CKA_VERIFY, true),
+			p11.NewAttribute(p11.CKA_EC_PARAMS, ecParams),
+			p11.NewAttribute(p11.CKA_LABEL, cfg.KeyLabel),
+		},
+		[]*p11.Attribute{
+			p11.NewAttribute(p11.CKA_TOKEN, true),
+			p11.NewAttribute(p11.CKA_PRIVATE, true),
+			p11.NewAttribute(p11.CKA_SENSITIVE, true),
+			p11.NewAttribute(p11.CKA_SIGN, true),
+			p11.NewAttribute(p11.CKA_LABEL, cfg.KeyLabel),
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestPKCS11Signer_SoftHSM(t *testing.T) {
+	cfg := Config{ModulePath: softHSMModule(), TokenLabel: "mcms", PIN: "1234", KeyLabel: "signer"}
+	initSoftHSMToken(t, cfg)
+
+	session, err := Open(cfg)
+	require.NoError(t, err)
+	signer := mcms.NewPKCS11Signer(session)
+
+	addr, err := signer.GetAddress()
+	require.NoError(t, err)
+
+	payload := []byte("0x000000000000000000000000000000")
+	sig, err := signer.Sign(payload)
+	require.NoError(t, err)
+
+	recovered, err := crypto.SigToPub(accounts.TextHash(payload), sig)
+	require.NoError(t, err)
+	require.Equal(t, addr, crypto.PubkeyToAddress(*recovered))
+	require.NoError(t, session.Close())
+
+	_, err = Open(Config{ModulePath: cfg.ModulePath, TokenLabel: cfg.TokenLabel, PIN: "0000", KeyLabel: cfg.KeyLabel})
+	require.ErrorContains(t, err, "CKR_PIN_INCORRECT")
+}
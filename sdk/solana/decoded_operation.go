@@ -0,0 +1,75 @@
+package solana
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/mcms/sdk"
+)
+
+// DecodedAccount is an account meta of a decoded instruction, labelled with the IDL account name.
+// Accounts beyond the ones declared by the IDL are labelled remaining0, remaining1, etc.
+type DecodedAccount struct {
+	Name       string           `json:"name"`
+	PublicKey  solana.PublicKey `json:"publicKey"`
+	IsWritable bool             `json:"isWritable"`
+	IsSigner   bool             `json:"isSigner"`
+}
+
+type DecodedOperation struct {
+	InstructionName string
+	InputKeys       []string
+	InputArgs       []any
+	Accounts        []DecodedAccount
+}
+
+var _ sdk.DecodedOperation = &DecodedOperation{}
+
+func NewDecodedOperation(
+	instructionName string, inputKeys []string, inputArgs []any, accounts []DecodedAccount,
+) (*DecodedOperation, error) {
+	if len(inputKeys) != len(inputArgs) {
+		return nil, errors.New("input keys and input args must have the same length")
+	}
+
+	return &DecodedOperation{
+		InstructionName: instructionName,
+		InputKeys:       inputKeys,
+		InputArgs:       inputArgs,
+		Accounts:        accounts,
+	}, nil
+}
+
+func (d *DecodedOperation) MethodName() string {
+	return d.InstructionName
+}
+
+func (d *DecodedOperation) Keys() []string {
+	return d.InputKeys
+}
+
+func (d *DecodedOperation) Args() []any {
+	return d.InputArgs
+}
+
+func (d *DecodedOperation) String() (string, string, error) {
+	// Create a human-readable representation of the decoded operation
+	// by displaying the input arguments and the labelled accounts
+	// e.g. {"args": {"key1": "value1"}, "accounts": [{"name": "config", ...}]}
+	inputMap := make(map[string]any)
+	for i, key := range d.InputKeys {
+		inputMap[key] = d.InputArgs[i]
+	}
+
+	byteMap, err := json.MarshalIndent(struct {
+		Args     map[string]any   `json:"args"`
+		Accounts []DecodedAccount `json:"accounts"`
+	}{inputMap, d.Accounts}, "", "  ")
+	if err != nil {
+		return "", "", err
+	}
+
+	return d.InstructionName, string(byteMap), nil
+}
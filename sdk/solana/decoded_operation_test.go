@@ -0,0 +1,30 @@
+package solana
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecodedOperation(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewDecodedOperation("setRoot", []string{"root"}, []any{}, nil)
+	require.EqualError(t, err, "input keys and input args must have the same length")
+
+	accounts := []DecodedAccount{{Name: "config", PublicKey: solana.SystemProgramID, IsWritable: true}}
+	op, err := NewDecodedOperation("updateDelay", []string{"delay"}, []any{uint64(60)}, accounts)
+	require.NoError(t, err)
+	require.Equal(t, "updateDelay", op.MethodName())
+	require.Equal(t, []string{"delay"}, op.Keys())
+	require.Equal(t, []any{uint64(60)}, op.Args())
+
+	method, args, err := op.String()
+	require.NoError(t, err)
+	require.Equal(t, "updateDelay", method)
+	require.JSONEq(t, `{
+		"args": {"delay": 60},
+		"accounts": [{"name": "config", "publicKey": "11111111111111111111111111111111", "isWritable": true, "isSigner": false}]
+	}`, args)
+}
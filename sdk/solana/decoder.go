@@ -0,0 +1,331 @@
+package solana
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"unicode/utf8"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// maxDecodeDepth bounds the nesting of decoded types, protecting against recursive type
+// definitions.
+const maxDecodeDepth = 32
+
+type Decoder struct{}
+
+var _ sdk.Decoder = &Decoder{}
+
+func NewDecoder() *Decoder {
+	return &Decoder{}
+}
+
+// Decode decodes the instruction data of the transaction against the Anchor IDL JSON passed as
+// contractInterfaces. The instruction is identified by its discriminator, and the transaction
+// accounts are labelled with the account names of the instruction.
+func (d Decoder) Decode(tx types.Transaction, contractInterfaces string) (sdk.DecodedOperation, error) {
+	parsed, err := parseIDL(contractInterfaces)
+	if err != nil {
+		return nil, err
+	}
+
+	additionalFields, err := ParseAdditionalFields(tx.AdditionalFields)
+	if err != nil {
+		return nil, err
+	}
+
+	ix, err := parsed.instruction(tx.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &borshReader{idl: parsed, data: tx.Data[len(ix.Discriminator):]}
+	keys := make([]string, len(ix.Args))
+	args := make([]any, len(ix.Args))
+	for i, arg := range ix.Args {
+		keys[i] = arg.Name
+		if args[i], err = r.read(arg.Type, 0); err != nil {
+			return nil, fmt.Errorf("failed to decode argument %s of instruction %s: %w", arg.Name, ix.Name, err)
+		}
+	}
+	if len(r.data) > 0 {
+		return nil, fmt.Errorf("%d unexpected trailing bytes in data of instruction %s", len(r.data), ix.Name)
+	}
+
+	names := accountNames(ix.Accounts, "")
+	accounts := make([]DecodedAccount, len(additionalFields.Accounts))
+	for i, meta := range additionalFields.Accounts {
+		name := fmt.Sprintf("remaining%d", i-len(names))
+		if i < len(names) {
+			name = names[i]
+		}
+		accounts[i] = DecodedAccount{
+			Name:       name,
+			PublicKey:  meta.PublicKey,
+			IsWritable: meta.IsWritable,
+			IsSigner:   meta.IsSigner,
+		}
+	}
+
+	return NewDecodedOperation(ix.Name, keys, args, accounts)
+}
+
+// borshReader decodes Borsh encoded values described by IDL types.
+type borshReader struct {
+	idl  *idl
+	data []byte
+}
+
+var errUnexpectedEOF = errors.New("unexpected end of instruction data")
+
+func (r *borshReader) next(n int) ([]byte, error) {
+	if n < 0 || len(r.data) < n {
+		return nil, errUnexpectedEOF
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+
+	return b, nil
+}
+
+func (r *borshReader) readLength() (int, error) {
+	b, err := r.next(4)
+	if err != nil {
+		return 0, err
+	}
+	length := binary.LittleEndian.Uint32(b)
+	// Every element takes at least a byte, which bounds allocations for malformed input
+	if int64(length) > int64(len(r.data)) {
+		return 0, fmt.Errorf("length %d exceeds the remaining %d bytes", length, len(r.data))
+	}
+
+	return int(length), nil
+}
+
+func (r *borshReader) read(t idlType, depth int) (any, error) {
+	if depth > maxDecodeDepth {
+		return nil, errors.New("maximum type nesting depth exceeded")
+	}
+
+	switch {
+	case t.Vec != nil:
+		length, err := r.readLength()
+		if err != nil {
+			return nil, err
+		}
+		if t.Vec.Primitive == "u8" {
+			return r.next(length)
+		}
+
+		return r.readSequence(*t.Vec, length, depth)
+	case t.Array != nil:
+		if t.Array.Primitive == "u8" {
+			return r.next(t.ArrayLen)
+		}
+
+		return r.readSequence(*t.Array, t.ArrayLen, depth)
+	case t.Option != nil, t.COption != nil:
+		inner, tagSize := t.Option, 1
+		if t.COption != nil {
+			inner, tagSize = t.COption, 4
+		}
+		tag, err := r.next(tagSize)
+		if err != nil {
+			return nil, err
+		}
+		if tag[0] == 0 {
+			return nil, nil
+		}
+
+		return r.read(*inner, depth+1)
+	case t.Defined != "":
+		return r.readDefined(t.Defined, depth)
+	default:
+		return r.readPrimitive(t.Primitive)
+	}
+}
+
+func (r *borshReader) readSequence(t idlType, length int, depth int) ([]any, error) {
+	values := make([]any, length)
+	for i := range values {
+		value, err := r.read(t, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+func (r *borshReader) readFields(fields []idlField, depth int) (map[string]any, error) {
+	values := make(map[string]any, len(fields))
+	for _, field := range fields {
+		value, err := r.read(field.Type, depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		values[field.Name] = value
+	}
+
+	return values, nil
+}
+
+// readDefined decodes a struct into a map of field names to values, and an enum into the name of
+// the variant, or a map of the variant name to its fields for variants holding data.
+func (r *borshReader) readDefined(name string, depth int) (any, error) {
+	def, err := r.idl.typeDef(name)
+	if err != nil {
+		return nil, err
+	}
+
+	switch def.Type.Kind {
+	case "struct":
+		return r.readFields(def.Type.Fields, depth)
+	case "enum":
+		tag, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		if int(tag[0]) >= len(def.Type.Variants) {
+			return nil, fmt.Errorf("invalid variant %d of enum %s", tag[0], name)
+		}
+
+		variant := def.Type.Variants[tag[0]]
+		switch {
+		case len(variant.Fields) > 0:
+			fields, err := r.readFields(variant.Fields, depth)
+			if err != nil {
+				return nil, err
+			}
+
+			return map[string]any{variant.Name: fields}, nil
+		case len(variant.TupleFields) > 0:
+			values := make([]any, len(variant.TupleFields))
+			for i, field := range variant.TupleFields {
+				if values[i], err = r.read(field, depth+1); err != nil {
+					return nil, err
+				}
+			}
+
+			return map[string]any{variant.Name: values}, nil
+		default:
+			return variant.Name, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported kind %s of type %s", def.Type.Kind, name)
+	}
+}
+
+func (r *borshReader) readPrimitive(primitive string) (any, error) {
+	switch primitive {
+	case "bool":
+		b, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+
+		return b[0] != 0, nil
+	case "u8", "i8":
+		b, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		if primitive == "i8" {
+			return int8(b[0]), nil
+		}
+
+		return b[0], nil
+	case "u16", "i16":
+		b, err := r.next(2)
+		if err != nil {
+			return nil, err
+		}
+		v := binary.LittleEndian.Uint16(b)
+		if primitive == "i16" {
+			return int16(v), nil
+		}
+
+		return v, nil
+	case "u32", "i32", "f32":
+		b, err := r.next(4)
+		if err != nil {
+			return nil, err
+		}
+		v := binary.LittleEndian.Uint32(b)
+		switch primitive {
+		case "i32":
+			return int32(v), nil
+		case "f32":
+			return math.Float32frombits(v), nil
+		}
+
+		return v, nil
+	case "u64", "i64", "f64":
+		b, err := r.next(8)
+		if err != nil {
+			return nil, err
+		}
+		v := binary.LittleEndian.Uint64(b)
+		switch primitive {
+		case "i64":
+			return int64(v), nil
+		case "f64":
+			return math.Float64frombits(v), nil
+		}
+
+		return v, nil
+	case "u128", "i128":
+		b, err := r.next(16)
+		if err != nil {
+			return nil, err
+		}
+		// Little endian to big endian
+		be := make([]byte, len(b))
+		for i := range b {
+			be[len(b)-1-i] = b[i]
+		}
+		v := new(big.Int).SetBytes(be)
+		if primitive == "i128" && be[0]&0x80 != 0 {
+			v.Sub(v, new(big.Int).Lsh(big.NewInt(1), 128))
+		}
+
+		return v, nil
+	case "string":
+		length, err := r.readLength()
+		if err != nil {
+			return nil, err
+		}
+		b, err := r.next(length)
+		if err != nil {
+			return nil, err
+		}
+		if !utf8.Valid(b) {
+			return nil, errors.New("invalid UTF-8 string")
+		}
+
+		return string(b), nil
+	case "bytes":
+		length, err := r.readLength()
+		if err != nil {
+			return nil, err
+		}
+
+		return r.next(length)
+	case "publicKey", "pubkey":
+		b, err := r.next(solana.PublicKeyLength)
+		if err != nil {
+			return nil, err
+		}
+
+		return solana.PublicKeyFromBytes(b), nil
+	default:
+		return nil, fmt.Errorf("unsupported IDL type %q", primitive)
+	}
+}
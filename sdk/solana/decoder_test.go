@@ -0,0 +1,308 @@
+package solana
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	timelockBindings "github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/v0_1_1/timelock"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// testTimelockIDL is a subset of the legacy Anchor IDL of the timelock program.
+const testTimelockIDL = `{
+  "version": "0.0.1-dev",
+  "name": "timelock",
+  "instructions": [
+    {
+      "name": "batchAddAccess",
+      "accounts": [
+        {"name": "config", "isMut": false, "isSigner": false},
+        {"name": "accessControllerProgram", "isMut": false, "isSigner": false},
+        {"name": "roleAccessController", "isMut": true, "isSigner": false},
+        {"name": "authority", "isMut": true, "isSigner": true}
+      ],
+      "args": [
+        {"name": "timelockId", "type": {"array": ["u8", 32]}},
+        {"name": "role", "type": {"defined": "Role"}}
+      ]
+    },
+    {
+      "name": "initializeInstruction",
+      "accounts": [
+        {"name": "operation", "isMut": true, "isSigner": false},
+        {"name": "config", "isMut": false, "isSigner": false},
+        {"name": "roleAccessController", "isMut": false, "isSigner": false},
+        {"name": "authority", "isMut": true, "isSigner": true},
+        {"name": "systemProgram", "isMut": false, "isSigner": false}
+      ],
+      "args": [
+        {"name": "timelockId", "type": {"array": ["u8", 32]}},
+        {"name": "id", "type": {"array": ["u8", 32]}},
+        {"name": "programId", "type": "publicKey"},
+        {"name": "accounts", "type": {"vec": {"defined": "InstructionAccount"}}}
+      ]
+    },
+    {
+      "name": "updateDelay",
+      "accounts": [
+        {"name": "config", "isMut": true, "isSigner": false},
+        {"name": "authority", "isMut": false, "isSigner": true}
+      ],
+      "args": [
+        {"name": "timelockId", "type": {"array": ["u8", 32]}},
+        {"name": "delay", "type": "u64"}
+      ]
+    }
+  ],
+  "types": [
+    {
+      "name": "InstructionAccount",
+      "type": {
+        "kind": "struct",
+        "fields": [
+          {"name": "pubkey", "type": "publicKey"},
+          {"name": "isSigner", "type": "bool"},
+          {"name": "isWritable", "type": "bool"}
+        ]
+      }
+    },
+    {
+      "name": "Role",
+      "type": {
+        "kind": "enum",
+        "variants": [{"name": "Admin"}, {"name": "Proposer"}, {"name": "Executor"}, {"name": "Canceller"}, {"name": "Bypasser"}]
+      }
+    }
+  ]
+}`
+
+// testCurrentIDL is an IDL in the current Anchor format, with explicit discriminators.
+const testCurrentIDL = `{
+  "address": "11111111111111111111111111111111",
+  "metadata": {"name": "example", "version": "0.1.0", "spec": "0.1.0"},
+  "instructions": [
+    {
+      "name": "configure",
+      "discriminator": [1, 2, 3, 4, 5, 6, 7, 8],
+      "accounts": [
+        {"name": "state", "writable": true},
+        {"name": "admin", "accounts": [{"name": "owner", "signer": true}, {"name": "delegate"}]}
+      ],
+      "args": [
+        {"name": "label", "type": "string"},
+        {"name": "limit", "type": {"option": "u128"}},
+        {"name": "offset", "type": "i64"},
+        {"name": "mode", "type": {"defined": {"name": "Mode"}}},
+        {"name": "owners", "type": {"vec": "pubkey"}}
+      ]
+    }
+  ],
+  "types": [
+    {
+      "name": "Mode",
+      "type": {
+        "kind": "enum",
+        "variants": [
+          {"name": "Off"},
+          {"name": "Fixed", "fields": ["u16", "bool"]},
+          {"name": "Ranged", "fields": [{"name": "min", "type": "u8"}, {"name": "max", "type": "u8"}]}
+        ]
+      }
+    }
+  ]
+}`
+
+func TestDecoder_Decode(t *testing.T) {
+	t.Parallel()
+
+	timelockID := [32]byte{1, 2, 3}
+	config := solana.MPK("8pPNjm5F2xGUG8q7fFwNLcDmAnMDRamEotiDZbJ5seqo")
+	authority := solana.MPK("HzWdnV141bP1PXXce4NJ6NCJgd6jr3kMaySuevbpgwaV")
+	controller := solana.MPK("GB4a1oU4WWwn1Gd7BxJb5qXgtg5jx1hc4n5Y2pgeMbGc")
+	extra := solana.MPK("4nQy1k5hSu4uaDPZ6uLT9sGPqcYWV1M3rWmYN3k1yJ7L")
+
+	batchAddAccess, err := timelockBindings.NewBatchAddAccessInstruction(
+		timelockID, timelockBindings.Executor_Role, config, solana.SystemProgramID, controller, authority,
+	).ValidateAndBuild()
+	require.NoError(t, err)
+
+	initializeInstruction, err := timelockBindings.NewInitializeInstructionInstruction(
+		timelockID, [32]byte{4}, extra,
+		[]timelockBindings.InstructionAccount{{Pubkey: authority, IsSigner: true}, {Pubkey: config, IsWritable: true}},
+		config, config, controller, authority, solana.SystemProgramID,
+	).ValidateAndBuild()
+	require.NoError(t, err)
+
+	toTransaction := func(ix solana.Instruction, extraAccounts ...*solana.AccountMeta) types.Transaction {
+		data, derr := ix.Data()
+		require.NoError(t, derr)
+		tx, terr := NewTransaction(solana.SystemProgramID.String(), data, big.NewInt(0),
+			append(ix.Accounts(), extraAccounts...), "RBACTimelock", nil)
+		require.NoError(t, terr)
+
+		return tx
+	}
+
+	tests := []struct {
+		name               string
+		tx                 types.Transaction
+		contractInterfaces string
+		want               *DecodedOperation
+		wantErr            string
+	}{
+		{
+			name:               "enum argument with remaining accounts",
+			tx:                 toTransaction(batchAddAccess, &solana.AccountMeta{PublicKey: extra, IsWritable: true}),
+			contractInterfaces: testTimelockIDL,
+			want: &DecodedOperation{
+				InstructionName: "batchAddAccess",
+				InputKeys:       []string{"timelockId", "role"},
+				InputArgs:       []any{timelockID[:], "Executor"},
+				Accounts: []DecodedAccount{
+					{Name: "config", PublicKey: config},
+					{Name: "accessControllerProgram", PublicKey: solana.SystemProgramID},
+					{Name: "roleAccessController", PublicKey: controller, IsWritable: true},
+					{Name: "authority", PublicKey: authority, IsWritable: true, IsSigner: true},
+					{Name: "remaining0", PublicKey: extra, IsWritable: true},
+				},
+			},
+		},
+		{
+			name:               "vector of structs",
+			tx:                 toTransaction(initializeInstruction),
+			contractInterfaces: testTimelockIDL,
+			want: &DecodedOperation{
+				InstructionName: "initializeInstruction",
+				InputKeys:       []string{"timelockId", "id", "programId", "accounts"},
+				InputArgs: []any{timelockID[:], []byte{4, 31: 0}, extra, []any{
+					map[string]any{"pubkey": authority, "isSigner": true, "isWritable": false},
+					map[string]any{"pubkey": config, "isSigner": false, "isWritable": true},
+				}},
+				Accounts: []DecodedAccount{
+					{Name: "operation", PublicKey: config, IsWritable: true},
+					{Name: "config", PublicKey: config},
+					{Name: "roleAccessController", PublicKey: controller},
+					{Name: "authority", PublicKey: authority, IsWritable: true, IsSigner: true},
+					{Name: "systemProgram", PublicKey: solana.SystemProgramID},
+				},
+			},
+		},
+		{
+			name: "current IDL format",
+			tx: types.Transaction{
+				Data: append([]byte{1, 2, 3, 4, 5, 6, 7, 8},
+					// label: "abc"
+					3, 0, 0, 0, 'a', 'b', 'c',
+					// limit: Some(2^64)
+					1, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
+					// offset: -2
+					0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+					// mode: Ranged{min: 1, max: 9}
+					2, 1, 9,
+					// owners: []
+					0, 0, 0, 0,
+				),
+				AdditionalFields: toJSON(t, AdditionalFields{Accounts: []*solana.AccountMeta{
+					{PublicKey: config, IsWritable: true},
+					{PublicKey: authority, IsSigner: true},
+					{PublicKey: controller},
+				}}),
+			},
+			contractInterfaces: testCurrentIDL,
+			want: &DecodedOperation{
+				InstructionName: "configure",
+				InputKeys:       []string{"label", "limit", "offset", "mode", "owners"},
+				InputArgs: []any{
+					"abc", new(big.Int).Lsh(big.NewInt(1), 64), int64(-2),
+					map[string]any{"Ranged": map[string]any{"min": uint8(1), "max": uint8(9)}}, []any{},
+				},
+				Accounts: []DecodedAccount{
+					{Name: "state", PublicKey: config, IsWritable: true},
+					{Name: "admin.owner", PublicKey: authority, IsSigner: true},
+					{Name: "admin.delegate", PublicKey: controller},
+				},
+			},
+		},
+		{
+			name: "tuple enum variant",
+			tx: types.Transaction{
+				Data: append([]byte{1, 2, 3, 4, 5, 6, 7, 8},
+					// label: "", limit: None, offset: 0
+					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+					// mode: Fixed(7, true), owners: []
+					1, 7, 0, 1, 0, 0, 0, 0,
+				),
+			},
+			contractInterfaces: testCurrentIDL,
+			want: &DecodedOperation{
+				InstructionName: "configure",
+				InputKeys:       []string{"label", "limit", "offset", "mode", "owners"},
+				InputArgs:       []any{"", nil, int64(0), map[string]any{"Fixed": []any{uint16(7), true}}, []any{}},
+				Accounts:        []DecodedAccount{},
+			},
+		},
+		{
+			name:               "invalid IDL",
+			tx:                 toTransaction(batchAddAccess),
+			contractInterfaces: "{",
+			wantErr:            "failed to parse IDL",
+		},
+		{
+			name:               "unknown discriminator",
+			tx:                 types.Transaction{Data: []byte{9, 9, 9, 9, 9, 9, 9, 9}},
+			contractInterfaces: testTimelockIDL,
+			wantErr:            "no instruction found for discriminator 0909090909090909",
+		},
+		{
+			name:               "data shorter than discriminator",
+			tx:                 types.Transaction{Data: []byte{1}},
+			contractInterfaces: testTimelockIDL,
+			wantErr:            "instruction data of 1 bytes is shorter than the discriminator",
+		},
+		{
+			name:               "truncated arguments",
+			tx:                 types.Transaction{Data: []byte{1, 2, 3, 4, 5, 6, 7, 8, 3, 0, 0, 0, 'a'}},
+			contractInterfaces: testCurrentIDL,
+			wantErr:            "failed to decode argument label of instruction configure: length 3 exceeds the remaining 1 bytes",
+		},
+		{
+			name: "trailing bytes",
+			tx: types.Transaction{
+				Data: append([]byte{1, 2, 3, 4, 5, 6, 7, 8},
+					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff,
+				),
+			},
+			contractInterfaces: testCurrentIDL,
+			wantErr:            "1 unexpected trailing bytes in data of instruction configure",
+		},
+		{
+			name: "invalid enum variant",
+			tx: types.Transaction{
+				Data: append([]byte{1, 2, 3, 4, 5, 6, 7, 8},
+					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7,
+				),
+			},
+			contractInterfaces: testCurrentIDL,
+			wantErr:            "failed to decode argument mode of instruction configure: invalid variant 7 of enum Mode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewDecoder().Decode(tt.tx, tt.contractInterfaces)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,469 @@
+package solana
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	bindings "github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/v0_1_1/mcm"
+	solanaCommon "github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.EventFilterer = (*EventFilterer)(nil)
+
+// signaturesPageSize is the maximum number of signatures returned by getSignaturesForAddress.
+const signaturesPageSize = 1000
+
+const programDataLogPrefix = "Program data: "
+
+// Events of the mcm and timelock programs. anchor-go does not generate event types, so they are
+// declared here with the layout of the program IDLs.
+type (
+	// NewRootEvent is emitted by the mcm program when a new root is set.
+	NewRootEvent struct {
+		Root                         [32]byte
+		ValidUntil                   uint32
+		MetadataChainID              uint64
+		MetadataMultisig             solana.PublicKey
+		MetadataPreOpCount           uint64
+		MetadataPostOpCount          uint64
+		MetadataOverridePreviousRoot bool
+	}
+
+	// OpExecutedEvent is emitted by the mcm program when an operation is executed.
+	OpExecutedEvent struct {
+		Nonce uint64
+		To    solana.PublicKey
+		Data  []byte
+	}
+
+	// ConfigSetEvent is emitted by the mcm program when a new config is set.
+	ConfigSetEvent struct {
+		GroupParents  [32]byte
+		GroupQuorums  [32]byte
+		IsRootCleared bool
+		Signers       []bindings.McmSigner
+	}
+
+	// CallScheduledEvent is emitted by the timelock program for every call of a scheduled operation.
+	CallScheduledEvent struct {
+		ID          [32]byte
+		Index       uint64
+		Target      solana.PublicKey
+		Predecessor [32]byte
+		Salt        [32]byte
+		Delay       uint64
+		Data        []byte
+	}
+
+	// CallExecutedEvent is emitted by the timelock program for every call of an executed operation.
+	CallExecutedEvent struct {
+		ID     [32]byte
+		Index  uint64
+		Target solana.PublicKey
+		Data   []byte
+	}
+
+	// CancelledEvent is emitted by the timelock program when an operation is cancelled.
+	CancelledEvent struct {
+		ID [32]byte
+	}
+)
+
+// EventFilterer is an EventFilterer implementation for Solana chains. Events are read from the
+// logs of the transactions touching the config account of the MCM and timelock instances, and
+// blocks are slots.
+//
+// The RPC returns the signatures of an account newest first, so the signatures fetched for a
+// query are kept and consecutive queries over increasing slot ranges, like the chunks of an
+// EventWatcher scan, only fetch the signatures they have not seen yet instead of paging again from
+// the chain tip.
+//
+// The timelock program keeps its role members in access controller accounts and emits no
+// RoleGranted events.
+type EventFilterer struct {
+	client        *rpc.Client
+	chainSelector types.ChainSelector
+
+	mu      sync.Mutex
+	windows map[solana.PublicKey]*signatureWindow
+}
+
+// NewEventFilterer creates a new EventFilterer for Solana chains
+func NewEventFilterer(client *rpc.Client, chainSelector types.ChainSelector) *EventFilterer {
+	return &EventFilterer{
+		client:        client,
+		chainSelector: chainSelector,
+		windows:       make(map[solana.PublicKey]*signatureWindow),
+	}
+}
+
+// LatestBlock returns the latest confirmed slot.
+func (f *EventFilterer) LatestBlock(ctx context.Context) (uint64, error) {
+	slot, err := f.client.GetSlot(ctx, rpc.CommitmentConfirmed)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest slot: %w", err)
+	}
+
+	return slot, nil
+}
+
+// eventSource is a program instance whose events are filtered.
+type eventSource struct {
+	address    string
+	programID  solana.PublicKey
+	account    solana.PublicKey
+	isTimelock bool
+}
+
+// eventTransaction is a successful transaction touching the account of some event sources.
+type eventTransaction struct {
+	signature solana.Signature
+	slot      uint64
+	sources   []eventSource
+}
+
+// FilterEvents returns the events of the query, ordered by slot and log position.
+func (f *EventFilterer) FilterEvents(ctx context.Context, query types.EventQuery) ([]types.Event, error) {
+	sources, err := eventSources(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var transactions []*eventTransaction
+	bySignature := map[solana.Signature]*eventTransaction{}
+	for _, source := range sources {
+		signatures, sigErr := f.signatures(ctx, source.account, query.FromBlock, query.ToBlock)
+		if sigErr != nil {
+			return nil, fmt.Errorf("failed to get signatures of %s: %w", source.address, sigErr)
+		}
+		for _, signature := range signatures {
+			tx, ok := bySignature[signature.Signature]
+			if !ok {
+				tx = &eventTransaction{signature: signature.Signature, slot: signature.Slot}
+				bySignature[signature.Signature] = tx
+				transactions = append(transactions, tx)
+			}
+			tx.sources = append(tx.sources, source)
+		}
+	}
+	slices.SortStableFunc(transactions, func(a, b *eventTransaction) int { return cmp.Compare(a.slot, b.slot) })
+
+	var events []types.Event
+	for _, tx := range transactions {
+		txEvents, txErr := f.transactionEvents(ctx, tx)
+		if txErr != nil {
+			return nil, fmt.Errorf("failed to get events of transaction %s: %w", tx.signature, txErr)
+		}
+		events = append(events, txEvents...)
+	}
+
+	return events, nil
+}
+
+func eventSources(query types.EventQuery) ([]eventSource, error) {
+	var sources []eventSource
+	if query.MCMAddress != "" {
+		programID, seed, err := ParseContractAddress(query.MCMAddress)
+		if err != nil {
+			return nil, err
+		}
+		configPDA, err := FindConfigPDA(programID, seed)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, eventSource{address: query.MCMAddress, programID: programID, account: configPDA})
+	}
+	if query.TimelockAddress != "" {
+		programID, seed, err := ParseContractAddress(query.TimelockAddress)
+		if err != nil {
+			return nil, err
+		}
+		configPDA, err := FindTimelockConfigPDA(programID, seed)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, eventSource{address: query.TimelockAddress, programID: programID, account: configPDA, isTimelock: true})
+	}
+
+	return sources, nil
+}
+
+// signatureWindow is a contiguous run of the signatures of an account, newest first. It is
+// extended with the Before cursor of its oldest signature towards older slots, and with the Until
+// cursor of its newest signature towards newer ones.
+type signatureWindow struct {
+	signatures []*rpc.TransactionSignature
+	// Whether the oldest signature of the window is the first one of the account
+	exhausted bool
+}
+
+// covers reports whether the window holds all the signatures of the account from slot onwards,
+// up to its newest signature.
+func (w *signatureWindow) covers(slot uint64) bool {
+	return w.exhausted || (len(w.signatures) > 0 && w.signatures[len(w.signatures)-1].Slot < slot)
+}
+
+// prune drops the signatures before slot, keeping the newest of them as the Before cursor.
+func (w *signatureWindow) prune(slot uint64) {
+	i := slices.IndexFunc(w.signatures, func(signature *rpc.TransactionSignature) bool { return signature.Slot < slot })
+	if i >= 0 && i+1 < len(w.signatures) {
+		w.signatures = w.signatures[:i+1]
+		w.exhausted = false
+	}
+}
+
+// signatures returns the signatures of the successful transactions touching account in the
+// slot range, oldest first.
+func (f *EventFilterer) signatures(
+	ctx context.Context, account solana.PublicKey, fromSlot, toSlot uint64,
+) ([]*rpc.TransactionSignature, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	window, ok := f.windows[account]
+	if !ok {
+		window = &signatureWindow{}
+		f.windows[account] = window
+	}
+
+	if len(window.signatures) == 0 {
+		// Page from the chain tip
+		page, exhausted, err := f.signaturePages(ctx, account, solana.Signature{}, solana.Signature{}, fromSlot)
+		if err != nil {
+			return nil, err
+		}
+		window.signatures, window.exhausted = page, exhausted
+	} else {
+		if toSlot > window.signatures[0].Slot {
+			// Page the signatures newer than the window
+			newer, _, err := f.signaturePages(ctx, account, solana.Signature{}, window.signatures[0].Signature, 0)
+			if err != nil {
+				return nil, err
+			}
+			window.signatures = append(newer, window.signatures...)
+		}
+		if !window.covers(fromSlot) {
+			// Page the signatures older than the window
+			before := window.signatures[len(window.signatures)-1].Signature
+			older, exhausted, err := f.signaturePages(ctx, account, before, solana.Signature{}, fromSlot)
+			if err != nil {
+				return nil, err
+			}
+			window.signatures, window.exhausted = append(window.signatures, older...), exhausted
+		}
+	}
+
+	var signatures []*rpc.TransactionSignature
+	for _, signature := range slices.Backward(window.signatures) {
+		if signature.Slot >= fromSlot && signature.Slot <= toSlot && signature.Err == nil {
+			signatures = append(signatures, signature)
+		}
+	}
+
+	// The next queries of a scan are for later slots
+	window.prune(fromSlot)
+
+	return signatures, nil
+}
+
+// signaturePages returns the signatures of account before and until the given signatures (when
+// set), newest first, fetching pages until a slot before fromSlot is reached. exhausted is true
+// when there are no older signatures.
+func (f *EventFilterer) signaturePages(
+	ctx context.Context, account solana.PublicKey, before, until solana.Signature, fromSlot uint64,
+) (signatures []*rpc.TransactionSignature, exhausted bool, err error) {
+	limit := signaturesPageSize
+	opts := &rpc.GetSignaturesForAddressOpts{Limit: &limit, Commitment: rpc.CommitmentConfirmed, Before: before, Until: until}
+
+	for {
+		page, err := f.client.GetSignaturesForAddressWithOpts(ctx, account, opts)
+		if err != nil {
+			return nil, false, err
+		}
+		signatures = append(signatures, page...)
+		if len(page) < limit {
+			return signatures, until.IsZero(), nil
+		}
+
+		if page[len(page)-1].Slot < fromSlot {
+			return signatures, false, nil
+		}
+		opts.Before = page[len(page)-1].Signature
+	}
+}
+
+func (f *EventFilterer) transactionEvents(ctx context.Context, tx *eventTransaction) ([]types.Event, error) {
+	version := uint64(0)
+	result, err := f.client.GetTransaction(ctx, tx.signature, &rpc.GetTransactionOpts{
+		Commitment:                     rpc.CommitmentConfirmed,
+		MaxSupportedTransactionVersion: &version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Meta == nil {
+		return nil, nil
+	}
+
+	var events []types.Event
+	for i, data := range programData(result.Meta.LogMessages) {
+		sourceIdx := slices.IndexFunc(tx.sources, func(source eventSource) bool { return source.programID == data.programID })
+		if sourceIdx < 0 {
+			continue
+		}
+		source := tx.sources[sourceIdx]
+
+		event, ok, decodeErr := decodeEvent(data.data, source.isTimelock)
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+		if !ok {
+			continue
+		}
+		event.ChainSelector = f.chainSelector
+		event.Address = source.address
+		event.Block = tx.slot
+		event.TxHash = tx.signature.String()
+		event.Index = uint(i) //nolint:gosec // i is a slice index
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// programLogData is the data logged by a program with sol_log_data, as anchor events are.
+type programLogData struct {
+	programID solana.PublicKey
+	data      []byte
+}
+
+// programData returns the data logged in a transaction, attributed to the program logging it by
+// following the invocations in the logs.
+func programData(logs []string) []programLogData {
+	var (
+		stack []solana.PublicKey
+		data  []programLogData
+	)
+	for _, line := range logs {
+		if encoded, ok := strings.CutPrefix(line, programDataLogPrefix); ok {
+			if len(stack) == 0 {
+				continue
+			}
+			for _, field := range strings.Fields(encoded) {
+				decoded, err := base64.StdEncoding.DecodeString(field)
+				if err != nil {
+					continue
+				}
+				data = append(data, programLogData{programID: stack[len(stack)-1], data: decoded})
+			}
+
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[0] != "Program" {
+			continue
+		}
+		switch {
+		case fields[2] == "invoke":
+			programID, err := solana.PublicKeyFromBase58(fields[1])
+			if err != nil {
+				continue
+			}
+			stack = append(stack, programID)
+		case (fields[2] == "success" || fields[2] == "failed:") && len(stack) > 0:
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	return data
+}
+
+// decodeEvent decodes the anchor event in data. It returns false if data is not an event of the
+// mcm program, or of the timelock program if isTimelock is set.
+func decodeEvent(data []byte, isTimelock bool) (types.Event, bool, error) {
+	if isTimelock {
+		return decodeTimelockEvent(data)
+	}
+
+	return decodeMCMEvent(data)
+}
+
+func decodeMCMEvent(data []byte) (types.Event, bool, error) {
+	switch {
+	case solanaCommon.IsEvent("NewRoot", data):
+		var event NewRootEvent
+		if err := decodeEventData(data, &event); err != nil {
+			return types.Event{}, false, err
+		}
+
+		return types.Event{Kind: types.EventKindNewRoot, Root: event.Root, ValidUntil: event.ValidUntil, RawData: event}, true, nil
+	case solanaCommon.IsEvent("OpExecuted", data):
+		var event OpExecutedEvent
+		if err := decodeEventData(data, &event); err != nil {
+			return types.Event{}, false, err
+		}
+
+		return types.Event{Kind: types.EventKindOpExecuted, Nonce: event.Nonce, RawData: event}, true, nil
+	case solanaCommon.IsEvent("ConfigSet", data):
+		var event ConfigSetEvent
+		if err := decodeEventData(data, &event); err != nil {
+			return types.Event{}, false, err
+		}
+
+		return types.Event{Kind: types.EventKindConfigSet, IsRootCleared: event.IsRootCleared, RawData: event}, true, nil
+	default:
+		return types.Event{}, false, nil
+	}
+}
+
+func decodeTimelockEvent(data []byte) (types.Event, bool, error) {
+	switch {
+	case solanaCommon.IsEvent("CallScheduled", data):
+		var event CallScheduledEvent
+		if err := decodeEventData(data, &event); err != nil {
+			return types.Event{}, false, err
+		}
+
+		return types.Event{Kind: types.EventKindCallScheduled, OperationID: event.ID, CallIndex: event.Index, RawData: event}, true, nil
+	case solanaCommon.IsEvent("CallExecuted", data):
+		var event CallExecutedEvent
+		if err := decodeEventData(data, &event); err != nil {
+			return types.Event{}, false, err
+		}
+
+		return types.Event{Kind: types.EventKindCallExecuted, OperationID: event.ID, CallIndex: event.Index, RawData: event}, true, nil
+	case solanaCommon.IsEvent("Cancelled", data):
+		var event CancelledEvent
+		if err := decodeEventData(data, &event); err != nil {
+			return types.Event{}, false, err
+		}
+
+		return types.Event{Kind: types.EventKindCancelled, OperationID: event.ID, RawData: event}, true, nil
+	default:
+		return types.Event{}, false, nil
+	}
+}
+
+// decodeEventData decodes the borsh encoded fields of an anchor event, after its discriminator.
+func decodeEventData(data []byte, event any) error {
+	const discriminatorLength = 8
+	if err := bin.NewBorshDecoder(data[discriminatorLength:]).Decode(event); err != nil {
+		return fmt.Errorf("failed to decode %T: %w", event, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+package solana
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	solanaCommon "github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+
+	"github.com/smartcontractkit/mcms/sdk/solana/mocks"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestEventFilterer_LatestBlock(t *testing.T) {
+	t.Parallel()
+
+	filterer, jsonRPCClient := newTestEventFilterer(t)
+	mockGetSlot(t, jsonRPCClient, 42, nil)
+
+	slot, err := filterer.LatestBlock(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), slot)
+
+	mockGetSlot(t, jsonRPCClient, 0, errors.New("rpc error"))
+
+	_, err = filterer.LatestBlock(t.Context())
+	require.EqualError(t, err, "failed to get latest slot: rpc error")
+}
+
+func TestEventFilterer_FilterEvents(t *testing.T) {
+	t.Parallel()
+
+	mcmAddress := ContractAddress(testMCMProgramID, testPDASeed)
+	timelockAddress := ContractAddress(testTimelockProgramID, testPDASeed)
+	mcmConfigPDA, err := FindConfigPDA(testMCMProgramID, testPDASeed)
+	require.NoError(t, err)
+	timelockConfigPDA, err := FindTimelockConfigPDA(testTimelockProgramID, testPDASeed)
+	require.NoError(t, err)
+
+	setRootSig := solana.Signature{1}
+	failedSig := solana.Signature{2}
+	executeSig := solana.Signature{3}
+	oldSig := solana.Signature{4}
+	opID := [32]byte{0xaa}
+
+	filterer, jsonRPCClient := newTestEventFilterer(t)
+	mockGetSignatures(t, jsonRPCClient, mcmConfigPDA, []*rpc.TransactionSignature{
+		{Signature: executeSig, Slot: 12},
+		{Signature: failedSig, Slot: 11, Err: map[string]any{"InstructionError": []any{0, "Custom"}}},
+		{Signature: setRootSig, Slot: 10},
+		{Signature: oldSig, Slot: 5},
+	})
+	mockGetSignatures(t, jsonRPCClient, timelockConfigPDA, []*rpc.TransactionSignature{
+		{Signature: executeSig, Slot: 12},
+	})
+	mockGetTransactionLogs(t, jsonRPCClient, setRootSig, []string{
+		"Program " + testMCMProgramID.String() + " invoke [1]",
+		"Program log: Instruction: SetRoot",
+		programDataLog(t, "NewRoot", NewRootEvent{Root: [32]byte{0x01}, ValidUntil: 100}),
+		"Program " + testMCMProgramID.String() + " success",
+	})
+	mockGetTransactionLogs(t, jsonRPCClient, executeSig, []string{
+		"Program " + testMCMProgramID.String() + " invoke [1]",
+		"Program " + testTimelockProgramID.String() + " invoke [2]",
+		programDataLog(t, "CallScheduled", CallScheduledEvent{ID: opID, Index: 1, Data: []byte{0x01}}),
+		"Program " + testTimelockProgramID.String() + " success",
+		programDataLog(t, "OpExecuted", OpExecutedEvent{Nonce: 7, Data: []byte{0x02}}),
+		"Program " + testMCMProgramID.String() + " success",
+	})
+
+	events, err := filterer.FilterEvents(t.Context(), types.EventQuery{
+		MCMAddress:      mcmAddress,
+		TimelockAddress: timelockAddress,
+		FromBlock:       10,
+		ToBlock:         12,
+	})
+	require.NoError(t, err)
+
+	selector := types.ChainSelector(chainsel.SOLANA_DEVNET.Selector)
+	require.Equal(t, []types.Event{
+		{
+			Kind: types.EventKindNewRoot, ChainSelector: selector, Address: mcmAddress, Block: 10, TxHash: setRootSig.String(),
+			Root: [32]byte{0x01}, ValidUntil: 100, RawData: NewRootEvent{Root: [32]byte{0x01}, ValidUntil: 100},
+		},
+		{
+			Kind: types.EventKindCallScheduled, ChainSelector: selector, Address: timelockAddress, Block: 12, TxHash: executeSig.String(),
+			OperationID: opID, CallIndex: 1, RawData: CallScheduledEvent{ID: opID, Index: 1, Data: []byte{0x01}},
+		},
+		{
+			Kind: types.EventKindOpExecuted, ChainSelector: selector, Address: mcmAddress, Block: 12, TxHash: executeSig.String(), Index: 1,
+			Nonce: 7, RawData: OpExecutedEvent{Nonce: 7, Data: []byte{0x02}},
+		},
+	}, events)
+}
+
+func TestEventFilterer_signatures(t *testing.T) {
+	t.Parallel()
+
+	account := solana.PublicKey{1}
+	sig := func(i byte, slot uint64) *rpc.TransactionSignature {
+		return &rpc.TransactionSignature{Signature: solana.Signature{i}, Slot: slot}
+	}
+	slots := func(signatures []*rpc.TransactionSignature) []uint64 {
+		got := make([]uint64, len(signatures))
+		for i, signature := range signatures {
+			got[i] = signature.Slot
+		}
+
+		return got
+	}
+
+	filterer, jsonRPCClient := newTestEventFilterer(t)
+
+	// The first chunk pages from the chain tip down to its first slot
+	mockGetSignaturesPage(t, jsonRPCClient, account, solana.Signature{}, solana.Signature{},
+		[]*rpc.TransactionSignature{sig(5, 50), sig(4, 40), sig(3, 30), sig(2, 20)})
+	got, err := filterer.signatures(t.Context(), account, 20, 29)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{20}, slots(got))
+
+	// The next chunks are served by the signatures already fetched
+	got, err = filterer.signatures(t.Context(), account, 30, 39)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{30}, slots(got))
+
+	// Chunks past the newest signature fetch the newer ones only, until the newest one
+	mockGetSignaturesPage(t, jsonRPCClient, account, solana.Signature{}, solana.Signature{5},
+		[]*rpc.TransactionSignature{sig(6, 60)})
+	got, err = filterer.signatures(t.Context(), account, 40, 69)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{40, 50, 60}, slots(got))
+
+	// Older chunks continue from the Before cursor of the oldest signature kept
+	mockGetSignaturesPage(t, jsonRPCClient, account, solana.Signature{3}, solana.Signature{},
+		[]*rpc.TransactionSignature{sig(2, 20), sig(1, 10)})
+	got, err = filterer.signatures(t.Context(), account, 10, 25)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 20}, slots(got))
+}
+
+func TestEventFilterer_FilterEvents_InvalidAddress(t *testing.T) {
+	t.Parallel()
+
+	filterer, _ := newTestEventFilterer(t)
+
+	_, err := filterer.FilterEvents(t.Context(), types.EventQuery{MCMAddress: "invalid"})
+	require.ErrorIs(t, err, ErrInvalidContractAddressFormat)
+}
+
+func TestProgramData(t *testing.T) {
+	t.Parallel()
+
+	other := randomPublicKey(t)
+	logs := []string{
+		"Program " + testMCMProgramID.String() + " invoke [1]",
+		"Program data: AQ==",
+		"Program " + other.String() + " invoke [2]",
+		"Program data: Ag== Aw==",
+		"Program " + other.String() + " failed: custom program error: 0x1",
+		"Program log: Program data: not data",
+		"Program data: BA==",
+		"Program " + testMCMProgramID.String() + " consumed 100 of 200000 compute units",
+		"Program " + testMCMProgramID.String() + " success",
+		"Program data: BQ==",
+	}
+
+	require.Equal(t, []programLogData{
+		{programID: testMCMProgramID, data: []byte{1}},
+		{programID: other, data: []byte{2}},
+		{programID: other, data: []byte{3}},
+		{programID: testMCMProgramID, data: []byte{4}},
+	}, programData(logs))
+}
+
+// ----- helpers -----
+
+func newTestEventFilterer(t *testing.T) (*EventFilterer, *mocks.JSONRPCClient) {
+	t.Helper()
+	jsonRPCClient := mocks.NewJSONRPCClient(t)
+	filterer := NewEventFilterer(rpc.NewWithCustomRPCClient(jsonRPCClient), types.ChainSelector(chainsel.SOLANA_DEVNET.Selector))
+
+	return filterer, jsonRPCClient
+}
+
+func mockGetSlot(t *testing.T, client *mocks.JSONRPCClient, slot uint64, mockError error) {
+	t.Helper()
+
+	client.EXPECT().CallForInto(anyContext, mock.Anything, "getSlot",
+		[]any{rpc.M{"commitment": rpc.CommitmentConfirmed}},
+	).RunAndReturn(func(_ context.Context, output any, _ string, _ []any) error {
+		result, ok := output.(*uint64)
+		require.True(t, ok)
+		*result = slot
+
+		return mockError
+	}).Once()
+}
+
+func mockGetSignatures(
+	t *testing.T, client *mocks.JSONRPCClient, account solana.PublicKey, signatures []*rpc.TransactionSignature,
+) {
+	t.Helper()
+
+	client.EXPECT().CallForInto(anyContext, mock.Anything, "getSignaturesForAddress",
+		mock.MatchedBy(func(params []any) bool { return params[0] == account }),
+	).RunAndReturn(func(_ context.Context, output any, _ string, _ []any) error {
+		result, ok := output.(*[]*rpc.TransactionSignature)
+		require.True(t, ok)
+		*result = signatures
+
+		return nil
+	}).Once()
+}
+
+func mockGetSignaturesPage(
+	t *testing.T, client *mocks.JSONRPCClient, account solana.PublicKey, before, until solana.Signature,
+	signatures []*rpc.TransactionSignature,
+) {
+	t.Helper()
+
+	client.EXPECT().CallForInto(anyContext, mock.Anything, "getSignaturesForAddress",
+		mock.MatchedBy(func(params []any) bool {
+			opts, ok := params[1].(rpc.M)
+			if !ok || params[0] != account {
+				return false
+			}
+			gotBefore, _ := opts["before"].(solana.Signature)
+			gotUntil, _ := opts["until"].(solana.Signature)
+
+			return gotBefore == before && gotUntil == until
+		}),
+	).RunAndReturn(func(_ context.Context, output any, _ string, _ []any) error {
+		result, ok := output.(*[]*rpc.TransactionSignature)
+		require.True(t, ok)
+		*result = signatures
+
+		return nil
+	}).Once()
+}
+
+func mockGetTransactionLogs(t *testing.T, client *mocks.JSONRPCClient, signature solana.Signature, logs []string) {
+	t.Helper()
+
+	client.EXPECT().CallForInto(anyContext, mock.Anything, "getTransaction",
+		mock.MatchedBy(func(params []any) bool { return params[0] == signature }),
+	).RunAndReturn(func(_ context.Context, output any, _ string, _ []any) error {
+		result, ok := output.(**rpc.GetTransactionResult)
+		require.True(t, ok)
+		*result = &rpc.GetTransactionResult{Meta: &rpc.TransactionMeta{LogMessages: logs}}
+
+		return nil
+	}).Once()
+}
+
+func programDataLog(t *testing.T, name string, event any) string {
+	t.Helper()
+
+	data, err := bin.MarshalBorsh(event)
+	require.NoError(t, err)
+
+	return programDataLogPrefix + base64.StdEncoding.EncodeToString(append(solanaCommon.Discriminator("event", name), data...))
+}
@@ -0,0 +1,187 @@
+package solana
+
+import (
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func init() {
+	if err := sdk.RegisterFamily(sdk.Family{
+		Name: chainsel.FamilySolana,
+		NewEncoder: func(selector types.ChainSelector, txCount uint64, overridePreviousRoot bool, _ bool) sdk.Encoder {
+			return NewEncoder(selector, txCount, overridePreviousRoot)
+		},
+		NewTimelockConverter: func(types.ChainSelector, types.ChainMetadata) (sdk.TimelockConverter, error) {
+			return NewTimelockConverter(), nil
+		},
+		NewExecutor:              buildSolanaExecutor,
+		NewSimulator:             buildSolanaSimulator,
+		NewInspector:             buildSolanaInspector,
+		NewTimelockExecutor:      buildSolanaTimelockExecutor,
+		NewTimelockInspector:     buildSolanaTimelockInspector,
+		NewTimelockConfigurer:    buildSolanaTimelockConfigurer,
+		NewEventFilterer:         buildSolanaEventFilterer,
+		NewDecoder:               func() sdk.Decoder { return NewDecoder() },
+		OperationID:              OperationID,
+		ValidateAdditionalFields: ValidateAdditionalFields,
+		ValidateChainMetadata:    ValidateChainMetadata,
+		TimelockRoleMember:       solanaTimelockRoleMember,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// solanaTimelockRoleMember returns the signer PDA of the MCM, which calls the timelock.
+func solanaTimelockRoleMember(mcmAddress string) (string, error) {
+	programID, seed, err := ParseContractAddress(mcmAddress)
+	if err != nil {
+		return "", err
+	}
+	signer, err := FindSignerPDA(programID, seed)
+	if err != nil {
+		return "", err
+	}
+
+	return signer.String(), nil
+}
+
+func buildSolanaExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Executor, error) {
+	executor, err := newSolanaExecutor(chains, chainSelector, encoder)
+	if err != nil {
+		return nil, err
+	}
+
+	return executor, nil
+}
+
+func newSolanaExecutor(chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder) (*Executor, error) {
+	rawSelector := uint64(chainSelector)
+	solanaEncoder, ok := encoder.(*Encoder)
+	if !ok {
+		return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
+	}
+	client, ok := solanaClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing solana chain client for selector %d", chainSelector)
+	}
+	signer, ok := solanaSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing solana chain signer for selector %d", chainSelector)
+	}
+
+	return NewExecutor(solanaEncoder, client, *signer), nil
+}
+
+// buildSolanaSimulator simulates the transactions of the executor.
+func buildSolanaSimulator(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Simulator, error) {
+	executor, err := newSolanaExecutor(chains, chainSelector, encoder)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSimulator(executor), nil
+}
+
+func buildSolanaInspector(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Inspector, error) {
+	rawSelector := uint64(selector)
+	client, ok := solanaClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Solana chain client for selector %d", rawSelector)
+	}
+
+	return NewInspector(client), nil
+}
+
+func buildSolanaTimelockExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.TimelockExecutor, error) {
+	rawSelector := uint64(chainSelector)
+	client, ok := solanaClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing solana chain client for selector %d", chainSelector)
+	}
+	signer, ok := solanaSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing solana chain signer for selector %d", chainSelector)
+	}
+
+	return NewTimelockExecutor(client, *signer), nil
+}
+
+func buildSolanaTimelockInspector(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, _ types.ChainMetadata,
+) (sdk.TimelockInspector, error) {
+	rawSelector := uint64(chainSelector)
+	client, ok := solanaClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Solana chain client for selector %d", rawSelector)
+	}
+
+	return NewTimelockInspector(client), nil
+}
+
+func buildSolanaTimelockConfigurer(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.TimelockConfigurer, error) {
+	rawSelector := uint64(selector)
+	client, ok := solanaClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Solana chain client for selector %d", rawSelector)
+	}
+	signer, ok := solanaSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Solana chain signer for selector %d", rawSelector)
+	}
+
+	return NewTimelockConfigurer(client, *signer), nil
+}
+
+func buildSolanaEventFilterer(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.EventFilterer, error) {
+	rawSelector := uint64(selector)
+	client, ok := solanaClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Solana chain client for selector %d", rawSelector)
+	}
+
+	return NewEventFilterer(client, selector), nil
+}
+
+// ChainAccessor is implemented by a sdk.ChainAccessor to serve Solana chains.
+type ChainAccessor interface {
+	SolanaClient(selector uint64) (*rpc.Client, bool)
+	SolanaSigner(selector uint64) (*solana.PrivateKey, bool)
+}
+
+// solanaClient returns the Solana client of the selector if the ChainAccessor serves Solana chains.
+func solanaClient(chains sdk.ChainAccessor, selector uint64) (*rpc.Client, bool) {
+	solanaChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return solanaChains.SolanaClient(selector)
+}
+
+// solanaSigner returns the Solana signer of the selector if the ChainAccessor serves Solana chains.
+func solanaSigner(chains sdk.ChainAccessor, selector uint64) (*solana.PrivateKey, bool) {
+	solanaChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return solanaChains.SolanaSigner(selector)
+}
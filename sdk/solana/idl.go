@@ -0,0 +1,256 @@
+package solana
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// discriminatorLength is the length of the Anchor instruction discriminator prefixing the
+// instruction data.
+const discriminatorLength = 8
+
+// idl is the subset of an Anchor IDL needed to decode instructions. Both the legacy format (Anchor
+// < 0.30, with camelCase names and derived discriminators) and the current format (explicit
+// discriminators) are supported.
+type idl struct {
+	Instructions []idlInstruction `json:"instructions"`
+	Types        []idlTypeDef     `json:"types"`
+}
+
+type idlInstruction struct {
+	Name          string           `json:"name"`
+	Discriminator []byte           `json:"-"`
+	Accounts      []idlAccountItem `json:"accounts"`
+	Args          []idlField       `json:"args"`
+}
+
+func (i *idlInstruction) UnmarshalJSON(b []byte) error {
+	type instruction idlInstruction
+	var raw struct {
+		instruction
+		// Decoded as integers, as []byte would be decoded from base64
+		Discriminator []uint16 `json:"discriminator"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	*i = idlInstruction(raw.instruction)
+	for _, d := range raw.Discriminator {
+		if d > 0xff {
+			return fmt.Errorf("invalid discriminator of instruction %s", i.Name)
+		}
+		i.Discriminator = append(i.Discriminator, byte(d))
+	}
+	if len(i.Discriminator) == 0 {
+		i.Discriminator = legacyDiscriminator(i.Name)
+	}
+
+	return nil
+}
+
+// idlAccountItem is an instruction account, or a group of accounts when Accounts is set.
+type idlAccountItem struct {
+	Name     string           `json:"name"`
+	Accounts []idlAccountItem `json:"accounts"`
+}
+
+// accountNames flattens the instruction accounts into the order in which they are passed to the
+// instruction. Accounts of nested groups are prefixed with the group name.
+func accountNames(items []idlAccountItem, prefix string) []string {
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		if len(item.Accounts) > 0 {
+			names = append(names, accountNames(item.Accounts, prefix+item.Name+".")...)
+			continue
+		}
+		names = append(names, prefix+item.Name)
+	}
+
+	return names
+}
+
+type idlField struct {
+	Name string  `json:"name"`
+	Type idlType `json:"type"`
+}
+
+// idlType is an IDL type: a primitive, or one of the vec, option, array and defined compound types.
+type idlType struct {
+	Primitive string
+	Vec       *idlType
+	Option    *idlType
+	COption   *idlType
+	Array     *idlType
+	ArrayLen  int
+	Defined   string
+}
+
+func (t *idlType) UnmarshalJSON(b []byte) error {
+	var primitive string
+	if err := json.Unmarshal(b, &primitive); err == nil {
+		t.Primitive = primitive
+		return nil
+	}
+
+	var compound struct {
+		Vec     *idlType          `json:"vec"`
+		Option  *idlType          `json:"option"`
+		COption *idlType          `json:"coption"`
+		Array   []json.RawMessage `json:"array"`
+		Defined json.RawMessage   `json:"defined"`
+	}
+	if err := json.Unmarshal(b, &compound); err != nil {
+		return fmt.Errorf("invalid IDL type %s: %w", b, err)
+	}
+
+	switch {
+	case compound.Vec != nil:
+		t.Vec = compound.Vec
+	case compound.Option != nil:
+		t.Option = compound.Option
+	case compound.COption != nil:
+		t.COption = compound.COption
+	case compound.Array != nil:
+		if len(compound.Array) != 2 {
+			return fmt.Errorf("invalid IDL array type %s", b)
+		}
+		t.Array = &idlType{}
+		if err := json.Unmarshal(compound.Array[0], t.Array); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(compound.Array[1], &t.ArrayLen); err != nil {
+			return fmt.Errorf("invalid IDL array length %s: %w", compound.Array[1], err)
+		}
+	case compound.Defined != nil:
+		// Legacy IDLs use the type name, current IDLs an object with the name
+		if err := json.Unmarshal(compound.Defined, &t.Defined); err != nil {
+			var defined struct {
+				Name string `json:"name"`
+			}
+			if err = json.Unmarshal(compound.Defined, &defined); err != nil {
+				return fmt.Errorf("invalid IDL defined type %s: %w", compound.Defined, err)
+			}
+			t.Defined = defined.Name
+		}
+	default:
+		return fmt.Errorf("unsupported IDL type %s", b)
+	}
+
+	return nil
+}
+
+type idlTypeDef struct {
+	Name string `json:"name"`
+	Type struct {
+		Kind     string       `json:"kind"`
+		Fields   []idlField   `json:"fields"`
+		Variants []idlVariant `json:"variants"`
+	} `json:"type"`
+}
+
+// idlVariant is an enum variant. Variants hold either named fields or tuple fields.
+type idlVariant struct {
+	Name        string
+	Fields      []idlField
+	TupleFields []idlType
+}
+
+func (v *idlVariant) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Name   string            `json:"name"`
+		Fields []json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	v.Name = raw.Name
+	for _, field := range raw.Fields {
+		var named idlField
+		if err := json.Unmarshal(field, &named); err == nil && named.Name != "" {
+			v.Fields = append(v.Fields, named)
+			continue
+		}
+
+		var tuple idlType
+		if err := json.Unmarshal(field, &tuple); err != nil {
+			return fmt.Errorf("invalid field of enum variant %s: %w", v.Name, err)
+		}
+		v.TupleFields = append(v.TupleFields, tuple)
+	}
+
+	return nil
+}
+
+// parseIDL parses an Anchor IDL JSON document.
+func parseIDL(contractInterfaces string) (*idl, error) {
+	var parsed idl
+	if err := json.Unmarshal([]byte(contractInterfaces), &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse IDL: %w", err)
+	}
+	if len(parsed.Instructions) == 0 {
+		return nil, errors.New("IDL does not define any instructions")
+	}
+
+	return &parsed, nil
+}
+
+// instruction finds the instruction whose discriminator prefixes the data.
+func (i *idl) instruction(data []byte) (*idlInstruction, error) {
+	if len(data) < discriminatorLength {
+		return nil, fmt.Errorf("instruction data of %d bytes is shorter than the discriminator", len(data))
+	}
+
+	for idx := range i.Instructions {
+		ix := &i.Instructions[idx]
+		if len(ix.Discriminator) > 0 && bytes.HasPrefix(data, ix.Discriminator) {
+			return ix, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no instruction found for discriminator %x", data[:discriminatorLength])
+}
+
+func (i *idl) typeDef(name string) (*idlTypeDef, error) {
+	for idx := range i.Types {
+		if i.Types[idx].Name == name {
+			return &i.Types[idx], nil
+		}
+	}
+
+	return nil, fmt.Errorf("type %s is not defined in the IDL", name)
+}
+
+// legacyDiscriminator derives the discriminator of an instruction of a legacy IDL, which is the
+// first 8 bytes of the sha256 hash of "global:<snake_case_name>".
+func legacyDiscriminator(name string) []byte {
+	hash := sha256.Sum256([]byte("global:" + toSnakeCase(name)))
+
+	return hash[:discriminatorLength]
+}
+
+// toSnakeCase converts a camelCase instruction name to the snake_case name of the program.
+func toSnakeCase(s string) string {
+	runes := []rune(s)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]))
+			nextLower := i > 0 && i+1 < len(runes) && unicode.IsUpper(runes[i-1]) && unicode.IsLower(runes[i+1])
+			if prevLower || nextLower {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
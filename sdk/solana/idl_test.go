@@ -0,0 +1,93 @@
+package solana
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "setRoot", want: "set_root"},
+		{in: "initialize", want: "initialize"},
+		{in: "appendSignatures", want: "append_signatures"},
+		{in: "setConfigV2", want: "set_config_v2"},
+		{in: "initSVMConfig", want: "init_svm_config"},
+		{in: "already_snake", want: "already_snake"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, toSnakeCase(tt.in))
+		})
+	}
+}
+
+func TestLegacyDiscriminator(t *testing.T) {
+	t.Parallel()
+
+	hash := sha256.Sum256([]byte("global:set_root"))
+	require.Equal(t, hash[:8], legacyDiscriminator("setRoot"))
+}
+
+func TestIDLType_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		json    string
+		want    idlType
+		wantErr string
+	}{
+		{name: "primitive", json: `"u64"`, want: idlType{Primitive: "u64"}},
+		{name: "vec", json: `{"vec":"u8"}`, want: idlType{Vec: &idlType{Primitive: "u8"}}},
+		{name: "option", json: `{"option":"bool"}`, want: idlType{Option: &idlType{Primitive: "bool"}}},
+		{name: "coption", json: `{"coption":"pubkey"}`, want: idlType{COption: &idlType{Primitive: "pubkey"}}},
+		{name: "array", json: `{"array":["u8",32]}`, want: idlType{Array: &idlType{Primitive: "u8"}, ArrayLen: 32}},
+		{name: "legacy defined", json: `{"defined":"Role"}`, want: idlType{Defined: "Role"}},
+		{name: "defined", json: `{"defined":{"name":"Role"}}`, want: idlType{Defined: "Role"}},
+		{name: "invalid array", json: `{"array":["u8"]}`, wantErr: "invalid IDL array type"},
+		{name: "unsupported", json: `{"generic":"T"}`, wantErr: "unsupported IDL type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got idlType
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseIDL(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := parseIDL(testCurrentIDL)
+	require.NoError(t, err)
+	require.Len(t, parsed.Instructions, 1)
+	require.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8}, parsed.Instructions[0].Discriminator)
+	require.Equal(t, []string{"state", "admin.owner", "admin.delegate"}, accountNames(parsed.Instructions[0].Accounts, ""))
+
+	_, err = parseIDL(`{"instructions":[]}`)
+	require.EqualError(t, err, "IDL does not define any instructions")
+
+	_, err = parseIDL(`{"instructions":[{"name":"x","discriminator":[256]}]}`)
+	require.ErrorContains(t, err, "invalid discriminator of instruction x")
+}
@@ -17,6 +17,7 @@ import (
 )
 
 var _ sdk.Inspector = (*Inspector)(nil)
+var _ sdk.PostOpCountInspector = (*Inspector)(nil)
 
 // Inspector is an Inspector implementation for Solana chains, giving access to the state of the MCMS contract
 type Inspector struct {
@@ -117,6 +118,26 @@ func (e *Inspector) GetRootMetadata(ctx context.Context, mcmAddress string) (typ
 	}, nil
 }
 
+// GetRootPostOpCount returns the post-op count of the root held by the MCM.
+func (e *Inspector) GetRootPostOpCount(ctx context.Context, mcmAddress string) (uint64, error) {
+	programID, seed, err := ParseContractAddress(mcmAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	pda, err := FindRootMetadataPDA(programID, seed)
+	if err != nil {
+		return 0, err
+	}
+	var rootMetadata bindings.RootMetadata
+	err = solanaCommon.GetAccountDataBorshInto(ctx, e.client, pda, rpc.CommitmentConfirmed, &rootMetadata)
+	if err != nil {
+		return 0, err
+	}
+
+	return rootMetadata.PostOpCount, nil
+}
+
 func (e *Inspector) getExpiringRootAndOpCountData(ctx context.Context, expiringRootAndOpCountPDA solana.PublicKey,
 ) (bindings.ExpiringRootAndOpCount, error) {
 	var newRootAndOpCount bindings.ExpiringRootAndOpCount
@@ -0,0 +1,293 @@
+package stellar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stellar/go-stellar-sdk/keypair"
+	protocol "github.com/stellar/go-stellar-sdk/protocols/rpc"
+	"github.com/stellar/go-stellar-sdk/txnbuild"
+	"github.com/stellar/go-stellar-sdk/xdr"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// RPCClient is the subset of the Stellar RPC (Soroban RPC) API used by the MCMS SDK.
+//
+// It is satisfied by *rpcclient.Client from github.com/stellar/go-stellar-sdk/clients/rpcclient.
+type RPCClient interface {
+	GetNetwork(ctx context.Context) (protocol.GetNetworkResponse, error)
+	SimulateTransaction(ctx context.Context, request protocol.SimulateTransactionRequest) (protocol.SimulateTransactionResponse, error)
+	SendTransaction(ctx context.Context, request protocol.SendTransactionRequest) (protocol.SendTransactionResponse, error)
+	GetTransaction(ctx context.Context, request protocol.GetTransactionRequest) (protocol.GetTransactionResponse, error)
+	LoadAccount(ctx context.Context, address string) (txnbuild.Account, error)
+}
+
+const (
+	// simulationSourceAccount is the source account used for read-only contract calls. Simulation
+	// does not check signatures or sequence numbers, so any well-formed account id works.
+	simulationSourceAccount = "GAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAWHF"
+
+	// sendStatusPending, sendStatusDuplicate, sendStatusTryAgainLater and sendStatusError are the
+	// statuses returned by sendTransaction.
+	sendStatusPending       = "PENDING"
+	sendStatusDuplicate     = "DUPLICATE"
+	sendStatusTryAgainLater = "TRY_AGAIN_LATER"
+	sendStatusError         = "ERROR"
+
+	// DefaultPollInterval is the interval at which submitted transactions are polled for a result.
+	DefaultPollInterval = 500 * time.Millisecond
+	// DefaultPollTimeout is how long to wait for a submitted transaction before giving up.
+	DefaultPollTimeout = 60 * time.Second
+	// defaultTxTimeout is the validity window of submitted transactions, in seconds.
+	defaultTxTimeout = 300
+)
+
+// ErrTransactionFailed is returned when a submitted transaction is included in a ledger but fails.
+var ErrTransactionFailed = errors.New("stellar transaction failed")
+
+// contractInvoker wraps an RPCClient with the logic to simulate and submit Soroban contract
+// invocations. A nil signer restricts the invoker to read-only calls.
+type contractInvoker struct {
+	client RPCClient
+	signer *keypair.Full
+
+	pollInterval time.Duration
+	pollTimeout  time.Duration
+}
+
+func newContractInvoker(client RPCClient, signer *keypair.Full) *contractInvoker {
+	return &contractInvoker{
+		client:       client,
+		signer:       signer,
+		pollInterval: DefaultPollInterval,
+		pollTimeout:  DefaultPollTimeout,
+	}
+}
+
+// call simulates a read-only invocation of a contract function and returns its return value.
+func (c *contractInvoker) call(ctx context.Context, contract string, function string, args ...xdr.ScVal) (xdr.ScVal, error) {
+	op, err := invokeContractOp(contract, function, args)
+	if err != nil {
+		return xdr.ScVal{}, err
+	}
+
+	tx, err := buildTransaction(&txnbuild.SimpleAccount{AccountID: simulationSourceAccount}, op, txnbuild.MinBaseFee)
+	if err != nil {
+		return xdr.ScVal{}, err
+	}
+
+	sim, err := c.simulate(ctx, tx, function)
+	if err != nil {
+		return xdr.ScVal{}, err
+	}
+
+	if len(sim.Results) == 0 || sim.Results[0].ReturnValueXDR == nil {
+		return xdr.ScVal{}, fmt.Errorf("simulation of %s returned no result", function)
+	}
+
+	var ret xdr.ScVal
+	if err := xdr.SafeUnmarshalBase64(*sim.Results[0].ReturnValueXDR, &ret); err != nil {
+		return xdr.ScVal{}, fmt.Errorf("failed to decode %s return value: %w", function, err)
+	}
+
+	return ret, nil
+}
+
+// invoke simulates, signs and submits a state-changing invocation of a contract function and
+// waits for it to be included in a ledger.
+func (c *contractInvoker) invoke(ctx context.Context, contract string, function string, args ...xdr.ScVal) (types.TransactionResult, error) {
+	if c.signer == nil {
+		return types.TransactionResult{}, errors.New("signer is required to submit stellar transactions")
+	}
+
+	network, err := c.client.GetNetwork(ctx)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to get network passphrase: %w", err)
+	}
+
+	account, err := c.client.LoadAccount(ctx, c.signer.Address())
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to load source account %s: %w", c.signer.Address(), err)
+	}
+	sequence, err := account.GetSequenceNumber()
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to get source account sequence: %w", err)
+	}
+	source := &txnbuild.SimpleAccount{AccountID: account.GetAccountID(), Sequence: sequence}
+
+	op, err := invokeContractOp(contract, function, args)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	// Simulate first to obtain the footprint, resource fee and authorization entries
+	simTx, err := buildTransaction(&txnbuild.SimpleAccount{AccountID: source.AccountID, Sequence: source.Sequence}, op, txnbuild.MinBaseFee)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	sim, err := c.simulate(ctx, simTx, function)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	var sorobanData xdr.SorobanTransactionData
+	if err = xdr.SafeUnmarshalBase64(sim.TransactionDataXDR, &sorobanData); err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to decode soroban transaction data: %w", err)
+	}
+	op.Ext = xdr.TransactionExt{V: 1, SorobanData: &sorobanData}
+
+	if len(sim.Results) > 0 && sim.Results[0].AuthXDR != nil {
+		for _, a := range *sim.Results[0].AuthXDR {
+			var entry xdr.SorobanAuthorizationEntry
+			if err = xdr.SafeUnmarshalBase64(a, &entry); err != nil {
+				return types.TransactionResult{}, fmt.Errorf("failed to decode authorization entry: %w", err)
+			}
+			op.Auth = append(op.Auth, entry)
+		}
+	}
+
+	tx, err := buildTransaction(source, op, txnbuild.MinBaseFee+sim.MinResourceFee)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	tx, err = tx.Sign(network.Passphrase, c.signer)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	envelope, err := tx.Base64()
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to encode transaction envelope: %w", err)
+	}
+
+	sent, err := c.client.SendTransaction(ctx, protocol.SendTransactionRequest{Transaction: envelope})
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to send %s transaction: %w", function, err)
+	}
+
+	switch sent.Status {
+	case sendStatusPending, sendStatusDuplicate:
+	case sendStatusTryAgainLater, sendStatusError:
+		return types.TransactionResult{}, fmt.Errorf("%s transaction %s rejected with status %s: %s",
+			function, sent.Hash, sent.Status, sent.ErrorResultXDR)
+	default:
+		return types.TransactionResult{}, fmt.Errorf("%s transaction %s returned unknown status %s", function, sent.Hash, sent.Status)
+	}
+
+	result, err := c.waitForTransaction(ctx, sent.Hash)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("%s transaction %s: %w", function, sent.Hash, err)
+	}
+
+	return types.NewTransactionResult(sent.Hash, result, chainsel.FamilyStellar), nil
+}
+
+// simulate runs simulateTransaction and surfaces simulation errors.
+func (c *contractInvoker) simulate(ctx context.Context, tx *txnbuild.Transaction, function string) (protocol.SimulateTransactionResponse, error) {
+	envelope, err := tx.Base64()
+	if err != nil {
+		return protocol.SimulateTransactionResponse{}, fmt.Errorf("failed to encode transaction envelope: %w", err)
+	}
+
+	sim, err := c.client.SimulateTransaction(ctx, protocol.SimulateTransactionRequest{Transaction: envelope})
+	if err != nil {
+		return protocol.SimulateTransactionResponse{}, fmt.Errorf("failed to simulate %s: %w", function, err)
+	}
+	if sim.Error != "" {
+		return protocol.SimulateTransactionResponse{}, &SimulationError{Function: function, Message: sim.Error, Events: sim.EventsXDR}
+	}
+	if sim.RestorePreamble != nil {
+		return protocol.SimulateTransactionResponse{}, fmt.Errorf("simulation of %s requires restoring archived ledger entries", function)
+	}
+
+	return sim, nil
+}
+
+// waitForTransaction polls getTransaction until the transaction leaves the NOT_FOUND state.
+func (c *contractInvoker) waitForTransaction(ctx context.Context, hash string) (protocol.GetTransactionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.pollTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := c.client.GetTransaction(ctx, protocol.GetTransactionRequest{Hash: hash})
+		if err != nil {
+			if ctx.Err() != nil {
+				return protocol.GetTransactionResponse{}, fmt.Errorf("timed out waiting for transaction: %w", ctx.Err())
+			}
+
+			return protocol.GetTransactionResponse{}, fmt.Errorf("failed to get transaction: %w", err)
+		}
+
+		switch resp.Status {
+		case protocol.TransactionStatusSuccess:
+			return resp, nil
+		case protocol.TransactionStatusFailed:
+			return resp, fmt.Errorf("%w: %s", ErrTransactionFailed, resp.ResultXDR)
+		}
+
+		select {
+		case <-ctx.Done():
+			return protocol.GetTransactionResponse{}, fmt.Errorf("timed out waiting for transaction: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// SimulationError is returned when the Stellar RPC reports an error while simulating a contract
+// invocation. Events holds the base64 encoded diagnostic events emitted during the simulation.
+type SimulationError struct {
+	Function string
+	Message  string
+	Events   []string
+}
+
+func (e *SimulationError) Error() string {
+	return fmt.Sprintf("simulation of %s failed: %s", e.Function, e.Message)
+}
+
+func invokeContractOp(contract string, function string, args []xdr.ScVal) (*txnbuild.InvokeHostFunction, error) {
+	contractID, err := parseContractID(contract)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract address %q: %w", contract, err)
+	}
+
+	if args == nil {
+		args = []xdr.ScVal{}
+	}
+
+	return &txnbuild.InvokeHostFunction{
+		HostFunction: xdr.HostFunction{
+			Type: xdr.HostFunctionTypeHostFunctionTypeInvokeContract,
+			InvokeContract: &xdr.InvokeContractArgs{
+				ContractAddress: contractScAddress(contractID),
+				FunctionName:    xdr.ScSymbol(function),
+				Args:            args,
+			},
+		},
+	}, nil
+}
+
+func buildTransaction(source txnbuild.Account, op *txnbuild.InvokeHostFunction, fee int64) (*txnbuild.Transaction, error) {
+	tx, err := txnbuild.NewTransaction(txnbuild.TransactionParams{
+		SourceAccount:        source,
+		IncrementSequenceNum: true,
+		Operations:           []txnbuild.Operation{op},
+		BaseFee:              fee,
+		Preconditions:        txnbuild.Preconditions{TimeBounds: txnbuild.NewTimeout(defaultTxTimeout)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transaction: %w", err)
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,123 @@
+package stellar
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/stellar/go-stellar-sdk/keypair"
+	protocol "github.com/stellar/go-stellar-sdk/protocols/rpc"
+	"github.com/stellar/go-stellar-sdk/xdr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractInvoker_Call(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.handle("echo", func(args []xdr.ScVal) (xdr.ScVal, error) {
+		require.Len(t, args, 1)
+		return args[0], nil
+	})
+
+	invoker := newContractInvoker(client, nil)
+	ret, err := invoker.call(context.Background(), testContractAddress(1), "echo", scU64(42))
+	require.NoError(t, err)
+
+	n, err := fromU64(ret)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), n)
+	require.Empty(t, rpc.submitted())
+}
+
+func TestContractInvoker_Call_SimulationError(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.handle("boom", func([]xdr.ScVal) (xdr.ScVal, error) {
+		return xdr.ScVal{}, errors.New("HostError: Error(Contract, #3)")
+	})
+
+	invoker := newContractInvoker(client, nil)
+	_, err := invoker.call(context.Background(), testContractAddress(1), "boom")
+
+	var simErr *SimulationError
+	require.ErrorAs(t, err, &simErr)
+	require.Equal(t, "boom", simErr.Function)
+	require.Contains(t, simErr.Message, "Error(Contract, #3)")
+}
+
+func TestContractInvoker_Call_InvalidContract(t *testing.T) {
+	t.Parallel()
+
+	_, client := newRPCStandIn(t)
+	invoker := newContractInvoker(client, nil)
+
+	_, err := invoker.call(context.Background(), "not-a-contract", "get_op_count")
+	require.ErrorContains(t, err, "invalid contract address")
+}
+
+func TestContractInvoker_Invoke(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("set_value", scVoid())
+
+	contract := testContractAddress(2)
+	invoker := newContractInvoker(client, keypair.MustRandom())
+	result, err := invoker.invoke(context.Background(), contract, "set_value", scU32(7))
+	require.NoError(t, err)
+
+	require.Len(t, result.Hash, 64)
+	require.Equal(t, chainsel.FamilyStellar, result.ChainFamily)
+	require.IsType(t, protocol.GetTransactionResponse{}, result.RawData)
+
+	submitted := rpc.submitted()
+	require.Len(t, submitted, 1)
+	require.Equal(t, contract, submitted[0].Contract)
+	require.Equal(t, "set_value", submitted[0].Function)
+	require.Equal(t, []xdr.ScVal{scU32(7)}, submitted[0].Args)
+}
+
+func TestContractInvoker_Invoke_NoSigner(t *testing.T) {
+	t.Parallel()
+
+	_, client := newRPCStandIn(t)
+	invoker := newContractInvoker(client, nil)
+
+	_, err := invoker.invoke(context.Background(), testContractAddress(2), "set_value")
+	require.ErrorContains(t, err, "signer is required")
+}
+
+func TestContractInvoker_Invoke_TransactionFailed(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("set_value", scVoid())
+	rpc.setTxStatus(protocol.TransactionStatusFailed)
+
+	invoker := newContractInvoker(client, keypair.MustRandom())
+	_, err := invoker.invoke(context.Background(), testContractAddress(2), "set_value")
+	require.ErrorIs(t, err, ErrTransactionFailed)
+}
+
+func TestContractInvoker_Invoke_Timeout(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("set_value", scVoid())
+	rpc.setTxStatus(protocol.TransactionStatusNotFound)
+
+	invoker := newContractInvoker(client, keypair.MustRandom())
+	invoker.pollInterval = time.Millisecond
+	invoker.pollTimeout = 20 * time.Millisecond
+
+	_, err := invoker.invoke(context.Background(), testContractAddress(2), "set_value")
+	require.ErrorContains(t, err, "timed out waiting for transaction")
+}
+
+func scVoid() xdr.ScVal {
+	return xdr.ScVal{Type: xdr.ScValTypeScvVoid}
+}
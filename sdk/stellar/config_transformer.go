@@ -0,0 +1,227 @@
+package stellar
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stellar/go-stellar-sdk/xdr"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	sdkerrors "github.com/smartcontractkit/mcms/sdk/errors"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/evm/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+const numGroups = 32
+
+// Signer mirrors the Signer struct of the Stellar MCMS contract.
+type Signer struct {
+	Addr  common.Address
+	Index uint8
+	Group uint8
+}
+
+// Config mirrors the Config struct of the Stellar MCMS contract. Soroban has no u8 type, so the
+// contract stores indexes, groups, quorums and parents as u32.
+type Config struct {
+	Signers      []Signer
+	GroupQuorums [numGroups]uint8
+	GroupParents [numGroups]uint8
+}
+
+type ConfigTransformer = sdk.ConfigTransformer[Config, any]
+
+var _ ConfigTransformer = &configTransformer{}
+
+type configTransformer struct {
+	evmTransformer evm.ConfigTransformer
+}
+
+func NewConfigTransformer() ConfigTransformer { return &configTransformer{} }
+
+// ToChainConfig converts the chain agnostic config to the chain-specific config
+func (e *configTransformer) ToChainConfig(cfg types.Config, _ any) (Config, error) {
+	groupQuorums, groupParents, signerAddrs, signerGroups, err := sdk.ExtractSetConfigInputs(&cfg)
+	if err != nil {
+		return Config{}, fmt.Errorf("unable to extract set config inputs: %w", err)
+	}
+
+	if len(signerAddrs) > math.MaxUint8 {
+		return Config{}, sdkerrors.NewTooManySignersError(uint64(len(signerAddrs)))
+	}
+
+	signers := make([]Signer, len(signerAddrs))
+	for i, addr := range signerAddrs {
+		signers[i] = Signer{
+			Addr:  addr,
+			Index: uint8(i), //nolint:gosec // G115 bounded by the check above
+			Group: signerGroups[i],
+		}
+	}
+
+	return Config{
+		Signers:      signers,
+		GroupQuorums: groupQuorums,
+		GroupParents: groupParents,
+	}, nil
+}
+
+// ToConfig Maps the chain-specific config to the chain-agnostic config
+func (e *configTransformer) ToConfig(config Config) (*types.Config, error) {
+	// Re-using the EVM implementation here, but need to convert input first
+	evmConfig := bindings.ManyChainMultiSigConfig{
+		Signers:      make([]bindings.ManyChainMultiSigSigner, len(config.Signers)),
+		GroupQuorums: config.GroupQuorums,
+		GroupParents: config.GroupParents,
+	}
+
+	for i, s := range config.Signers {
+		evmConfig.Signers[i] = bindings.ManyChainMultiSigSigner{
+			Addr:  s.Addr,
+			Index: s.Index,
+			Group: s.Group,
+		}
+	}
+
+	return e.evmTransformer.ToConfig(evmConfig)
+}
+
+// configFromScVal decodes the value returned by the get_config contract function.
+func configFromScVal(v xdr.ScVal) (Config, error) {
+	fields, err := fromStruct(v)
+	if err != nil {
+		return Config{}, fmt.Errorf("config: %w", err)
+	}
+
+	var cfg Config
+
+	signersVal, err := field(fields, "signers")
+	if err != nil {
+		return Config{}, fmt.Errorf("config: %w", err)
+	}
+	signers, err := fromVec(signersVal)
+	if err != nil {
+		return Config{}, fmt.Errorf("config.signers: %w", err)
+	}
+	cfg.Signers = make([]Signer, len(signers))
+	for i, sv := range signers {
+		cfg.Signers[i], err = signerFromScVal(sv)
+		if err != nil {
+			return Config{}, fmt.Errorf("config.signers[%d]: %w", i, err)
+		}
+	}
+
+	if cfg.GroupQuorums, err = groupArrayFromScVal(fields, "group_quorums"); err != nil {
+		return Config{}, fmt.Errorf("config: %w", err)
+	}
+	if cfg.GroupParents, err = groupArrayFromScVal(fields, "group_parents"); err != nil {
+		return Config{}, fmt.Errorf("config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+func signerFromScVal(v xdr.ScVal) (Signer, error) {
+	fields, err := fromStruct(v)
+	if err != nil {
+		return Signer{}, err
+	}
+
+	addrVal, err := field(fields, "addr")
+	if err != nil {
+		return Signer{}, err
+	}
+	addr, err := fromBytes(addrVal)
+	if err != nil {
+		return Signer{}, fmt.Errorf("addr: %w", err)
+	}
+	if len(addr) != common.AddressLength {
+		return Signer{}, fmt.Errorf("addr: expected %d bytes, got %d", common.AddressLength, len(addr))
+	}
+
+	index, err := u8Field(fields, "index")
+	if err != nil {
+		return Signer{}, err
+	}
+	group, err := u8Field(fields, "group")
+	if err != nil {
+		return Signer{}, err
+	}
+
+	return Signer{Addr: common.BytesToAddress(addr), Index: index, Group: group}, nil
+}
+
+func groupArrayFromScVal(fields map[string]xdr.ScVal, name string) ([numGroups]uint8, error) {
+	var out [numGroups]uint8
+
+	v, err := field(fields, name)
+	if err != nil {
+		return out, err
+	}
+	vals, err := fromVec(v)
+	if err != nil {
+		return out, fmt.Errorf("%s: %w", name, err)
+	}
+	if len(vals) > numGroups {
+		return out, fmt.Errorf("%s: expected at most %d entries, got %d", name, numGroups, len(vals))
+	}
+
+	for i, gv := range vals {
+		n, err := fromU32(gv)
+		if err != nil {
+			return out, fmt.Errorf("%s[%d]: %w", name, i, err)
+		}
+		if n > math.MaxUint8 {
+			return out, fmt.Errorf("%s[%d]: value %d exceeds u8", name, i, n)
+		}
+		out[i] = uint8(n)
+	}
+
+	return out, nil
+}
+
+func u8Field(fields map[string]xdr.ScVal, name string) (uint8, error) {
+	v, err := field(fields, name)
+	if err != nil {
+		return 0, err
+	}
+	n, err := fromU32(v)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", name, err)
+	}
+	if n > math.MaxUint8 {
+		return 0, fmt.Errorf("%s: value %d exceeds u8", name, n)
+	}
+
+	return uint8(n), nil
+}
+
+// groupArrayToScVal encodes a group quorums or group parents array as Vec<u32>.
+func groupArrayToScVal(groups [numGroups]uint8) xdr.ScVal {
+	vals := make([]xdr.ScVal, len(groups))
+	for i, g := range groups {
+		vals[i] = scU32(uint32(g))
+	}
+
+	return scVec(vals...)
+}
+
+// ToScVal encodes the config as the Config struct accepted and returned by the contract.
+func (c Config) ToScVal() xdr.ScVal {
+	signers := make([]xdr.ScVal, len(c.Signers))
+	for i, s := range c.Signers {
+		signers[i] = scStruct(map[string]xdr.ScVal{
+			"addr":  scBytes(s.Addr.Bytes()),
+			"index": scU32(uint32(s.Index)),
+			"group": scU32(uint32(s.Group)),
+		})
+	}
+
+	return scStruct(map[string]xdr.ScVal{
+		"signers":       scVec(signers...),
+		"group_quorums": groupArrayToScVal(c.GroupQuorums),
+		"group_parents": groupArrayToScVal(c.GroupParents),
+	})
+}
@@ -0,0 +1,133 @@
+package stellar
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stellar/go-stellar-sdk/xdr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func testConfig() types.Config {
+	return types.Config{
+		Quorum: 1,
+		Signers: []common.Address{
+			common.HexToAddress("0x1111111111111111111111111111111111111111"),
+			common.HexToAddress("0x2222222222222222222222222222222222222222"),
+		},
+		GroupSigners: []types.Config{
+			{
+				Quorum:       1,
+				Signers:      []common.Address{common.HexToAddress("0x3333333333333333333333333333333333333333")},
+				GroupSigners: []types.Config{},
+			},
+		},
+	}
+}
+
+func TestConfigTransformer_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	transformer := NewConfigTransformer()
+	cfg := testConfig()
+
+	chainCfg, err := transformer.ToChainConfig(cfg, nil)
+	require.NoError(t, err)
+	require.Len(t, chainCfg.Signers, 3)
+	require.Equal(t, uint8(1), chainCfg.GroupQuorums[0])
+	require.Equal(t, uint8(1), chainCfg.GroupQuorums[1])
+	require.Equal(t, uint8(0), chainCfg.GroupParents[1])
+
+	decoded, err := configFromScVal(chainCfg.ToScVal())
+	require.NoError(t, err)
+	require.Equal(t, chainCfg, decoded)
+
+	got, err := transformer.ToConfig(decoded)
+	require.NoError(t, err)
+	require.Equal(t, cfg, *got)
+}
+
+func TestConfigTransformer_ToChainConfig_TooManySigners(t *testing.T) {
+	t.Parallel()
+
+	signers := make([]common.Address, 256)
+	for i := range signers {
+		signers[i] = common.BigToAddress(big.NewInt(int64(i + 1)))
+	}
+
+	_, err := NewConfigTransformer().ToChainConfig(types.Config{Quorum: 1, Signers: signers}, nil)
+	require.ErrorContains(t, err, "too many signers")
+}
+
+func TestConfigFromScVal_Errors(t *testing.T) {
+	t.Parallel()
+
+	valid := Config{Signers: []Signer{{Addr: common.HexToAddress("0x01")}}}
+
+	tests := []struct {
+		name    string
+		input   xdr.ScVal
+		wantErr string
+	}{
+		{
+			name:    "not a struct",
+			input:   scU32(1),
+			wantErr: "expected map",
+		},
+		{
+			name: "missing signers",
+			input: scStruct(map[string]xdr.ScVal{
+				"group_quorums": groupArrayToScVal(valid.GroupQuorums),
+				"group_parents": groupArrayToScVal(valid.GroupParents),
+			}),
+			wantErr: `missing field "signers"`,
+		},
+		{
+			name: "short signer address",
+			input: scStruct(map[string]xdr.ScVal{
+				"signers": scVec(scStruct(map[string]xdr.ScVal{
+					"addr":  scBytes([]byte{1}),
+					"index": scU32(0),
+					"group": scU32(0),
+				})),
+				"group_quorums": groupArrayToScVal(valid.GroupQuorums),
+				"group_parents": groupArrayToScVal(valid.GroupParents),
+			}),
+			wantErr: "expected 20 bytes",
+		},
+		{
+			name: "group out of range",
+			input: scStruct(map[string]xdr.ScVal{
+				"signers": scVec(scStruct(map[string]xdr.ScVal{
+					"addr":  scBytes(valid.Signers[0].Addr.Bytes()),
+					"index": scU32(0),
+					"group": scU32(256),
+				})),
+				"group_quorums": groupArrayToScVal(valid.GroupQuorums),
+				"group_parents": groupArrayToScVal(valid.GroupParents),
+			}),
+			wantErr: "exceeds u8",
+		},
+		{
+			name: "too many groups",
+			input: scStruct(map[string]xdr.ScVal{
+				"signers":       scVec(),
+				"group_quorums": scVec(make([]xdr.ScVal, numGroups+1)...),
+				"group_parents": groupArrayToScVal(valid.GroupParents),
+			}),
+			wantErr: "expected at most 32 entries",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := configFromScVal(tt.input)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
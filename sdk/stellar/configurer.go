@@ -0,0 +1,53 @@
+package stellar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stellar/go-stellar-sdk/keypair"
+	"github.com/stellar/go-stellar-sdk/xdr"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.Configurer = (*Configurer)(nil)
+
+// Configurer configures the MCMS contracts on Stellar chains.
+type Configurer struct {
+	invoker *contractInvoker
+}
+
+// NewConfigurer creates a new Configurer for Stellar chains. The signer must be the owner of the
+// MCMS contract.
+func NewConfigurer(client RPCClient, signer *keypair.Full) *Configurer {
+	return &Configurer{
+		invoker: newContractInvoker(client, signer),
+	}
+}
+
+// SetConfig sets the configuration for the MCMS contract on the specified Stellar chain.
+func (c *Configurer) SetConfig(ctx context.Context, mcmAddr string, cfg *types.Config, clearRoot bool) (types.TransactionResult, error) {
+	groupQuorums, groupParents, signerAddresses, signerGroups, err := sdk.ExtractSetConfigInputs(cfg)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("unable to extract set config inputs: %w", err)
+	}
+
+	addrs := make([]xdr.ScVal, len(signerAddresses))
+	for i, addr := range signerAddresses {
+		addrs[i] = scBytes(addr.Bytes())
+	}
+
+	groups := make([]xdr.ScVal, len(signerGroups))
+	for i, g := range signerGroups {
+		groups[i] = scU32(uint32(g))
+	}
+
+	return c.invoker.invoke(ctx, mcmAddr, "set_config",
+		scVec(addrs...),
+		scVec(groups...),
+		groupArrayToScVal(groupQuorums),
+		groupArrayToScVal(groupParents),
+		scBool(clearRoot),
+	)
+}
@@ -0,0 +1,70 @@
+package stellar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stellar/go-stellar-sdk/keypair"
+	"github.com/stellar/go-stellar-sdk/xdr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigurer_SetConfig(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("set_config", scVoid())
+
+	mcm := testContractAddress(1)
+	cfg := testConfig()
+
+	_, err := NewConfigurer(client, keypair.MustRandom()).SetConfig(context.Background(), mcm, &cfg, true)
+	require.NoError(t, err)
+
+	submitted := rpc.submitted()
+	require.Len(t, submitted, 1)
+	require.Equal(t, mcm, submitted[0].Contract)
+	require.Equal(t, "set_config", submitted[0].Function)
+
+	args := submitted[0].Args
+	require.Len(t, args, 5)
+
+	addrs, err := fromVec(args[0])
+	require.NoError(t, err)
+	require.Len(t, addrs, 3)
+	first, err := fromBytes(addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, cfg.Signers[0].Bytes(), first)
+
+	groups, err := fromVec(args[1])
+	require.NoError(t, err)
+	require.Len(t, groups, 3)
+
+	quorums, err := fromVec(args[2])
+	require.NoError(t, err)
+	require.Len(t, quorums, numGroups)
+	q0, err := fromU32(quorums[0])
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), q0)
+
+	clearRoot, err := fromBool(args[4])
+	require.NoError(t, err)
+	require.True(t, clearRoot)
+}
+
+func TestConfigurer_SetConfig_Rejected(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.handle("set_config", func([]xdr.ScVal) (xdr.ScVal, error) {
+		return xdr.ScVal{}, errors.New("HostError: Error(Auth, InvalidAction)")
+	})
+	cfg := testConfig()
+
+	_, err := NewConfigurer(client, keypair.MustRandom()).SetConfig(context.Background(), testContractAddress(1), &cfg, false)
+
+	var simErr *SimulationError
+	require.ErrorAs(t, err, &simErr)
+	require.Empty(t, rpc.submitted())
+}
@@ -0,0 +1,152 @@
+package stellar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stellar/go-stellar-sdk/keypair"
+	"github.com/stellar/go-stellar-sdk/xdr"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.Executor = (*Executor)(nil)
+
+// Executor is an Executor implementation for Stellar chains, allowing for the execution of
+// operations on the MCMS contract
+type Executor struct {
+	*Encoder
+	*Inspector
+
+	invoker *contractInvoker
+}
+
+// NewExecutor creates a new Executor for Stellar chains. The signer pays for and signs the
+// submitted transactions.
+func NewExecutor(encoder *Encoder, client RPCClient, signer *keypair.Full) *Executor {
+	return &Executor{
+		Encoder:   encoder,
+		Inspector: NewInspector(client),
+		invoker:   newContractInvoker(client, signer),
+	}
+}
+
+func (e *Executor) ExecuteOperation(
+	ctx context.Context,
+	metadata types.ChainMetadata,
+	nonce uint32,
+	proof []common.Hash,
+	op types.Operation,
+) (types.TransactionResult, error) {
+	if e.Encoder == nil {
+		return types.TransactionResult{}, errors.New("failed to create sdk.Executor - encoder (sdk.Encoder) is nil")
+	}
+
+	opVal, err := e.toScOperation(nonce, metadata, op)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	return e.invoker.invoke(ctx, metadata.MCMAddress, "execute", opVal, hashesToScVal(proof))
+}
+
+func (e *Executor) SetRoot(
+	ctx context.Context,
+	metadata types.ChainMetadata,
+	proof []common.Hash,
+	root [32]byte,
+	validUntil uint32,
+	sortedSignatures []types.Signature,
+) (types.TransactionResult, error) {
+	if e.Encoder == nil {
+		return types.TransactionResult{}, errors.New("failed to create sdk.Executor - encoder (sdk.Encoder) is nil")
+	}
+
+	metadataVal, err := e.toScRootMetadata(metadata)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	signatures := make([]xdr.ScVal, len(sortedSignatures))
+	for i, sig := range sortedSignatures {
+		signatures[i] = scStruct(map[string]xdr.ScVal{
+			"v": scU32(uint32(sig.V)),
+			"r": scBytes(sig.R.Bytes()),
+			"s": scBytes(sig.S.Bytes()),
+		})
+	}
+
+	return e.invoker.invoke(ctx, metadata.MCMAddress, "set_root",
+		scBytes(root[:]),
+		scU32(validUntil),
+		metadataVal,
+		hashesToScVal(proof),
+		scVec(signatures...),
+	)
+}
+
+// toScOperation converts an operation to the Op struct expected by the execute contract function.
+func (e *Encoder) toScOperation(nonce uint32, metadata types.ChainMetadata, op types.Operation) (xdr.ScVal, error) {
+	chainID, err := chainNetworkID(e.ChainSelector)
+	if err != nil {
+		return xdr.ScVal{}, err
+	}
+
+	multisig, err := parseContractID(metadata.MCMAddress)
+	if err != nil {
+		return xdr.ScVal{}, fmt.Errorf("invalid mcm address: %w", err)
+	}
+
+	to, err := parseContractID(op.Transaction.To)
+	if err != nil {
+		return xdr.ScVal{}, fmt.Errorf("invalid transaction target: %w", err)
+	}
+
+	value, err := parseValueWord(op.Transaction.AdditionalFields)
+	if err != nil {
+		return xdr.ScVal{}, fmt.Errorf("invalid additional fields: %w", err)
+	}
+
+	return scStruct(map[string]xdr.ScVal{
+		"chain_id": scBytes(chainID.Bytes()),
+		"multisig": scContract(multisig),
+		"nonce":    scU64(uint64(nonce)),
+		"to":       scContract(to),
+		"value":    scBytes(value[:]),
+		"data":     scBytes(op.Transaction.Data),
+	}), nil
+}
+
+// toScRootMetadata converts chain metadata to the RootMetadata struct expected by the set_root
+// contract function.
+func (e *Encoder) toScRootMetadata(metadata types.ChainMetadata) (xdr.ScVal, error) {
+	chainID, err := chainNetworkID(e.ChainSelector)
+	if err != nil {
+		return xdr.ScVal{}, err
+	}
+
+	multisig, err := parseContractID(metadata.MCMAddress)
+	if err != nil {
+		return xdr.ScVal{}, fmt.Errorf("invalid mcm address: %w", err)
+	}
+
+	return scStruct(map[string]xdr.ScVal{
+		"chain_id":               scBytes(chainID.Bytes()),
+		"multisig":               scContract(multisig),
+		"pre_op_count":           scU64(metadata.StartingOpCount),
+		"post_op_count":          scU64(metadata.StartingOpCount + e.TxCount),
+		"override_previous_root": scBool(e.OverridePreviousRoot),
+	}), nil
+}
+
+func hashesToScVal(hashes []common.Hash) xdr.ScVal {
+	vals := make([]xdr.ScVal, len(hashes))
+	for i, h := range hashes {
+		vals[i] = scBytes(h.Bytes())
+	}
+
+	return scVec(vals...)
+}
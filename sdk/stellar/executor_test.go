@@ -0,0 +1,147 @@
+package stellar
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stellar/go-stellar-sdk/keypair"
+	"github.com/stellar/go-stellar-sdk/xdr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestExecutor_ExecuteOperation(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("execute", scVoid())
+
+	mcm := testContractAddress(1)
+	target := testContractAddress(2)
+	metadata := types.ChainMetadata{MCMAddress: mcm, StartingOpCount: 3}
+	tx, err := NewTransaction(target, "set_value", []xdr.ScVal{scU32(9)}, "Counter", nil)
+	require.NoError(t, err)
+	op := types.Operation{ChainSelector: stellarTestnetSelector, Transaction: tx}
+	proof := []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")}
+
+	executor := NewExecutor(NewEncoder(stellarTestnetSelector, 1, false), client, keypair.MustRandom())
+	result, err := executor.ExecuteOperation(context.Background(), metadata, 3, proof, op)
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Hash)
+
+	submitted := rpc.submitted()
+	require.Len(t, submitted, 1)
+	require.Equal(t, mcm, submitted[0].Contract)
+	require.Equal(t, "execute", submitted[0].Function)
+	require.Len(t, submitted[0].Args, 2)
+
+	fields, err := fromStruct(submitted[0].Args[0])
+	require.NoError(t, err)
+	nonce, err := fromU64(fields["nonce"])
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), nonce)
+	to, err := fromAddress(fields["to"])
+	require.NoError(t, err)
+	require.Equal(t, target, to)
+	data, err := fromBytes(fields["data"])
+	require.NoError(t, err)
+	require.Equal(t, tx.Data, data)
+	value, err := fromBytes32(fields["value"])
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{}, value)
+
+	proofVals, err := fromVec(submitted[0].Args[1])
+	require.NoError(t, err)
+	require.Len(t, proofVals, 2)
+	p1, err := fromBytes32(proofVals[1])
+	require.NoError(t, err)
+	require.Equal(t, proof[1], common.Hash(p1))
+}
+
+func TestExecutor_ExecuteOperation_InvalidOperation(t *testing.T) {
+	t.Parallel()
+
+	_, client := newRPCStandIn(t)
+	executor := NewExecutor(NewEncoder(stellarTestnetSelector, 1, false), client, keypair.MustRandom())
+	metadata := types.ChainMetadata{MCMAddress: testContractAddress(1)}
+
+	_, err := executor.ExecuteOperation(context.Background(), metadata, 0, nil, types.Operation{
+		Transaction: types.Transaction{To: "bad"},
+	})
+	require.ErrorContains(t, err, "invalid transaction target")
+
+	_, err = executor.ExecuteOperation(context.Background(), metadata, 0, nil, types.Operation{
+		Transaction: types.Transaction{To: testContractAddress(2), AdditionalFields: json.RawMessage(`{"value":"0x1"}`)},
+	})
+	require.ErrorContains(t, err, "invalid additional fields")
+
+	_, err = executor.ExecuteOperation(context.Background(), types.ChainMetadata{MCMAddress: "bad"}, 0, nil, types.Operation{})
+	require.ErrorContains(t, err, "invalid mcm address")
+}
+
+func TestExecutor_ExecuteOperation_NilEncoder(t *testing.T) {
+	t.Parallel()
+
+	_, client := newRPCStandIn(t)
+	executor := NewExecutor(nil, client, keypair.MustRandom())
+
+	_, err := executor.ExecuteOperation(context.Background(), types.ChainMetadata{}, 0, nil, types.Operation{})
+	require.ErrorContains(t, err, "encoder (sdk.Encoder) is nil")
+
+	_, err = executor.SetRoot(context.Background(), types.ChainMetadata{}, nil, [32]byte{}, 0, nil)
+	require.ErrorContains(t, err, "encoder (sdk.Encoder) is nil")
+}
+
+func TestExecutor_SetRoot(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("set_root", scVoid())
+
+	mcm := testContractAddress(1)
+	metadata := types.ChainMetadata{MCMAddress: mcm, StartingOpCount: 4}
+	root := common.HexToHash("0xaa")
+	sig := types.Signature{R: common.HexToHash("0x01"), S: common.HexToHash("0x02"), V: 27}
+
+	executor := NewExecutor(NewEncoder(stellarTestnetSelector, 2, true), client, keypair.MustRandom())
+	_, err := executor.SetRoot(context.Background(), metadata, nil, root, 1700000000, []types.Signature{sig})
+	require.NoError(t, err)
+
+	submitted := rpc.submitted()
+	require.Len(t, submitted, 1)
+	require.Equal(t, "set_root", submitted[0].Function)
+	args := submitted[0].Args
+	require.Len(t, args, 5)
+
+	gotRoot, err := fromBytes32(args[0])
+	require.NoError(t, err)
+	require.Equal(t, root, common.Hash(gotRoot))
+
+	validUntil, err := fromU32(args[1])
+	require.NoError(t, err)
+	require.Equal(t, uint32(1700000000), validUntil)
+
+	md, err := fromStruct(args[2])
+	require.NoError(t, err)
+	pre, err := fromU64(md["pre_op_count"])
+	require.NoError(t, err)
+	post, err := fromU64(md["post_op_count"])
+	require.NoError(t, err)
+	override, err := fromBool(md["override_previous_root"])
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), pre)
+	require.Equal(t, uint64(6), post)
+	require.True(t, override)
+
+	sigs, err := fromVec(args[4])
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	sigFields, err := fromStruct(sigs[0])
+	require.NoError(t, err)
+	v, err := fromU32(sigFields["v"])
+	require.NoError(t, err)
+	require.Equal(t, uint32(27), v)
+}
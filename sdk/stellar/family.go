@@ -0,0 +1,137 @@
+package stellar
+
+import (
+	"fmt"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/stellar/go-stellar-sdk/keypair"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func init() {
+	if err := sdk.RegisterFamily(sdk.Family{
+		Name: chainsel.FamilyStellar,
+		NewEncoder: func(selector types.ChainSelector, txCount uint64, overridePreviousRoot bool, _ bool) sdk.Encoder {
+			return NewEncoder(selector, txCount, overridePreviousRoot)
+		},
+		NewTimelockConverter: func(types.ChainSelector, types.ChainMetadata) (sdk.TimelockConverter, error) {
+			return NewTimelockConverter(), nil
+		},
+		NewExecutor:              buildStellarExecutor,
+		NewInspector:             buildStellarInspector,
+		NewTimelockExecutor:      buildStellarTimelockExecutor,
+		NewTimelockInspector:     buildStellarTimelockInspector,
+		NewTimelockConfigurer:    buildStellarTimelockConfigurer,
+		OperationID:              OperationID,
+		ValidateAdditionalFields: ValidateAdditionalFields,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func buildStellarExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Executor, error) {
+	rawSelector := uint64(chainSelector)
+	stellarEncoder, ok := encoder.(*Encoder)
+	if !ok {
+		return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
+	}
+	client, ok := stellarClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing stellar chain client for selector %d", chainSelector)
+	}
+	signer, ok := stellarSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing stellar chain signer for selector %d", chainSelector)
+	}
+
+	return NewExecutor(stellarEncoder, client, signer), nil
+}
+
+func buildStellarInspector(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Inspector, error) {
+	rawSelector := uint64(selector)
+	client, ok := stellarClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Stellar chain client for selector %d", rawSelector)
+	}
+
+	return NewInspector(client), nil
+}
+
+func buildStellarTimelockExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.TimelockExecutor, error) {
+	rawSelector := uint64(chainSelector)
+	client, ok := stellarClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing stellar chain client for selector %d", chainSelector)
+	}
+	signer, ok := stellarSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing stellar chain signer for selector %d", chainSelector)
+	}
+
+	return NewTimelockExecutor(client, signer), nil
+}
+
+func buildStellarTimelockInspector(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, _ types.ChainMetadata,
+) (sdk.TimelockInspector, error) {
+	rawSelector := uint64(chainSelector)
+	client, ok := stellarClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Stellar chain client for selector %d", rawSelector)
+	}
+
+	return NewTimelockInspector(client), nil
+}
+
+func buildStellarTimelockConfigurer(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.TimelockConfigurer, error) {
+	rawSelector := uint64(selector)
+	client, ok := stellarClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Stellar chain client for selector %d", rawSelector)
+	}
+	signer, ok := stellarSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Stellar chain signer for selector %d", rawSelector)
+	}
+
+	return NewTimelockConfigurer(client, signer), nil
+}
+
+// ChainAccessor is optionally implemented by a sdk.ChainAccessor to serve Stellar chains. The
+// Stellar hooks type-assert the ChainAccessor and report a missing client or signer otherwise.
+type ChainAccessor interface {
+	StellarClient(selector uint64) (RPCClient, bool)
+	StellarSigner(selector uint64) (*keypair.Full, bool)
+}
+
+// stellarClient returns the Stellar client of the selector if the ChainAccessor serves Stellar
+// chains.
+func stellarClient(chains sdk.ChainAccessor, selector uint64) (RPCClient, bool) {
+	stellarChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return stellarChains.StellarClient(selector)
+}
+
+// stellarSigner returns the Stellar signer of the selector if the ChainAccessor serves Stellar
+// chains.
+func stellarSigner(chains sdk.ChainAccessor, selector uint64) (*keypair.Full, bool) {
+	stellarChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return stellarChains.StellarSigner(selector)
+}
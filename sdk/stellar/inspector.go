@@ -0,0 +1,128 @@
+package stellar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.Inspector = (*Inspector)(nil)
+var _ sdk.PostOpCountInspector = (*Inspector)(nil)
+
+// Inspector is an Inspector implementation for Stellar, reading the MCMS contract state through
+// simulated contract calls.
+type Inspector struct {
+	invoker *contractInvoker
+
+	configTransformer ConfigTransformer
+}
+
+// NewInspector creates a new Inspector for Stellar chains
+func NewInspector(client RPCClient) *Inspector {
+	return &Inspector{
+		invoker:           newContractInvoker(client, nil),
+		configTransformer: NewConfigTransformer(),
+	}
+}
+
+func (i *Inspector) GetConfig(ctx context.Context, mcmAddr string) (*types.Config, error) {
+	ret, err := i.invoker.call(ctx, mcmAddr, "get_config")
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := configFromScVal(ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode config: %w", err)
+	}
+
+	return i.configTransformer.ToConfig(cfg)
+}
+
+func (i *Inspector) GetOpCount(ctx context.Context, mcmAddr string) (uint64, error) {
+	ret, err := i.invoker.call(ctx, mcmAddr, "get_op_count")
+	if err != nil {
+		return 0, err
+	}
+
+	return fromU64(ret)
+}
+
+func (i *Inspector) GetRoot(ctx context.Context, mcmAddr string) (common.Hash, uint32, error) {
+	ret, err := i.invoker.call(ctx, mcmAddr, "get_root")
+	if err != nil {
+		return common.Hash{}, 0, err
+	}
+
+	// get_root returns the tuple (root, valid_until)
+	vals, err := fromVec(ret)
+	if err != nil {
+		return common.Hash{}, 0, fmt.Errorf("failed to decode root: %w", err)
+	}
+	if len(vals) != 2 { //nolint:mnd // (root, valid_until)
+		return common.Hash{}, 0, fmt.Errorf("failed to decode root: expected 2 values, got %d", len(vals))
+	}
+
+	root, err := fromBytes32(vals[0])
+	if err != nil {
+		return common.Hash{}, 0, fmt.Errorf("failed to decode root: %w", err)
+	}
+	validUntil, err := fromU32(vals[1])
+	if err != nil {
+		return common.Hash{}, 0, fmt.Errorf("failed to decode valid until: %w", err)
+	}
+
+	return root, validUntil, nil
+}
+
+func (i *Inspector) GetRootMetadata(ctx context.Context, mcmAddr string) (types.ChainMetadata, error) {
+	ret, err := i.invoker.call(ctx, mcmAddr, "get_root_metadata")
+	if err != nil {
+		return types.ChainMetadata{}, err
+	}
+
+	fields, err := fromStruct(ret)
+	if err != nil {
+		return types.ChainMetadata{}, fmt.Errorf("failed to decode root metadata: %w", err)
+	}
+	preOpCount, err := field(fields, "pre_op_count")
+	if err != nil {
+		return types.ChainMetadata{}, fmt.Errorf("failed to decode root metadata: %w", err)
+	}
+	startingOpCount, err := fromU64(preOpCount)
+	if err != nil {
+		return types.ChainMetadata{}, fmt.Errorf("failed to decode root metadata: %w", err)
+	}
+
+	return types.ChainMetadata{
+		StartingOpCount: startingOpCount,
+		MCMAddress:      mcmAddr,
+	}, nil
+}
+
+// GetRootPostOpCount returns the post-op count of the root held by the MCM.
+func (i *Inspector) GetRootPostOpCount(ctx context.Context, mcmAddr string) (uint64, error) {
+	ret, err := i.invoker.call(ctx, mcmAddr, "get_root_metadata")
+	if err != nil {
+		return 0, err
+	}
+
+	fields, err := fromStruct(ret)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode root metadata: %w", err)
+	}
+	postOpCount, err := field(fields, "post_op_count")
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode root metadata: %w", err)
+	}
+	count, err := fromU64(postOpCount)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode root metadata: %w", err)
+	}
+
+	return count, nil
+}
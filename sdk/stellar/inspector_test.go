@@ -0,0 +1,130 @@
+package stellar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stellar/go-stellar-sdk/xdr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestInspector_GetConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := testConfig()
+	chainCfg, err := NewConfigTransformer().ToChainConfig(cfg, nil)
+	require.NoError(t, err)
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("get_config", chainCfg.ToScVal())
+
+	got, err := NewInspector(client).GetConfig(context.Background(), testContractAddress(1))
+	require.NoError(t, err)
+	require.Equal(t, cfg, *got)
+}
+
+func TestInspector_GetConfig_DecodeError(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("get_config", scU32(1))
+
+	_, err := NewInspector(client).GetConfig(context.Background(), testContractAddress(1))
+	require.ErrorContains(t, err, "failed to decode config")
+}
+
+func TestInspector_GetOpCount(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("get_op_count", scU64(12))
+
+	got, err := NewInspector(client).GetOpCount(context.Background(), testContractAddress(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), got)
+}
+
+func TestInspector_GetRoot(t *testing.T) {
+	t.Parallel()
+
+	root := common.HexToHash("0xabcdef")
+
+	tests := []struct {
+		name           string
+		ret            xdr.ScVal
+		wantRoot       common.Hash
+		wantValidUntil uint32
+		wantErr        string
+	}{
+		{
+			name:           "success",
+			ret:            scVec(scBytes(root.Bytes()), scU32(1700000000)),
+			wantRoot:       root,
+			wantValidUntil: 1700000000,
+		},
+		{
+			name:    "wrong arity",
+			ret:     scVec(scBytes(root.Bytes())),
+			wantErr: "expected 2 values",
+		},
+		{
+			name:    "short root",
+			ret:     scVec(scBytes([]byte{1}), scU32(1)),
+			wantErr: "expected 32 bytes",
+		},
+		{
+			name:    "invalid valid until",
+			ret:     scVec(scBytes(root.Bytes()), scU64(1)),
+			wantErr: "failed to decode valid until",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rpc, client := newRPCStandIn(t)
+			rpc.returns("get_root", tt.ret)
+
+			gotRoot, gotValidUntil, err := NewInspector(client).GetRoot(context.Background(), testContractAddress(1))
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantRoot, gotRoot)
+			require.Equal(t, tt.wantValidUntil, gotValidUntil)
+		})
+	}
+}
+
+func TestInspector_GetRootMetadata(t *testing.T) {
+	t.Parallel()
+
+	mcm := testContractAddress(1)
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("get_root_metadata", scStruct(map[string]xdr.ScVal{
+		"chain_id":               scBytes(make([]byte, 32)),
+		"multisig":               scContract(xdr.ContractId{}),
+		"pre_op_count":           scU64(5),
+		"post_op_count":          scU64(8),
+		"override_previous_root": scBool(false),
+	}))
+
+	got, err := NewInspector(client).GetRootMetadata(context.Background(), mcm)
+	require.NoError(t, err)
+	require.Equal(t, types.ChainMetadata{StartingOpCount: 5, MCMAddress: mcm}, got)
+}
+
+func TestInspector_GetRootMetadata_MissingField(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("get_root_metadata", scStruct(map[string]xdr.ScVal{}))
+
+	_, err := NewInspector(client).GetRootMetadata(context.Background(), testContractAddress(1))
+	require.ErrorContains(t, err, `missing field "pre_op_count"`)
+}
@@ -0,0 +1,300 @@
+package stellar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stellar/go-stellar-sdk/clients/rpcclient"
+	"github.com/stellar/go-stellar-sdk/network"
+	protocol "github.com/stellar/go-stellar-sdk/protocols/rpc"
+	"github.com/stellar/go-stellar-sdk/strkey"
+	"github.com/stellar/go-stellar-sdk/xdr"
+	"github.com/stretchr/testify/require"
+)
+
+// contractHandler emulates a contract function: it receives the call arguments and returns the
+// function result or an error, which is reported as a simulation failure.
+type contractHandler func(args []xdr.ScVal) (xdr.ScVal, error)
+
+// invocation is a contract invocation submitted to the stand-in with sendTransaction.
+type invocation struct {
+	Contract string
+	Function string
+	Args     []xdr.ScVal
+}
+
+// rpcStandIn is a minimal local Stellar RPC server. Contract calls are dispatched to handlers
+// registered by function name, and submitted transactions are recorded and reported as successful.
+type rpcStandIn struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu          sync.Mutex
+	handlers    map[string]contractHandler
+	invocations []invocation
+	sequence    int64
+	txStatus    string
+}
+
+func newRPCStandIn(t *testing.T) (*rpcStandIn, *rpcclient.Client) {
+	t.Helper()
+
+	s := &rpcStandIn{
+		t:        t,
+		handlers: map[string]contractHandler{},
+		sequence: 100,
+		txStatus: protocol.TransactionStatusSuccess,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.server.Close)
+
+	client := rpcclient.NewClient(s.server.URL, s.server.Client())
+	t.Cleanup(func() { _ = client.Close() })
+
+	return s, client
+}
+
+// handle registers the handler of a contract function.
+func (s *rpcStandIn) handle(function string, h contractHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[function] = h
+}
+
+// returns registers a contract function that always returns v.
+func (s *rpcStandIn) returns(function string, v xdr.ScVal) {
+	s.handle(function, func([]xdr.ScVal) (xdr.ScVal, error) { return v, nil })
+}
+
+// setTxStatus sets the status reported by getTransaction for submitted transactions.
+func (s *rpcStandIn) setTxStatus(status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.txStatus = status
+}
+
+func (s *rpcStandIn) submitted() []invocation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]invocation(nil), s.invocations...)
+}
+
+type jsonRPCRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRPCResponse struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      any           `json:"id"`
+	Result  any           `json:"result,omitempty"`
+	Error   *jsonRPCError `json:"error,omitempty"`
+}
+
+func (s *rpcStandIn) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	require.NoError(s.t, err)
+
+	var resp any
+	if strings.HasPrefix(strings.TrimSpace(string(body)), "[") {
+		var reqs []jsonRPCRequest
+		require.NoError(s.t, json.Unmarshal(body, &reqs))
+		resps := make([]jsonRPCResponse, len(reqs))
+		for i, req := range reqs {
+			resps[i] = s.dispatch(req)
+		}
+		resp = resps
+	} else {
+		var req jsonRPCRequest
+		require.NoError(s.t, json.Unmarshal(body, &req))
+		resp = s.dispatch(req)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(s.t, json.NewEncoder(w).Encode(resp))
+}
+
+func (s *rpcStandIn) dispatch(req jsonRPCRequest) jsonRPCResponse {
+	resp := jsonRPCResponse{JSONRPC: "2.0", ID: req.ID}
+
+	var (
+		result any
+		err    error
+	)
+	switch req.Method {
+	case "getNetwork":
+		result = protocol.GetNetworkResponse{Passphrase: network.TestNetworkPassphrase, ProtocolVersion: 23}
+	case "getLedgerEntries":
+		result, err = s.getLedgerEntries(req.Params)
+	case "simulateTransaction":
+		result, err = s.simulateTransaction(req.Params)
+	case "sendTransaction":
+		result, err = s.sendTransaction(req.Params)
+	case "getTransaction":
+		s.mu.Lock()
+		status := s.txStatus
+		s.mu.Unlock()
+		result = protocol.GetTransactionResponse{
+			LatestLedger:       1,
+			TransactionDetails: protocol.TransactionDetails{Status: status, Ledger: 1},
+		}
+	default:
+		err = fmt.Errorf("method %s not supported", req.Method)
+	}
+
+	if err != nil {
+		resp.Error = &jsonRPCError{Code: -32600, Message: err.Error()}
+	} else {
+		resp.Result = result
+	}
+
+	return resp
+}
+
+func (s *rpcStandIn) getLedgerEntries(params json.RawMessage) (protocol.GetLedgerEntriesResponse, error) {
+	var req protocol.GetLedgerEntriesRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return protocol.GetLedgerEntriesResponse{}, err
+	}
+
+	entries := make([]protocol.LedgerEntryResult, 0, len(req.Keys))
+	for _, k := range req.Keys {
+		var key xdr.LedgerKey
+		if err := xdr.SafeUnmarshalBase64(k, &key); err != nil {
+			return protocol.GetLedgerEntriesResponse{}, err
+		}
+		if key.Account == nil {
+			return protocol.GetLedgerEntriesResponse{}, errors.New("only account entries are supported")
+		}
+
+		s.mu.Lock()
+		seq := s.sequence
+		s.mu.Unlock()
+
+		data, err := xdr.MarshalBase64(xdr.LedgerEntryData{
+			Type:    xdr.LedgerEntryTypeAccount,
+			Account: &xdr.AccountEntry{AccountId: key.Account.AccountId, SeqNum: xdr.SequenceNumber(seq)},
+		})
+		if err != nil {
+			return protocol.GetLedgerEntriesResponse{}, err
+		}
+		entries = append(entries, protocol.LedgerEntryResult{KeyXDR: k, DataXDR: data})
+	}
+
+	return protocol.GetLedgerEntriesResponse{Entries: entries, LatestLedger: 1}, nil
+}
+
+func (s *rpcStandIn) simulateTransaction(params json.RawMessage) (protocol.SimulateTransactionResponse, error) {
+	inv, _, err := s.decodeInvocation(params)
+	if err != nil {
+		return protocol.SimulateTransactionResponse{}, err
+	}
+
+	s.mu.Lock()
+	h, ok := s.handlers[inv.Function]
+	s.mu.Unlock()
+	if !ok {
+		return protocol.SimulateTransactionResponse{Error: "function not found: " + inv.Function, LatestLedger: 1}, nil
+	}
+
+	ret, err := h(inv.Args)
+	if err != nil {
+		return protocol.SimulateTransactionResponse{Error: err.Error(), LatestLedger: 1}, nil
+	}
+
+	retXDR, err := xdr.MarshalBase64(ret)
+	if err != nil {
+		return protocol.SimulateTransactionResponse{}, err
+	}
+	txData, err := xdr.MarshalBase64(xdr.SorobanTransactionData{})
+	if err != nil {
+		return protocol.SimulateTransactionResponse{}, err
+	}
+
+	return protocol.SimulateTransactionResponse{
+		TransactionDataXDR: txData,
+		MinResourceFee:     1000,
+		Results:            []protocol.SimulateHostFunctionResult{{ReturnValueXDR: &retXDR, AuthXDR: &[]string{}}},
+		LatestLedger:       1,
+	}, nil
+}
+
+func (s *rpcStandIn) sendTransaction(params json.RawMessage) (protocol.SendTransactionResponse, error) {
+	inv, env, err := s.decodeInvocation(params)
+	if err != nil {
+		return protocol.SendTransactionResponse{}, err
+	}
+	if len(env.Signatures()) == 0 {
+		return protocol.SendTransactionResponse{}, errors.New("transaction is not signed")
+	}
+
+	hash, err := network.HashTransactionInEnvelope(env, network.TestNetworkPassphrase)
+	if err != nil {
+		return protocol.SendTransactionResponse{}, err
+	}
+
+	s.mu.Lock()
+	s.invocations = append(s.invocations, inv)
+	s.sequence++
+	s.mu.Unlock()
+
+	return protocol.SendTransactionResponse{
+		Status:       sendStatusPending,
+		Hash:         fmt.Sprintf("%x", hash),
+		LatestLedger: 1,
+	}, nil
+}
+
+func (s *rpcStandIn) decodeInvocation(params json.RawMessage) (invocation, xdr.TransactionEnvelope, error) {
+	var req struct {
+		Transaction string `json:"transaction"`
+	}
+	if err := json.Unmarshal(params, &req); err != nil {
+		return invocation{}, xdr.TransactionEnvelope{}, err
+	}
+
+	var env xdr.TransactionEnvelope
+	if err := xdr.SafeUnmarshalBase64(req.Transaction, &env); err != nil {
+		return invocation{}, xdr.TransactionEnvelope{}, err
+	}
+
+	ops := env.Operations()
+	if len(ops) != 1 || ops[0].Body.InvokeHostFunctionOp == nil {
+		return invocation{}, xdr.TransactionEnvelope{}, errors.New("expected a single invoke host function operation")
+	}
+	call := ops[0].Body.InvokeHostFunctionOp.HostFunction.InvokeContract
+	if call == nil {
+		return invocation{}, xdr.TransactionEnvelope{}, errors.New("expected a contract invocation")
+	}
+
+	contract, err := call.ContractAddress.String()
+	if err != nil {
+		return invocation{}, xdr.TransactionEnvelope{}, err
+	}
+
+	return invocation{Contract: contract, Function: string(call.FunctionName), Args: call.Args}, env, nil
+}
+
+// testContractAddress returns the strkey of a contract id filled with b.
+func testContractAddress(b byte) string {
+	var id [32]byte
+	for i := range id {
+		id[i] = b
+	}
+
+	return strkey.MustEncode(strkey.VersionByteContract, id[:])
+}
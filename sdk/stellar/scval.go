@@ -0,0 +1,189 @@
+package stellar
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/stellar/go-stellar-sdk/strkey"
+	"github.com/stellar/go-stellar-sdk/xdr"
+)
+
+// Helpers to build and read the Soroban values (xdr.ScVal) exchanged with the MCMS and timelock
+// contracts. Contract structs are represented as maps keyed by field-name symbols, as generated by
+// the soroban-sdk #[contracttype] macro.
+
+func scBytes(b []byte) xdr.ScVal {
+	v := xdr.ScBytes(slices.Clone(b))
+	return xdr.ScVal{Type: xdr.ScValTypeScvBytes, Bytes: &v}
+}
+
+func scU32(n uint32) xdr.ScVal {
+	v := xdr.Uint32(n)
+	return xdr.ScVal{Type: xdr.ScValTypeScvU32, U32: &v}
+}
+
+func scU64(n uint64) xdr.ScVal {
+	v := xdr.Uint64(n)
+	return xdr.ScVal{Type: xdr.ScValTypeScvU64, U64: &v}
+}
+
+func scBool(b bool) xdr.ScVal {
+	return xdr.ScVal{Type: xdr.ScValTypeScvBool, B: &b}
+}
+
+func scSymbol(s string) xdr.ScVal {
+	v := xdr.ScSymbol(s)
+	return xdr.ScVal{Type: xdr.ScValTypeScvSymbol, Sym: &v}
+}
+
+func scVec(vals ...xdr.ScVal) xdr.ScVal {
+	v := xdr.ScVec(vals)
+	if v == nil {
+		v = xdr.ScVec{}
+	}
+	vp := &v
+
+	return xdr.ScVal{Type: xdr.ScValTypeScvVec, Vec: &vp}
+}
+
+// scStruct builds a contract struct value; entries are sorted by key as required by the host.
+func scStruct(fields map[string]xdr.ScVal) xdr.ScVal {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	m := make(xdr.ScMap, 0, len(keys))
+	for _, k := range keys {
+		m = append(m, xdr.ScMapEntry{Key: scSymbol(k), Val: fields[k]})
+	}
+	mp := &m
+
+	return xdr.ScVal{Type: xdr.ScValTypeScvMap, Map: &mp}
+}
+
+func contractScAddress(id xdr.ContractId) xdr.ScAddress {
+	return xdr.ScAddress{Type: xdr.ScAddressTypeScAddressTypeContract, ContractId: &id}
+}
+
+func scContract(id xdr.ContractId) xdr.ScVal {
+	addr := contractScAddress(id)
+	return xdr.ScVal{Type: xdr.ScValTypeScvAddress, Address: &addr}
+}
+
+// scAddress converts an account (G...) or contract (C... or 32-byte hex) address to an ScVal.
+func scAddress(s string) (xdr.ScVal, error) {
+	s = strings.TrimSpace(s)
+	if strkey.IsValidEd25519PublicKey(s) {
+		accountID, err := xdr.AddressToAccountId(s)
+		if err != nil {
+			return xdr.ScVal{}, fmt.Errorf("invalid account address %q: %w", s, err)
+		}
+		addr := xdr.ScAddress{Type: xdr.ScAddressTypeScAddressTypeAccount, AccountId: &accountID}
+
+		return xdr.ScVal{Type: xdr.ScValTypeScvAddress, Address: &addr}, nil
+	}
+
+	id, err := parseContractID(s)
+	if err != nil {
+		return xdr.ScVal{}, fmt.Errorf("invalid address %q: %w", s, err)
+	}
+
+	return scContract(id), nil
+}
+
+func fromBytes(v xdr.ScVal) ([]byte, error) {
+	b, ok := v.GetBytes()
+	if !ok {
+		return nil, fmt.Errorf("expected bytes, got %s", v.Type)
+	}
+
+	return b, nil
+}
+
+func fromBytes32(v xdr.ScVal) ([32]byte, error) {
+	b, err := fromBytes(v)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if len(b) != abiWordBytes {
+		return [32]byte{}, fmt.Errorf("expected 32 bytes, got %d", len(b))
+	}
+
+	return [32]byte(b), nil
+}
+
+func fromU32(v xdr.ScVal) (uint32, error) {
+	n, ok := v.GetU32()
+	if !ok {
+		return 0, fmt.Errorf("expected u32, got %s", v.Type)
+	}
+
+	return uint32(n), nil
+}
+
+func fromU64(v xdr.ScVal) (uint64, error) {
+	n, ok := v.GetU64()
+	if !ok {
+		return 0, fmt.Errorf("expected u64, got %s", v.Type)
+	}
+
+	return uint64(n), nil
+}
+
+func fromBool(v xdr.ScVal) (bool, error) {
+	b, ok := v.GetB()
+	if !ok {
+		return false, fmt.Errorf("expected bool, got %s", v.Type)
+	}
+
+	return b, nil
+}
+
+func fromVec(v xdr.ScVal) ([]xdr.ScVal, error) {
+	vec, ok := v.GetVec()
+	if !ok || vec == nil {
+		return nil, fmt.Errorf("expected vec, got %s", v.Type)
+	}
+
+	return *vec, nil
+}
+
+func fromStruct(v xdr.ScVal) (map[string]xdr.ScVal, error) {
+	m, ok := v.GetMap()
+	if !ok || m == nil {
+		return nil, fmt.Errorf("expected map, got %s", v.Type)
+	}
+
+	fields := make(map[string]xdr.ScVal, len(*m))
+	for _, entry := range *m {
+		key, ok := entry.Key.GetSym()
+		if !ok {
+			return nil, fmt.Errorf("expected symbol map key, got %s", entry.Key.Type)
+		}
+		fields[string(key)] = entry.Val
+	}
+
+	return fields, nil
+}
+
+// field returns the named field of a decoded contract struct.
+func field(fields map[string]xdr.ScVal, name string) (xdr.ScVal, error) {
+	v, ok := fields[name]
+	if !ok {
+		return xdr.ScVal{}, fmt.Errorf("missing field %q", name)
+	}
+
+	return v, nil
+}
+
+func fromAddress(v xdr.ScVal) (string, error) {
+	addr, ok := v.GetAddress()
+	if !ok {
+		return "", fmt.Errorf("expected address, got %s", v.Type)
+	}
+
+	return addr.String()
+}
@@ -0,0 +1,126 @@
+package stellar
+
+import (
+	"testing"
+
+	"github.com/stellar/go-stellar-sdk/keypair"
+	"github.com/stellar/go-stellar-sdk/xdr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScVal_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	b, err := fromBytes(scBytes([]byte{1, 2, 3}))
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, b)
+
+	u32, err := fromU32(scU32(7))
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), u32)
+
+	u64, err := fromU64(scU64(1 << 40))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<40), u64)
+
+	ok, err := fromBool(scBool(true))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	vec, err := fromVec(scVec(scU32(1), scU32(2)))
+	require.NoError(t, err)
+	require.Len(t, vec, 2)
+
+	empty, err := fromVec(scVec())
+	require.NoError(t, err)
+	require.Empty(t, empty)
+}
+
+func TestScVal_TypeMismatch(t *testing.T) {
+	t.Parallel()
+
+	_, err := fromBytes(scU32(1))
+	require.ErrorContains(t, err, "expected bytes")
+
+	_, err = fromBytes32(scBytes([]byte{1}))
+	require.ErrorContains(t, err, "expected 32 bytes")
+
+	_, err = fromU32(scU64(1))
+	require.ErrorContains(t, err, "expected u32")
+
+	_, err = fromU64(scU32(1))
+	require.ErrorContains(t, err, "expected u64")
+
+	_, err = fromBool(scU32(1))
+	require.ErrorContains(t, err, "expected bool")
+
+	_, err = fromVec(scU32(1))
+	require.ErrorContains(t, err, "expected vec")
+
+	_, err = fromStruct(scU32(1))
+	require.ErrorContains(t, err, "expected map")
+
+	_, err = fromAddress(scU32(1))
+	require.ErrorContains(t, err, "expected address")
+}
+
+func TestScStruct_SortedKeys(t *testing.T) {
+	t.Parallel()
+
+	v := scStruct(map[string]xdr.ScVal{
+		"zeta":  scU32(3),
+		"alpha": scU32(1),
+		"mid":   scU32(2),
+	})
+
+	m, ok := v.GetMap()
+	require.True(t, ok)
+	keys := make([]string, 0, len(*m))
+	for _, e := range *m {
+		keys = append(keys, string(e.Key.MustSym()))
+	}
+	require.Equal(t, []string{"alpha", "mid", "zeta"}, keys)
+
+	fields, err := fromStruct(v)
+	require.NoError(t, err)
+	mid, err := field(fields, "mid")
+	require.NoError(t, err)
+	require.Equal(t, scU32(2), mid)
+
+	_, err = field(fields, "missing")
+	require.ErrorContains(t, err, `missing field "missing"`)
+}
+
+func TestScAddress(t *testing.T) {
+	t.Parallel()
+
+	account := keypair.MustRandom().Address()
+	contract := testContractAddress(9)
+
+	tests := []struct {
+		name    string
+		address string
+		wantErr string
+	}{
+		{name: "account", address: account},
+		{name: "contract", address: contract},
+		{name: "invalid", address: "nope", wantErr: "invalid address"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := scAddress(tt.address)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := fromAddress(v)
+			require.NoError(t, err)
+			require.Equal(t, tt.address, got)
+		})
+	}
+}
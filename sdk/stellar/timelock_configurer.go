@@ -0,0 +1,65 @@
+package stellar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stellar/go-stellar-sdk/keypair"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.TimelockConfigurer = (*TimelockConfigurer)(nil)
+
+// TimelockConfigurer configures timelock parameters on Stellar chains.
+type TimelockConfigurer struct {
+	TimelockInspector
+	invoker *contractInvoker
+}
+
+// NewTimelockConfigurer creates a new TimelockConfigurer for Stellar chains. The signer must hold
+// the admin role on the timelock contract.
+func NewTimelockConfigurer(client RPCClient, signer *keypair.Full) *TimelockConfigurer {
+	return &TimelockConfigurer{
+		TimelockInspector: *NewTimelockInspector(client),
+		invoker:           newContractInvoker(client, signer),
+	}
+}
+
+// UpdateDelay calls update_delay on the timelock contract to change the minimum delay.
+func (c *TimelockConfigurer) UpdateDelay(
+	ctx context.Context, timelockAddress string, newDelay uint64,
+) (types.TransactionResult, error) {
+	result, err := c.invoker.invoke(ctx, timelockAddress, "update_delay", scU64(newDelay))
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to update delay on %s: %w", timelockAddress, err)
+	}
+
+	return result, nil
+}
+
+// GrantRole calls grant_role on the timelock contract for a target account or contract address.
+func (c *TimelockConfigurer) GrantRole(
+	ctx context.Context,
+	timelockAddress string,
+	role sdk.TimelockRole,
+	targetAddress string,
+) (types.TransactionResult, error) {
+	roleSym, err := TimelockRoleSymbol(role)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	account, err := scAddress(targetAddress)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("invalid target address: %w", err)
+	}
+
+	result, err := c.invoker.invoke(ctx, timelockAddress, "grant_role", scSymbol(roleSym), account)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to grant role %s to %s on %s: %w", role, targetAddress, timelockAddress, err)
+	}
+
+	return result, nil
+}
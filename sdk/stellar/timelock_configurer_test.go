@@ -0,0 +1,76 @@
+package stellar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stellar/go-stellar-sdk/keypair"
+	"github.com/stellar/go-stellar-sdk/xdr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk"
+)
+
+func TestTimelockConfigurer_UpdateDelay(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("update_delay", scVoid())
+	timelock := testContractAddress(4)
+
+	_, err := NewTimelockConfigurer(client, keypair.MustRandom()).UpdateDelay(context.Background(), timelock, 7200)
+	require.NoError(t, err)
+
+	submitted := rpc.submitted()
+	require.Len(t, submitted, 1)
+	require.Equal(t, timelock, submitted[0].Contract)
+	require.Equal(t, "update_delay", submitted[0].Function)
+	require.Equal(t, []xdr.ScVal{scU64(7200)}, submitted[0].Args)
+}
+
+func TestTimelockConfigurer_UpdateDelay_Rejected(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.handle("update_delay", func([]xdr.ScVal) (xdr.ScVal, error) {
+		return xdr.ScVal{}, errors.New("HostError: Error(Auth, InvalidAction)")
+	})
+
+	_, err := NewTimelockConfigurer(client, keypair.MustRandom()).UpdateDelay(context.Background(), testContractAddress(4), 1)
+	require.ErrorContains(t, err, "failed to update delay")
+}
+
+func TestTimelockConfigurer_GrantRole(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("grant_role", scVoid())
+	timelock := testContractAddress(4)
+	target := keypair.MustRandom().Address()
+
+	_, err := NewTimelockConfigurer(client, keypair.MustRandom()).GrantRole(context.Background(), timelock, sdk.TimelockRoleCanceller, target)
+	require.NoError(t, err)
+
+	submitted := rpc.submitted()
+	require.Len(t, submitted, 1)
+	require.Equal(t, "grant_role", submitted[0].Function)
+	require.Len(t, submitted[0].Args, 2)
+	require.Equal(t, "canceller", string(submitted[0].Args[0].MustSym()))
+	account, err := fromAddress(submitted[0].Args[1])
+	require.NoError(t, err)
+	require.Equal(t, target, account)
+}
+
+func TestTimelockConfigurer_GrantRole_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, client := newRPCStandIn(t)
+	configurer := NewTimelockConfigurer(client, keypair.MustRandom())
+
+	_, err := configurer.GrantRole(context.Background(), testContractAddress(4), sdk.TimelockRole(99), testContractAddress(5))
+	require.ErrorContains(t, err, "invalid timelock role")
+
+	_, err = configurer.GrantRole(context.Background(), testContractAddress(4), sdk.TimelockRoleProposer, "bad")
+	require.ErrorContains(t, err, "invalid target address")
+}
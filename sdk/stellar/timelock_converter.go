@@ -0,0 +1,153 @@
+package stellar
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stellar/go-stellar-sdk/xdr"
+
+	"github.com/smartcontractkit/mcms/internal/utils/abi"
+	"github.com/smartcontractkit/mcms/sdk"
+	sdkerrors "github.com/smartcontractkit/mcms/sdk/errors"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.TimelockConverter = (*TimelockConverter)(nil)
+
+// Call mirrors the Call struct of the Stellar RBAC timelock contract.
+type Call struct {
+	Target [32]byte
+	Value  *big.Int
+	Data   []byte
+}
+
+type TimelockConverter struct{}
+
+// NewTimelockConverter creates a new TimelockConverter
+func NewTimelockConverter() *TimelockConverter {
+	return &TimelockConverter{}
+}
+
+func (t *TimelockConverter) ConvertBatchToChainOperations(
+	_ context.Context,
+	_ types.ChainMetadata,
+	bop types.BatchOperation,
+	timelockAddress string,
+	_ string,
+	delay types.Duration,
+	action types.TimelockAction,
+	predecessor common.Hash,
+	salt common.Hash,
+) ([]types.Operation, common.Hash, error) {
+	calls, err := ConvertBatchToCalls(bop)
+	if err != nil {
+		return []types.Operation{}, common.Hash{}, fmt.Errorf("failed to convert batch to calls: %w", err)
+	}
+
+	tags := make([]string, 0)
+	for _, tx := range bop.Transactions {
+		tags = append(tags, tx.Tags...)
+	}
+
+	operationID, err := HashOperationBatch(calls, predecessor, salt)
+	if err != nil {
+		return []types.Operation{}, common.Hash{}, err
+	}
+
+	var function string
+	var args []xdr.ScVal
+	switch action {
+	case types.TimelockActionSchedule:
+		function = "schedule_batch"
+		args = []xdr.ScVal{callsToScVal(calls), scBytes(predecessor.Bytes()), scBytes(salt.Bytes()), scU64(uint64(delay.Seconds()))}
+	case types.TimelockActionCancel:
+		function = "cancel"
+		args = []xdr.ScVal{scBytes(operationID.Bytes())}
+	case types.TimelockActionBypass:
+		function = "bypasser_execute_batch"
+		args = []xdr.ScVal{callsToScVal(calls)}
+	default:
+		return []types.Operation{}, common.Hash{}, sdkerrors.NewInvalidTimelockOperationError(string(action))
+	}
+
+	tx, err := NewTransaction(timelockAddress, function, args, "RBACTimelock", tags)
+	if err != nil {
+		return []types.Operation{}, common.Hash{}, fmt.Errorf("failed to encode timelock action data: %w", err)
+	}
+
+	op := types.Operation{
+		ChainSelector: bop.ChainSelector,
+		Transaction:   tx,
+	}
+
+	return []types.Operation{op}, operationID, nil
+}
+
+func OperationID(
+	batchOp types.BatchOperation,
+	_ types.TimelockAction,
+	predecessor common.Hash,
+	salt common.Hash,
+) (common.Hash, error) {
+	calls, err := ConvertBatchToCalls(batchOp)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to convert batch to calls: %w", err)
+	}
+
+	return HashOperationBatch(calls, predecessor, salt)
+}
+
+// ConvertBatchToCalls converts the transactions of a batch operation to timelock calls.
+func ConvertBatchToCalls(bop types.BatchOperation) ([]Call, error) {
+	calls := make([]Call, len(bop.Transactions))
+	for i, tx := range bop.Transactions {
+		target, err := parseContractID(tx.To)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target address: %w", err)
+		}
+
+		value, err := parseValueWord(tx.AdditionalFields)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse Stellar additional fields: %w", err)
+		}
+
+		calls[i] = Call{
+			Target: target,
+			Value:  new(big.Int).SetBytes(value[:]),
+			Data:   tx.Data,
+		}
+	}
+
+	return calls, nil
+}
+
+// HashOperationBatch replicates the operation id calculation of the Stellar timelock contract,
+// keccak256(abi.encode(calls, predecessor, salt)) with contract ids encoded as bytes32.
+func HashOperationBatch(calls []Call, predecessor, salt [32]byte) (common.Hash, error) {
+	const _abi = `[{"components":[{"internalType":"bytes32","name":"target","type":"bytes32"},{"internalType":"uint256","name":"value","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"}],"internalType":"struct Call[]","name":"calls","type":"tuple[]"},{"internalType":"bytes32","name":"predecessor","type":"bytes32"},{"internalType":"bytes32","name":"salt","type":"bytes32"}]`
+	encoded, err := abi.Encode(_abi, calls, predecessor, salt)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+func callsToScVal(calls []Call) xdr.ScVal {
+	vals := make([]xdr.ScVal, len(calls))
+	for i, c := range calls {
+		var value [32]byte
+		c.Value.FillBytes(value[:])
+
+		vals[i] = scStruct(map[string]xdr.ScVal{
+			"target": scContract(xdr.ContractId(c.Target)),
+			"value":  scBytes(value[:]),
+			"data":   scBytes(c.Data),
+		})
+	}
+
+	return scVec(vals...)
+}
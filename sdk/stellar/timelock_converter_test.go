@@ -0,0 +1,159 @@
+package stellar
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stellar/go-stellar-sdk/xdr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/utils/abi"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func testBatchOperation(t *testing.T) types.BatchOperation {
+	t.Helper()
+
+	tx1, err := NewTransaction(testContractAddress(2), "set_value", []xdr.ScVal{scU32(1)}, "Counter", []string{"a"})
+	require.NoError(t, err)
+	tx2, err := NewTransaction(testContractAddress(3), "set_value", []xdr.ScVal{scU32(2)}, "Counter", []string{"b"})
+	require.NoError(t, err)
+
+	return types.BatchOperation{
+		ChainSelector: stellarTestnetSelector,
+		Transactions:  []types.Transaction{tx1, tx2},
+	}
+}
+
+func TestTimelockConverter_ConvertBatchToChainOperations(t *testing.T) {
+	t.Parallel()
+
+	bop := testBatchOperation(t)
+	timelock := testContractAddress(4)
+	predecessor := common.HexToHash("0x01")
+	salt := common.HexToHash("0x02")
+
+	calls, err := ConvertBatchToCalls(bop)
+	require.NoError(t, err)
+	wantID, err := HashOperationBatch(calls, predecessor, salt)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		action       types.TimelockAction
+		wantFunction string
+		wantArgs     []xdr.ScVal
+		wantErr      string
+	}{
+		{
+			name:         "schedule",
+			action:       types.TimelockActionSchedule,
+			wantFunction: "schedule_batch",
+			wantArgs:     []xdr.ScVal{callsToScVal(calls), scBytes(predecessor.Bytes()), scBytes(salt.Bytes()), scU64(3600)},
+		},
+		{
+			name:         "cancel",
+			action:       types.TimelockActionCancel,
+			wantFunction: "cancel",
+			wantArgs:     []xdr.ScVal{scBytes(wantID.Bytes())},
+		},
+		{
+			name:         "bypass",
+			action:       types.TimelockActionBypass,
+			wantFunction: "bypasser_execute_batch",
+			wantArgs:     []xdr.ScVal{callsToScVal(calls)},
+		},
+		{
+			name:    "invalid action",
+			action:  types.TimelockAction("invalid"),
+			wantErr: "invalid timelock operation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ops, opID, err := NewTimelockConverter().ConvertBatchToChainOperations(
+				context.Background(), types.ChainMetadata{}, bop, timelock, testContractAddress(1),
+				types.NewDuration(time.Hour), tt.action, predecessor, salt,
+			)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, wantID, opID)
+			require.Len(t, ops, 1)
+			require.Equal(t, stellarTestnetSelector, ops[0].ChainSelector)
+			require.Equal(t, timelock, ops[0].Transaction.To)
+			require.Equal(t, "RBACTimelock", ops[0].Transaction.ContractType)
+			require.Equal(t, []string{"a", "b"}, ops[0].Transaction.Tags)
+
+			function, args, err := DecodeInvocationData(ops[0].Transaction.Data)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantFunction, function)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestOperationID(t *testing.T) {
+	t.Parallel()
+
+	bop := testBatchOperation(t)
+	predecessor := common.HexToHash("0x01")
+	salt := common.HexToHash("0x02")
+
+	got, err := OperationID(bop, types.TimelockActionSchedule, predecessor, salt)
+	require.NoError(t, err)
+
+	// Independently encode the calls with the contract ids as bytes32 words
+	type call struct {
+		Target [32]byte
+		Value  *big.Int
+		Data   []byte
+	}
+	want := make([]call, len(bop.Transactions))
+	for i, tx := range bop.Transactions {
+		id, err := parseContractID(tx.To)
+		require.NoError(t, err)
+		want[i] = call{Target: id, Value: big.NewInt(0), Data: tx.Data}
+	}
+	encoded, err := abi.Encode(`[{"components":[{"name":"target","type":"bytes32"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"}],"name":"calls","type":"tuple[]"},{"name":"predecessor","type":"bytes32"},{"name":"salt","type":"bytes32"}]`,
+		want, predecessor, salt)
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256Hash(encoded), got)
+
+	otherSalt, err := OperationID(bop, types.TimelockActionSchedule, predecessor, common.HexToHash("0x03"))
+	require.NoError(t, err)
+	require.NotEqual(t, got, otherSalt)
+}
+
+func TestConvertBatchToCalls(t *testing.T) {
+	t.Parallel()
+
+	value := "0x00000000000000000000000000000000000000000000000000000000000000ff"
+	calls, err := ConvertBatchToCalls(types.BatchOperation{Transactions: []types.Transaction{{
+		To:               testContractAddress(2),
+		Data:             []byte{1},
+		AdditionalFields: json.RawMessage(`{"value":"` + value + `"}`),
+	}}})
+	require.NoError(t, err)
+	require.Len(t, calls, 1)
+	require.Equal(t, big.NewInt(255), calls[0].Value)
+
+	_, err = ConvertBatchToCalls(types.BatchOperation{Transactions: []types.Transaction{{To: "bad"}}})
+	require.ErrorContains(t, err, "invalid target address")
+
+	_, err = ConvertBatchToCalls(types.BatchOperation{Transactions: []types.Transaction{{
+		To:               testContractAddress(2),
+		AdditionalFields: json.RawMessage(`{"value":"0x1"}`),
+	}}})
+	require.ErrorContains(t, err, "failed to parse Stellar additional fields")
+}
@@ -0,0 +1,41 @@
+package stellar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stellar/go-stellar-sdk/keypair"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.TimelockExecutor = (*TimelockExecutor)(nil)
+
+// TimelockExecutor is an Executor implementation for Stellar chains for accessing the RBAC
+// timelock contract
+type TimelockExecutor struct {
+	TimelockInspector
+	invoker *contractInvoker
+}
+
+// NewTimelockExecutor creates a new TimelockExecutor
+func NewTimelockExecutor(client RPCClient, signer *keypair.Full) *TimelockExecutor {
+	return &TimelockExecutor{
+		TimelockInspector: *NewTimelockInspector(client),
+		invoker:           newContractInvoker(client, signer),
+	}
+}
+
+func (t *TimelockExecutor) Execute(
+	ctx context.Context, bop types.BatchOperation, timelockAddress string, predecessor common.Hash, salt common.Hash,
+) (types.TransactionResult, error) {
+	calls, err := ConvertBatchToCalls(bop)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to convert batch to calls: %w", err)
+	}
+
+	return t.invoker.invoke(ctx, timelockAddress, "execute_batch",
+		callsToScVal(calls), scBytes(predecessor.Bytes()), scBytes(salt.Bytes()))
+}
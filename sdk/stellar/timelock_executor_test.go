@@ -0,0 +1,49 @@
+package stellar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stellar/go-stellar-sdk/keypair"
+	"github.com/stellar/go-stellar-sdk/xdr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestTimelockExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("execute_batch", scVoid())
+
+	bop := testBatchOperation(t)
+	timelock := testContractAddress(4)
+	predecessor := common.HexToHash("0x01")
+	salt := common.HexToHash("0x02")
+
+	result, err := NewTimelockExecutor(client, keypair.MustRandom()).Execute(context.Background(), bop, timelock, predecessor, salt)
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Hash)
+
+	calls, err := ConvertBatchToCalls(bop)
+	require.NoError(t, err)
+
+	submitted := rpc.submitted()
+	require.Len(t, submitted, 1)
+	require.Equal(t, timelock, submitted[0].Contract)
+	require.Equal(t, "execute_batch", submitted[0].Function)
+	require.Equal(t, []xdr.ScVal{callsToScVal(calls), scBytes(predecessor.Bytes()), scBytes(salt.Bytes())}, submitted[0].Args)
+}
+
+func TestTimelockExecutor_Execute_InvalidBatch(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+
+	_, err := NewTimelockExecutor(client, keypair.MustRandom()).Execute(context.Background(),
+		types.BatchOperation{Transactions: []types.Transaction{{To: "bad"}}}, testContractAddress(4), common.Hash{}, common.Hash{})
+	require.ErrorContains(t, err, "failed to convert batch to calls")
+	require.Empty(t, rpc.submitted())
+}
@@ -0,0 +1,109 @@
+package stellar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smartcontractkit/mcms/sdk"
+)
+
+var _ sdk.TimelockInspector = (*TimelockInspector)(nil)
+
+// TimelockInspector is an Inspector implementation for Stellar, for accessing the RBAC timelock
+// contract
+type TimelockInspector struct {
+	invoker *contractInvoker
+}
+
+// NewTimelockInspector creates a new TimelockInspector
+func NewTimelockInspector(client RPCClient) *TimelockInspector {
+	return &TimelockInspector{
+		invoker: newContractInvoker(client, nil),
+	}
+}
+
+// GetAdmins returns the list of addresses with the admin role
+func (tm TimelockInspector) GetAdmins(ctx context.Context, address string) ([]string, error) {
+	return tm.getRoleMembers(ctx, address, sdk.TimelockRoleAdmin)
+}
+
+// GetProposers returns the list of addresses with the proposer role
+func (tm TimelockInspector) GetProposers(ctx context.Context, address string) ([]string, error) {
+	return tm.getRoleMembers(ctx, address, sdk.TimelockRoleProposer)
+}
+
+// GetExecutors returns the list of addresses with the executor role
+func (tm TimelockInspector) GetExecutors(ctx context.Context, address string) ([]string, error) {
+	return tm.getRoleMembers(ctx, address, sdk.TimelockRoleExecutor)
+}
+
+// GetBypassers returns the list of addresses with the bypasser role
+func (tm TimelockInspector) GetBypassers(ctx context.Context, address string) ([]string, error) {
+	return tm.getRoleMembers(ctx, address, sdk.TimelockRoleBypasser)
+}
+
+// GetCancellers returns the list of addresses with the canceller role
+func (tm TimelockInspector) GetCancellers(ctx context.Context, address string) ([]string, error) {
+	return tm.getRoleMembers(ctx, address, sdk.TimelockRoleCanceller)
+}
+
+func (tm TimelockInspector) getRoleMembers(ctx context.Context, address string, role sdk.TimelockRole) ([]string, error) {
+	roleSym, err := TimelockRoleSymbol(role)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := tm.invoker.call(ctx, address, "get_role_members", scSymbol(roleSym))
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := fromVec(ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s role members: %w", roleSym, err)
+	}
+
+	members := make([]string, len(vals))
+	for i, v := range vals {
+		members[i], err = fromAddress(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s role member %d: %w", roleSym, i, err)
+		}
+	}
+
+	return members, nil
+}
+
+func (tm TimelockInspector) IsOperation(ctx context.Context, address string, opID [32]byte) (bool, error) {
+	return tm.isOperationFor(ctx, address, "is_operation", opID)
+}
+
+func (tm TimelockInspector) IsOperationPending(ctx context.Context, address string, opID [32]byte) (bool, error) {
+	return tm.isOperationFor(ctx, address, "is_operation_pending", opID)
+}
+
+func (tm TimelockInspector) IsOperationReady(ctx context.Context, address string, opID [32]byte) (bool, error) {
+	return tm.isOperationFor(ctx, address, "is_operation_ready", opID)
+}
+
+func (tm TimelockInspector) IsOperationDone(ctx context.Context, address string, opID [32]byte) (bool, error) {
+	return tm.isOperationFor(ctx, address, "is_operation_done", opID)
+}
+
+func (tm TimelockInspector) isOperationFor(ctx context.Context, address string, function string, opID [32]byte) (bool, error) {
+	ret, err := tm.invoker.call(ctx, address, function, scBytes(opID[:]))
+	if err != nil {
+		return false, err
+	}
+
+	return fromBool(ret)
+}
+
+func (tm TimelockInspector) GetMinDelay(ctx context.Context, address string) (uint64, error) {
+	ret, err := tm.invoker.call(ctx, address, "get_min_delay")
+	if err != nil {
+		return 0, err
+	}
+
+	return fromU64(ret)
+}
@@ -0,0 +1,132 @@
+package stellar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stellar/go-stellar-sdk/keypair"
+	"github.com/stellar/go-stellar-sdk/xdr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk"
+)
+
+func TestTimelockInspector_GetRoleMembers(t *testing.T) {
+	t.Parallel()
+
+	account := keypair.MustRandom().Address()
+	contract := testContractAddress(5)
+	accountVal, err := scAddress(account)
+	require.NoError(t, err)
+	contractVal, err := scAddress(contract)
+	require.NoError(t, err)
+
+	rpc, client := newRPCStandIn(t)
+	rpc.handle("get_role_members", func(args []xdr.ScVal) (xdr.ScVal, error) {
+		switch string(args[0].MustSym()) {
+		case "proposer":
+			return scVec(accountVal, contractVal), nil
+		case "executor":
+			return scVec(contractVal), nil
+		case "admin":
+			return scVec(accountVal), nil
+		default:
+			return scVec(), nil
+		}
+	})
+
+	inspector := NewTimelockInspector(client)
+	timelock := testContractAddress(4)
+
+	proposers, err := inspector.GetProposers(context.Background(), timelock)
+	require.NoError(t, err)
+	require.Equal(t, []string{account, contract}, proposers)
+
+	executors, err := inspector.GetExecutors(context.Background(), timelock)
+	require.NoError(t, err)
+	require.Equal(t, []string{contract}, executors)
+
+	admins, err := inspector.GetAdmins(context.Background(), timelock)
+	require.NoError(t, err)
+	require.Equal(t, []string{account}, admins)
+
+	bypassers, err := inspector.GetBypassers(context.Background(), timelock)
+	require.NoError(t, err)
+	require.Empty(t, bypassers)
+
+	cancellers, err := inspector.GetCancellers(context.Background(), timelock)
+	require.NoError(t, err)
+	require.Empty(t, cancellers)
+}
+
+func TestTimelockInspector_GetRoleMembers_InvalidResponse(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("get_role_members", scVec(scU32(1)))
+
+	_, err := NewTimelockInspector(client).GetProposers(context.Background(), testContractAddress(4))
+	require.ErrorContains(t, err, "failed to decode proposer role member 0")
+
+	_, err = NewTimelockInspector(client).getRoleMembers(context.Background(), testContractAddress(4), sdk.TimelockRole(99))
+	require.ErrorContains(t, err, "invalid timelock role")
+}
+
+func TestTimelockInspector_IsOperation(t *testing.T) {
+	t.Parallel()
+
+	opID := [32]byte{1}
+	rpc, client := newRPCStandIn(t)
+	for function, result := range map[string]bool{
+		"is_operation":         true,
+		"is_operation_pending": false,
+		"is_operation_ready":   true,
+		"is_operation_done":    false,
+	} {
+		rpc.handle(function, func(args []xdr.ScVal) (xdr.ScVal, error) {
+			id, err := fromBytes32(args[0])
+			if err != nil {
+				return xdr.ScVal{}, err
+			}
+			if id != opID {
+				return xdr.ScVal{}, errors.New("unexpected operation id")
+			}
+
+			return scBool(result), nil
+		})
+	}
+
+	inspector := NewTimelockInspector(client)
+	timelock := testContractAddress(4)
+
+	ok, err := inspector.IsOperation(context.Background(), timelock, opID)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = inspector.IsOperationPending(context.Background(), timelock, opID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = inspector.IsOperationReady(context.Background(), timelock, opID)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = inspector.IsOperationDone(context.Background(), timelock, opID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = inspector.IsOperation(context.Background(), timelock, [32]byte{2})
+	require.ErrorContains(t, err, "unexpected operation id")
+}
+
+func TestTimelockInspector_GetMinDelay(t *testing.T) {
+	t.Parallel()
+
+	rpc, client := newRPCStandIn(t)
+	rpc.returns("get_min_delay", scU64(3600))
+
+	got, err := NewTimelockInspector(client).GetMinDelay(context.Background(), testContractAddress(4))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3600), got)
+}
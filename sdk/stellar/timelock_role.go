@@ -0,0 +1,25 @@
+package stellar
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/mcms/sdk"
+)
+
+var timelockRoleSymbols = map[sdk.TimelockRole]string{
+	sdk.TimelockRoleAdmin:     "admin",
+	sdk.TimelockRoleBypasser:  "bypasser",
+	sdk.TimelockRoleCanceller: "canceller",
+	sdk.TimelockRoleExecutor:  "executor",
+	sdk.TimelockRoleProposer:  "proposer",
+}
+
+// TimelockRoleSymbol returns the symbol identifying role in the Stellar RBAC timelock contract.
+func TimelockRoleSymbol(role sdk.TimelockRole) (string, error) {
+	sym, ok := timelockRoleSymbols[role]
+	if !ok {
+		return "", fmt.Errorf("invalid timelock role: %d", role)
+	}
+
+	return sym, nil
+}
@@ -0,0 +1,40 @@
+package stellar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk"
+)
+
+func TestTimelockRoleSymbol(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		role    sdk.TimelockRole
+		want    string
+		wantErr string
+	}{
+		{role: sdk.TimelockRoleAdmin, want: "admin"},
+		{role: sdk.TimelockRoleBypasser, want: "bypasser"},
+		{role: sdk.TimelockRoleCanceller, want: "canceller"},
+		{role: sdk.TimelockRoleExecutor, want: "executor"},
+		{role: sdk.TimelockRoleProposer, want: "proposer"},
+		{role: sdk.TimelockRole(99), wantErr: "invalid timelock role: 99"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role.String(), func(t *testing.T) {
+			t.Parallel()
+
+			got, err := TimelockRoleSymbol(tt.role)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
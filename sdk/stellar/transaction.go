@@ -0,0 +1,93 @@
+package stellar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/stellar/go-stellar-sdk/xdr"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// AdditionalFields are the Stellar specific fields of a transaction.
+//
+// Value is the optional 32-byte hex encoded StellarOp.value; the V1 contracts require it to be
+// zero, so it is normally omitted.
+type AdditionalFields struct {
+	Value string `json:"value,omitempty"`
+}
+
+// ValidateAdditionalFields checks that the additional fields of a transaction are well-formed.
+func ValidateAdditionalFields(additionalFields json.RawMessage) error {
+	if _, err := parseValueWord(additionalFields); err != nil {
+		return fmt.Errorf("invalid Stellar additional fields: %w", err)
+	}
+
+	return nil
+}
+
+// EncodeInvocationData encodes a contract function call as the opaque `data` of an MCMS
+// operation: the XDR encoding of a Vec whose first element is the function name symbol and whose
+// remaining elements are the call arguments.
+func EncodeInvocationData(function string, args ...xdr.ScVal) ([]byte, error) {
+	if function == "" {
+		return nil, errors.New("function name is required")
+	}
+
+	vals := append([]xdr.ScVal{scSymbol(function)}, args...)
+	data, err := xdr.ScVec(vals).MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode invocation data: %w", err)
+	}
+
+	return data, nil
+}
+
+// DecodeInvocationData decodes operation data produced by EncodeInvocationData into the function
+// name and its arguments.
+func DecodeInvocationData(data []byte) (string, []xdr.ScVal, error) {
+	var vals xdr.ScVec
+	if err := xdr.SafeUnmarshal(data, &vals); err != nil {
+		return "", nil, fmt.Errorf("failed to decode invocation data: %w", err)
+	}
+	if len(vals) == 0 {
+		return "", nil, errors.New("invocation data is empty")
+	}
+
+	function, ok := vals[0].GetSym()
+	if !ok {
+		return "", nil, fmt.Errorf("expected function name symbol, got %s", vals[0].Type)
+	}
+
+	return string(function), vals[1:], nil
+}
+
+// NewTransaction builds a types.Transaction that invokes function on the contract at `to`.
+func NewTransaction(
+	to string,
+	function string,
+	args []xdr.ScVal,
+	contractType string,
+	tags []string,
+) (types.Transaction, error) {
+	data, err := EncodeInvocationData(function, args...)
+	if err != nil {
+		return types.Transaction{}, err
+	}
+
+	additionalFields, err := json.Marshal(AdditionalFields{})
+	if err != nil {
+		return types.Transaction{}, fmt.Errorf("failed to marshal additional fields: %w", err)
+	}
+
+	return types.Transaction{
+		OperationMetadata: types.OperationMetadata{
+			ContractType: contractType,
+			Tags:         tags,
+		},
+		To:               to,
+		Data:             data,
+		AdditionalFields: additionalFields,
+	}, nil
+}
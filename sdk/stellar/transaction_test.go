@@ -0,0 +1,91 @@
+package stellar
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stellar/go-stellar-sdk/xdr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAdditionalFields(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   json.RawMessage
+		wantErr string
+	}{
+		{name: "empty", input: nil},
+		{name: "empty object", input: json.RawMessage(`{}`)},
+		{name: "value", input: json.RawMessage(`{"value":"0x0000000000000000000000000000000000000000000000000000000000000001"}`)},
+		{name: "short value", input: json.RawMessage(`{"value":"0x01"}`), wantErr: "invalid Stellar additional fields"},
+		{name: "malformed", input: json.RawMessage(`{`), wantErr: "invalid Stellar additional fields"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateAdditionalFields(tt.input)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInvocationData_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data, err := EncodeInvocationData("transfer", scU64(10), scBool(true))
+	require.NoError(t, err)
+
+	function, args, err := DecodeInvocationData(data)
+	require.NoError(t, err)
+	require.Equal(t, "transfer", function)
+	require.Equal(t, []xdr.ScVal{scU64(10), scBool(true)}, args)
+}
+
+func TestInvocationData_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, err := EncodeInvocationData("")
+	require.ErrorContains(t, err, "function name is required")
+
+	_, _, err = DecodeInvocationData([]byte{0xff})
+	require.ErrorContains(t, err, "failed to decode invocation data")
+
+	empty, err := xdr.ScVec{}.MarshalBinary()
+	require.NoError(t, err)
+	_, _, err = DecodeInvocationData(empty)
+	require.ErrorContains(t, err, "invocation data is empty")
+
+	notSym, err := xdr.ScVec{scU32(1)}.MarshalBinary()
+	require.NoError(t, err)
+	_, _, err = DecodeInvocationData(notSym)
+	require.ErrorContains(t, err, "expected function name symbol")
+}
+
+func TestNewTransaction(t *testing.T) {
+	t.Parallel()
+
+	to := testContractAddress(3)
+	tx, err := NewTransaction(to, "set_value", []xdr.ScVal{scU32(1)}, "Counter", []string{"tag"})
+	require.NoError(t, err)
+
+	require.Equal(t, to, tx.To)
+	require.Equal(t, "Counter", tx.ContractType)
+	require.Equal(t, []string{"tag"}, tx.Tags)
+	require.JSONEq(t, `{}`, string(tx.AdditionalFields))
+
+	function, args, err := DecodeInvocationData(tx.Data)
+	require.NoError(t, err)
+	require.Equal(t, "set_value", function)
+	require.Equal(t, []xdr.ScVal{scU32(1)}, args)
+
+	_, err = NewTransaction(to, "", nil, "Counter", nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,55 @@
+package sui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	suirpcv2 "github.com/block-vision/sui-go-sdk/pb/sui/rpc/v2"
+	"google.golang.org/grpc"
+)
+
+// DevInspector runs transactions with the transaction checks disabled, as the dev-inspect mode of
+// the Sui nodes. Such transactions may call package functions and pass values of any Move type,
+// and are never committed.
+type DevInspector interface {
+	DevInspectPTB(ctx context.Context, bcsBytes []byte) error
+}
+
+var _ DevInspector = (*GRPCDevInspector)(nil)
+
+// GRPCDevInspector is the DevInspector of the gRPC API of a Sui node.
+type GRPCDevInspector struct {
+	client suirpcv2.TransactionExecutionServiceClient
+}
+
+// NewGRPCDevInspector creates a DevInspector sending its transactions over the gRPC connection to
+// a Sui node.
+func NewGRPCDevInspector(conn grpc.ClientConnInterface) *GRPCDevInspector {
+	return &GRPCDevInspector{client: suirpcv2.NewTransactionExecutionServiceClient(conn)}
+}
+
+// DevInspectPTB simulates the transaction with the transaction checks disabled, returning an
+// error if its execution fails.
+func (d *GRPCDevInspector) DevInspectPTB(ctx context.Context, bcsBytes []byte) error {
+	checks := suirpcv2.SimulateTransactionRequest_DISABLED
+	doGasSelection := false
+	response, err := d.client.SimulateTransaction(ctx, &suirpcv2.SimulateTransactionRequest{
+		Transaction:    &suirpcv2.Transaction{Bcs: &suirpcv2.Bcs{Value: bcsBytes}},
+		Checks:         &checks,
+		DoGasSelection: &doGasSelection,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to dev-inspect transaction: %w", err)
+	}
+
+	status := response.GetTransaction().GetEffects().GetStatus()
+	if status == nil {
+		return errors.New("dev-inspect returned no execution status")
+	}
+	if !status.GetSuccess() {
+		return fmt.Errorf("dev-inspect failed: %s", status.GetError().GetDescription())
+	}
+
+	return nil
+}
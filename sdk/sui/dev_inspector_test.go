@@ -0,0 +1,91 @@
+package sui
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	suirpcv2 "github.com/block-vision/sui-go-sdk/pb/sui/rpc/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeTransactionExecutionService returns the response to the SimulateTransaction requests.
+type fakeTransactionExecutionService struct {
+	suirpcv2.TransactionExecutionServiceClient
+
+	request  *suirpcv2.SimulateTransactionRequest
+	response *suirpcv2.SimulateTransactionResponse
+	err      error
+}
+
+func (f *fakeTransactionExecutionService) SimulateTransaction(
+	_ context.Context, in *suirpcv2.SimulateTransactionRequest, _ ...grpc.CallOption,
+) (*suirpcv2.SimulateTransactionResponse, error) {
+	f.request = in
+	return f.response, f.err
+}
+
+func simulateResponse(status *suirpcv2.ExecutionStatus) *suirpcv2.SimulateTransactionResponse {
+	return &suirpcv2.SimulateTransactionResponse{
+		Transaction: &suirpcv2.ExecutedTransaction{Effects: &suirpcv2.TransactionEffects{Status: status}},
+	}
+}
+
+func TestGRPCDevInspector_DevInspectPTB(t *testing.T) {
+	t.Parallel()
+
+	success := true
+	failure := false
+	description := "MoveAbort in 1st command"
+
+	tests := []struct {
+		name     string
+		response *suirpcv2.SimulateTransactionResponse
+		err      error
+		wantErr  string
+	}{
+		{
+			name:     "success",
+			response: simulateResponse(&suirpcv2.ExecutionStatus{Success: &success}),
+		},
+		{
+			name: "failure - execution failed",
+			response: simulateResponse(&suirpcv2.ExecutionStatus{
+				Success: &failure,
+				Error:   &suirpcv2.ExecutionError{Description: &description},
+			}),
+			wantErr: "dev-inspect failed: MoveAbort in 1st command",
+		},
+		{
+			name:     "failure - no status",
+			response: simulateResponse(nil),
+			wantErr:  "dev-inspect returned no execution status",
+		},
+		{
+			name:    "failure - rpc error",
+			err:     errors.New("unavailable"),
+			wantErr: "failed to dev-inspect transaction: unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := &fakeTransactionExecutionService{response: tt.response, err: tt.err}
+			inspector := &GRPCDevInspector{client: service}
+
+			err := inspector.DevInspectPTB(t.Context(), []byte{0x01, 0x02})
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.wantErr)
+			}
+
+			require.Equal(t, []byte{0x01, 0x02}, service.request.GetTransaction().GetBcs().GetValue())
+			require.Equal(t, suirpcv2.SimulateTransactionRequest_DISABLED, service.request.GetChecks())
+			require.False(t, service.request.GetDoGasSelection())
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package sui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	suirpcv2 "github.com/block-vision/sui-go-sdk/pb/sui/rpc/v2"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	modulemcms "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/mcms"
+	cslclient "github.com/smartcontractkit/chainlink-sui/relayer/client"
+
+	"github.com/smartcontractkit/mcms/internal/utils/safecast"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.EventFilterer = (*EventFilterer)(nil)
+
+// EventFilterer is an EventFilterer implementation for Sui. Blocks are checkpoints, which are
+// scanned for the events emitted by the mcms module of the MCMS package.
+//
+// The MCMS and timelock of a package are shared objects of the same module, so the addresses of a
+// query only select which events are returned and are copied to them. Sui has no RoleGranted
+// event, and only the NewRoot, OpExecuted and ConfigSet events of the role of the filterer are
+// returned. Timelock calls are executed by the PTB following the timelock, so the CallInitiated
+// events are returned as CallExecuted events.
+type EventFilterer struct {
+	client        cslclient.SuiPTBClient
+	chainSelector types.ChainSelector
+	mcmsPackageID string
+	role          TimelockRole
+}
+
+// NewEventFilterer creates a new EventFilterer for the MCMS package mcmsPackageID.
+func NewEventFilterer(
+	client cslclient.SuiPTBClient, chainSelector types.ChainSelector, mcmsPackageID string, role TimelockRole,
+) (*EventFilterer, error) {
+	if _, err := AddressFromHex(mcmsPackageID); err != nil {
+		return nil, fmt.Errorf("failed to parse MCMS package ID: %w", err)
+	}
+
+	return &EventFilterer{
+		client:        client,
+		chainSelector: chainSelector,
+		mcmsPackageID: mcmsPackageID,
+		role:          role,
+	}, nil
+}
+
+// LatestBlock returns the sequence number of the latest checkpoint.
+func (f *EventFilterer) LatestBlock(ctx context.Context) (uint64, error) {
+	checkpoint, err := f.client.GetLatestCheckpoint(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest checkpoint: %w", err)
+	}
+
+	return checkpoint.GetSequenceNumber(), nil
+}
+
+// FilterEvents returns the events of the query, ordered by checkpoint and transaction.
+func (f *EventFilterer) FilterEvents(ctx context.Context, query types.EventQuery) ([]types.Event, error) {
+	if query.MCMAddress == "" && query.TimelockAddress == "" {
+		return nil, nil
+	}
+
+	var events []types.Event
+	for sequenceNumber := query.FromBlock; sequenceNumber <= query.ToBlock; sequenceNumber++ {
+		checkpoint, err := f.client.GetCheckpointData(ctx, sequenceNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get checkpoint %d: %w", sequenceNumber, err)
+		}
+
+		for _, tx := range checkpoint.Transactions {
+			if !tx.GetEffects().GetStatus().GetSuccess() {
+				continue
+			}
+
+			for index, txEvent := range tx.GetEvents().GetEvents() {
+				name, ok := f.eventName(txEvent.GetEventType())
+				if !ok {
+					continue
+				}
+
+				var event *types.Event
+				eventAddress := query.MCMAddress
+				if query.MCMAddress != "" {
+					event, err = f.decodeMCMEvent(name, txEvent)
+				}
+				if event == nil && err == nil && query.TimelockAddress != "" {
+					event, err = decodeTimelockEvent(name, txEvent)
+					eventAddress = query.TimelockAddress
+				}
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode %s event of transaction %s: %w", name, tx.GetDigest(), err)
+				}
+				if event == nil {
+					continue
+				}
+
+				event.ChainSelector = f.chainSelector
+				event.Address = eventAddress
+				event.Block = sequenceNumber
+				event.TxHash = tx.GetDigest()
+				event.Index = uint(index)
+				events = append(events, *event)
+			}
+		}
+	}
+
+	return events, nil
+}
+
+// eventName returns the name of an event of the mcms module of the MCMS package, e.g. NewRoot for
+// 0x2::mcms::NewRoot. ok is false for the events of other modules.
+func (f *EventFilterer) eventName(eventType string) (name string, ok bool) {
+	parts := strings.Split(eventType, "::")
+	if len(parts) != 3 || parts[1] != "mcms" {
+		return "", false
+	}
+	address, err := AddressFromHex(parts[0])
+	if err != nil {
+		return "", false
+	}
+	packageID, err := AddressFromHex(f.mcmsPackageID)
+	if err != nil || *address != *packageID {
+		return "", false
+	}
+
+	return parts[2], true
+}
+
+// decodeMCMEvent decodes the NewRoot, OpExecuted and ConfigSet events of the role of the filterer.
+func (f *EventFilterer) decodeMCMEvent(name string, txEvent *suirpcv2.Event) (*types.Event, error) {
+	switch name {
+	case "NewRoot":
+		data, err := decodeEventJSON[modulemcms.NewRoot](txEvent)
+		if err != nil || data.Role != f.role.Byte() {
+			return nil, err
+		}
+		validUntil, err := safecast.Uint64ToUint32(data.ValidUntil)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.Event{
+			Kind:       types.EventKindNewRoot,
+			Root:       common.BytesToHash(data.Root),
+			ValidUntil: validUntil,
+			RawData:    data,
+		}, nil
+	case "OpExecuted":
+		data, err := decodeEventJSON[modulemcms.OpExecuted](txEvent)
+		if err != nil || data.Role != f.role.Byte() {
+			return nil, err
+		}
+
+		return &types.Event{Kind: types.EventKindOpExecuted, Nonce: data.Nonce, RawData: data}, nil
+	case "ConfigSet":
+		data, err := decodeEventJSON[modulemcms.ConfigSet](txEvent)
+		if err != nil || data.Role != f.role.Byte() {
+			return nil, err
+		}
+
+		return &types.Event{Kind: types.EventKindConfigSet, IsRootCleared: data.IsRootCleared, RawData: data}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// decodeTimelockEvent decodes the CallScheduled, CallInitiated and Cancelled events.
+func decodeTimelockEvent(name string, txEvent *suirpcv2.Event) (*types.Event, error) {
+	switch name {
+	case "CallScheduled":
+		data, err := decodeEventJSON[modulemcms.CallScheduled](txEvent)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.Event{
+			Kind:        types.EventKindCallScheduled,
+			OperationID: common.BytesToHash(data.Id),
+			CallIndex:   data.Index,
+			RawData:     data,
+		}, nil
+	case "CallInitiated":
+		data, err := decodeEventJSON[modulemcms.CallInitiated](txEvent)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.Event{
+			Kind:        types.EventKindCallExecuted,
+			OperationID: common.BytesToHash(data.Id),
+			CallIndex:   data.Index,
+			RawData:     data,
+		}, nil
+	case "Cancelled":
+		data, err := decodeEventJSON[modulemcms.Cancelled](txEvent)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.Event{Kind: types.EventKindCancelled, OperationID: common.BytesToHash(data.Id), RawData: data}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// decodeEventJSON decodes the JSON rendering of an event into its binding.
+func decodeEventJSON[T any](txEvent *suirpcv2.Event) (T, error) {
+	var data T
+	if txEvent.GetJson() == nil {
+		return data, errors.New("event has no JSON rendering")
+	}
+	if err := bind.DecodeJSONReturn(txEvent.GetJson().AsInterface(), &data); err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
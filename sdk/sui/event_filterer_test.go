@@ -0,0 +1,135 @@
+package sui
+
+import (
+	"errors"
+	"testing"
+
+	suirpcv2 "github.com/block-vision/sui-go-sdk/pb/sui/rpc/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	modulemcms "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/mcms"
+	cslclient "github.com/smartcontractkit/chainlink-sui/relayer/client"
+
+	mocksui "github.com/smartcontractkit/mcms/sdk/sui/mocks/sui"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+const testEventPackageID = "0x2a"
+
+func TestNewEventFilterer(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewEventFilterer(mocksui.NewSuiPTBClient(t), 1, "invalid", TimelockRoleProposer)
+	require.ErrorContains(t, err, "failed to parse MCMS package ID")
+}
+
+func TestEventFilterer_LatestBlock(t *testing.T) {
+	t.Parallel()
+
+	client := mocksui.NewSuiPTBClient(t)
+	filterer, err := NewEventFilterer(client, 1, testEventPackageID, TimelockRoleProposer)
+	require.NoError(t, err)
+
+	sequenceNumber := uint64(42)
+	client.EXPECT().GetLatestCheckpoint(mock.Anything).Return(&suirpcv2.Checkpoint{SequenceNumber: &sequenceNumber}, nil).Once()
+	latest, err := filterer.LatestBlock(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, sequenceNumber, latest)
+
+	client.EXPECT().GetLatestCheckpoint(mock.Anything).Return(nil, errors.New("rpc error")).Once()
+	_, err = filterer.LatestBlock(t.Context())
+	require.EqualError(t, err, "failed to get latest checkpoint: rpc error")
+}
+
+func TestEventFilterer_FilterEvents(t *testing.T) {
+	t.Parallel()
+
+	client := mocksui.NewSuiPTBClient(t)
+	filterer, err := NewEventFilterer(client, 1, testEventPackageID, TimelockRoleProposer)
+	require.NoError(t, err)
+
+	packageID := "0x000000000000000000000000000000000000000000000000000000000000002a"
+	client.EXPECT().GetCheckpointData(mock.Anything, uint64(10)).Return(&cslclient.CheckpointData{
+		Transactions: []*suirpcv2.ExecutedTransaction{
+			executedTransaction(t, "tx1", true,
+				suiEvent(t, "0x2::coin::CoinEvent", map[string]any{}),
+				suiEvent(t, packageID+"::mcms::NewRoot", map[string]any{
+					"role": float64(TimelockRoleProposer), "root": "0x01", "valid_until": "100",
+				}),
+				suiEvent(t, packageID+"::mcms::NewRoot", map[string]any{
+					"role": float64(TimelockRoleBypasser), "root": "0x02", "valid_until": "100",
+				}),
+			),
+			executedTransaction(t, "tx2", false,
+				suiEvent(t, packageID+"::mcms::Cancelled", map[string]any{"id": "0x01"}),
+			),
+		},
+	}, nil).Once()
+	client.EXPECT().GetCheckpointData(mock.Anything, uint64(11)).Return(&cslclient.CheckpointData{
+		Transactions: []*suirpcv2.ExecutedTransaction{
+			executedTransaction(t, "tx3", true,
+				suiEvent(t, "0x2b::mcms::OpExecuted", map[string]any{"role": float64(TimelockRoleProposer), "nonce": "1"}),
+				suiEvent(t, packageID+"::mcms::OpExecuted", map[string]any{"role": float64(TimelockRoleProposer), "nonce": "7"}),
+				suiEvent(t, packageID+"::mcms::CallInitiated", map[string]any{"id": "0xab", "index": "2"}),
+			),
+		},
+	}, nil).Once()
+
+	events, err := filterer.FilterEvents(t.Context(), types.EventQuery{
+		MCMAddress:      "0x1",
+		TimelockAddress: "0x2",
+		FromBlock:       10,
+		ToBlock:         11,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, events, 3)
+	require.Equal(t, types.Event{
+		Kind: types.EventKindNewRoot, ChainSelector: 1, Address: "0x1", Block: 10, TxHash: "tx1", Index: 1,
+		Root: common.BytesToHash([]byte{0x01}), ValidUntil: 100,
+		RawData: modulemcms.NewRoot{Role: TimelockRoleProposer.Byte(), Root: []byte{0x01}, ValidUntil: 100},
+	}, events[0])
+	require.Equal(t, types.EventKindOpExecuted, events[1].Kind)
+	require.Equal(t, "0x1", events[1].Address)
+	require.Equal(t, uint64(11), events[1].Block)
+	require.Equal(t, uint(1), events[1].Index)
+	require.Equal(t, uint64(7), events[1].Nonce)
+	require.Equal(t, types.EventKindCallExecuted, events[2].Kind)
+	require.Equal(t, "0x2", events[2].Address)
+	require.Equal(t, common.BytesToHash([]byte{0xab}), events[2].OperationID)
+	require.Equal(t, uint64(2), events[2].CallIndex)
+}
+
+func TestEventFilterer_FilterEvents_Error(t *testing.T) {
+	t.Parallel()
+
+	client := mocksui.NewSuiPTBClient(t)
+	filterer, err := NewEventFilterer(client, 1, testEventPackageID, TimelockRoleProposer)
+	require.NoError(t, err)
+
+	client.EXPECT().GetCheckpointData(mock.Anything, uint64(5)).Return(nil, errors.New("rpc error")).Once()
+	_, err = filterer.FilterEvents(t.Context(), types.EventQuery{MCMAddress: "0x1", FromBlock: 5, ToBlock: 5})
+	require.EqualError(t, err, "failed to get checkpoint 5: rpc error")
+}
+
+func executedTransaction(t *testing.T, digest string, success bool, events ...*suirpcv2.Event) *suirpcv2.ExecutedTransaction {
+	t.Helper()
+
+	return &suirpcv2.ExecutedTransaction{
+		Digest:  &digest,
+		Effects: &suirpcv2.TransactionEffects{Status: &suirpcv2.ExecutionStatus{Success: &success}},
+		Events:  &suirpcv2.TransactionEvents{Events: events},
+	}
+}
+
+func suiEvent(t *testing.T, eventType string, data map[string]any) *suirpcv2.Event {
+	t.Helper()
+
+	value, err := structpb.NewValue(data)
+	require.NoError(t, err)
+
+	return &suirpcv2.Event{EventType: &eventType, Json: value}
+}
@@ -148,53 +148,73 @@ func (e Executor) ExecuteOperation(
 	if err != nil {
 		return types.TransactionResult{}, fmt.Errorf("building PTB for execute call: %w", err)
 	}
-	// Now build the timelock call using the result from the execute call
+	if err = e.appendTimelockDispatch(ctx, opts, ptb, timelockCallback, op, additionalFields); err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	// Execute the complete PTB with every call
+	tx, err := e.ExecutePTB(ctx, opts, e.client, ptb)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("op execution with PTB failed: %w", err)
+	}
+
+	return types.TransactionResult{
+		Hash:        tx.Digest,
+		ChainFamily: chainsel.FamilySui,
+		RawData:     tx,
+	}, nil
+}
+
+// appendTimelockDispatch appends to the PTB the timelock dispatch of the operation, which consumes
+// the TimelockCallbackParams of the MCMS execute call, followed by the calls of bypassed batches.
+func (e Executor) appendTimelockDispatch(
+	ctx context.Context, opts *bind.CallOpts, ptb *transaction.Transaction, timelockCallback *transaction.Argument,
+	op types.Operation, additionalFields AdditionalFields,
+) error {
 	encoder := e.mcms.Encoder()
 	if additionalFields.Function != suiTimelockBypassFunctionName && additionalFields.Function != suiTimelockScheduleFunctionName && additionalFields.Function != suiTimelockCancelFunctionName {
-		return types.TransactionResult{}, fmt.Errorf("unsupported timelock action: %s", additionalFields.Function)
+		return fmt.Errorf("unsupported timelock action: %s", additionalFields.Function)
 	}
 
 	if additionalFields.Function == suiTimelockScheduleFunctionName {
 		timelockCall, encodeErr := encoder.DispatchTimelockScheduleBatchWithArgs(e.timelockObj, "0x6", timelockCallback)
 		if encodeErr != nil {
-			return types.TransactionResult{}, fmt.Errorf("creating timelock call: %w", encodeErr)
+			return fmt.Errorf("creating timelock call: %w", encodeErr)
 		}
-		_, err = e.mcms.Bound().AppendPTB(ctx, opts, ptb, timelockCall)
-		if err != nil {
-			return types.TransactionResult{}, fmt.Errorf("adding timelock call to PTB: %w", err)
+		if _, err := e.mcms.Bound().AppendPTB(ctx, opts, ptb, timelockCall); err != nil {
+			return fmt.Errorf("adding timelock call to PTB: %w", err)
 		}
 	}
 
 	if additionalFields.Function == suiTimelockCancelFunctionName {
 		timelockCall, encodeErr := encoder.DispatchTimelockCancelWithArgs(e.timelockObj, timelockCallback)
 		if encodeErr != nil {
-			return types.TransactionResult{}, fmt.Errorf("creating timelock call: %w", encodeErr)
+			return fmt.Errorf("creating timelock call: %w", encodeErr)
 		}
-		_, err = e.mcms.Bound().AppendPTB(ctx, opts, ptb, timelockCall)
-		if err != nil {
-			return types.TransactionResult{}, fmt.Errorf("adding timelock call to PTB: %w", err)
+		if _, err := e.mcms.Bound().AppendPTB(ctx, opts, ptb, timelockCall); err != nil {
+			return fmt.Errorf("adding timelock call to PTB: %w", err)
 		}
 	}
 
 	if additionalFields.Function == suiTimelockBypassFunctionName {
 		timelockCall, timelockErr := encoder.DispatchTimelockBypasserExecuteBatchWithArgs(timelockCallback)
 		if timelockErr != nil {
-			return types.TransactionResult{}, fmt.Errorf("creating timelock call: %w", timelockErr)
+			return fmt.Errorf("creating timelock call: %w", timelockErr)
 		}
 
 		// Add the timelock call to the same PTB
 		// If bypass, this a set of execute callbacks
 		executeCallback, extendCallbackErr := e.mcms.Bound().AppendPTB(ctx, opts, ptb, timelockCall)
 		if extendCallbackErr != nil {
-			return types.TransactionResult{}, fmt.Errorf("building PTB for timelock call: %w", extendCallbackErr)
+			return fmt.Errorf("building PTB for timelock call: %w", extendCallbackErr)
 		}
 		// Decode calls from transaction data
 		calls, desErr := deserializeTimelockBypasserExecuteBatch(op.Transaction.Data)
 		if desErr != nil {
-			return types.TransactionResult{}, fmt.Errorf("failed to deserialize timelock bypasser execute batch: %w", err)
+			return fmt.Errorf("failed to deserialize timelock bypasser execute batch: %w", desErr)
 		}
 		if len(calls) != len(additionalFields.InternalStateObjects) {
-			return types.TransactionResult{}, errors.New("mismatched call and state object count")
+			return errors.New("mismatched call and state object count")
 		}
 		for i, call := range calls {
 			callTarget := call.Target
@@ -202,13 +222,13 @@ func (e Executor) ExecuteOperation(
 			if i < len(additionalFields.InternalLatestPackageIDs) && additionalFields.InternalLatestPackageIDs[i] != "" {
 				latestAddr, latestErr := AddressFromHex(additionalFields.InternalLatestPackageIDs[i])
 				if latestErr != nil {
-					return types.TransactionResult{}, fmt.Errorf("failed to parse internal_latest_package_ids[%d] %q: %w", i, additionalFields.InternalLatestPackageIDs[i], latestErr)
+					return fmt.Errorf("failed to parse internal_latest_package_ids[%d] %q: %w", i, additionalFields.InternalLatestPackageIDs[i], latestErr)
 				}
 				callTarget = latestAddr.Bytes()
 			} else if additionalFields.LatestPackageID != "" {
 				latestAddr, latestErr := AddressFromHex(additionalFields.LatestPackageID)
 				if latestErr != nil {
-					return types.TransactionResult{}, fmt.Errorf("failed to parse latest_package_id %q: %w", additionalFields.LatestPackageID, latestErr)
+					return fmt.Errorf("failed to parse latest_package_id %q: %w", additionalFields.LatestPackageID, latestErr)
 				}
 				callTarget = latestAddr.Bytes()
 			}
@@ -223,20 +243,11 @@ func (e Executor) ExecuteOperation(
 		}
 
 		if extendErr := e.executingCallbackParams.AppendPTB(ctx, ptb, executeCallback, calls); extendErr != nil {
-			return types.TransactionResult{}, fmt.Errorf("extending PTB from executing callback params: %w", extendErr)
+			return fmt.Errorf("extending PTB from executing callback params: %w", extendErr)
 		}
 	}
-	// Execute the complete PTB with every call
-	tx, err := e.ExecutePTB(ctx, opts, e.client, ptb)
-	if err != nil {
-		return types.TransactionResult{}, fmt.Errorf("op execution with PTB failed: %w", err)
-	}
 
-	return types.TransactionResult{
-		Hash:        tx.Digest,
-		ChainFamily: chainsel.FamilySui,
-		RawData:     tx,
-	}, nil
+	return nil
 }
 
 func (e Executor) SetRoot(
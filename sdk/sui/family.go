@@ -0,0 +1,223 @@
+package sui
+
+import (
+	"fmt"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	cslclient "github.com/smartcontractkit/chainlink-sui/relayer/client"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func init() {
+	if err := sdk.RegisterFamily(sdk.Family{
+		Name: chainsel.FamilySui,
+		NewEncoder: func(selector types.ChainSelector, txCount uint64, overridePreviousRoot bool, _ bool) sdk.Encoder {
+			return NewEncoder(selector, txCount, overridePreviousRoot)
+		},
+		NewTimelockConverter: func(types.ChainSelector, types.ChainMetadata) (sdk.TimelockConverter, error) {
+			converter, _ := NewTimelockConverter()
+
+			return converter, nil
+		},
+		NewExecutor:              buildSuiExecutor,
+		NewSimulator:             buildSuiSimulator,
+		NewInspector:             buildSuiInspector,
+		NewTimelockExecutor:      buildSuiTimelockExecutor,
+		NewTimelockInspector:     buildSuiTimelockInspector,
+		NewTimelockConfigurer:    buildSuiTimelockConfigurer,
+		NewEventFilterer:         buildSuiEventFilterer,
+		NewDecoder:               func() sdk.Decoder { return NewDecoder() },
+		OperationID:              OperationID,
+		ValidateAdditionalFields: ValidateAdditionalFields,
+		ValidateChainMetadata:    ValidateChainMetadata,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func buildSuiExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, _ types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.Executor, error) {
+	executor, err := newSuiExecutor(chains, chainSelector, encoder, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return executor, nil
+}
+
+func newSuiExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, metadata types.ChainMetadata,
+) (*Executor, error) {
+	rawSelector := uint64(chainSelector)
+	suiEncoder, ok := encoder.(*Encoder)
+	if !ok {
+		return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
+	}
+	client, ok := suiClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing sui chain client for selector %d", chainSelector)
+	}
+	signer, ok := suiSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing sui chain signer for selector %d", chainSelector)
+	}
+
+	suiMetadata, err := SuiMetadata(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sui metadata from proposal: %w", err)
+	}
+	entrypointEncoder := NewCCIPEntrypointArgEncoder(suiMetadata.RegistryObj, suiMetadata.DeployerStateObj)
+
+	return NewExecutor(client, signer, suiEncoder, entrypointEncoder, suiMetadata.McmsPackageID, suiMetadata.Role,
+		metadata.MCMAddress, suiMetadata.AccountObj, suiMetadata.RegistryObj, suiMetadata.TimelockObj)
+}
+
+// DevInspectorAccessor is optionally implemented by a sdk.ChainAccessor to serve the DevInspector
+// of a Sui chain, with which the simulators simulate operations without their merkle proof.
+type DevInspectorAccessor interface {
+	SuiDevInspector(selector uint64) (DevInspector, bool)
+}
+
+// buildSuiSimulator simulates the transactions of the executor.
+func buildSuiSimulator(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, _ types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.Simulator, error) {
+	executor, err := newSuiExecutor(chains, chainSelector, encoder, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []SimulatorOption
+	if accessor, ok := chains.(DevInspectorAccessor); ok {
+		if devInspector, ok := accessor.SuiDevInspector(uint64(chainSelector)); ok {
+			opts = append(opts, WithDevInspector(devInspector))
+		}
+	}
+
+	return NewSimulator(executor, opts...), nil
+}
+
+func buildSuiInspector(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.Inspector, error) {
+	rawSelector := uint64(selector)
+	client, ok := suiClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Sui chain client for selector %d", rawSelector)
+	}
+	signer, ok := suiSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Sui chain client for selector %d", rawSelector)
+	}
+	suiMetadata, err := SuiMetadata(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing sui metadata: %w", err)
+	}
+
+	return NewInspector(client, signer, suiMetadata.McmsPackageID, suiMetadata.Role)
+}
+
+func buildSuiTimelockExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, _ types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.TimelockExecutor, error) {
+	rawSelector := uint64(chainSelector)
+	client, ok := suiClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing sui chain client for selector %d", chainSelector)
+	}
+	signer, ok := suiSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing sui chain signer for selector %d", chainSelector)
+	}
+
+	suiMetadata, err := SuiMetadata(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sui metadata from proposal: %w", err)
+	}
+	entrypointEncoder := NewCCIPEntrypointArgEncoder(suiMetadata.RegistryObj, suiMetadata.DeployerStateObj)
+
+	return NewTimelockExecutor(client, signer, entrypointEncoder, suiMetadata.McmsPackageID,
+		suiMetadata.RegistryObj, suiMetadata.AccountObj)
+}
+
+func buildSuiTimelockInspector(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, metadata types.ChainMetadata,
+) (sdk.TimelockInspector, error) {
+	rawSelector := uint64(chainSelector)
+	client, ok := suiClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Sui chain client for selector %d", rawSelector)
+	}
+	signer, ok := suiSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Sui signer for selector %d", rawSelector)
+	}
+
+	suiMetadata, err := SuiMetadata(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("parse sui metadata for selector %d: %w", rawSelector, err)
+	}
+
+	return NewTimelockInspector(client, signer, suiMetadata.McmsPackageID)
+}
+
+func buildSuiTimelockConfigurer(
+	_ sdk.ChainAccessor, _ types.ChainSelector, _ types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.TimelockConfigurer, error) {
+	suiMetadata, err := SuiMetadata(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing sui metadata: %w", err)
+	}
+
+	return NewTimelockConfigurer(suiMetadata.McmsPackageID), nil
+}
+
+// buildSuiEventFilterer requires a client reading checkpoints, e.g. the gRPC PTB client.
+func buildSuiEventFilterer(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, metadata types.ChainMetadata,
+) (sdk.EventFilterer, error) {
+	rawSelector := uint64(selector)
+	client, ok := suiClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Sui chain client for selector %d", rawSelector)
+	}
+	ptbClient, ok := client.(cslclient.SuiPTBClient)
+	if !ok {
+		return nil, fmt.Errorf("sui client %T does not support checkpoint queries", client)
+	}
+	suiMetadata, err := SuiMetadata(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing sui metadata: %w", err)
+	}
+
+	return NewEventFilterer(ptbClient, selector, suiMetadata.McmsPackageID, suiMetadata.Role)
+}
+
+// ChainAccessor is implemented by a sdk.ChainAccessor to serve Sui chains.
+type ChainAccessor interface {
+	SuiClient(selector uint64) (cslclient.BindingsClient, bool)
+	SuiSigner(selector uint64) (SuiSigner, bool)
+}
+
+// suiClient returns the Sui client of the selector if the ChainAccessor serves Sui chains.
+func suiClient(chains sdk.ChainAccessor, selector uint64) (cslclient.BindingsClient, bool) {
+	suiChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return suiChains.SuiClient(selector)
+}
+
+// suiSigner returns the Sui signer of the selector if the ChainAccessor serves Sui chains.
+func suiSigner(chains sdk.ChainAccessor, selector uint64) (SuiSigner, bool) {
+	suiChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return suiChains.SuiSigner(selector)
+}
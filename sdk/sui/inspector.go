@@ -26,6 +26,7 @@ const (
 )
 
 var _ sdk.Inspector = &Inspector{}
+var _ sdk.PostOpCountInspector = &Inspector{}
 
 type Inspector struct {
 	ConfigTransformer
@@ -168,3 +169,20 @@ func (i Inspector) GetRootMetadata(ctx context.Context, mcmsAddr string) (types.
 		MCMAddress:      rootMetadata.Multisig,
 	}, nil
 }
+
+// GetRootPostOpCount returns the post-op count of the root held by the MCM for the role of the
+// inspector.
+func (i Inspector) GetRootPostOpCount(ctx context.Context, mcmsAddr string) (uint64, error) {
+	stateObj := bind.Object{Id: mcmsAddr}
+
+	opts := &bind.CallOpts{
+		Signer: i.signer,
+	}
+
+	rootMetadata, err := i.mcms.DevInspect().GetRootMetadata(ctx, opts, stateObj, i.role.Byte())
+	if err != nil {
+		return 0, fmt.Errorf("failed to GetRootMetadata: %w", err)
+	}
+
+	return rootMetadata.PostOpCount, nil
+}
@@ -0,0 +1,265 @@
+package sui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/signer"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/ethereum/go-ethereum/common"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	modulemcms "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/mcms"
+	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
+	cslclient "github.com/smartcontractkit/chainlink-sui/relayer/client"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// ErrDevInspectorRequired is returned by SimulateOperation when the simulator has no
+// DevInspector. Sui operations are only callable with the TimelockCallbackParams created by the
+// MCMS execute call, which requires the merkle proof of the operation, unless the transaction
+// checks are disabled.
+var ErrDevInspectorRequired = errors.New("simulating a Sui operation without its merkle proof requires a DevInspector, " +
+	"see WithDevInspector or use SimulateExecuteOperation")
+
+var _ sdk.Simulator = &Simulator{}
+
+// Simulator dry-runs the PTBs built by the Executor through the node transaction simulation,
+// without committing them.
+type Simulator struct {
+	executor     Executor
+	devInspector DevInspector
+}
+
+// SimulatorOption configures a Simulator.
+type SimulatorOption func(*Simulator)
+
+// WithDevInspector sets the DevInspector with which SimulateOperation runs the operations.
+func WithDevInspector(devInspector DevInspector) SimulatorOption {
+	return func(s *Simulator) {
+		s.devInspector = devInspector
+	}
+}
+
+// NewSimulator creates a Simulator of the transactions of the executor. The client of the executor
+// is the DevInspector of the simulator if it implements the interface, unless WithDevInspector is
+// given.
+func NewSimulator(executor *Executor, opts ...SimulatorOption) *Simulator {
+	simulator := &Simulator{executor: *executor}
+	simulator.executor.ExecutePTB = simulatePTB
+	if devInspector, ok := executor.client.(DevInspector); ok {
+		simulator.devInspector = devInspector
+	}
+	for _, opt := range opts {
+		opt(simulator)
+	}
+
+	return simulator
+}
+
+func (s *Simulator) SimulateSetRoot(
+	ctx context.Context, _ string,
+	metadata types.ChainMetadata, proof []common.Hash, root [32]byte,
+	validUntil uint32, sortedSignatures []types.Signature,
+) error {
+	var additionalFieldsMetadata AdditionalFieldsMetadata
+	if len(metadata.AdditionalFields) > 0 {
+		if err := json.Unmarshal(metadata.AdditionalFields, &additionalFieldsMetadata); err != nil {
+			return fmt.Errorf("failed to unmarshal additional fields metadata: %w", err)
+		}
+	}
+
+	chainID, err := chainsel.SuiChainIdFromSelector(uint64(s.executor.ChainSelector))
+	if err != nil {
+		return err
+	}
+	chainIDBig := new(big.Int).SetUint64(chainID)
+
+	proofBytes := make([][]byte, len(proof))
+	for i, hash := range proof {
+		proofBytes[i] = hash.Bytes()
+	}
+
+	gasBudget := DefaultExecuteGasBudget
+	opts := &bind.CallOpts{
+		Signer:    s.executor.signer,
+		GasBudget: &gasBudget,
+	}
+
+	setRootCall, err := s.executor.mcms.Encoder().SetRoot(
+		bind.Object{Id: s.executor.mcmsObj},
+		bind.Object{Id: "0x6"}, // Clock object ID in Sui
+		additionalFieldsMetadata.Role.Byte(),
+		root[:],
+		uint64(validUntil),
+		chainIDBig,
+		s.executor.mcmsPackageID,
+		metadata.StartingOpCount,
+		metadata.StartingOpCount+s.executor.TxCount,
+		s.executor.OverridePreviousRoot,
+		proofBytes,
+		encodeSignatures(sortedSignatures),
+	)
+	if err != nil {
+		return fmt.Errorf("encoding SetRoot call on Sui mcms contract: %w", err)
+	}
+
+	ptb := transaction.NewTransaction()
+	if _, err = s.executor.mcms.Bound().AppendPTB(ctx, opts, ptb, setRootCall); err != nil {
+		return fmt.Errorf("building PTB for set root call: %w", err)
+	}
+
+	_, err = s.executor.ExecutePTB(ctx, opts, s.executor.client, ptb)
+
+	return err
+}
+
+// SimulateOperation dev-inspects the timelock dispatch of the operation and the calls it appends,
+// from TimelockCallbackParams passed as a value instead of being created by the MCMS execute call.
+// The merkle proof, nonce and expiry checks of the execute call are not simulated, see
+// SimulateExecuteOperation for those. It returns ErrDevInspectorRequired if the simulator has no
+// DevInspector.
+func (s *Simulator) SimulateOperation(
+	ctx context.Context, metadata types.ChainMetadata, operation types.Operation,
+) error {
+	if s.devInspector == nil {
+		return ErrDevInspectorRequired
+	}
+
+	var additionalFields AdditionalFields
+	if err := json.Unmarshal(operation.Transaction.AdditionalFields, &additionalFields); err != nil {
+		return fmt.Errorf("failed to unmarshal additional fields: %w", err)
+	}
+
+	var additionalFieldsMetadata AdditionalFieldsMetadata
+	if len(metadata.AdditionalFields) > 0 {
+		if err := json.Unmarshal(metadata.AdditionalFields, &additionalFieldsMetadata); err != nil {
+			return fmt.Errorf("failed to unmarshal additional fields metadata: %w", err)
+		}
+	}
+
+	gasBudget := DefaultExecuteGasBudget
+	opts := &bind.CallOpts{
+		Signer:    s.executor.signer,
+		GasBudget: &gasBudget,
+	}
+
+	ptb := transaction.NewTransaction()
+	timelockCallback := ptb.Pure(modulemcms.TimelockCallbackParams{
+		ModuleName:   additionalFields.ModuleName,
+		FunctionName: additionalFields.Function,
+		Data:         operation.Transaction.Data,
+		Role:         additionalFieldsMetadata.Role.Byte(),
+	})
+	if err := s.executor.appendTimelockDispatch(ctx, opts, ptb, &timelockCallback, operation, additionalFields); err != nil {
+		return err
+	}
+
+	bcsBytes, err := buildSimulationBytes(ctx, opts, s.executor.client, ptb)
+	if err != nil {
+		return err
+	}
+
+	if err = s.devInspector.DevInspectPTB(ctx, bcsBytes); err != nil {
+		return SimulateError{err: err}
+	}
+
+	return nil
+}
+
+// SimulateExecuteOperation dry-runs the executing PTB that ExecuteOperation would send for the
+// operation, including the timelock dispatch and the calls appended from the
+// ExecutingCallbackParams. The root of the operation must be set on chain.
+func (s *Simulator) SimulateExecuteOperation(
+	ctx context.Context, metadata types.ChainMetadata, nonce uint32, proof []common.Hash, operation types.Operation,
+) error {
+	_, err := s.executor.ExecuteOperation(ctx, metadata, nonce, proof, operation)
+
+	return err
+}
+
+// simulatePTB is a drop-in replacement of bind.ExecutePTB which simulates the PTB instead of
+// signing and executing it.
+func simulatePTB(
+	ctx context.Context, opts *bind.CallOpts, client cslclient.BindingsClient, ptb *transaction.Transaction,
+) (*models.SuiTransactionBlockResponse, error) {
+	bcsBytes, err := buildSimulationBytes(ctx, opts, client, ptb)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = client.SimulatePTB(ctx, bcsBytes); err != nil {
+		return nil, SimulateError{err: err}
+	}
+
+	return &models.SuiTransactionBlockResponse{Digest: "<simulated-transaction>"}, nil
+}
+
+// buildSimulationBytes builds the transaction bytes of the PTB sent by the signer of opts, without
+// selecting gas coins.
+func buildSimulationBytes(
+	ctx context.Context, opts *bind.CallOpts, client cslclient.BindingsClient, ptb *transaction.Transaction,
+) ([]byte, error) {
+	if opts == nil || opts.Signer == nil {
+		return nil, errors.New("CallOpts with Signer is required")
+	}
+
+	signerAddress, err := opts.Signer.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signer address: %w", err)
+	}
+	sender, err := bindutils.ConvertAddressToString(signerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signer address %v: %w", signerAddress, err)
+	}
+
+	gasPrice, err := client.GetReferenceGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reference gas price: %w", err)
+	}
+
+	gasBudget := DefaultExecuteGasBudget
+	if opts.GasBudget != nil {
+		gasBudget = *opts.GasBudget
+	}
+
+	ptb.SetSender(models.SuiAddress(sender))
+	ptb.SetGasBudget(gasBudget)
+	ptb.SetGasPrice(gasPrice.Uint64())
+	ptb.SetSigner(&signer.Signer{Address: sender})
+
+	bcsBytes, err := ptb.BuildBCSBytes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transaction bytes: %w", err)
+	}
+
+	return bcsBytes, nil
+}
+
+// SimulateError is returned when the simulation of a PTB fails, e.g. when a Move call aborts.
+type SimulateError struct {
+	err error
+}
+
+func (e SimulateError) Error() string {
+	return e.err.Error()
+}
+
+func (e SimulateError) Unwrap() error {
+	return e.err
+}
+
+// Logs returns the failure reported by the node, one line per entry. Sui does not return
+// execution logs for failed simulations.
+func (e SimulateError) Logs() []string {
+	return strings.Split(e.err.Error(), "\n")
+}
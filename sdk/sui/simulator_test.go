@@ -0,0 +1,244 @@
+package sui
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	modulemcms "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/mcms"
+
+	mockbindutils "github.com/smartcontractkit/mcms/sdk/sui/mocks/bindutils"
+	mockmcms "github.com/smartcontractkit/mcms/sdk/sui/mocks/mcms"
+	mocksui "github.com/smartcontractkit/mcms/sdk/sui/mocks/sui"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+const testSimulatorSender = "0x742d35cc6b8d4c8c8e1b9b3b2d2a8b9c8d7e6f1234567890abcdef0123456789"
+
+func newTestSimulator(t *testing.T) (*Simulator, *mocksui.BindingsClient, *mockmcms.IMcms, *mockmcms.McmsEncoder, *mockbindutils.IBoundContract) {
+	t.Helper()
+
+	mockClient := mocksui.NewBindingsClient(t)
+	mockSigner := mockbindutils.NewSuiSigner(t)
+	mockSigner.EXPECT().GetAddress().Return(testSimulatorSender, nil).Maybe()
+	mockmcmsContract := mockmcms.NewIMcms(t)
+	mockEncoder := mockmcms.NewMcmsEncoder(t)
+	mockBound := mockbindutils.NewIBoundContract(t)
+
+	executor := &Executor{
+		signer:        mockSigner,
+		mcms:          mockmcmsContract,
+		mcmsPackageID: "0x123456789abcdef",
+		mcmsObj:       mcmsObj,
+		timelockObj:   timelockObj,
+		registryObj:   registryObj,
+		accountObj:    accountObj,
+		client:        mockClient,
+		Encoder: &Encoder{
+			ChainSelector: types.ChainSelector(chainsel.SUI_TESTNET.Selector),
+			TxCount:       5,
+		},
+	}
+
+	return NewSimulator(executor), mockClient, mockmcmsContract, mockEncoder, mockBound
+}
+
+func TestSimulator_SimulateSetRoot(t *testing.T) {
+	t.Parallel()
+
+	metadata := types.ChainMetadata{
+		StartingOpCount:  10,
+		AdditionalFields: []byte(`{"role":2}`),
+	}
+	proof := []common.Hash{common.HexToHash("0x1")}
+	root := [32]byte(common.HexToHash("0x1234"))
+	signatures := []types.Signature{{R: common.HexToHash("0x3"), S: common.HexToHash("0x4"), V: 0}}
+	chainID, err := chainsel.SuiChainIdFromSelector(chainsel.SUI_TESTNET.Selector)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		simErr   error
+		wantErr  string
+		wantLogs []string
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:     "failure - move abort",
+			simErr:   errors.New("simulate failed: MoveAbort in 1st command\nabort code: 8"),
+			wantErr:  "simulate failed: MoveAbort in 1st command\nabort code: 8",
+			wantLogs: []string{"simulate failed: MoveAbort in 1st command", "abort code: 8"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			simulator, mockClient, mockmcmsContract, mockEncoder, mockBound := newTestSimulator(t)
+			mockmcmsContract.EXPECT().Encoder().Return(mockEncoder)
+			mockEncoder.EXPECT().SetRoot(
+				bind.Object{Id: mcmsObj},
+				bind.Object{Id: "0x6"},
+				byte(2),
+				root[:],
+				uint64(2082758400),
+				new(big.Int).SetUint64(chainID),
+				"0x123456789abcdef",
+				uint64(10),
+				uint64(15),
+				false,
+				[][]byte{proof[0].Bytes()},
+				encodeSignatures(signatures),
+			).Return(&bind.EncodedCall{}, nil)
+			mockmcmsContract.EXPECT().Bound().Return(mockBound)
+			mockBound.EXPECT().AppendPTB(mock.Anything, mock.Anything, mock.Anything, &bind.EncodedCall{}).Return(nil, nil)
+			mockClient.EXPECT().GetReferenceGasPrice(mock.Anything).Return(big.NewInt(1000), nil)
+			mockClient.EXPECT().SimulatePTB(mock.Anything, mock.Anything).Return(nil, tt.simErr)
+
+			err := simulator.SimulateSetRoot(t.Context(), "", metadata, proof, root, 2082758400, signatures)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tt.wantErr)
+			var simErr SimulateError
+			require.ErrorAs(t, err, &simErr)
+			require.Equal(t, tt.wantLogs, simErr.Logs())
+		})
+	}
+}
+
+// devInspectorFunc is a DevInspector calling the function.
+type devInspectorFunc func(ctx context.Context, bcsBytes []byte) error
+
+func (f devInspectorFunc) DevInspectPTB(ctx context.Context, bcsBytes []byte) error {
+	return f(ctx, bcsBytes)
+}
+
+func TestSimulator_SimulateOperation(t *testing.T) {
+	t.Parallel()
+
+	op := types.Operation{
+		Transaction: types.Transaction{
+			To:               "0x0000000000000000000000000000000000000000000000000000000000000123",
+			Data:             []byte("test_data"),
+			AdditionalFields: []byte(`{"module_name": "mcms", "function": "timelock_schedule_batch"}`),
+		},
+	}
+	metadata := types.ChainMetadata{AdditionalFields: []byte(`{"role":2}`)}
+
+	tests := []struct {
+		name       string
+		inspectErr error
+		wantErr    string
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:       "failure - move abort",
+			inspectErr: errors.New("dev-inspect failed: MoveAbort in 1st command"),
+			wantErr:    "dev-inspect failed: MoveAbort in 1st command",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			simulator, mockClient, mockmcmsContract, mockEncoder, mockBound := newTestSimulator(t)
+			var inspected []byte
+			WithDevInspector(devInspectorFunc(func(_ context.Context, bcsBytes []byte) error {
+				inspected = bcsBytes
+				return tt.inspectErr
+			}))(simulator)
+
+			mockmcmsContract.EXPECT().Encoder().Return(mockEncoder)
+			mockEncoder.EXPECT().DispatchTimelockScheduleBatchWithArgs(timelockObj, "0x6", mock.Anything).Return(&bind.EncodedCall{}, nil)
+			mockmcmsContract.EXPECT().Bound().Return(mockBound)
+			mockBound.EXPECT().AppendPTB(mock.Anything, mock.Anything, mock.Anything, &bind.EncodedCall{}).Return(nil, nil)
+			mockClient.EXPECT().GetReferenceGasPrice(mock.Anything).Return(big.NewInt(1000), nil)
+
+			err := simulator.SimulateOperation(t.Context(), metadata, op)
+
+			// The TimelockCallbackParams are an input of the transaction instead of the result of
+			// the MCMS execute call
+			params, encodeErr := mystenbcs.Marshal(modulemcms.TimelockCallbackParams{
+				ModuleName: "mcms", FunctionName: "timelock_schedule_batch", Data: []byte("test_data"), Role: 2,
+			})
+			require.NoError(t, encodeErr)
+			require.True(t, bytes.Contains(inspected, params))
+
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+			var simErr SimulateError
+			require.ErrorAs(t, err, &simErr)
+		})
+	}
+}
+
+func TestSimulator_SimulateOperation_NoDevInspector(t *testing.T) {
+	t.Parallel()
+
+	simulator, _, _, _, _ := newTestSimulator(t)
+	err := simulator.SimulateOperation(t.Context(), types.ChainMetadata{}, types.Operation{})
+	require.ErrorIs(t, err, ErrDevInspectorRequired)
+}
+
+func TestSimulator_SimulateExecuteOperation(t *testing.T) {
+	t.Parallel()
+
+	simulator, mockClient, mockmcmsContract, mockEncoder, mockBound := newTestSimulator(t)
+
+	nonce := uint32(123)
+	proof := []common.Hash{common.HexToHash("0x1234")}
+	op := types.Operation{
+		Transaction: types.Transaction{
+			To:               "0x0000000000000000000000000000000000000000000000000000000000000123",
+			Data:             []byte("test_data"),
+			AdditionalFields: []byte(`{"module_name": "test_module", "function": "timelock_schedule_batch"}`),
+		},
+	}
+
+	mockmcmsContract.EXPECT().Encoder().Return(mockEncoder).Times(2)
+	mockEncoder.EXPECT().Execute(
+		mock.AnythingOfType("bind.Object"),
+		mock.AnythingOfType("bind.Object"),
+		byte(2),
+		mock.AnythingOfType("*big.Int"),
+		"0x123456789abcdef",
+		uint64(nonce),
+		"0000000000000000000000000000000000000000000000000000000000000123",
+		"test_module",
+		"timelock_schedule_batch",
+		[]byte("test_data"),
+		[][]byte{proof[0].Bytes()},
+	).Return(nil, nil)
+	mockmcmsContract.EXPECT().Bound().Return(mockBound).Times(2)
+	mockBound.EXPECT().AppendPTB(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Times(2)
+	mockEncoder.EXPECT().DispatchTimelockScheduleBatchWithArgs(timelockObj, "0x6", mock.Anything).Return(nil, nil)
+	mockClient.EXPECT().GetReferenceGasPrice(mock.Anything).Return(big.NewInt(1000), nil)
+	mockClient.EXPECT().SimulatePTB(mock.Anything, mock.Anything).Return(nil, errors.New("simulate failed: EInvalidProof"))
+
+	err := simulator.SimulateExecuteOperation(t.Context(), types.ChainMetadata{AdditionalFields: []byte(`{"role":2}`)}, nonce, proof, op)
+	require.EqualError(t, err, "op execution with PTB failed: simulate failed: EInvalidProof")
+
+	var simErr SimulateError
+	require.ErrorAs(t, err, &simErr)
+}
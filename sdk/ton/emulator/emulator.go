@@ -0,0 +1,316 @@
+//go:build tonemulator
+
+// Package emulator implements ton.Emulator on top of the TON transaction emulator
+// library (libemulator) shipped with github.com/tonkeeper/tongo.
+//
+// The package is only built with the tonemulator build tag, as it links libemulator through cgo:
+// the shared library from the tongo module (lib/linux or lib/darwin) must be on the library
+// path (e.g. LD_LIBRARY_PATH) of the binary at runtime.
+package emulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/samber/lo"
+	"github.com/tonkeeper/tongo/boc"
+	tongotlb "github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/txemulator"
+
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/ton"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	mcmston "github.com/smartcontractkit/mcms/sdk/ton"
+)
+
+var _ mcmston.Emulator = (*Emulator)(nil)
+
+// Emulator emulates transactions with the TON transaction emulator, using the blockchain config
+// either given on creation or loaded from the chain on the first emulation.
+type Emulator struct {
+	client ton.APIClientWrapped
+
+	mu       sync.Mutex
+	emulator *txemulator.Emulator
+}
+
+// New creates an Emulator using the given blockchain config (the root cell of the config
+// params dictionary).
+func New(config *cell.Cell) (*Emulator, error) {
+	if config == nil {
+		return nil, errors.New("failed to create emulator - config (*cell.Cell) is nil")
+	}
+
+	e, err := newTxEmulator(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Emulator{emulator: e}, nil
+}
+
+// NewFromClient creates an Emulator loading the blockchain config of the chain from the client
+// on the first emulation.
+func NewFromClient(client ton.APIClientWrapped) (*Emulator, error) {
+	if lo.IsNil(client) {
+		return nil, errors.New("failed to create emulator - client (ton.APIClientWrapped) is nil")
+	}
+
+	return &Emulator{client: client}, nil
+}
+
+// EmulateInternalMessage emulates the processing of msg by account at time now.
+func (e *Emulator) EmulateInternalMessage(
+	ctx context.Context, account *tlb.Account, msg *tlb.InternalMessage, now uint32,
+) (mcmston.EmulationResult, error) {
+	shardAccount, err := toShardAccount(account)
+	if err != nil {
+		return mcmston.EmulationResult{}, err
+	}
+
+	message, err := toMessage(msg)
+	if err != nil {
+		return mcmston.EmulationResult{}, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.emulator == nil {
+		e.emulator, err = e.loadTxEmulator(ctx)
+		if err != nil {
+			return mcmston.EmulationResult{}, err
+		}
+	}
+
+	if err = e.emulator.SetUnixtime(now); err != nil {
+		return mcmston.EmulationResult{}, fmt.Errorf("failed to set emulation time: %w", err)
+	}
+	// The end lt of the last transaction, stored in the account, is where the next one starts
+	if err = e.emulator.SetLT(account.State.LastTransactionLT); err != nil {
+		return mcmston.EmulationResult{}, fmt.Errorf("failed to set emulation lt: %w", err)
+	}
+
+	res, err := e.emulator.Emulate(shardAccount, message)
+	if err != nil {
+		return mcmston.EmulationResult{}, fmt.Errorf("failed to emulate transaction: %w", err)
+	}
+
+	return toEmulationResult(res)
+}
+
+func (e *Emulator) loadTxEmulator(ctx context.Context) (*txemulator.Emulator, error) {
+	blockID, err := e.client.CurrentMasterchainInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current block: %w", err)
+	}
+
+	blockchainConfig, err := e.client.GetBlockchainConfig(ctx, blockID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blockchain config: %w", err)
+	}
+
+	config, err := configCell(blockchainConfig.All())
+	if err != nil {
+		return nil, err
+	}
+
+	return newTxEmulator(config)
+}
+
+func newTxEmulator(config *cell.Cell) (*txemulator.Emulator, error) {
+	c, err := toTongoCell(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	e, err := txemulator.NewEmulator(c, txemulator.LogTruncated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create emulator: %w", err)
+	}
+
+	return e, nil
+}
+
+// configCell builds the config params dictionary (HashmapE 32 ^Cell) from the config params
+func configCell(params map[int32]*cell.Cell) (*cell.Cell, error) {
+	dict := cell.NewDict(32)
+	for id, param := range params {
+		value := cell.BeginCell().MustStoreRef(param).EndCell()
+		if err := dict.SetIntKey(big.NewInt(int64(id)), value); err != nil {
+			return nil, fmt.Errorf("failed to store config param %d: %w", id, err)
+		}
+	}
+
+	return dict.AsCell(), nil
+}
+
+// toShardAccount serializes the account state loaded by tonutils back to its on-chain form
+func toShardAccount(account *tlb.Account) (tongotlb.ShardAccount, error) {
+	if account == nil || account.State == nil || !account.State.IsValid {
+		return tongotlb.ShardAccount{}, errors.New("account state is missing")
+	}
+
+	accountCell, err := accountStateCell(account.State)
+	if err != nil {
+		return tongotlb.ShardAccount{}, fmt.Errorf("failed to serialize account state: %w", err)
+	}
+
+	lastTxHash := account.LastTxHash
+	if lastTxHash == nil {
+		lastTxHash = make([]byte, 32)
+	}
+
+	shardAccountCell, err := tlb.ToCell(tlb.ShardAccount{
+		Account:       accountCell,
+		LastTransHash: lastTxHash,
+		LastTransLT:   account.LastTxLT,
+	})
+	if err != nil {
+		return tongotlb.ShardAccount{}, fmt.Errorf("failed to serialize shard account: %w", err)
+	}
+
+	var shardAccount tongotlb.ShardAccount
+	if err = unmarshalTongo(shardAccountCell, &shardAccount); err != nil {
+		return tongotlb.ShardAccount{}, fmt.Errorf("failed to load shard account: %w", err)
+	}
+
+	return shardAccount, nil
+}
+
+// accountStateCell serializes an account:
+//
+//	account$1 addr:MsgAddressInt storage_stat:StorageInfo storage:AccountStorage = Account;
+//	account_storage$_ last_trans_lt:uint64 balance:CurrencyCollection state:AccountState = AccountStorage;
+func accountStateCell(state *tlb.AccountState) (*cell.Cell, error) {
+	info := state.StorageInfo
+	if info.StorageUsed.CellsUsed == nil {
+		info.StorageUsed.CellsUsed = big.NewInt(0)
+	}
+	if info.StorageUsed.BitsUsed == nil {
+		info.StorageUsed.BitsUsed = big.NewInt(0)
+	}
+	if info.StorageExtra == nil {
+		info.StorageExtra = tlb.StorageExtraNone{}
+	}
+
+	storageInfo, err := tlb.ToCell(info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize storage info: %w", err)
+	}
+
+	b := cell.BeginCell().
+		MustStoreBoolBit(true).
+		MustStoreAddr(state.Address).
+		MustStoreBuilder(storageInfo.ToBuilder()).
+		MustStoreUInt(state.LastTransactionLT, 64).
+		MustStoreBigCoins(state.Balance.Nano()).
+		MustStoreDict(state.ExtraCurrencies)
+
+	switch state.Status {
+	case tlb.AccountStatusActive:
+		if state.StateInit == nil {
+			return nil, errors.New("active account has no state init")
+		}
+		stateInit, err := tlb.ToCell(state.StateInit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize state init: %w", err)
+		}
+		b.MustStoreBoolBit(true).MustStoreBuilder(stateInit.ToBuilder())
+	case tlb.AccountStatusFrozen:
+		b.MustStoreUInt(0b01, 2).MustStoreSlice(state.StateHash, 256)
+	case tlb.AccountStatusUninit:
+		b.MustStoreUInt(0b00, 2)
+	default:
+		return nil, fmt.Errorf("unsupported account status %s", state.Status)
+	}
+
+	return b.EndCell(), nil
+}
+
+func toMessage(msg *tlb.InternalMessage) (tongotlb.Message, error) {
+	if msg == nil {
+		return tongotlb.Message{}, errors.New("message is nil")
+	}
+
+	msgCell, err := tlb.ToCell(msg)
+	if err != nil {
+		return tongotlb.Message{}, fmt.Errorf("failed to serialize message: %w", err)
+	}
+
+	var message tongotlb.Message
+	if err = unmarshalTongo(msgCell, &message); err != nil {
+		return tongotlb.Message{}, fmt.Errorf("failed to load message: %w", err)
+	}
+
+	return message, nil
+}
+
+func toEmulationResult(res txemulator.EmulationResult) (mcmston.EmulationResult, error) {
+	if !res.Success {
+		// The emulator rejected the message before producing a transaction
+		if res.Error == nil {
+			return mcmston.EmulationResult{}, errors.New("emulation failed")
+		}
+		if res.Error.ExitCode == 0 {
+			return mcmston.EmulationResult{}, fmt.Errorf("emulation failed: %s", res.Error.Text)
+		}
+
+		return mcmston.EmulationResult{
+			ExitCode: int32(res.Error.ExitCode), //nolint:gosec // TVM exit codes fit in int32
+			VMLog:    res.Logs,
+		}, nil
+	}
+
+	descr := res.Emulation.Transaction.Description
+	if descr.SumType != "TransOrd" {
+		return mcmston.EmulationResult{}, fmt.Errorf("unexpected transaction type %s", descr.SumType)
+	}
+	ord := descr.TransOrd
+
+	result := mcmston.EmulationResult{Success: !ord.Aborted, VMLog: res.Logs}
+	if ord.ComputePh.SumType == "TrPhaseComputeVm" {
+		vm := ord.ComputePh.TrPhaseComputeVm
+		result.ExitCode = vm.Vm.ExitCode
+		if !vm.Success {
+			result.Success = false
+
+			return result, nil
+		}
+	}
+
+	if ord.Action.Exists {
+		action := ord.Action.Value.Value
+		if !action.Success {
+			result.Success = false
+			result.ExitCode = action.ResultCode
+		}
+	}
+
+	return result, nil
+}
+
+func toTongoCell(c *cell.Cell) (*boc.Cell, error) {
+	cells, err := boc.DeserializeBoc(c.ToBOC())
+	if err != nil {
+		return nil, err
+	}
+	if len(cells) != 1 {
+		return nil, fmt.Errorf("expected a single root cell, got %d", len(cells))
+	}
+
+	return cells[0], nil
+}
+
+func unmarshalTongo(c *cell.Cell, v any) error {
+	tc, err := toTongoCell(c)
+	if err != nil {
+		return err
+	}
+
+	return tongotlb.Unmarshal(tc, v)
+}
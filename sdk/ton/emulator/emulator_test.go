@@ -0,0 +1,180 @@
+//go:build tonemulator
+
+package emulator
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/txemulator"
+
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/ton"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	ton_mocks "github.com/smartcontractkit/mcms/sdk/ton/mocks"
+)
+
+const now = 1700000000
+
+func defaultConfig(t *testing.T) *cell.Cell {
+	t.Helper()
+
+	raw, err := base64.StdEncoding.DecodeString(txemulator.DefaultConfig)
+	require.NoError(t, err)
+	config, err := cell.FromBOC(raw)
+	require.NoError(t, err)
+
+	return config
+}
+
+// testAccount returns an active account running the given code
+func testAccount(addr *address.Address, code *cell.Cell) *tlb.Account {
+	return &tlb.Account{
+		IsActive: true,
+		State: &tlb.AccountState{
+			IsValid: true,
+			Address: addr,
+			StorageInfo: tlb.StorageInfo{
+				StorageUsed: tlb.StorageUsed{CellsUsed: big.NewInt(0), BitsUsed: big.NewInt(0)},
+				LastPaid:    now,
+			},
+			AccountStorage: tlb.AccountStorage{
+				Status:            tlb.AccountStatusActive,
+				LastTransactionLT: 11,
+				Balance:           tlb.MustFromTON("10"),
+				StateInit:         &tlb.StateInit{Code: code, Data: cell.BeginCell().EndCell()},
+			},
+		},
+		Code:       code,
+		LastTxLT:   10,
+		LastTxHash: make([]byte, 32),
+	}
+}
+
+func testMessage(dst *address.Address) *tlb.InternalMessage {
+	return &tlb.InternalMessage{
+		IHRDisabled: true,
+		Bounce:      true,
+		SrcAddr:     address.MustParseAddr("EQADa3W6G0nSiTV4a6euRA42fU9QxSEnb-WeDpcrtWzA2jM8"),
+		DstAddr:     dst,
+		Amount:      tlb.MustFromTON("1"),
+		Body:        cell.BeginCell().MustStoreUInt(0x12345678, 32).EndCell(),
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(nil)
+	require.EqualError(t, err, "failed to create emulator - config (*cell.Cell) is nil")
+
+	_, err = NewFromClient(nil)
+	require.EqualError(t, err, "failed to create emulator - client (ton.APIClientWrapped) is nil")
+}
+
+func TestEmulator_EmulateInternalMessage(t *testing.T) {
+	t.Parallel()
+
+	addr := address.MustParseAddr("EQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAB7-7")
+
+	tests := []struct {
+		name         string
+		code         *cell.Cell
+		wantSuccess  bool
+		wantExitCode int32
+	}{
+		{
+			name:        "success",
+			code:        cell.BeginCell().EndCell(),
+			wantSuccess: true,
+		},
+		{
+			name: "compute phase failure",
+			// THROW 42
+			code:         cell.BeginCell().MustStoreSlice([]byte{0xF2, 0x2A}, 16).EndCell(),
+			wantExitCode: 42,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := New(defaultConfig(t))
+			require.NoError(t, err)
+
+			result, err := e.EmulateInternalMessage(t.Context(), testAccount(addr, tt.code), testMessage(addr), now)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSuccess, result.Success)
+			require.Equal(t, tt.wantExitCode, result.ExitCode)
+		})
+	}
+}
+
+func TestEmulator_EmulateInternalMessage_Errors(t *testing.T) {
+	t.Parallel()
+
+	addr := address.MustParseAddr("EQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAB7-7")
+
+	e, err := New(defaultConfig(t))
+	require.NoError(t, err)
+
+	_, err = e.EmulateInternalMessage(t.Context(), &tlb.Account{}, testMessage(addr), now)
+	require.EqualError(t, err, "account state is missing")
+
+	_, err = e.EmulateInternalMessage(t.Context(), testAccount(addr, cell.BeginCell().EndCell()), nil, now)
+	require.EqualError(t, err, "message is nil")
+}
+
+func TestConfigCell(t *testing.T) {
+	t.Parallel()
+
+	config := defaultConfig(t)
+	kvs, err := config.AsDict(32).LoadAll()
+	require.NoError(t, err)
+
+	params := map[int32]*cell.Cell{}
+	for _, kv := range kvs {
+		ref, err := kv.Value.LoadRef()
+		require.NoError(t, err)
+		params[int32(kv.Key.MustLoadInt(32))] = ref.MustToCell() //nolint:gosec // config keys are int32
+	}
+
+	got, err := configCell(params)
+	require.NoError(t, err)
+
+	gotKVs, err := got.AsDict(32).LoadAll()
+	require.NoError(t, err)
+	require.Len(t, gotKVs, len(kvs))
+
+	// The rebuilt config is usable by the emulator
+	addr := address.MustParseAddr("EQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAB7-7")
+	e, err := New(got)
+	require.NoError(t, err)
+	result, err := e.EmulateInternalMessage(t.Context(), testAccount(addr, cell.BeginCell().EndCell()), testMessage(addr), now)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+}
+
+func TestEmulator_NewFromClient(t *testing.T) {
+	t.Parallel()
+
+	addr := address.MustParseAddr("EQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAB7-7")
+
+	client := ton_mocks.NewAPIClientWrapped(t)
+	client.EXPECT().CurrentMasterchainInfo(mock.Anything).Return(&ton.BlockIDExt{}, nil)
+	client.EXPECT().GetBlockchainConfig(mock.Anything, mock.Anything).Return(nil, errors.New("boom"))
+
+	e, err := NewFromClient(client)
+	require.NoError(t, err)
+
+	_, err = e.EmulateInternalMessage(context.Background(), testAccount(addr, cell.BeginCell().EndCell()), testMessage(addr), now)
+	require.EqualError(t, err, "failed to get blockchain config: boom")
+}
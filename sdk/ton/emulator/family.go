@@ -0,0 +1,58 @@
+//go:build tonemulator
+
+package emulator
+
+import (
+	"fmt"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	mcmston "github.com/smartcontractkit/mcms/sdk/ton"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// The TON family is registered without a simulator, which emulates the TON messages with
+// libemulator. The ton package, imported by this one, registers first.
+func init() {
+	family, ok := sdk.LookupFamily(chainsel.FamilyTon)
+	if !ok {
+		panic("chain family ton is not registered")
+	}
+
+	family.NewSimulator = buildSimulator
+	if err := sdk.ReplaceFamily(family); err != nil {
+		panic(err)
+	}
+}
+
+func buildSimulator(
+	chains sdk.ChainAccessor, selector types.ChainSelector, encoder sdk.Encoder, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Simulator, error) {
+	rawSelector := uint64(selector)
+	tonChains, ok := chains.(mcmston.ChainAccessor)
+	if !ok {
+		return nil, fmt.Errorf("missing TON chain client for selector %d", rawSelector)
+	}
+	client, ok := tonChains.TonClient(rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing TON chain client for selector %d", rawSelector)
+	}
+	signer, ok := tonChains.TonSigner(rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing TON chain wallet for selector %d", rawSelector)
+	}
+
+	e, err := NewFromClient(client)
+	if err != nil {
+		return nil, err
+	}
+
+	return mcmston.NewSimulator(mcmston.SimulatorOpts{
+		Encoder:  encoder,
+		Client:   client,
+		Emulator: e,
+		Sender:   signer.WalletAddress(),
+		Amount:   mcmston.DefaultSendAmount,
+	})
+}
@@ -0,0 +1,288 @@
+package ton
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/ton"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/smartcontractkit/mcms/internal/utils/safecast"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+
+	"github.com/smartcontractkit/chainlink-ton/cciplib/ton/tvm"
+	"github.com/smartcontractkit/chainlink-ton/pkg/bindings/lib/access/rbac"
+	"github.com/smartcontractkit/chainlink-ton/pkg/bindings/mcms/mcms"
+	"github.com/smartcontractkit/chainlink-ton/pkg/bindings/mcms/timelock"
+)
+
+// listTransactionsLimit is the number of transactions fetched per liteserver request.
+const listTransactionsLimit = 16
+
+var (
+	opcodeNewRoot       = tvm.MustExtractMagic(reflect.TypeFor[mcms.NewRoot]())
+	opcodeOpExecuted    = tvm.MustExtractMagic(reflect.TypeFor[mcms.OpExecuted]())
+	opcodeConfigSet     = tvm.MustExtractMagic(reflect.TypeFor[mcms.ConfigSet]())
+	opcodeCallScheduled = tvm.MustExtractMagic(reflect.TypeFor[timelock.CallScheduled]())
+	opcodeCallExecuted  = tvm.MustExtractMagic(reflect.TypeFor[timelock.CallExecuted]())
+	opcodeCancelled     = tvm.MustExtractMagic(reflect.TypeFor[timelock.Cancelled]())
+)
+
+var _ sdk.EventFilterer = (*EventFilterer)(nil)
+
+// EventFilterer is an EventFilterer implementation for TON. Accounts have no block numbers of
+// their own, so blocks are logical times: the events are decoded from the outgoing messages of the
+// transactions of the contracts, and the block of an event is the logical time of its transaction.
+type EventFilterer struct {
+	client        ton.APIClientWrapped
+	chainSelector types.ChainSelector
+}
+
+// NewEventFilterer creates a new EventFilterer for TON chains
+func NewEventFilterer(client ton.APIClientWrapped, chainSelector types.ChainSelector) *EventFilterer {
+	return &EventFilterer{
+		client:        client,
+		chainSelector: chainSelector,
+	}
+}
+
+// LatestBlock returns a logical time that all later transactions are guaranteed to exceed: the
+// lowest end logical time of the latest masterchain block and of the shard blocks it commits.
+func (f *EventFilterer) LatestBlock(ctx context.Context) (uint64, error) {
+	master, err := f.client.CurrentMasterchainInfo(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get masterchain info: %w", err)
+	}
+	shards, err := f.client.GetBlockShardsInfo(ctx, master)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get shards of block %d: %w", master.SeqNo, err)
+	}
+
+	var latest uint64
+	for i, blockID := range append([]*ton.BlockIDExt{master}, shards...) {
+		block, err := f.client.GetBlockData(ctx, blockID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get block %d: %w", blockID.SeqNo, err)
+		}
+		if i == 0 || block.BlockInfo.EndLt < latest {
+			latest = block.BlockInfo.EndLt
+		}
+	}
+
+	return latest, nil
+}
+
+// FilterEvents returns the events of the query, ordered by logical time and message index.
+func (f *EventFilterer) FilterEvents(ctx context.Context, query types.EventQuery) ([]types.Event, error) {
+	var events []types.Event
+	if query.MCMAddress != "" {
+		mcmEvents, err := f.filterAccountEvents(ctx, query.MCMAddress, query.FromBlock, query.ToBlock, f.decodeMCMEvent)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, mcmEvents...)
+	}
+	if query.TimelockAddress != "" {
+		timelockEvents, err := f.filterAccountEvents(ctx, query.TimelockAddress, query.FromBlock, query.ToBlock, f.decodeTimelockEvent)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, timelockEvents...)
+	}
+
+	slices.SortStableFunc(events, func(a, b types.Event) int {
+		return cmp.Or(cmp.Compare(a.Block, b.Block), cmp.Compare(a.Index, b.Index))
+	})
+
+	return events, nil
+}
+
+func (f *EventFilterer) filterAccountEvents(
+	ctx context.Context, _address string, fromLT, toLT uint64, decode func(opcode uint64, body *cell.Cell) (*types.Event, error),
+) ([]types.Event, error) {
+	addr, err := address.ParseAddr(_address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	txs, err := f.transactions(ctx, addr, fromLT, toLT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions of %s: %w", _address, err)
+	}
+
+	var events []types.Event
+	for _, tx := range txs {
+		if tx.IO.Out == nil {
+			continue
+		}
+		msgs, err := tx.IO.Out.ToSlice()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load out messages of transaction %x: %w", tx.Hash, err)
+		}
+
+		for index, msg := range msgs {
+			body := msg.Msg.Payload()
+			if body == nil || body.BitsSize() < 32 {
+				continue
+			}
+			opcode, err := body.BeginParse().LoadUInt(32)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load opcode: %w", err)
+			}
+
+			event, err := decode(opcode, body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode message %d of transaction %x: %w", index, tx.Hash, err)
+			}
+			if event == nil {
+				continue
+			}
+
+			event.ChainSelector = f.chainSelector
+			event.Address = _address
+			event.Block = tx.LT
+			event.TxHash = fmt.Sprintf("%x", tx.Hash)
+			event.Index = uint(index)
+			events = append(events, *event)
+		}
+	}
+
+	return events, nil
+}
+
+// transactions returns the transactions of the account with a logical time in [fromLT, toLT],
+// oldest first. The liteserver lists transactions backwards from the last one of the account.
+func (f *EventFilterer) transactions(ctx context.Context, addr *address.Address, fromLT, toLT uint64) ([]*tlb.Transaction, error) {
+	master, err := f.client.CurrentMasterchainInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get masterchain info: %w", err)
+	}
+	account, err := f.client.GetAccount(ctx, master, addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	var txs []*tlb.Transaction
+	lt, hash := account.LastTxLT, account.LastTxHash
+	for lt != 0 && lt >= fromLT {
+		page, err := f.client.ListTransactions(ctx, addr, listTransactionsLimit, lt, hash)
+		if errors.Is(err, ton.ErrNoTransactionsWereFound) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tx := range slices.Backward(page) {
+			if tx.LT < fromLT {
+				break
+			}
+			if tx.LT <= toLT {
+				txs = append(txs, tx)
+			}
+		}
+		lt, hash = page[0].PrevTxLT, page[0].PrevTxHash
+	}
+	slices.Reverse(txs)
+
+	return txs, nil
+}
+
+// decodeMCMEvent decodes the NewRoot, OpExecuted and ConfigSet replies of the MCMS contract.
+func (f *EventFilterer) decodeMCMEvent(opcode uint64, body *cell.Cell) (*types.Event, error) {
+	switch opcode {
+	case opcodeNewRoot:
+		var data mcms.NewRoot
+		if err := tlb.LoadFromCell(&data, body.BeginParse()); err != nil {
+			return nil, err
+		}
+		validUntil, err := safecast.Uint64ToUint32(data.ValidUntil)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.Event{
+			Kind:       types.EventKindNewRoot,
+			Root:       common.BigToHash(data.Root.Value()),
+			ValidUntil: validUntil,
+			RawData:    data,
+		}, nil
+	case opcodeOpExecuted:
+		var data mcms.OpExecuted
+		if err := tlb.LoadFromCell(&data, body.BeginParse()); err != nil {
+			return nil, err
+		}
+
+		return &types.Event{Kind: types.EventKindOpExecuted, Nonce: data.Nonce, RawData: data}, nil
+	case opcodeConfigSet:
+		var data mcms.ConfigSet
+		if err := tlb.LoadFromCell(&data, body.BeginParse()); err != nil {
+			return nil, err
+		}
+
+		return &types.Event{Kind: types.EventKindConfigSet, IsRootCleared: data.IsRootCleared, RawData: data}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// decodeTimelockEvent decodes the CallScheduled, CallExecuted, Cancelled and RoleGranted logs
+// of the timelock contract.
+func (f *EventFilterer) decodeTimelockEvent(opcode uint64, body *cell.Cell) (*types.Event, error) {
+	switch opcode {
+	case opcodeCallScheduled:
+		var data timelock.CallScheduled
+		if err := tlb.LoadFromCell(&data, body.BeginParse()); err != nil {
+			return nil, err
+		}
+
+		return &types.Event{
+			Kind:        types.EventKindCallScheduled,
+			OperationID: common.BigToHash(data.ID.Value()),
+			CallIndex:   data.Index,
+			RawData:     data,
+		}, nil
+	case opcodeCallExecuted:
+		var data timelock.CallExecuted
+		if err := tlb.LoadFromCell(&data, body.BeginParse()); err != nil {
+			return nil, err
+		}
+
+		return &types.Event{
+			Kind:        types.EventKindCallExecuted,
+			OperationID: common.BigToHash(data.ID.Value()),
+			CallIndex:   data.Index,
+			RawData:     data,
+		}, nil
+	case opcodeCancelled:
+		var data timelock.Cancelled
+		if err := tlb.LoadFromCell(&data, body.BeginParse()); err != nil {
+			return nil, err
+		}
+
+		return &types.Event{Kind: types.EventKindCancelled, OperationID: common.BigToHash(data.ID.Value()), RawData: data}, nil
+	case rbac.OpcodeRoleGranted:
+		var data rbac.RoleGranted
+		if err := tlb.LoadFromCell(&data, body.BeginParse()); err != nil {
+			return nil, err
+		}
+
+		return &types.Event{
+			Kind:    types.EventKindRoleGranted,
+			Role:    common.BigToHash(data.Role.Value()),
+			Account: data.Account.String(),
+			RawData: data,
+		}, nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,180 @@
+package ton_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/ton"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/smartcontractkit/chainlink-ton/cciplib/ton/tlbe"
+	"github.com/smartcontractkit/chainlink-ton/pkg/bindings/lib/access/rbac"
+	"github.com/smartcontractkit/chainlink-ton/pkg/bindings/mcms/mcms"
+	"github.com/smartcontractkit/chainlink-ton/pkg/bindings/mcms/timelock"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	mcmston "github.com/smartcontractkit/mcms/sdk/ton"
+	ton_mocks "github.com/smartcontractkit/mcms/sdk/ton/mocks"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestEventFilterer_LatestBlock(t *testing.T) {
+	t.Parallel()
+
+	client := ton_mocks.NewAPIClientWrapped(t)
+	filterer := mcmston.NewEventFilterer(client, chaintest.Chain7Selector)
+
+	master := &ton.BlockIDExt{Workchain: -1, SeqNo: 1}
+	shards := []*ton.BlockIDExt{{Workchain: 0, SeqNo: 2}, {Workchain: 0, SeqNo: 3}}
+	client.EXPECT().CurrentMasterchainInfo(mock.Anything).Return(master, nil).Once()
+	client.EXPECT().GetBlockShardsInfo(mock.Anything, master).Return(shards, nil).Once()
+	client.EXPECT().GetBlockData(mock.Anything, master).Return(blockWithEndLT(300), nil).Once()
+	client.EXPECT().GetBlockData(mock.Anything, shards[0]).Return(blockWithEndLT(200), nil).Once()
+	client.EXPECT().GetBlockData(mock.Anything, shards[1]).Return(blockWithEndLT(250), nil).Once()
+
+	latest, err := filterer.LatestBlock(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), latest)
+
+	client.EXPECT().CurrentMasterchainInfo(mock.Anything).Return(nil, errors.New("liteserver error")).Once()
+	_, err = filterer.LatestBlock(t.Context())
+	require.EqualError(t, err, "failed to get masterchain info: liteserver error")
+}
+
+func TestEventFilterer_FilterEvents(t *testing.T) {
+	t.Parallel()
+
+	mcmAddr := address.NewAddress(0, 0, common.LeftPadBytes([]byte{1}, 32))
+	timelockAddr := address.NewAddress(0, 0, common.LeftPadBytes([]byte{2}, 32))
+	receiver := address.NewAddress(0, 0, common.LeftPadBytes([]byte{3}, 32))
+	opID := big.NewInt(0xabcd)
+	role := big.NewInt(0x42)
+
+	client := ton_mocks.NewAPIClientWrapped(t)
+	filterer := mcmston.NewEventFilterer(client, chaintest.Chain7Selector)
+
+	// Messages with unknown opcodes are skipped, as are the transactions outside of the range.
+	mcmTxs := []*tlb.Transaction{
+		transactionWithOutMessages(t, 5, 0, mcms.OpExecuted{Nonce: 1, To: receiver, Data: cell.BeginCell().EndCell()}),
+		transactionWithOutMessages(t, 10, 5,
+			cell.BeginCell().MustStoreUInt(0x12345678, 32).EndCell(),
+			mcms.OpExecuted{Nonce: 2, To: receiver, Data: cell.BeginCell().EndCell()},
+		),
+		transactionWithOutMessages(t, 20, 10, mcms.OpExecuted{Nonce: 3, To: receiver, Data: cell.BeginCell().EndCell()}),
+	}
+	timelockTxs := []*tlb.Transaction{
+		transactionWithOutMessages(t, 12, 0,
+			rbac.RoleGranted{Role: tlbe.NewUint256(role), Account: receiver, Sender: mcmAddr},
+			timelock.Cancelled{ID: tlbe.NewUint256(opID)},
+		),
+	}
+	mockListTransactions(t, client, mcmAddr, mcmTxs)
+	mockListTransactions(t, client, timelockAddr, timelockTxs)
+
+	events, err := filterer.FilterEvents(t.Context(), types.EventQuery{
+		MCMAddress:      mcmAddr.String(),
+		TimelockAddress: timelockAddr.String(),
+		FromBlock:       10,
+		ToBlock:         15,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, events, 3)
+	require.Equal(t, types.EventKindOpExecuted, events[0].Kind)
+	require.Equal(t, mcmAddr.String(), events[0].Address)
+	require.Equal(t, uint64(10), events[0].Block)
+	require.Equal(t, uint(1), events[0].Index)
+	require.Equal(t, uint64(2), events[0].Nonce)
+	require.Equal(t, fmt.Sprintf("%x", mcmTxs[1].Hash), events[0].TxHash)
+	require.Equal(t, chaintest.Chain7Selector, events[0].ChainSelector)
+	require.IsType(t, mcms.OpExecuted{}, events[0].RawData)
+
+	require.Equal(t, types.EventKindRoleGranted, events[1].Kind)
+	require.Equal(t, timelockAddr.String(), events[1].Address)
+	require.Equal(t, uint64(12), events[1].Block)
+	require.Equal(t, common.BigToHash(role), events[1].Role)
+	require.Equal(t, receiver.String(), events[1].Account)
+
+	require.Equal(t, types.EventKindCancelled, events[2].Kind)
+	require.Equal(t, uint(1), events[2].Index)
+	require.Equal(t, common.BigToHash(opID), events[2].OperationID)
+}
+
+func TestEventFilterer_FilterEvents_Errors(t *testing.T) {
+	t.Parallel()
+
+	client := ton_mocks.NewAPIClientWrapped(t)
+	filterer := mcmston.NewEventFilterer(client, chaintest.Chain7Selector)
+
+	_, err := filterer.FilterEvents(t.Context(), types.EventQuery{MCMAddress: "invalid"})
+	require.ErrorContains(t, err, "invalid address")
+
+	addr := address.MustParseAddr("EQADa3W6G0nSiTV4a6euRA42fU9QxSEnb-WeDpcrtWzA2jM8")
+	client.EXPECT().CurrentMasterchainInfo(mock.Anything).Return(&ton.BlockIDExt{}, nil).Once()
+	client.EXPECT().GetAccount(mock.Anything, mock.Anything, addr).Return(&tlb.Account{LastTxLT: 5, LastTxHash: []byte{1}}, nil).Once()
+	client.EXPECT().ListTransactions(mock.Anything, addr, mock.Anything, uint64(5), []byte{1}).
+		Return(nil, errors.New("liteserver error")).Once()
+
+	_, err = filterer.FilterEvents(t.Context(), types.EventQuery{MCMAddress: addr.String(), ToBlock: 10})
+	require.EqualError(t, err, "failed to list transactions of "+addr.String()+": liteserver error")
+}
+
+func blockWithEndLT(endLT uint64) *tlb.Block {
+	block := &tlb.Block{}
+	block.BlockInfo.EndLt = endLT
+
+	return block
+}
+
+// transactionWithOutMessages builds a transaction sending external out messages with the bodies.
+func transactionWithOutMessages(t *testing.T, lt, prevLT uint64, bodies ...any) *tlb.Transaction {
+	t.Helper()
+
+	out := cell.NewDict(15)
+	for i, body := range bodies {
+		bodyCell, ok := body.(*cell.Cell)
+		if !ok {
+			var err error
+			bodyCell, err = tlb.ToCell(body)
+			require.NoError(t, err)
+		}
+		msg, err := tlb.ToCell(&tlb.ExternalMessageOut{
+			SrcAddr: address.NewAddressNone(),
+			DstAddr: address.NewAddressNone(),
+			Body:    bodyCell,
+		})
+		require.NoError(t, err)
+		require.NoError(t, out.SetIntKey(big.NewInt(int64(i)), cell.BeginCell().MustStoreRef(msg).EndCell()))
+	}
+
+	tx := &tlb.Transaction{LT: lt, PrevTxLT: prevLT, Hash: []byte{byte(lt)}}
+	if prevLT != 0 {
+		tx.PrevTxHash = []byte{byte(prevLT)}
+	}
+	tx.IO.Out = &tlb.MessagesList{List: out}
+
+	return tx
+}
+
+// mockListTransactions mocks the account state and the liteserver listing the transactions of
+// the account backwards, one transaction per page.
+func mockListTransactions(t *testing.T, client *ton_mocks.APIClientWrapped, addr *address.Address, txs []*tlb.Transaction) {
+	t.Helper()
+
+	last := txs[len(txs)-1]
+	client.EXPECT().CurrentMasterchainInfo(mock.Anything).Return(&ton.BlockIDExt{}, nil).Once()
+	client.EXPECT().GetAccount(mock.Anything, mock.Anything, addr).
+		Return(&tlb.Account{IsActive: true, LastTxLT: last.LT, LastTxHash: last.Hash}, nil).Once()
+	for _, tx := range txs {
+		client.EXPECT().ListTransactions(mock.Anything, addr, mock.Anything, tx.LT, tx.Hash).
+			Return([]*tlb.Transaction{tx}, nil).Maybe()
+	}
+}
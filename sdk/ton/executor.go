@@ -96,31 +96,108 @@ func (e *executor) ExecuteOperation(
 		return z, fmt.Errorf("invalid mcms address: %w", err)
 	}
 
+	body, err := encodeExecuteBody(e.Encoder, metadata, nonce, proof, op)
+	if err != nil {
+		return z, err
+	}
+
+	return e.CheckPendingSend(ctx, dstAddr, body)
+}
+
+func (e *executor) SetRoot(
+	ctx context.Context,
+	metadata types.ChainMetadata,
+	proof []common.Hash,
+	root [32]byte,
+	validUntil uint32,
+	sortedSignatures []types.Signature,
+) (types.TransactionResult, error) {
+	var z types.TransactionResult // zero value
+
+	// Map to Ton Address type
+	dstAddr, err := address.ParseAddr(metadata.MCMAddress)
+	if err != nil {
+		return z, fmt.Errorf("invalid mcms address: %w", err)
+	}
+
+	body, err := encodeSetRootBody(e.Encoder, metadata, proof, root, validUntil, sortedSignatures)
+	if err != nil {
+		return z, err
+	}
+
+	return e.CheckPendingSend(ctx, dstAddr, body)
+}
+
+func (e *executor) CheckPendingSend(ctx context.Context, dstAddr *address.Address, body *cell.Cell) (types.TransactionResult, error) {
+	var z types.TransactionResult // zero value
+
+	// Check the status of potential pending operation
+	// Get current block
+	blockID, err := e.client.CurrentMasterchainInfo(ctx)
+	if err != nil {
+		return z, fmt.Errorf("failed to get current block: %w", err)
+	}
+
+	// Load the full block to get timestamp and hash
+	block, err := e.client.GetBlockData(ctx, blockID)
+	if err != nil {
+		return z, fmt.Errorf("failed to get block data: %w", err)
+	}
+
+	// Load the current on-chain time
+	now := block.BlockInfo.GenUtime
+
+	info, err := tvm.CallGetter(ctx, e.client, blockID, dstAddr, mcms.GetOpPendingInfo)
+	if err != nil {
+		return z, fmt.Errorf("failed to call mcms.GetOpPendingInfo getter: %w", err)
+	}
+
+	tx := TxOpts{
+		Wallet:  e.wallet,
+		DstAddr: dstAddr,
+		Amount:  e.amount,
+		Body:    body,
+	}
+
+	return SendTxAfter(ctx, tx, uint64(now), info.ValidAfter, DefaultWaitBuffer, e.wait)
+}
+
+func (e executor) Equal(otherSdkExec sdk.Executor) bool {
+	other, ok := otherSdkExec.(*executor)
+	return ok &&
+		e.Encoder == other.Encoder &&
+		e.wallet == other.wallet &&
+		e.amount == other.amount &&
+		e.wait == other.wait
+}
+
+// encodeExecuteBody encodes the body of the MCMS execute message for the operation.
+func encodeExecuteBody(encoder sdk.Encoder, metadata types.ChainMetadata, nonce uint32, proof []common.Hash, op types.Operation) (*cell.Cell, error) {
 	// Encode operation
-	oe, ok := e.Encoder.(OperationEncoder[mcms.Op])
+	oe, ok := encoder.(OperationEncoder[mcms.Op])
 	if !ok {
-		return z, errors.New("failed to assert OperationEncoder")
+		return nil, errors.New("failed to assert OperationEncoder")
 	}
 
 	bindOp, err := oe.ToOperation(nonce, metadata, op)
 	if err != nil {
-		return z, fmt.Errorf("failed to convert to operation: %w", err)
+		return nil, fmt.Errorf("failed to convert to operation: %w", err)
 	}
 
 	// Encode proofs
-	pe, ok := e.Encoder.(ProofEncoder[mcms.Proof])
+	pe, ok := encoder.(ProofEncoder[mcms.Proof])
 	if !ok {
-		return z, errors.New("failed to assert ProofEncoder")
+		return nil, errors.New("failed to assert ProofEncoder")
 	}
 
 	bindProof, err := pe.ToProof(proof)
 	if err != nil {
-		return z, fmt.Errorf("failed to encode proof: %w", err)
+		return nil, fmt.Errorf("failed to encode proof: %w", err)
 	}
 
 	qID, err := tvm.RandomQueryID()
 	if err != nil {
-		return z, fmt.Errorf("failed to generate random query ID: %w", err)
+		return nil, fmt.Errorf("failed to generate random query ID: %w", err)
 	}
 
 	body, err := tlb.ToCell(mcms.Execute{
@@ -130,64 +207,57 @@ func (e *executor) ExecuteOperation(
 		Proof: bindProof,
 	})
 	if err != nil {
-		return z, fmt.Errorf("failed to encode ExecuteBatch body: %w", err)
+		return nil, fmt.Errorf("failed to encode ExecuteBatch body: %w", err)
 	}
 
-	return e.CheckPendingSend(ctx, dstAddr, body)
+	return body, nil
 }
 
-func (e *executor) SetRoot(
-	ctx context.Context,
+// encodeSetRootBody encodes the body of the MCMS set root message.
+func encodeSetRootBody(
+	encoder sdk.Encoder,
 	metadata types.ChainMetadata,
 	proof []common.Hash,
 	root [32]byte,
 	validUntil uint32,
 	sortedSignatures []types.Signature,
-) (types.TransactionResult, error) {
-	var z types.TransactionResult // zero value
-
-	// Map to Ton Address type
-	dstAddr, err := address.ParseAddr(metadata.MCMAddress)
-	if err != nil {
-		return z, fmt.Errorf("invalid mcms address: %w", err)
-	}
-
+) (*cell.Cell, error) {
 	// Encode root metadata
-	rme, ok := e.Encoder.(RootMetadataEncoder[mcms.RootMetadata])
+	rme, ok := encoder.(RootMetadataEncoder[mcms.RootMetadata])
 	if !ok {
-		return z, errors.New("failed to assert RootMetadataEncoder")
+		return nil, errors.New("failed to assert RootMetadataEncoder")
 	}
 
 	rm, err := rme.ToRootMetadata(metadata)
 	if err != nil {
-		return z, fmt.Errorf("failed to convert to root metadata: %w", err)
+		return nil, fmt.Errorf("failed to convert to root metadata: %w", err)
 	}
 
 	// Encode proofs
-	pe, ok := e.Encoder.(ProofEncoder[mcms.Proof])
+	pe, ok := encoder.(ProofEncoder[mcms.Proof])
 	if !ok {
-		return z, errors.New("failed to assert ProofEncoder")
+		return nil, errors.New("failed to assert ProofEncoder")
 	}
 
 	bindProof, err := pe.ToProof(proof)
 	if err != nil {
-		return z, fmt.Errorf("failed to encode proof: %w", err)
+		return nil, fmt.Errorf("failed to encode proof: %w", err)
 	}
 
 	// Encode signatures
-	se, ok := e.Encoder.(SignaturesEncoder[mcms.Signature])
+	se, ok := encoder.(SignaturesEncoder[mcms.Signature])
 	if !ok {
-		return z, errors.New("failed to assert SignatureEncoder")
+		return nil, errors.New("failed to assert SignatureEncoder")
 	}
 
 	bindSignatures, err := se.ToSignatures(sortedSignatures, root)
 	if err != nil {
-		return z, fmt.Errorf("failed to encode signatures: %w", err)
+		return nil, fmt.Errorf("failed to encode signatures: %w", err)
 	}
 
 	qID, err := tvm.RandomQueryID()
 	if err != nil {
-		return z, fmt.Errorf("failed to generate random query ID: %w", err)
+		return nil, fmt.Errorf("failed to generate random query ID: %w", err)
 	}
 
 	body, err := tlb.ToCell(mcms.SetRoot{
@@ -201,51 +271,8 @@ func (e *executor) SetRoot(
 		Signatures:    bindSignatures,
 	})
 	if err != nil {
-		return z, fmt.Errorf("failed to encode ExecuteBatch body: %w", err)
-	}
-
-	return e.CheckPendingSend(ctx, dstAddr, body)
-}
-
-func (e *executor) CheckPendingSend(ctx context.Context, dstAddr *address.Address, body *cell.Cell) (types.TransactionResult, error) {
-	var z types.TransactionResult // zero value
-
-	// Check the status of potential pending operation
-	// Get current block
-	blockID, err := e.client.CurrentMasterchainInfo(ctx)
-	if err != nil {
-		return z, fmt.Errorf("failed to get current block: %w", err)
+		return nil, fmt.Errorf("failed to encode ExecuteBatch body: %w", err)
 	}
 
-	// Load the full block to get timestamp and hash
-	block, err := e.client.GetBlockData(ctx, blockID)
-	if err != nil {
-		return z, fmt.Errorf("failed to get block data: %w", err)
-	}
-
-	// Load the current on-chain time
-	now := block.BlockInfo.GenUtime
-
-	info, err := tvm.CallGetter(ctx, e.client, blockID, dstAddr, mcms.GetOpPendingInfo)
-	if err != nil {
-		return z, fmt.Errorf("failed to call mcms.GetOpPendingInfo getter: %w", err)
-	}
-
-	tx := TxOpts{
-		Wallet:  e.wallet,
-		DstAddr: dstAddr,
-		Amount:  e.amount,
-		Body:    body,
-	}
-
-	return SendTxAfter(ctx, tx, uint64(now), info.ValidAfter, DefaultWaitBuffer, e.wait)
-}
-
-func (e executor) Equal(otherSdkExec sdk.Executor) bool {
-	other, ok := otherSdkExec.(*executor)
-	return ok &&
-		e.Encoder == other.Encoder &&
-		e.wallet == other.wallet &&
-		e.amount == other.amount &&
-		e.wait == other.wait
+	return body, nil
 }
@@ -0,0 +1,159 @@
+package ton
+
+import (
+	"fmt"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/xssnick/tonutils-go/ton"
+	"github.com/xssnick/tonutils-go/ton/wallet"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// The simulator is registered by the emulator package, built with the tonemulator build tag as it
+// links libemulator. The decoder needs the TL-B registry of the target contracts, so it is not
+// registered.
+func init() {
+	if err := sdk.RegisterFamily(sdk.Family{
+		Name: chainsel.FamilyTon,
+		NewEncoder: func(selector types.ChainSelector, txCount uint64, overridePreviousRoot bool, _ bool) sdk.Encoder {
+			return NewEncoder(selector, txCount, overridePreviousRoot)
+		},
+		NewTimelockConverter: func(types.ChainSelector, types.ChainMetadata) (sdk.TimelockConverter, error) {
+			return NewTimelockConverter(DefaultSendAmount), nil
+		},
+		NewExecutor:              buildTonExecutor,
+		NewInspector:             buildTonInspector,
+		NewTimelockExecutor:      buildTonTimelockExecutor,
+		NewTimelockInspector:     buildTonTimelockInspector,
+		NewTimelockConfigurer:    buildTonTimelockConfigurer,
+		NewEventFilterer:         buildTonEventFilterer,
+		OperationID:              OperationID,
+		ValidateAdditionalFields: ValidateAdditionalFields,
+		TimelockRoleMember: func(mcmAddress string) (string, error) {
+			return mcmAddress, nil
+		},
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func buildTonExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, encoder sdk.Encoder, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Executor, error) {
+	rawSelector := uint64(chainSelector)
+	tonEncoder, ok := encoder.(*Encoder)
+	if !ok {
+		return nil, fmt.Errorf("invalid encoder type for selector %d: %T", chainSelector, encoder)
+	}
+	client, ok := tonClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing ton chain client for selector %d", chainSelector)
+	}
+	signer, ok := tonSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing ton client signer for selector %d", chainSelector)
+	}
+
+	return NewExecutor(ExecutorOpts{
+		Encoder: tonEncoder,
+		Client:  client,
+		Wallet:  signer,
+		Amount:  DefaultSendAmount,
+	})
+}
+
+func buildTonInspector(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.Inspector, error) {
+	rawSelector := uint64(selector)
+	client, ok := tonClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Ton chain client for selector %d", rawSelector)
+	}
+
+	return NewInspector(client), nil
+}
+
+func buildTonTimelockExecutor(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.TimelockExecutor, error) {
+	rawSelector := uint64(chainSelector)
+	client, ok := tonClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing ton chain client for selector %d", chainSelector)
+	}
+	signer, ok := tonSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing ton client signer for selector %d", chainSelector)
+	}
+
+	return NewTimelockExecutor(TimelockExecutorOpts{
+		Client: client,
+		Wallet: signer,
+		Amount: DefaultSendAmount,
+	})
+}
+
+func buildTonTimelockInspector(
+	chains sdk.ChainAccessor, chainSelector types.ChainSelector, _ types.ChainMetadata,
+) (sdk.TimelockInspector, error) {
+	rawSelector := uint64(chainSelector)
+	client, ok := tonClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing TON chain client for selector %d", rawSelector)
+	}
+
+	return NewTimelockInspector(client), nil
+}
+
+func buildTonTimelockConfigurer(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.TimelockConfigurer, error) {
+	rawSelector := uint64(selector)
+	w, ok := tonSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing TON chain wallet for selector %d", rawSelector)
+	}
+
+	return NewTimelockConfigurer(w, DefaultSendAmount), nil
+}
+
+func buildTonEventFilterer(
+	chains sdk.ChainAccessor, selector types.ChainSelector, _ types.TimelockAction, _ types.ChainMetadata,
+) (sdk.EventFilterer, error) {
+	rawSelector := uint64(selector)
+	client, ok := tonClient(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing Ton chain client for selector %d", rawSelector)
+	}
+
+	return NewEventFilterer(client, selector), nil
+}
+
+// ChainAccessor is implemented by a sdk.ChainAccessor to serve TON chains.
+type ChainAccessor interface {
+	TonClient(selector uint64) (ton.APIClientWrapped, bool)
+	TonSigner(selector uint64) (*wallet.Wallet, bool)
+}
+
+// tonClient returns the TON client of the selector if the ChainAccessor serves TON chains.
+func tonClient(chains sdk.ChainAccessor, selector uint64) (ton.APIClientWrapped, bool) {
+	tonChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return tonChains.TonClient(selector)
+}
+
+// tonSigner returns the TON signer of the selector if the ChainAccessor serves TON chains.
+func tonSigner(chains sdk.ChainAccessor, selector uint64) (*wallet.Wallet, bool) {
+	tonChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return tonChains.TonSigner(selector)
+}
@@ -17,6 +17,7 @@ import (
 )
 
 var _ sdk.Inspector = (*Inspector)(nil)
+var _ sdk.PostOpCountInspector = (*Inspector)(nil)
 
 // Inspector is an interface for inspecting on chain state of MCMS contracts.
 type Inspector struct {
@@ -86,3 +87,18 @@ func (i Inspector) GetRootMetadata(ctx context.Context, _address string) (types.
 		MCMAddress:      _address,
 	}, nil
 }
+
+// GetRootPostOpCount returns the post-op count of the root held by the MCM.
+func (i Inspector) GetRootPostOpCount(ctx context.Context, _address string) (uint64, error) {
+	addr, err := address.ParseAddr(_address)
+	if err != nil {
+		return 0, err
+	}
+
+	rm, err := tvm.CallGetterLatest(ctx, i.client, addr, mcms.GetRootMetadata)
+	if err != nil {
+		return 0, err
+	}
+
+	return rm.PostOpCount, nil
+}
@@ -0,0 +1,205 @@
+package ton
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/samber/lo"
+
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/ton"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// Emulator emulates locally the processing of an internal message by an account, on top of its
+// current on-chain state. Implementations typically wrap the TON emulator library.
+type Emulator interface {
+	EmulateInternalMessage(ctx context.Context, account *tlb.Account, msg *tlb.InternalMessage, now uint32) (EmulationResult, error)
+}
+
+// EmulationResult is the outcome of an emulated transaction
+type EmulationResult struct {
+	// Whether both the compute and the action phases succeeded
+	Success bool
+	// Exit code of the compute phase, or result code of the action phase
+	ExitCode int32
+	// VM log of the compute phase
+	VMLog string
+}
+
+// sdk.Simulator implementation for TON chains, emulating the MCMS messages locally
+type simulator struct {
+	encoder  sdk.Encoder
+	client   ton.APIClientWrapped
+	emulator Emulator
+
+	sender *address.Address
+	amount tlb.Coins
+}
+
+type SimulatorOpts struct {
+	Encoder  sdk.Encoder
+	Client   ton.APIClientWrapped
+	Emulator Emulator
+
+	// Sender of the emulated SetRoot message
+	Sender *address.Address
+	// Value sent (to MCMS) with the emulated SetRoot message
+	Amount tlb.Coins
+}
+
+// NewSimulator creates a new Simulator for TON chains
+func NewSimulator(opts SimulatorOpts) (sdk.Simulator, error) {
+	if lo.IsNil(opts.Encoder) {
+		return nil, errors.New("failed to create sdk.Simulator - encoder (sdk.Encoder) is nil")
+	}
+
+	if lo.IsNil(opts.Client) {
+		return nil, errors.New("failed to create sdk.Simulator - client (ton.APIClientWrapped) is nil")
+	}
+
+	if lo.IsNil(opts.Emulator) {
+		return nil, errors.New("failed to create sdk.Simulator - emulator (Emulator) is nil")
+	}
+
+	if opts.Sender == nil {
+		return nil, errors.New("failed to create sdk.Simulator - sender (*address.Address) is nil")
+	}
+
+	return &simulator{
+		encoder:  opts.Encoder,
+		client:   opts.Client,
+		emulator: opts.Emulator,
+		sender:   opts.Sender,
+		amount:   opts.Amount,
+	}, nil
+}
+
+func (s *simulator) SimulateSetRoot(
+	ctx context.Context,
+	_ string,
+	metadata types.ChainMetadata,
+	proof []common.Hash,
+	root [32]byte,
+	validUntil uint32,
+	sortedSignatures []types.Signature,
+) error {
+	// Map to Ton Address type
+	dstAddr, err := address.ParseAddr(metadata.MCMAddress)
+	if err != nil {
+		return fmt.Errorf("invalid mcms address: %w", err)
+	}
+
+	body, err := encodeSetRootBody(s.encoder, metadata, proof, root, validUntil, sortedSignatures)
+	if err != nil {
+		return err
+	}
+
+	return s.emulate(ctx, s.sender, dstAddr, s.amount, body)
+}
+
+// SimulateOperation emulates the internal message sent by MCMS to the target of the operation
+// when the operation is executed.
+func (s *simulator) SimulateOperation(
+	ctx context.Context,
+	metadata types.ChainMetadata,
+	op types.Operation,
+) error {
+	// Map to Ton Address type
+	srcAddr, err := address.ParseAddr(metadata.MCMAddress)
+	if err != nil {
+		return fmt.Errorf("invalid mcms address: %w", err)
+	}
+
+	dstAddr, err := address.ParseAddr(op.Transaction.To)
+	if err != nil {
+		return fmt.Errorf("invalid target address: %w", err)
+	}
+
+	additionalFields := AdditionalFields{Value: big.NewInt(0)}
+	if len(op.Transaction.AdditionalFields) != 0 {
+		if err = json.Unmarshal(op.Transaction.AdditionalFields, &additionalFields); err != nil {
+			return fmt.Errorf("failed to unmarshal additional fields: %w", err)
+		}
+	}
+	if err = additionalFields.Validate(); err != nil {
+		return err
+	}
+
+	body, err := cell.FromBOC(op.Transaction.Data)
+	if err != nil {
+		return fmt.Errorf("invalid cell BOC data: %w", err)
+	}
+
+	return s.emulate(ctx, srcAddr, dstAddr, tlb.FromNanoTON(additionalFields.Value), body)
+}
+
+func (s *simulator) emulate(ctx context.Context, srcAddr, dstAddr *address.Address, amount tlb.Coins, body *cell.Cell) error {
+	blockID, err := s.client.CurrentMasterchainInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current block: %w", err)
+	}
+
+	// Load the full block to get the current on-chain time
+	block, err := s.client.GetBlockData(ctx, blockID)
+	if err != nil {
+		return fmt.Errorf("failed to get block data: %w", err)
+	}
+
+	account, err := s.client.GetAccount(ctx, blockID, dstAddr)
+	if err != nil {
+		return fmt.Errorf("failed to get account %s: %w", dstAddr, err)
+	}
+	if !account.IsActive {
+		return fmt.Errorf("account %s is not active", dstAddr)
+	}
+
+	msg := &tlb.InternalMessage{
+		IHRDisabled: true,
+		Bounce:      true,
+		SrcAddr:     srcAddr,
+		DstAddr:     dstAddr,
+		Amount:      amount,
+		Body:        body,
+	}
+
+	result, err := s.emulator.EmulateInternalMessage(ctx, account, msg, block.BlockInfo.GenUtime)
+	if err != nil {
+		return fmt.Errorf("failed to emulate message: %w", err)
+	}
+	if !result.Success {
+		return SimulateError{result: result}
+	}
+
+	return nil
+}
+
+type SimulateError struct {
+	result EmulationResult
+}
+
+func (e SimulateError) Error() string {
+	return fmt.Sprintf("emulated transaction failed with exit code %d", e.result.ExitCode)
+}
+
+func (e SimulateError) ExitCode() int32 {
+	return e.result.ExitCode
+}
+
+// Logs returns the lines of the VM log of the emulated transaction
+func (e SimulateError) Logs() []string {
+	if e.result.VMLog == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimRight(e.result.VMLog, "\n"), "\n")
+}
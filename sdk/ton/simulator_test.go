@@ -0,0 +1,196 @@
+package ton_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/ton"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/types"
+
+	mcmston "github.com/smartcontractkit/mcms/sdk/ton"
+
+	ton_mocks "github.com/smartcontractkit/mcms/sdk/ton/mocks"
+)
+
+// fakeEmulator records the emulated message and returns a fixed result
+type fakeEmulator struct {
+	result mcmston.EmulationResult
+	err    error
+
+	msg *tlb.InternalMessage
+	now uint32
+}
+
+func (f *fakeEmulator) EmulateInternalMessage(_ context.Context, _ *tlb.Account, msg *tlb.InternalMessage, now uint32) (mcmston.EmulationResult, error) {
+	f.msg = msg
+	f.now = now
+
+	return f.result, f.err
+}
+
+func mockSetup_TestSimulator(client *ton_mocks.APIClientWrapped, account *tlb.Account) {
+	client.EXPECT().CurrentMasterchainInfo(mock.Anything).
+		Return(&ton.BlockIDExt{}, nil)
+	block := &tlb.Block{}
+	block.BlockInfo.GenUtime = 1700000000
+	client.EXPECT().GetBlockData(mock.Anything, mock.Anything).
+		Return(block, nil)
+	client.EXPECT().GetAccount(mock.Anything, mock.Anything, mock.Anything).
+		Return(account, nil)
+}
+
+func TestNewSimulator(t *testing.T) {
+	t.Parallel()
+
+	encoder := &mcmston.Encoder{ChainSelector: chaintest.Chain7Selector}
+	client := ton_mocks.NewAPIClientWrapped(t)
+	sender := address.MustParseAddr("EQADa3W6G0nSiTV4a6euRA42fU9QxSEnb-WeDpcrtWzA2jM8")
+
+	_, err := mcmston.NewSimulator(mcmston.SimulatorOpts{Encoder: encoder, Client: client, Emulator: &fakeEmulator{}, Sender: sender})
+	require.NoError(t, err)
+
+	_, err = mcmston.NewSimulator(mcmston.SimulatorOpts{Client: client, Emulator: &fakeEmulator{}, Sender: sender})
+	require.EqualError(t, err, "failed to create sdk.Simulator - encoder (sdk.Encoder) is nil")
+
+	_, err = mcmston.NewSimulator(mcmston.SimulatorOpts{Encoder: encoder, Emulator: &fakeEmulator{}, Sender: sender})
+	require.EqualError(t, err, "failed to create sdk.Simulator - client (ton.APIClientWrapped) is nil")
+
+	_, err = mcmston.NewSimulator(mcmston.SimulatorOpts{Encoder: encoder, Client: client, Sender: sender})
+	require.EqualError(t, err, "failed to create sdk.Simulator - emulator (Emulator) is nil")
+
+	_, err = mcmston.NewSimulator(mcmston.SimulatorOpts{Encoder: encoder, Client: client, Emulator: &fakeEmulator{}})
+	require.EqualError(t, err, "failed to create sdk.Simulator - sender (*address.Address) is nil")
+}
+
+func TestSimulator_SimulateSetRoot(t *testing.T) {
+	t.Parallel()
+
+	mcmsAddr := "EQADa3W6G0nSiTV4a6euRA42fU9QxSEnb-WeDpcrtWzA2jM8"
+	sender := address.MustParseAddr("EQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAB7-7")
+
+	tests := []struct {
+		name     string
+		account  *tlb.Account
+		emulator *fakeEmulator
+		wantErr  string
+		wantLogs []string
+	}{
+		{
+			name:     "success",
+			account:  &tlb.Account{IsActive: true},
+			emulator: &fakeEmulator{result: mcmston.EmulationResult{Success: true}},
+		},
+		{
+			name:    "failure - emulated transaction failed",
+			account: &tlb.Account{IsActive: true},
+			emulator: &fakeEmulator{result: mcmston.EmulationResult{
+				ExitCode: 107,
+				VMLog:    "execute SETCODE\nhandling exception code 107\n",
+			}},
+			wantErr:  "emulated transaction failed with exit code 107",
+			wantLogs: []string{"execute SETCODE", "handling exception code 107"},
+		},
+		{
+			name:     "failure - emulator error",
+			account:  &tlb.Account{IsActive: true},
+			emulator: &fakeEmulator{err: errors.New("invalid config")},
+			wantErr:  "failed to emulate message: invalid config",
+		},
+		{
+			name:     "failure - inactive account",
+			account:  &tlb.Account{},
+			emulator: &fakeEmulator{},
+			wantErr:  "account " + mcmsAddr + " is not active",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := ton_mocks.NewAPIClientWrapped(t)
+			mockSetup_TestSimulator(client, tt.account)
+
+			simulator, err := mcmston.NewSimulator(mcmston.SimulatorOpts{
+				Encoder:  &mcmston.Encoder{ChainSelector: chaintest.Chain7Selector},
+				Client:   client,
+				Emulator: tt.emulator,
+				Sender:   sender,
+				Amount:   tlb.MustFromTON("0.1"),
+			})
+			require.NoError(t, err)
+
+			err = simulator.SimulateSetRoot(t.Context(), "", types.ChainMetadata{MCMAddress: mcmsAddr}, nil,
+				[32]byte{1, 2, 3}, 4130013354, []types.Signature{makeTestSignature("0xabcdef1234567890")})
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				if tt.wantLogs != nil {
+					var simErr mcmston.SimulateError
+					require.ErrorAs(t, err, &simErr)
+					require.Equal(t, int32(107), simErr.ExitCode())
+					require.Equal(t, tt.wantLogs, simErr.Logs())
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, sender.String(), tt.emulator.msg.SrcAddr.String())
+			require.Equal(t, mcmsAddr, tt.emulator.msg.DstAddr.String())
+			require.Equal(t, tlb.MustFromTON("0.1"), tt.emulator.msg.Amount)
+			require.Equal(t, uint32(1700000000), tt.emulator.now)
+		})
+	}
+}
+
+func TestSimulator_SimulateOperation(t *testing.T) {
+	t.Parallel()
+
+	mcmsAddr := "EQADa3W6G0nSiTV4a6euRA42fU9QxSEnb-WeDpcrtWzA2jM8"
+	targetAddr := "EQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAB7-7"
+	body := cell.BeginCell().MustStoreUInt(0x12345678, 32).EndCell()
+
+	client := ton_mocks.NewAPIClientWrapped(t)
+	mockSetup_TestSimulator(client, &tlb.Account{IsActive: true})
+	emulator := &fakeEmulator{result: mcmston.EmulationResult{Success: true}}
+
+	simulator, err := mcmston.NewSimulator(mcmston.SimulatorOpts{
+		Encoder:  &mcmston.Encoder{ChainSelector: chaintest.Chain7Selector},
+		Client:   client,
+		Emulator: emulator,
+		Sender:   address.MustParseAddr(targetAddr),
+	})
+	require.NoError(t, err)
+
+	err = simulator.SimulateOperation(t.Context(), types.ChainMetadata{MCMAddress: mcmsAddr}, types.Operation{
+		ChainSelector: chaintest.Chain7Selector,
+		Transaction: types.Transaction{
+			To:               targetAddr,
+			Data:             body.ToBOC(),
+			AdditionalFields: json.RawMessage(`{"value": 1000}`),
+		},
+	})
+	require.NoError(t, err)
+
+	// The target receives the message from MCMS
+	require.Equal(t, mcmsAddr, emulator.msg.SrcAddr.String())
+	require.Equal(t, targetAddr, emulator.msg.DstAddr.String())
+	require.Equal(t, tlb.FromNanoTON(big.NewInt(1000)), emulator.msg.Amount)
+	require.Equal(t, body.Hash(), emulator.msg.Body.Hash())
+
+	err = simulator.SimulateOperation(t.Context(), types.ChainMetadata{MCMAddress: mcmsAddr}, types.Operation{
+		Transaction: types.Transaction{To: targetAddr, Data: []byte{1, 2, 3}},
+	})
+	require.ErrorContains(t, err, "invalid cell BOC data")
+}
@@ -0,0 +1,24 @@
+package sdk
+
+import "context"
+
+// TransactionState is the on-chain state of a submitted transaction.
+type TransactionState string
+
+const (
+	// TransactionStatePending means the transaction is known to the chain but not included yet.
+	TransactionStatePending TransactionState = "pending"
+	// TransactionStateSucceeded means the transaction was included and succeeded.
+	TransactionStateSucceeded TransactionState = "succeeded"
+	// TransactionStateFailed means the transaction was included but reverted.
+	TransactionStateFailed TransactionState = "failed"
+	// TransactionStateNotFound means the transaction is unknown to the chain, e.g. because it was
+	// dropped from the mempool or replaced.
+	TransactionStateNotFound TransactionState = "notFound"
+)
+
+// TransactionChecker is an interface for looking up the state of a transaction submitted to a
+// chain, by the hash returned in its TransactionResult.
+type TransactionChecker interface {
+	TransactionState(ctx context.Context, txHash string) (TransactionState, error)
+}
@@ -6,6 +6,7 @@ import (
 	"math"
 
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/usbwallet/trezor"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -118,3 +119,24 @@ func (w *ledgerDriver) ledgerSignPersonalMessage(derivationPath []uint32, messag
 	signature := append(reply[1:], reply[0])
 	return signature, nil
 }
+
+func (w *trezorDriver) SignPersonalMessage(path accounts.DerivationPath, message []byte) ([]byte, error) {
+	if w.device == nil {
+		return nil, accounts.ErrWalletClosed
+	}
+	return w.trezorSignPersonalMessage(path, message)
+}
+
+func (w *trezorDriver) trezorSignPersonalMessage(derivationPath []uint32, message []byte) ([]byte, error) {
+	// The Trezor applies the EIP 191 prefix itself and waits for the user to confirm
+	response := new(trezor.EthereumMessageSignature)
+	if _, err := w.trezorExchange(&trezor.EthereumSignMessage{AddressN: derivationPath, Message: message}, response); err != nil {
+		return nil, err
+	}
+	// Extract the Ethereum signature and do a sanity validation
+	signature := response.GetSignature()
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("reply lacks signature: reply %v", signature)
+	}
+	return signature, nil
+}
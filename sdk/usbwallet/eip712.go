@@ -0,0 +1,332 @@
+package usbwallet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Add support for the full EIP 712 implementation of the Ledger Ethereum app, in which the
+// device receives the type definitions and the field values of the typed data instead of their
+// hashes, so that it can display each field before signing.
+// https://github.com/LedgerHQ/app-ethereum/blob/develop/doc/ethapp.adoc#eip712-send-struct-definition
+const (
+	ledgerOpEIP712StructDefinition     ledgerOpcode = 0x1a // Sends the definition of a struct type
+	ledgerOpEIP712StructImplementation ledgerOpcode = 0x1c // Sends the values of a struct
+
+	ledgerP1EIP712CompleteSend ledgerParam1 = 0x00 // Last chunk of a field value
+	ledgerP1EIP712PartialSend  ledgerParam1 = 0x01 // Chunk of a field value followed by others
+
+	ledgerP2EIP712StructName  ledgerParam2 = 0x00 // Name of the struct being defined or implemented
+	ledgerP2EIP712StructField ledgerParam2 = 0xff // Field definition or value of the current struct
+	ledgerP2EIP712FullSign    ledgerParam2 = 0x01 // Sign the typed data sent beforehand
+)
+
+// EIP 712 field type identifiers of the Ledger struct definitions.
+const (
+	ledgerEIP712TypeInt          byte = 1
+	ledgerEIP712TypeUint         byte = 2
+	ledgerEIP712TypeAddress      byte = 3
+	ledgerEIP712TypeBool         byte = 4
+	ledgerEIP712TypeString       byte = 5
+	ledgerEIP712TypeFixedBytes   byte = 6
+	ledgerEIP712TypeDynamicBytes byte = 7
+
+	ledgerEIP712TypeSizeFlag byte = 0x40 // Set when the type is followed by its size in bytes
+)
+
+// ledgerEIP712ChunkSize is the maximum size of a field value chunk.
+const ledgerEIP712ChunkSize = 255
+
+var (
+	eip712SizedType  = regexp.MustCompile(`^(int|uint|bytes)(\d*)$`)
+	eip712StructType = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+)
+
+// TypedDataWallet is implemented by the wallets able to sign EIP 712 typed data while displaying
+// its fields, as opposed to signing the domain and message hashes only.
+type TypedDataWallet interface {
+	accounts.Wallet
+
+	// SignTypedData requests the signature of the typed data, returned as [R || S || V] with V
+	// being 27 or 28.
+	SignTypedData(account accounts.Account, typedData apitypes.TypedData) ([]byte, error)
+}
+
+var _ TypedDataWallet = &wallet{}
+
+// SignTypedData implements TypedDataWallet.
+func (w *wallet) SignTypedData(account accounts.Account, typedData apitypes.TypedData) ([]byte, error) {
+	w.stateLock.RLock() // Comms have own mutex, this is for the state fields
+	defer w.stateLock.RUnlock()
+
+	// If the wallet is closed, abort
+	if w.device == nil {
+		return nil, accounts.ErrWalletClosed
+	}
+	// Make sure the requested account is contained within
+	path, ok := w.paths[account.Address]
+	if !ok {
+		return nil, accounts.ErrUnknownAccount
+	}
+	// All infos gathered and metadata checks out, request signing
+	<-w.commsLock
+	defer func() { w.commsLock <- struct{}{} }()
+
+	// Ensure the device isn't screwed with while user confirmation is pending
+	// TODO(karalabe): remove if hotplug lands on Windows
+	w.hub.commsLock.Lock()
+	w.hub.commsPend++
+	w.hub.commsLock.Unlock()
+
+	defer func() {
+		w.hub.commsLock.Lock()
+		w.hub.commsPend--
+		w.hub.commsLock.Unlock()
+	}()
+	return w.driver.SignTypedData(path, typedData)
+}
+
+func (w *ledgerDriver) SignTypedData(path accounts.DerivationPath, typedData apitypes.TypedData) ([]byte, error) {
+	// If the Ethereum app doesn't run, abort
+	if w.offline() {
+		return nil, accounts.ErrWalletClosed
+	}
+	// Ensure the wallet is capable of displaying the typed data
+	if w.version[0] < 1 || (w.version[0] == 1 && w.version[1] < 10) {
+		//lint:ignore ST1005 brand name displayed on the console
+		return nil, fmt.Errorf("version error: Ledger version >= 1.10.0 required for EIP-712 clear signing (found version v%d.%d.%d)", w.version[0], w.version[1], w.version[2])
+	}
+	return w.ledgerSignTypedData(path, typedData)
+}
+
+func (w *ledgerDriver) ledgerSignTypedData(derivationPath []uint32, typedData apitypes.TypedData) ([]byte, error) {
+	// Send the definitions of the domain and of the message struct types, along with the
+	// struct types they depend on
+	for _, name := range typedData.Dependencies(typedData.PrimaryType, []string{"EIP712Domain"}) {
+		if err := w.ledgerSendStructDefinition(name, typedData.Types[name]); err != nil {
+			return nil, err
+		}
+	}
+	// Send the values of the domain, then of the message
+	if err := w.ledgerSendStructImplementation(typedData, "EIP712Domain", typedData.Domain.Map()); err != nil {
+		return nil, err
+	}
+	if err := w.ledgerSendStructImplementation(typedData, typedData.PrimaryType, typedData.Message); err != nil {
+		return nil, err
+	}
+	// Flatten the derivation path into the Ledger request and sign
+	path := make([]byte, 1+4*len(derivationPath))
+	path[0] = byte(len(derivationPath))
+	for i, component := range derivationPath {
+		binary.BigEndian.PutUint32(path[1+4*i:], component)
+	}
+	reply, err := w.ledgerExchange(ledgerOpSignTypedMessage, ledgerP1InitTypedMessageData, ledgerP2EIP712FullSign, path)
+	if err != nil {
+		return nil, err
+	}
+	// Extract the Ethereum signature and do a sanity validation
+	if len(reply) != crypto.SignatureLength {
+		return nil, errors.New("reply lacks signature")
+	}
+	signature := append(reply[1:], reply[0])
+	return signature, nil
+}
+
+// SignTypedData is not supported by the Trezor, whose messages only include the hashes of the
+// domain and of the message.
+func (w *trezorDriver) SignTypedData(path accounts.DerivationPath, typedData apitypes.TypedData) ([]byte, error) {
+	return nil, accounts.ErrNotSupported
+}
+
+// ledgerSendStructDefinition sends the name and the field types of a struct type.
+func (w *ledgerDriver) ledgerSendStructDefinition(name string, fields []apitypes.Type) error {
+	if _, err := w.ledgerExchange(ledgerOpEIP712StructDefinition, 0, ledgerP2EIP712StructName, []byte(name)); err != nil {
+		return err
+	}
+	for _, field := range fields {
+		definition, err := ledgerEIP712FieldDefinition(field)
+		if err != nil {
+			return err
+		}
+		if _, err := w.ledgerExchange(ledgerOpEIP712StructDefinition, 0, ledgerP2EIP712StructField, definition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ledgerSendStructImplementation sends the values of the fields of a struct, recursing into the
+// fields of struct types.
+func (w *ledgerDriver) ledgerSendStructImplementation(typedData apitypes.TypedData, name string, data map[string]interface{}) error {
+	if _, err := w.ledgerExchange(ledgerOpEIP712StructImplementation, 0, ledgerP2EIP712StructName, []byte(name)); err != nil {
+		return err
+	}
+	return w.ledgerSendStructFields(typedData, name, data)
+}
+
+func (w *ledgerDriver) ledgerSendStructFields(typedData apitypes.TypedData, name string, data map[string]interface{}) error {
+	for _, field := range typedData.Types[name] {
+		value, ok := data[field.Name]
+		if !ok {
+			return fmt.Errorf("missing value of field %s of %s", field.Name, name)
+		}
+		if _, ok := typedData.Types[field.Type]; ok {
+			nested, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("invalid value of field %s of %s", field.Name, name)
+			}
+			if err := w.ledgerSendStructFields(typedData, field.Type, nested); err != nil {
+				return err
+			}
+			continue
+		}
+		encoded, err := ledgerEIP712FieldValue(typedData, field.Type, value)
+		if err != nil {
+			return fmt.Errorf("invalid value of field %s of %s: %w", field.Name, name, err)
+		}
+		if err := w.ledgerSendFieldValue(encoded); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ledgerSendFieldValue sends a field value prefixed by its length, split in chunks.
+func (w *ledgerDriver) ledgerSendFieldValue(value []byte) error {
+	if len(value) > math.MaxUint16 {
+		return fmt.Errorf("field value length %d exceeds uint16 max", len(value))
+	}
+	payload := binary.BigEndian.AppendUint16(nil, uint16(len(value))) //nolint:gosec // G115: overflow checked above
+	payload = append(payload, value...)
+
+	for len(payload) > 0 {
+		chunk, op := payload, ledgerP1EIP712CompleteSend
+		if len(chunk) > ledgerEIP712ChunkSize {
+			chunk, op = payload[:ledgerEIP712ChunkSize], ledgerP1EIP712PartialSend
+		}
+		if _, err := w.ledgerExchange(ledgerOpEIP712StructImplementation, op, ledgerP2EIP712StructField, chunk); err != nil {
+			return err
+		}
+		payload = payload[len(chunk):]
+	}
+	return nil
+}
+
+// ledgerEIP712FieldDefinition encodes the type and the name of a struct field. Arrays are not
+// supported.
+func ledgerEIP712FieldDefinition(field apitypes.Type) ([]byte, error) {
+	var definition []byte
+
+	switch field.Type {
+	case "address":
+		definition = []byte{ledgerEIP712TypeAddress}
+	case "bool":
+		definition = []byte{ledgerEIP712TypeBool}
+	case "string":
+		definition = []byte{ledgerEIP712TypeString}
+	case "bytes":
+		definition = []byte{ledgerEIP712TypeDynamicBytes}
+	default:
+		kind, size, ok := eip712SizedTypeOf(field.Type)
+		switch {
+		case ok && kind == "int":
+			definition = []byte{ledgerEIP712TypeSizeFlag | ledgerEIP712TypeInt, size}
+		case ok && kind == "uint":
+			definition = []byte{ledgerEIP712TypeSizeFlag | ledgerEIP712TypeUint, size}
+		case ok:
+			definition = []byte{ledgerEIP712TypeSizeFlag | ledgerEIP712TypeFixedBytes, size}
+		case eip712StructType.MatchString(field.Type) && len(field.Type) <= math.MaxUint8:
+			// Any other type is either a struct type or an array
+			definition = append([]byte{0, byte(len(field.Type))}, field.Type...)
+		default:
+			return nil, fmt.Errorf("unsupported EIP-712 type %s of field %s", field.Type, field.Name)
+		}
+	}
+	if len(field.Name) > math.MaxUint8 {
+		return nil, fmt.Errorf("EIP-712 field name %s too long", field.Name)
+	}
+	definition = append(definition, byte(len(field.Name)))
+	return append(definition, field.Name...), nil
+}
+
+// ledgerEIP712FieldValue encodes the value of a field of an atomic or dynamic type: integers
+// in big endian, with the fewest bytes for unsigned ones, addresses and fixed bytes as is, and
+// strings and dynamic bytes unhashed.
+func ledgerEIP712FieldValue(typedData apitypes.TypedData, typ string, value interface{}) ([]byte, error) {
+	switch typ {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %T", value)
+		}
+		return []byte(s), nil
+	case "bytes":
+		switch v := value.(type) {
+		case []byte:
+			return v, nil
+		case hexutil.Bytes:
+			return v, nil
+		case string:
+			return hexutil.Decode(v)
+		}
+		return nil, fmt.Errorf("expected bytes, got %T", value)
+	}
+	// Reuse the validation and encoding of the typed data hashing for the atomic types
+	encoded, err := typedData.EncodePrimitiveValue(typ, value, 1)
+	if err != nil {
+		return nil, err
+	}
+	switch kind, size, _ := eip712SizedTypeOf(typ); {
+	case typ == "address":
+		return encoded[common.HashLength-common.AddressLength:], nil
+	case typ == "bool":
+		return encoded[common.HashLength-1:], nil
+	case kind == "bytes":
+		return encoded[:size], nil
+	case kind == "int":
+		return encoded[common.HashLength-int(size):], nil
+	case kind == "uint":
+		encoded = encoded[common.HashLength-int(size):]
+		for len(encoded) > 1 && encoded[0] == 0 {
+			encoded = encoded[1:]
+		}
+		return encoded, nil
+	}
+	return nil, fmt.Errorf("unsupported EIP-712 type %s", typ)
+}
+
+// eip712SizedTypeOf returns the kind and the size in bytes of an integer or fixed bytes type.
+func eip712SizedTypeOf(typ string) (string, byte, bool) {
+	match := eip712SizedType.FindStringSubmatch(typ)
+	if match == nil {
+		return "", 0, false
+	}
+	if match[2] == "" {
+		// int and uint are aliases of int256 and uint256
+		return match[1], common.HashLength, match[1] != "bytes"
+	}
+	size, err := strconv.Atoi(match[2])
+	if err != nil {
+		return "", 0, false
+	}
+	if match[1] != "bytes" {
+		if size%8 != 0 {
+			return "", 0, false
+		}
+		size /= 8
+	}
+	if size < 1 || size > common.HashLength {
+		return "", 0, false
+	}
+	return match[1], byte(size), true
+}
@@ -0,0 +1,359 @@
+package usbwallet
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLedger is a Ledger Ethereum app speaking the HID transport, which rebuilds the typed data
+// from the struct definitions and implementations it receives and signs its EIP 712 hash.
+type fakeLedger struct {
+	key *ecdsa.PrivateKey
+
+	request []byte       // APDU being received
+	size    int          // Size of the APDU being received
+	reply   bytes.Buffer // Reply chunks not read yet
+	apdus   [][]byte     // APDUs received
+
+	types   apitypes.Types
+	current string                            // Struct type being defined
+	roots   []string                          // Struct types implemented
+	values  map[string]map[string]interface{} // Values of the implemented structs
+	leaves  []fakeLedgerLeaf                  // Fields waiting for a value
+	partial []byte                            // Value being received in chunks
+}
+
+type fakeLedgerLeaf struct {
+	data map[string]interface{}
+	name string
+	typ  string
+}
+
+func newFakeLedger(t *testing.T) *fakeLedger {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	return &fakeLedger{key: key, types: apitypes.Types{}, values: map[string]map[string]interface{}{}}
+}
+
+func (l *fakeLedger) Read(b []byte) (int, error) {
+	return l.reply.Read(b)
+}
+
+func (l *fakeLedger) Write(chunk []byte) (int, error) {
+	if binary.BigEndian.Uint16(chunk[3:5]) == 0 {
+		l.size = int(binary.BigEndian.Uint16(chunk[5:7]))
+		l.request = append([]byte{}, chunk[7:]...)
+	} else {
+		l.request = append(l.request, chunk[5:]...)
+	}
+	if len(l.request) < l.size {
+		return len(chunk), nil
+	}
+	apdu := l.request[:l.size]
+	l.apdus = append(l.apdus, apdu)
+
+	reply, err := l.handle(apdu[1], apdu[2], apdu[3], apdu[5:5+int(apdu[4])])
+	status := []byte{0x90, 0x00}
+	if err != nil {
+		reply, status = nil, []byte{0x6a, 0x80}
+	}
+	l.send(append(reply, status...))
+
+	return len(chunk), nil
+}
+
+// send frames the reply into 64 byte chunks.
+func (l *fakeLedger) send(payload []byte) {
+	payload = append(binary.BigEndian.AppendUint16(nil, uint16(len(payload))), payload...)
+	for seq := uint16(0); len(payload) > 0; seq++ {
+		chunk := make([]byte, 64)
+		copy(chunk, []byte{0x01, 0x01, 0x05})
+		binary.BigEndian.PutUint16(chunk[3:], seq)
+		n := copy(chunk[5:], payload)
+		payload = payload[n:]
+		l.reply.Write(chunk)
+	}
+}
+
+func (l *fakeLedger) handle(ins, p1, p2 byte, data []byte) ([]byte, error) {
+	switch {
+	case ins == byte(ledgerOpEIP712StructDefinition) && p2 == byte(ledgerP2EIP712StructName):
+		l.current = string(data)
+		l.types[l.current] = []apitypes.Type{}
+	case ins == byte(ledgerOpEIP712StructDefinition) && p2 == byte(ledgerP2EIP712StructField):
+		field, err := decodeFieldDefinition(data)
+		if err != nil {
+			return nil, err
+		}
+		l.types[l.current] = append(l.types[l.current], field)
+	case ins == byte(ledgerOpEIP712StructImplementation) && p2 == byte(ledgerP2EIP712StructName):
+		name := string(data)
+		l.roots = append(l.roots, name)
+		l.values[name] = map[string]interface{}{}
+		l.leaves = l.flatten(name, l.values[name])
+	case ins == byte(ledgerOpEIP712StructImplementation) && p2 == byte(ledgerP2EIP712StructField):
+		l.partial = append(l.partial, data...)
+		if p1 == byte(ledgerP1EIP712PartialSend) {
+			return nil, nil
+		}
+		value := l.partial[2:]
+		if int(binary.BigEndian.Uint16(l.partial)) != len(value) || len(l.leaves) == 0 {
+			return nil, fmt.Errorf("unexpected value %x", l.partial)
+		}
+		l.partial = nil
+		leaf := l.leaves[0]
+		l.leaves = l.leaves[1:]
+		leaf.data[leaf.name] = decodeFieldValue(leaf.typ, value)
+	case ins == byte(ledgerOpSignTypedMessage) && p2 == byte(ledgerP2EIP712FullSign):
+		return l.sign()
+	default:
+		return nil, fmt.Errorf("unexpected instruction %x", ins)
+	}
+	return nil, nil
+}
+
+// flatten returns the atomic fields of a struct in the order of their values.
+func (l *fakeLedger) flatten(name string, data map[string]interface{}) []fakeLedgerLeaf {
+	var leaves []fakeLedgerLeaf
+	for _, field := range l.types[name] {
+		if _, ok := l.types[field.Type]; ok {
+			nested := map[string]interface{}{}
+			data[field.Name] = nested
+			leaves = append(leaves, l.flatten(field.Type, nested)...)
+			continue
+		}
+		leaves = append(leaves, fakeLedgerLeaf{data: data, name: field.Name, typ: field.Type})
+	}
+	return leaves
+}
+
+func (l *fakeLedger) sign() ([]byte, error) {
+	if len(l.roots) != 2 || l.roots[0] != "EIP712Domain" || len(l.leaves) != 0 {
+		return nil, fmt.Errorf("unexpected implementations %v", l.roots)
+	}
+	domainValues := l.values["EIP712Domain"]
+	domain := apitypes.TypedDataDomain{}
+	domain.Name, _ = domainValues["name"].(string)
+	domain.Version, _ = domainValues["version"].(string)
+	domain.VerifyingContract, _ = domainValues["verifyingContract"].(string)
+	if chainID, ok := domainValues["chainId"].(*big.Int); ok {
+		domain.ChainId = (*math.HexOrDecimal256)(chainID)
+	}
+	hash, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types:       l.types,
+		PrimaryType: l.roots[1],
+		Domain:      domain,
+		Message:     l.values[l.roots[1]],
+	})
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(hash, l.key)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{sig[64] + 27}, sig[:64]...), nil
+}
+
+func decodeFieldDefinition(data []byte) (apitypes.Type, error) {
+	desc, offset := data[0], 1
+	var typ string
+	switch desc & 0x0f {
+	case 0:
+		n := int(data[1])
+		typ, offset = string(data[2:2+n]), 2+n
+	case ledgerEIP712TypeAddress:
+		typ = "address"
+	case ledgerEIP712TypeBool:
+		typ = "bool"
+	case ledgerEIP712TypeString:
+		typ = "string"
+	case ledgerEIP712TypeDynamicBytes:
+		typ = "bytes"
+	}
+	if desc&ledgerEIP712TypeSizeFlag != 0 {
+		size := int(data[offset])
+		offset++
+		switch desc & 0x0f {
+		case ledgerEIP712TypeInt:
+			typ = fmt.Sprintf("int%d", size*8)
+		case ledgerEIP712TypeUint:
+			typ = fmt.Sprintf("uint%d", size*8)
+		case ledgerEIP712TypeFixedBytes:
+			typ = fmt.Sprintf("bytes%d", size)
+		}
+	}
+	if typ == "" || int(data[offset]) != len(data)-offset-1 {
+		return apitypes.Type{}, fmt.Errorf("unexpected field definition %x", data)
+	}
+	return apitypes.Type{Name: string(data[offset+1:]), Type: typ}, nil
+}
+
+func decodeFieldValue(typ string, value []byte) interface{} {
+	switch {
+	case typ == "string":
+		return string(value)
+	case typ == "address":
+		return common.BytesToAddress(value).Hex()
+	case typ == "bool":
+		return value[0] == 1
+	case strings.HasPrefix(typ, "uint"):
+		return new(big.Int).SetBytes(value)
+	default:
+		return hexutil.Bytes(value)
+	}
+}
+
+func testTypedData(description string) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Proposal": {
+				{Name: "root", Type: "bytes32"},
+				{Name: "validUntil", Type: "uint32"},
+				{Name: "summary", Type: "Summary"},
+			},
+			"Summary": {
+				{Name: "description", Type: "string"},
+				{Name: "overridePreviousRoot", Type: "bool"},
+				{Name: "payload", Type: "bytes"},
+			},
+		},
+		PrimaryType: "Proposal",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "ManyChainMultiSig",
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(1),
+			VerifyingContract: "0x00000000000000000000000000000000000000aa",
+		},
+		Message: apitypes.TypedDataMessage{
+			"root":       common.HexToHash("0x1234").Bytes(),
+			"validUntil": big.NewInt(1700000000),
+			"summary": map[string]interface{}{
+				"description":          description,
+				"overridePreviousRoot": true,
+				"payload":              "0xdeadbeef",
+			},
+		},
+	}
+}
+
+func TestLedgerDriver_SignTypedData(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		description string
+	}{
+		{
+			name:        "success: short values",
+			description: "upgrade the timelock",
+		},
+		{
+			name:        "success: values sent in chunks",
+			description: strings.Repeat("a long description ", 30),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			device := newFakeLedger(t)
+			drv := newLedgerDriver(log.New()).(*ledgerDriver)
+			drv.device, drv.version = device, [3]byte{1, 10, 0}
+
+			typedData := testTypedData(tt.description)
+			sig, err := drv.SignTypedData(accounts.DefaultBaseDerivationPath, typedData)
+			require.NoError(t, err)
+			require.Len(t, sig, crypto.SignatureLength)
+
+			hash, _, err := apitypes.TypedDataAndHash(typedData)
+			require.NoError(t, err)
+			sig[crypto.RecoveryIDOffset] -= 27
+			pubKey, err := crypto.SigToPub(hash, sig)
+			require.NoError(t, err)
+			require.Equal(t, crypto.PubkeyToAddress(device.key.PublicKey), crypto.PubkeyToAddress(*pubKey))
+
+			// The signing request only carries the derivation path
+			last := device.apdus[len(device.apdus)-1]
+			require.Equal(t, []byte{0xe0, byte(ledgerOpSignTypedMessage), 0x00, byte(ledgerP2EIP712FullSign), 21}, last[:5])
+		})
+	}
+}
+
+func TestLedgerDriver_SignTypedData_Errors(t *testing.T) {
+	t.Parallel()
+
+	unsupported := testTypedData("")
+	unsupported.Types["Summary"] = append(unsupported.Types["Summary"], apitypes.Type{Name: "values", Type: "uint256[]"})
+	missing := testTypedData("")
+	delete(missing.Message, "validUntil")
+
+	tests := []struct {
+		name      string
+		version   [3]byte
+		typedData apitypes.TypedData
+		wantErr   string
+	}{
+		{
+			name:      "failure: app too old",
+			version:   [3]byte{1, 9, 19},
+			typedData: testTypedData(""),
+			wantErr:   "version error: Ledger version >= 1.10.0 required for EIP-712 clear signing (found version v1.9.19)",
+		},
+		{
+			name:      "failure: arrays are not supported",
+			version:   [3]byte{1, 10, 0},
+			typedData: unsupported,
+			wantErr:   "unsupported EIP-712 type uint256[] of field values",
+		},
+		{
+			name:      "failure: missing value",
+			version:   [3]byte{1, 10, 0},
+			typedData: missing,
+			wantErr:   "missing value of field validUntil of Proposal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			drv := newLedgerDriver(log.New()).(*ledgerDriver)
+			drv.device, drv.version = newFakeLedger(t), tt.version
+
+			_, err := drv.SignTypedData(accounts.DefaultBaseDerivationPath, tt.typedData)
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestTrezorDriver_SignTypedData(t *testing.T) {
+	t.Parallel()
+
+	_, err := (&trezorDriver{}).SignTypedData(accounts.DefaultBaseDerivationPath, testTypedData(""))
+	require.ErrorIs(t, err, accounts.ErrNotSupported)
+}
@@ -0,0 +1,103 @@
+package usbwallet
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/karalabe/hid"
+)
+
+// DefaultTrezorEmulatorAddr is the UDP address the Trezor emulator listens on by default.
+const DefaultTrezorEmulatorAddr = "127.0.0.1:21324"
+
+// Product identifiers reported for the emulator, matching the Trezor WebUSB hub filters.
+const (
+	trezorEmulatorVendorID  = 0x1209
+	trezorEmulatorProductID = 0x53c1
+	trezorEmulatorUsageID   = 0xffff
+)
+
+// NewTrezorEmulatorHub creates a new hardware wallet manager for a Trezor emulator listening
+// on the UDP address addr, as started by `trezor-emu` or the trezor-user-env images. The
+// emulator exchanges the same 64 byte packets as a physical device, one per datagram.
+func NewTrezorEmulatorHub(addr string) (*Hub, error) {
+	if _, err := net.ResolveUDPAddr("udp", addr); err != nil {
+		return nil, fmt.Errorf("invalid emulator address %q: %w", addr, err)
+	}
+	hub := &Hub{
+		scheme:     TrezorScheme,
+		vendorID:   trezorEmulatorVendorID,
+		productIDs: []uint16{trezorEmulatorProductID},
+		usageID:    trezorEmulatorUsageID,
+		makeDriver: newTrezorDriver,
+		enumerate: func(uint16, uint16) ([]hid.DeviceInfo, error) {
+			return []hid.DeviceInfo{{
+				Path:      addr,
+				VendorID:  trezorEmulatorVendorID,
+				ProductID: trezorEmulatorProductID,
+				UsagePage: trezorEmulatorUsageID,
+				Product:   "Trezor Emulator",
+			}}, nil
+		},
+		openDevice: openUDPDevice,
+		quit:       make(chan chan error),
+	}
+	hub.refreshWallets()
+	return hub, nil
+}
+
+// udpDevice is a hid.Device exchanging packets with an emulator over UDP.
+type udpDevice struct {
+	conn net.Conn
+}
+
+var _ hid.Device = (*udpDevice)(nil)
+
+// openUDPDevice connects to the emulator at the path of info.
+func openUDPDevice(info hid.DeviceInfo) (hid.Device, error) {
+	conn, err := net.Dial("udp", info.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to emulator: %w", err)
+	}
+	return &udpDevice{conn: conn}, nil
+}
+
+// Close closes the connection to the emulator.
+func (d *udpDevice) Close() error {
+	return d.conn.Close()
+}
+
+// Write sends a packet to the emulator.
+func (d *udpDevice) Write(b []byte) (int, error) {
+	return d.conn.Write(b)
+}
+
+// Read retrieves the next packet from the emulator, blocking until one arrives.
+func (d *udpDevice) Read(b []byte) (int, error) {
+	return d.ReadTimeout(b, 0)
+}
+
+// ReadTimeout retrieves the next packet from the emulator, waiting at most timeout
+// milliseconds. A timeout of 0 means blocking.
+func (d *udpDevice) ReadTimeout(b []byte, timeout int) (int, error) {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(time.Duration(timeout) * time.Millisecond)
+	}
+	if err := d.conn.SetReadDeadline(deadline); err != nil {
+		return 0, err
+	}
+	return d.conn.Read(b)
+}
+
+// GetFeatureReport is not supported by the emulator.
+func (d *udpDevice) GetFeatureReport([]byte) (int, error) {
+	return 0, errors.New("feature reports are not supported by the emulator")
+}
+
+// SendFeatureReport is not supported by the emulator.
+func (d *udpDevice) SendFeatureReport([]byte) (int, error) {
+	return 0, errors.New("feature reports are not supported by the emulator")
+}
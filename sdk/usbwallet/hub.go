@@ -32,6 +32,9 @@ import (
 // LedgerScheme is the protocol scheme prefixing account and wallet URLs.
 const LedgerScheme = "ledger"
 
+// TrezorScheme is the protocol scheme prefixing account and wallet URLs.
+const TrezorScheme = "trezor"
+
 // refreshCycle is the maximum time between wallet refreshes (if USB hotplug
 // notifications don't work).
 const refreshCycle = time.Second
@@ -49,6 +52,9 @@ type Hub struct {
 	endpointID int                     // USB endpoint identifier used for non-macOS device discovery
 	makeDriver func(log.Logger) driver // Factory method to construct a vendor specific driver
 
+	enumerate  func(vendorID uint16, productID uint16) ([]hid.DeviceInfo, error) // Device discovery, replaced for emulators
+	openDevice func(info hid.DeviceInfo) (hid.Device, error)                     // Device connection, replaced for emulators
+
 	refreshed   time.Time               // Time instance when the list of wallets was last refreshed
 	wallets     []accounts.Wallet       // List of USB wallet devices currently tracking
 	updateFeed  event.Feed              // Event feed to notify wallet additions/removals
@@ -89,6 +95,17 @@ func NewLedgerHub() (*Hub, error) {
 	}, 0xffa0, 0, newLedgerDriver)
 }
 
+// NewTrezorHubWithHID creates a new hardware wallet manager for Trezor devices.
+func NewTrezorHubWithHID() (*Hub, error) {
+	return newHub(TrezorScheme, 0x534c, []uint16{0x0001 /* Trezor HID */}, 0xff00, 0, newTrezorDriver)
+}
+
+// NewTrezorHubWithWebUSB creates a new hardware wallet manager for Trezor devices with
+// firmware version > 1.8.0
+func NewTrezorHubWithWebUSB() (*Hub, error) {
+	return newHub(TrezorScheme, 0x1209, []uint16{0x53c1 /* Trezor WebUSB */}, 0xffff /* No usage id on webusb, don't match unset (0) */, 0, newTrezorDriver)
+}
+
 // newHub creates a new hardware wallet manager for generic USB devices.
 func newHub(scheme string, vendorID uint16, productIDs []uint16, usageID uint16, endpointID int, makeDriver func(log.Logger) driver) (*Hub, error) {
 	if !hid.Supported() {
@@ -101,6 +118,8 @@ func newHub(scheme string, vendorID uint16, productIDs []uint16, usageID uint16,
 		usageID:    usageID,
 		endpointID: endpointID,
 		makeDriver: makeDriver,
+		enumerate:  hid.Enumerate,
+		openDevice: func(info hid.DeviceInfo) (hid.Device, error) { return info.Open() },
 		quit:       make(chan chan error),
 	}
 	hub.refreshWallets()
@@ -152,7 +171,7 @@ func (hub *Hub) refreshWallets() {
 			return
 		}
 	}
-	infos, err := hid.Enumerate(hub.vendorID, 0)
+	infos, err := hub.enumerate(hub.vendorID, 0)
 	if err != nil {
 		failcount := hub.enumFails.Add(1)
 		if runtime.GOOS == "linux" {
@@ -0,0 +1,160 @@
+package usbwallet
+
+import (
+	"net"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/usbwallet/trezor"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/karalabe/hid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/trezoremu"
+)
+
+func TestTrezorEmulatorHub_SignText(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	emulator := trezoremu.New(t, key)
+
+	hub, err := NewTrezorEmulatorHub(emulator.Addr())
+	require.NoError(t, err)
+	wallets := hub.Wallets()
+	require.Len(t, wallets, 1)
+	require.Equal(t, accounts.URL{Scheme: TrezorScheme, Path: emulator.Addr()}, wallets[0].URL())
+
+	wallet := wallets[0]
+	require.NoError(t, wallet.Open(""))
+	defer wallet.Close()
+
+	status, err := wallet.Status()
+	require.NoError(t, err)
+	require.Equal(t, "Trezor v2.8.0 'emulator' online", status)
+
+	path := accounts.DefaultBaseDerivationPath
+	account, err := wallet.Derive(path, true)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), account.Address)
+
+	message := crypto.Keccak256([]byte("signing message"))
+	sig, err := wallet.SignText(account, message)
+	require.NoError(t, err)
+	require.Len(t, sig, crypto.SignatureLength)
+
+	sig[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(accounts.TextHash(message), sig)
+	require.NoError(t, err)
+	require.Equal(t, account.Address, crypto.PubkeyToAddress(*pubKey))
+
+	paths, messages := emulator.SignRequests()
+	require.Equal(t, []accounts.DerivationPath{path}, paths)
+	require.Equal(t, [][]byte{message}, messages)
+}
+
+func TestTrezorEmulatorHub_Open(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		opts    []trezoremu.Option
+		pin     string
+		wantErr string
+	}{
+		{
+			name: "success: unlocked",
+		},
+		{
+			name: "success: unlocked with PIN",
+			opts: []trezoremu.Option{trezoremu.WithPIN("1234")},
+			pin:  "1234",
+		},
+		{
+			name:    "failure: wrong PIN",
+			opts:    []trezoremu.Option{trezoremu.WithPIN("1234")},
+			pin:     "4321",
+			wantErr: "trezor: PIN invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			emulator := trezoremu.New(t, key, tt.opts...)
+			hub, err := NewTrezorEmulatorHub(emulator.Addr())
+			require.NoError(t, err)
+			wallet := hub.Wallets()[0]
+			defer wallet.Close()
+
+			err = wallet.Open("")
+			if tt.pin != "" {
+				require.ErrorIs(t, err, ErrTrezorPINNeeded)
+				err = wallet.Open(tt.pin)
+			}
+
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTrezorEmulatorHub_Unreachable(t *testing.T) {
+	t.Parallel()
+
+	// Reserve a port and release it, so that nothing listens on it
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	addr := conn.LocalAddr().String()
+	require.NoError(t, conn.Close())
+
+	hub, err := NewTrezorEmulatorHub(addr)
+	require.NoError(t, err)
+	wallet := hub.Wallets()[0]
+	defer wallet.Close()
+
+	require.ErrorContains(t, wallet.Open(""), "connection refused")
+}
+
+func TestNewTrezorEmulatorHub_InvalidAddr(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTrezorEmulatorHub("not an address")
+	require.ErrorContains(t, err, `invalid emulator address "not an address"`)
+}
+
+func TestTrezorDriver_Exchange(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	emulator := trezoremu.New(t, key)
+
+	device, err := openUDPDevice(hid.DeviceInfo{Path: emulator.Addr()})
+	require.NoError(t, err)
+	defer device.Close()
+
+	drv := newTrezorDriver(log.New()).(*trezorDriver)
+	drv.device = device
+
+	// A reply of an unexpected type is reported
+	_, err = drv.trezorExchange(&trezor.Initialize{}, new(trezor.Success))
+	require.EqualError(t, err, "trezor: expected reply types [Success], got Features")
+
+	// Failures of the device are surfaced
+	_, err = drv.trezorExchange(&trezor.ButtonAck{}, new(trezor.Success))
+	require.EqualError(t, err, "trezor: Unexpected message")
+
+	// Signing requires an open device
+	_, err = (&trezorDriver{}).SignPersonalMessage(accounts.DefaultBaseDerivationPath, []byte("message"))
+	require.ErrorIs(t, err, accounts.ErrWalletClosed)
+}
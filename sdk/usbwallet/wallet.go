@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/karalabe/hid"
 )
 
@@ -71,6 +72,8 @@ type driver interface {
 	SignTypedMessage(path accounts.DerivationPath, messageHash []byte, domainHash []byte) ([]byte, error)
 
 	SignPersonalMessage(path accounts.DerivationPath, message []byte) ([]byte, error)
+
+	SignTypedData(path accounts.DerivationPath, typedData apitypes.TypedData) ([]byte, error)
 }
 
 // wallet represents the common functionality shared by all USB hardware
@@ -150,7 +153,7 @@ func (w *wallet) Open(passphrase string) error {
 	}
 	// Make sure the actual device connection is done only once
 	if w.device == nil {
-		device, err := w.info.Open()
+		device, err := w.hub.openDevice(w.info)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,27 @@
+package bindings
+
+import (
+	gethwrappers "github.com/smartcontractkit/mcms/sdk/evm/bindings"
+)
+
+// The zkSync Era contracts are compiled with zksolc but share the ABI of the EVM contracts, so the
+// types and callers of the EVM bindings are reused for them. Only deployments differ, as they go
+// through the zkSync bytecode above.
+
+type (
+	ManyChainMultiSigConfig       = gethwrappers.ManyChainMultiSigConfig
+	ManyChainMultiSigSigner       = gethwrappers.ManyChainMultiSigSigner
+	ManyChainMultiSigSignature    = gethwrappers.ManyChainMultiSigSignature
+	ManyChainMultiSigRootMetadata = gethwrappers.ManyChainMultiSigRootMetadata
+	ManyChainMultiSigOp           = gethwrappers.ManyChainMultiSigOp
+	RBACTimelockCall              = gethwrappers.RBACTimelockCall
+)
+
+var (
+	ManyChainMultiSigMetaData = gethwrappers.ManyChainMultiSigMetaData
+	RBACTimelockMetaData      = gethwrappers.RBACTimelockMetaData
+	CallProxyMetaData         = gethwrappers.CallProxyMetaData
+
+	NewManyChainMultiSigCaller = gethwrappers.NewManyChainMultiSigCaller
+	NewRBACTimelockCaller      = gethwrappers.NewRBACTimelockCaller
+)
@@ -0,0 +1,159 @@
+package zksync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/zksync-sdk/zksync2-go/accounts"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	zktypes "github.com/zksync-sdk/zksync2-go/types"
+	zkutils "github.com/zksync-sdk/zksync2-go/utils"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ Client = (*clients.Client)(nil)
+
+// Client is the subset of the zkSync Era JSON-RPC client used by the SDK. It is satisfied by
+// *clients.Client from github.com/zksync-sdk/zksync2-go/clients.
+type Client interface {
+	bind.ContractCaller
+
+	// CallContractL2 executes an EIP-712 message call without creating a transaction.
+	CallContractL2(ctx context.Context, msg zktypes.CallMsg, blockNumber *big.Int) ([]byte, error)
+	// EstimateGasL2 estimates the gas needed to execute an EIP-712 transaction.
+	EstimateGasL2(ctx context.Context, msg zktypes.CallMsg) (uint64, error)
+	// SendRawTransaction submits a signed and RLP encoded transaction.
+	SendRawTransaction(ctx context.Context, tx []byte) (common.Hash, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+}
+
+// TransactOpts holds the parameters used to build, sign and send EIP-712 transactions on zkSync Era.
+type TransactOpts struct {
+	// Signer signs the EIP-712 typed data of the transaction.
+	Signer accounts.Signer
+	// GasLimit is the gas limit of the transaction. It is estimated with eth_estimateGas when zero.
+	GasLimit uint64
+	// GasFeeCap is the maximum fee per gas. The suggested gas price is used when nil.
+	GasFeeCap *big.Int
+	// GasPerPubdata is the maximum gas per byte of pubdata. zkutils.DefaultGasPerPubdataLimit is
+	// used when nil.
+	GasPerPubdata *big.Int
+	// PaymasterParams optionally sponsors the transaction fee through a paymaster.
+	PaymasterParams *zktypes.PaymasterParams
+}
+
+// NewTransactOpts creates TransactOpts for the given signer, applying the gas and paymaster
+// settings of the chain metadata.
+func NewTransactOpts(signer accounts.Signer, metadata ChainMetadata) *TransactOpts {
+	return &TransactOpts{
+		Signer:          signer,
+		GasLimit:        metadata.GasLimit,
+		GasFeeCap:       metadata.GasPrice,
+		GasPerPubdata:   metadata.GasPerPubdata,
+		PaymasterParams: metadata.PaymasterParams(),
+	}
+}
+
+// transactor sends contract calls as signed EIP-712 (type 0x71) transactions.
+type transactor struct {
+	client Client
+	opts   *TransactOpts
+}
+
+// transact builds an EIP-712 transaction for the given calldata, estimating its gas through the
+// zkSync-aware eth_estimateGas when no limit is set, then signs and submits it.
+func (t transactor) transact(
+	ctx context.Context, to common.Address, data []byte, value *big.Int,
+) (types.TransactionResult, error) {
+	if t.opts == nil || t.opts.Signer == nil {
+		return types.TransactionResult{}, errors.New("zkSync transactor requires a signer")
+	}
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	from := t.opts.Signer.Address()
+
+	gasPerPubdata := t.opts.GasPerPubdata
+	if gasPerPubdata == nil {
+		gasPerPubdata = zkutils.DefaultGasPerPubdataLimit
+	}
+
+	gasFeeCap := t.opts.GasFeeCap
+	if gasFeeCap == nil {
+		var err error
+		gasFeeCap, err = t.client.SuggestGasPrice(ctx)
+		if err != nil {
+			return types.TransactionResult{}, fmt.Errorf("failed to suggest gas price: %w", err)
+		}
+	}
+
+	gasLimit := t.opts.GasLimit
+	if gasLimit == 0 {
+		var err error
+		gasLimit, err = t.client.EstimateGasL2(ctx, zktypes.CallMsg{
+			From:            from,
+			To:              &to,
+			Value:           value,
+			Data:            data,
+			GasFeeCap:       gasFeeCap,
+			GasPerPubdata:   gasPerPubdata,
+			PaymasterParams: t.opts.PaymasterParams,
+		})
+		if err != nil {
+			return types.TransactionResult{}, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+	}
+
+	nonce, err := t.client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to get nonce for %s: %w", from.Hex(), err)
+	}
+
+	tx := &zktypes.Transaction{
+		Nonce:           new(big.Int).SetUint64(nonce),
+		GasTipCap:       big.NewInt(0),
+		GasFeeCap:       gasFeeCap,
+		Gas:             new(big.Int).SetUint64(gasLimit),
+		To:              &to,
+		Value:           value,
+		Data:            hexutil.Bytes(data),
+		ChainID:         t.opts.Signer.ChainID(),
+		From:            &from,
+		GasPerPubdata:   gasPerPubdata,
+		PaymasterParams: t.opts.PaymasterParams,
+	}
+
+	typedData, err := tx.TypedData()
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to build EIP-712 typed data: %w", err)
+	}
+	signature, err := t.opts.Signer.SignTypedData(ctx, typedData)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	rawTx, err := tx.Encode(signature)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to encode transaction: %w", err)
+	}
+
+	hash, err := t.client.SendRawTransaction(ctx, rawTx)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to send transaction: %w", err)
+	}
+
+	return types.TransactionResult{
+		Hash:        hash.Hex(),
+		ChainFamily: chainsel.FamilyEVM,
+		RawData:     tx,
+	}, nil
+}
@@ -0,0 +1,226 @@
+package zksync
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/zksync-sdk/zksync2-go/accounts"
+	zktypes "github.com/zksync-sdk/zksync2-go/types"
+	zkutils "github.com/zksync-sdk/zksync2-go/utils"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms/sdk/zksync/mocks"
+)
+
+var (
+	zkSyncChainID = big.NewInt(int64(chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.EvmChainID))
+	testTxHash    = common.HexToHash("0xabc")
+)
+
+// newTestSigner returns an ECDSA signer for the zkSync Sepolia chain.
+func newTestSigner(t *testing.T) *accounts.ECDSASigner {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer, err := accounts.NewECDSASignerFromRawPrivateKey(crypto.FromECDSA(key), zkSyncChainID)
+	require.NoError(t, err)
+
+	return signer
+}
+
+// expectTransaction sets up the client expectations for a single transaction sent without a gas
+// limit or fee cap, and returns a function decoding the submitted EIP-712 transaction.
+func expectTransaction(t *testing.T, client *mocks.Client) func() *zktypes.Transaction {
+	t.Helper()
+
+	var rawTx []byte
+	client.EXPECT().SuggestGasPrice(mock.Anything).Return(big.NewInt(25_000_000), nil).Once()
+	client.EXPECT().EstimateGasL2(mock.Anything, mock.Anything).Return(uint64(500_000), nil).Once()
+	client.EXPECT().PendingNonceAt(mock.Anything, mock.Anything).Return(uint64(7), nil).Once()
+	client.EXPECT().SendRawTransaction(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, tx []byte) { rawTx = tx }).
+		Return(testTxHash, nil).Once()
+
+	return func() *zktypes.Transaction {
+		t.Helper()
+
+		require.NotEmpty(t, rawTx)
+		require.Equal(t, byte(0x71), rawTx[0], "expected an EIP-712 transaction")
+		tx := new(zktypes.Transaction)
+		require.NoError(t, tx.Decode(rawTx))
+
+		return tx
+	}
+}
+
+func TestTransactor_Transact(t *testing.T) {
+	t.Parallel()
+
+	client := mocks.NewClient(t)
+	signer := newTestSigner(t)
+	decode := expectTransaction(t, client)
+
+	to := common.HexToAddress("0x1234")
+	result, err := transactor{client: client, opts: &TransactOpts{Signer: signer}}.
+		transact(context.Background(), to, []byte{0xde, 0xad}, nil)
+	require.NoError(t, err)
+	require.Equal(t, testTxHash.Hex(), result.Hash)
+	require.Equal(t, chainsel.FamilyEVM, result.ChainFamily)
+
+	tx := decode()
+	require.Equal(t, to, *tx.To)
+	require.Equal(t, []byte{0xde, 0xad}, []byte(tx.Data))
+	require.Equal(t, big.NewInt(7), tx.Nonce)
+	require.Equal(t, big.NewInt(500_000), tx.Gas)
+	require.Equal(t, big.NewInt(25_000_000), tx.GasFeeCap)
+	require.Equal(t, zkutils.DefaultGasPerPubdataLimit, tx.GasPerPubdata)
+	require.Equal(t, zkSyncChainID, tx.ChainID)
+	require.Equal(t, signer.Address(), *tx.From)
+	require.Nil(t, tx.PaymasterParams)
+}
+
+func TestTransactor_Transact_WithOpts(t *testing.T) {
+	t.Parallel()
+
+	client := mocks.NewClient(t)
+	signer := newTestSigner(t)
+	paymaster := common.HexToAddress("0x9999")
+	opts := &TransactOpts{
+		Signer:        signer,
+		GasLimit:      1_000_000,
+		GasFeeCap:     big.NewInt(100),
+		GasPerPubdata: big.NewInt(800),
+		PaymasterParams: &zktypes.PaymasterParams{
+			Paymaster:      paymaster,
+			PaymasterInput: []byte{0x01},
+		},
+	}
+
+	// No gas price suggestion nor estimation is needed when both are configured
+	var rawTx []byte
+	client.EXPECT().PendingNonceAt(mock.Anything, signer.Address()).Return(uint64(0), nil).Once()
+	client.EXPECT().SendRawTransaction(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, tx []byte) { rawTx = tx }).
+		Return(testTxHash, nil).Once()
+
+	_, err := transactor{client: client, opts: opts}.transact(context.Background(), common.HexToAddress("0x1234"), nil, big.NewInt(5))
+	require.NoError(t, err)
+
+	tx := new(zktypes.Transaction)
+	require.NoError(t, tx.Decode(rawTx))
+	require.Equal(t, big.NewInt(1_000_000), tx.Gas)
+	require.Equal(t, big.NewInt(100), tx.GasFeeCap)
+	require.Equal(t, big.NewInt(800), tx.GasPerPubdata)
+	require.Equal(t, big.NewInt(5), tx.Value)
+	require.NotNil(t, tx.PaymasterParams)
+	require.Equal(t, paymaster, tx.PaymasterParams.Paymaster)
+	require.Equal(t, []byte{0x01}, tx.PaymasterParams.PaymasterInput)
+}
+
+func TestTransactor_Transact_EstimatesWithPaymaster(t *testing.T) {
+	t.Parallel()
+
+	client := mocks.NewClient(t)
+	signer := newTestSigner(t)
+	params := &zktypes.PaymasterParams{Paymaster: common.HexToAddress("0x9999")}
+	to := common.HexToAddress("0x1234")
+
+	client.EXPECT().EstimateGasL2(mock.Anything, mock.MatchedBy(func(msg zktypes.CallMsg) bool {
+		return msg.From == signer.Address() && *msg.To == to && msg.PaymasterParams == params &&
+			msg.GasPerPubdata == zkutils.DefaultGasPerPubdataLimit
+	})).Return(uint64(0), errors.New("paymaster validation failed")).Once()
+
+	_, err := transactor{client: client, opts: &TransactOpts{Signer: signer, GasFeeCap: big.NewInt(1), PaymasterParams: params}}.
+		transact(context.Background(), to, nil, nil)
+	require.EqualError(t, err, "failed to estimate gas: paymaster validation failed")
+}
+
+func TestTransactor_Transact_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		opts     *TransactOpts
+		noSigner bool
+		setup    func(client *mocks.Client)
+		wantErr  string
+	}{
+		{
+			name:     "missing signer",
+			opts:     &TransactOpts{},
+			noSigner: true,
+			setup:    func(*mocks.Client) {},
+			wantErr:  "zkSync transactor requires a signer",
+		},
+		{
+			name: "gas price failure",
+			opts: &TransactOpts{},
+			setup: func(client *mocks.Client) {
+				client.EXPECT().SuggestGasPrice(mock.Anything).Return(nil, errors.New("rpc down")).Once()
+			},
+			wantErr: "failed to suggest gas price: rpc down",
+		},
+		{
+			name: "nonce failure",
+			opts: &TransactOpts{GasLimit: 1, GasFeeCap: big.NewInt(1)},
+			setup: func(client *mocks.Client) {
+				client.EXPECT().PendingNonceAt(mock.Anything, mock.Anything).Return(0, errors.New("rpc down")).Once()
+			},
+			wantErr: "failed to get nonce for",
+		},
+		{
+			name: "send failure",
+			opts: &TransactOpts{GasLimit: 1, GasFeeCap: big.NewInt(1)},
+			setup: func(client *mocks.Client) {
+				client.EXPECT().PendingNonceAt(mock.Anything, mock.Anything).Return(0, nil).Once()
+				client.EXPECT().SendRawTransaction(mock.Anything, mock.Anything).Return(common.Hash{}, errors.New("nonce too low")).Once()
+			},
+			wantErr: "failed to send transaction: nonce too low",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := mocks.NewClient(t)
+			tt.setup(client)
+			if !tt.noSigner {
+				tt.opts.Signer = newTestSigner(t)
+			}
+
+			_, err := transactor{client: client, opts: tt.opts}.transact(context.Background(), common.HexToAddress("0x1234"), nil, nil)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestNewTransactOpts(t *testing.T) {
+	t.Parallel()
+
+	signer := newTestSigner(t)
+	paymaster := common.HexToAddress("0x9999")
+	opts := NewTransactOpts(signer, ChainMetadata{
+		GasPrice:       big.NewInt(3),
+		GasLimit:       4,
+		GasPerPubdata:  big.NewInt(5),
+		Paymaster:      &paymaster,
+		PaymasterInput: []byte{0x01},
+	})
+
+	require.Equal(t, &TransactOpts{
+		Signer:          signer,
+		GasLimit:        4,
+		GasFeeCap:       big.NewInt(3),
+		GasPerPubdata:   big.NewInt(5),
+		PaymasterParams: &zktypes.PaymasterParams{Paymaster: paymaster, PaymasterInput: []byte{0x01}},
+	}, opts)
+}
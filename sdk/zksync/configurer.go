@@ -0,0 +1,50 @@
+package zksync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.Configurer = (*Configurer)(nil)
+
+// Configurer configures the MCM contract on zkSync Era.
+type Configurer struct {
+	transactor transactor
+}
+
+// NewConfigurer creates a new Configurer for zkSync Era.
+func NewConfigurer(client Client, opts *TransactOpts) *Configurer {
+	return &Configurer{
+		transactor: transactor{client: client, opts: opts},
+	}
+}
+
+// SetConfig sets the configuration for the MCM contract on zkSync Era.
+func (c *Configurer) SetConfig(ctx context.Context, mcmAddr string, cfg *types.Config, clearRoot bool) (types.TransactionResult, error) {
+	groupQuorums, groupParents, signerAddrs, signerGroups, err := sdk.ExtractSetConfigInputs(cfg)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	abi, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+	data, err := abi.Pack("setConfig", signerAddrs, signerGroups, groupQuorums, groupParents, clearRoot)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to build setConfig call data: %w", err)
+	}
+
+	result, err := c.transactor.transact(ctx, common.HexToAddress(mcmAddr), data, nil)
+	if err != nil {
+		return result, fmt.Errorf("failed to set config on %s: %w", mcmAddr, err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,55 @@
+package zksync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/sdk/zksync/mocks"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestConfigurer_SetConfig(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	require.NoError(t, err)
+
+	client := mocks.NewClient(t)
+	decode := expectTransaction(t, client)
+
+	signers := []common.Address{
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		common.HexToAddress("0x2222222222222222222222222222222222222222"),
+	}
+	result, err := NewConfigurer(client, &TransactOpts{Signer: newTestSigner(t)}).
+		SetConfig(context.Background(), testMCMAddress, &types.Config{Quorum: 2, Signers: signers}, true)
+	require.NoError(t, err)
+	require.Equal(t, testTxHash.Hex(), result.Hash)
+
+	tx := decode()
+	require.Equal(t, common.HexToAddress(testMCMAddress), *tx.To)
+	require.Equal(t, parsed.Methods["setConfig"].ID, []byte(tx.Data[:4]))
+
+	args, err := parsed.Methods["setConfig"].Inputs.Unpack(tx.Data[4:])
+	require.NoError(t, err)
+	require.Equal(t, signers, args[0])
+	require.Equal(t, uint8(2), args[2].([32]uint8)[0])
+	require.True(t, args[4].(bool))
+}
+
+func TestConfigurer_SetConfig_SendFailure(t *testing.T) {
+	t.Parallel()
+
+	client := mocks.NewClient(t)
+	client.EXPECT().SuggestGasPrice(mock.Anything).Return(nil, errors.New("rpc down")).Once()
+
+	_, err := NewConfigurer(client, &TransactOpts{Signer: newTestSigner(t)}).SetConfig(context.Background(), testMCMAddress,
+		&types.Config{Quorum: 1, Signers: []common.Address{common.HexToAddress("0x1111111111111111111111111111111111111111")}}, false)
+	require.EqualError(t, err, "failed to set config on "+testMCMAddress+": failed to suggest gas price: rpc down")
+}
@@ -0,0 +1,108 @@
+package zksync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.Executor = (*Executor)(nil)
+
+// Executor is an Executor implementation for zkSync Era, executing operations on the MCMS
+// contract through EIP-712 transactions. Hashing is shared with EVM chains, so it is built on
+// top of the EVM encoder.
+type Executor struct {
+	*evm.Encoder
+	*Inspector
+	transactor transactor
+}
+
+// NewExecutor creates a new Executor for zkSync Era
+func NewExecutor(encoder *evm.Encoder, client Client, opts *TransactOpts) *Executor {
+	return &Executor{
+		Encoder:    encoder,
+		Inspector:  NewInspector(client),
+		transactor: transactor{client: client, opts: opts},
+	}
+}
+
+func (e *Executor) ExecuteOperation(
+	ctx context.Context,
+	metadata types.ChainMetadata,
+	nonce uint32,
+	proof []common.Hash,
+	op types.Operation,
+) (types.TransactionResult, error) {
+	if e.Encoder == nil {
+		return types.TransactionResult{}, errors.New("failed to create sdk.Executor - encoder (sdk.Encoder) is nil")
+	}
+
+	bindOp, err := e.ToGethOperation(nonce, metadata, op) //nolint:contextcheck // mirrors the EVM executor
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	abi, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+	data, err := abi.Pack("execute", bindOp, transformHashes(proof))
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to build execute call data: %w", err)
+	}
+
+	result, err := e.transactor.transact(ctx, common.HexToAddress(metadata.MCMAddress), data, nil)
+	if err != nil {
+		return result, fmt.Errorf("failed to execute operation: %w", err)
+	}
+
+	return result, nil
+}
+
+func (e *Executor) SetRoot(
+	ctx context.Context,
+	metadata types.ChainMetadata,
+	proof []common.Hash,
+	root [32]byte,
+	validUntil uint32,
+	sortedSignatures []types.Signature,
+) (types.TransactionResult, error) {
+	if e.Encoder == nil {
+		return types.TransactionResult{}, errors.New("failed to create sdk.Executor - encoder (sdk.Encoder) is nil")
+	}
+
+	bindMeta, err := e.ToGethRootMetadata(ctx, metadata)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	abi, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+	data, err := abi.Pack(
+		"setRoot",
+		root,
+		validUntil,
+		bindMeta,
+		transformHashes(proof),
+		transformSignatures(sortedSignatures),
+	)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to build setRoot call data: %w", err)
+	}
+
+	result, err := e.transactor.transact(ctx, common.HexToAddress(metadata.MCMAddress), data, nil)
+	if err != nil {
+		return result, fmt.Errorf("failed to set root: %w", err)
+	}
+
+	return result, nil
+}
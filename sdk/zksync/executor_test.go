@@ -0,0 +1,102 @@
+package zksync
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/sdk/zksync/mocks"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var zkSyncSelector = types.ChainSelector(chainsel.ETHEREUM_TESTNET_SEPOLIA_ZKSYNC_1.Selector)
+
+func TestExecutor_ExecuteOperation(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	require.NoError(t, err)
+
+	client := mocks.NewClient(t)
+	decode := expectTransaction(t, client)
+	encoder := evm.NewEncoder(zkSyncSelector, 1, false, true)
+	executor := NewExecutor(encoder, client, &TransactOpts{Signer: newTestSigner(t)})
+
+	metadata := types.ChainMetadata{MCMAddress: testMCMAddress}
+	op := types.Operation{
+		ChainSelector: zkSyncSelector,
+		Transaction:   evm.NewTransaction(common.HexToAddress("0x5678"), []byte{0x01}, big.NewInt(0), "Target", nil),
+	}
+	proof := []common.Hash{common.HexToHash("0x0a")}
+
+	result, err := executor.ExecuteOperation(context.Background(), metadata, 3, proof, op)
+	require.NoError(t, err)
+	require.Equal(t, testTxHash.Hex(), result.Hash)
+
+	tx := decode()
+	require.Equal(t, common.HexToAddress(testMCMAddress), *tx.To)
+	require.Equal(t, parsed.Methods["execute"].ID, []byte(tx.Data[:4]))
+
+	args, err := parsed.Methods["execute"].Inputs.Unpack(tx.Data[4:])
+	require.NoError(t, err)
+	require.Len(t, args, 2)
+	require.Equal(t, [][32]byte{proof[0]}, args[1])
+}
+
+func TestExecutor_SetRoot(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	require.NoError(t, err)
+
+	client := mocks.NewClient(t)
+	decode := expectTransaction(t, client)
+	encoder := evm.NewEncoder(zkSyncSelector, 1, false, true)
+	executor := NewExecutor(encoder, client, &TransactOpts{Signer: newTestSigner(t)})
+
+	root := [32]byte{0x01}
+	signatures := []types.Signature{{R: common.HexToHash("0x02"), S: common.HexToHash("0x03"), V: 0}}
+
+	_, err = executor.SetRoot(context.Background(), types.ChainMetadata{MCMAddress: testMCMAddress}, nil, root, 100, signatures)
+	require.NoError(t, err)
+
+	tx := decode()
+	require.Equal(t, parsed.Methods["setRoot"].ID, []byte(tx.Data[:4]))
+
+	args, err := parsed.Methods["setRoot"].Inputs.Unpack(tx.Data[4:])
+	require.NoError(t, err)
+	require.Equal(t, root, args[0])
+	require.Equal(t, uint32(100), args[1])
+	// V is normalized to the 27/28 form expected by the contract
+	require.Equal(t, uint8(27), args[4].([]struct {
+		V uint8    `json:"v"`
+		R [32]byte `json:"r"`
+		S [32]byte `json:"s"`
+	})[0].V)
+}
+
+func TestExecutor_Errors(t *testing.T) {
+	t.Parallel()
+
+	client := mocks.NewClient(t)
+	executor := NewExecutor(nil, client, &TransactOpts{Signer: newTestSigner(t)})
+
+	_, err := executor.ExecuteOperation(context.Background(), types.ChainMetadata{}, 0, nil, types.Operation{})
+	require.EqualError(t, err, "failed to create sdk.Executor - encoder (sdk.Encoder) is nil")
+	_, err = executor.SetRoot(context.Background(), types.ChainMetadata{}, nil, [32]byte{}, 0, nil)
+	require.EqualError(t, err, "failed to create sdk.Executor - encoder (sdk.Encoder) is nil")
+
+	client.EXPECT().SuggestGasPrice(mock.Anything).Return(nil, errors.New("rpc down")).Once()
+	executor = NewExecutor(evm.NewEncoder(zkSyncSelector, 1, false, true), client, &TransactOpts{Signer: newTestSigner(t)})
+	_, err = executor.SetRoot(context.Background(), types.ChainMetadata{MCMAddress: testMCMAddress}, nil, [32]byte{}, 0, nil)
+	require.EqualError(t, err, "failed to set root: failed to suggest gas price: rpc down")
+}
@@ -0,0 +1,173 @@
+package zksync
+
+import (
+	"fmt"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+	zkaccounts "github.com/zksync-sdk/zksync2-go/accounts"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// zkSync Era chains belong to the EVM family. The hooks of the EVM family are replaced to serve
+// the selectors with a zkSync client in the ChainAccessor through the zkSync Era stack, the
+// others through the EVM hooks. The evm package, imported by this one, registers first.
+func init() {
+	evmFamily, ok := sdk.LookupFamily(chainsel.FamilyEVM)
+	if !ok {
+		panic("chain family evm is not registered")
+	}
+
+	family := evmFamily
+	family.NewExecutor = func(
+		chains sdk.ChainAccessor, selector types.ChainSelector, encoder sdk.Encoder, action types.TimelockAction, metadata types.ChainMetadata,
+	) (sdk.Executor, error) {
+		rawSelector := uint64(selector)
+		client, ok := zkSyncClient(chains, rawSelector)
+		if !ok {
+			return evmFamily.NewExecutor(chains, selector, encoder, action, metadata)
+		}
+		evmEncoder, ok := encoder.(*evm.Encoder)
+		if !ok {
+			return nil, fmt.Errorf("invalid encoder type for selector %d: %T", selector, encoder)
+		}
+		opts, err := zkSyncTransactOpts(chains, rawSelector, metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewExecutor(evmEncoder, client, opts), nil
+	}
+	family.NewSimulator = func(
+		chains sdk.ChainAccessor, selector types.ChainSelector, encoder sdk.Encoder, action types.TimelockAction, metadata types.ChainMetadata,
+	) (sdk.Simulator, error) {
+		client, ok := zkSyncClient(chains, uint64(selector))
+		if !ok {
+			return evmFamily.NewSimulator(chains, selector, encoder, action, metadata)
+		}
+		evmEncoder, ok := encoder.(*evm.Encoder)
+		if !ok {
+			return nil, fmt.Errorf("invalid encoder type for selector %d: %T", selector, encoder)
+		}
+
+		return NewSimulator(evmEncoder, client)
+	}
+	family.NewInspector = func(
+		chains sdk.ChainAccessor, selector types.ChainSelector, action types.TimelockAction, metadata types.ChainMetadata,
+	) (sdk.Inspector, error) {
+		client, ok := zkSyncClient(chains, uint64(selector))
+		if !ok {
+			return evmFamily.NewInspector(chains, selector, action, metadata)
+		}
+
+		return NewInspector(client), nil
+	}
+	family.NewTimelockExecutor = func(
+		chains sdk.ChainAccessor, selector types.ChainSelector, action types.TimelockAction, metadata types.ChainMetadata,
+	) (sdk.TimelockExecutor, error) {
+		rawSelector := uint64(selector)
+		client, ok := zkSyncClient(chains, rawSelector)
+		if !ok {
+			return evmFamily.NewTimelockExecutor(chains, selector, action, metadata)
+		}
+		opts, err := zkSyncTransactOpts(chains, rawSelector, metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewTimelockExecutor(client, opts), nil
+	}
+	family.NewTimelockInspector = func(
+		chains sdk.ChainAccessor, selector types.ChainSelector, metadata types.ChainMetadata,
+	) (sdk.TimelockInspector, error) {
+		client, ok := zkSyncClient(chains, uint64(selector))
+		if !ok {
+			return evmFamily.NewTimelockInspector(chains, selector, metadata)
+		}
+
+		return NewTimelockInspector(client), nil
+	}
+	family.NewTimelockConfigurer = func(
+		chains sdk.ChainAccessor, selector types.ChainSelector, action types.TimelockAction, metadata types.ChainMetadata,
+	) (sdk.TimelockConfigurer, error) {
+		rawSelector := uint64(selector)
+		client, ok := zkSyncClient(chains, rawSelector)
+		if !ok {
+			return evmFamily.NewTimelockConfigurer(chains, selector, action, metadata)
+		}
+		opts, err := zkSyncTransactOpts(chains, rawSelector, metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewTimelockConfigurer(client, opts), nil
+	}
+	// The logs are filtered through the zkSync client, which serves the standard eth_getLogs, when
+	// it implements the EVM backend.
+	family.NewEventFilterer = func(
+		chains sdk.ChainAccessor, selector types.ChainSelector, action types.TimelockAction, metadata types.ChainMetadata,
+	) (sdk.EventFilterer, error) {
+		client, ok := zkSyncClient(chains, uint64(selector))
+		if !ok {
+			return evmFamily.NewEventFilterer(chains, selector, action, metadata)
+		}
+		backend, ok := client.(evm.ContractDeployBackend)
+		if !ok {
+			return nil, fmt.Errorf("zkSync client %T does not support log filtering", client)
+		}
+
+		return evm.NewEventFilterer(backend, selector), nil
+	}
+
+	if err := sdk.ReplaceFamily(family); err != nil {
+		panic(err)
+	}
+}
+
+// ChainAccessor is optionally implemented by a sdk.ChainAccessor to serve zkSync Era chains.
+type ChainAccessor interface {
+	// ZkSyncClient returns the zkSync Era client for an EVM selector. When present, the zkSync
+	// stack is used for the selector instead of the plain EVM one.
+	ZkSyncClient(selector uint64) (Client, bool)
+	ZkSyncSigner(selector uint64) (zkaccounts.Signer, bool)
+}
+
+// zkSyncTransactOpts builds the EIP-712 transaction options for a zkSync Era selector from the
+// ChainAccessor signer and the gas and paymaster fields of the chain metadata.
+func zkSyncTransactOpts(chains sdk.ChainAccessor, rawSelector uint64, metadata types.ChainMetadata) (*TransactOpts, error) {
+	signer, ok := zkSyncSigner(chains, rawSelector)
+	if !ok {
+		return nil, fmt.Errorf("missing zksync signer for selector %d", rawSelector)
+	}
+
+	zkChainMetadata, err := ParseChainMetadata(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse zkSync chain metadata for selector %d: %w", rawSelector, err)
+	}
+
+	return NewTransactOpts(signer, zkChainMetadata), nil
+}
+
+// zkSyncClient returns the zkSync Era client of the selector if the ChainAccessor serves zkSync
+// chains.
+func zkSyncClient(chains sdk.ChainAccessor, selector uint64) (Client, bool) {
+	zkChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return zkChains.ZkSyncClient(selector)
+}
+
+// zkSyncSigner returns the zkSync Era signer of the selector if the ChainAccessor serves zkSync
+// chains.
+func zkSyncSigner(chains sdk.ChainAccessor, selector uint64) (zkaccounts.Signer, bool) {
+	zkChains, ok := chains.(ChainAccessor)
+	if !ok {
+		return nil, false
+	}
+
+	return zkChains.ZkSyncSigner(selector)
+}
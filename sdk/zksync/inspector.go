@@ -0,0 +1,105 @@
+package zksync
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.Inspector = (*Inspector)(nil)
+var _ sdk.PostOpCountInspector = (*Inspector)(nil)
+
+// Inspector is an Inspector implementation for zkSync Era, giving access to the state of the
+// MCMS contract. Reads go through the zkSync bindings.
+type Inspector struct {
+	evm.ConfigTransformer
+	client Client
+}
+
+// NewInspector creates a new Inspector for zkSync Era
+func NewInspector(client Client) *Inspector {
+	return &Inspector{
+		ConfigTransformer: evm.ConfigTransformer{},
+		client:            client,
+	}
+}
+
+func (i *Inspector) GetConfig(ctx context.Context, address string) (*types.Config, error) {
+	mcmsObj, err := bindings.NewManyChainMultiSigCaller(common.HexToAddress(address), i.client)
+	if err != nil {
+		return nil, err
+	}
+
+	onchainConfig, err := mcmsObj.GetConfig(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+
+	return i.ToConfig(onchainConfig)
+}
+
+func (i *Inspector) GetOpCount(ctx context.Context, address string) (uint64, error) {
+	mcmsObj, err := bindings.NewManyChainMultiSigCaller(common.HexToAddress(address), i.client)
+	if err != nil {
+		return 0, err
+	}
+
+	opCount, err := mcmsObj.GetOpCount(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, err
+	}
+
+	return opCount.Uint64(), nil
+}
+
+func (i *Inspector) GetRoot(ctx context.Context, address string) (common.Hash, uint32, error) {
+	mcmsObj, err := bindings.NewManyChainMultiSigCaller(common.HexToAddress(address), i.client)
+	if err != nil {
+		return common.Hash{}, 0, err
+	}
+
+	root, err := mcmsObj.GetRoot(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return common.Hash{}, 0, err
+	}
+
+	return root.Root, root.ValidUntil, nil
+}
+
+func (i *Inspector) GetRootMetadata(ctx context.Context, address string) (types.ChainMetadata, error) {
+	mcmsObj, err := bindings.NewManyChainMultiSigCaller(common.HexToAddress(address), i.client)
+	if err != nil {
+		return types.ChainMetadata{}, err
+	}
+
+	metadata, err := mcmsObj.GetRootMetadata(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return types.ChainMetadata{}, err
+	}
+
+	return types.ChainMetadata{
+		StartingOpCount: metadata.PreOpCount.Uint64(),
+		MCMAddress:      address,
+	}, nil
+}
+
+// GetRootPostOpCount returns the post-op count of the root held by the MCM.
+func (i *Inspector) GetRootPostOpCount(ctx context.Context, address string) (uint64, error) {
+	mcmsObj, err := bindings.NewManyChainMultiSigCaller(common.HexToAddress(address), i.client)
+	if err != nil {
+		return 0, err
+	}
+
+	metadata, err := mcmsObj.GetRootMetadata(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, err
+	}
+
+	return metadata.PostOpCount.Uint64(), nil
+}
@@ -0,0 +1,109 @@
+package zksync
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/sdk/zksync/mocks"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+const testMCMAddress = "0x1234567890abcdef1234567890abcdef12345678"
+
+// expectCall mocks a single eth_call to the given contract method returning the packed outputs.
+func expectCall(t *testing.T, client *mocks.Client, abiMethod func() ([]byte, error)) {
+	t.Helper()
+
+	out, err := abiMethod()
+	require.NoError(t, err)
+	client.EXPECT().CallContract(mock.Anything, mock.IsType(ethereum.CallMsg{}), mock.Anything).Return(out, nil).Once()
+}
+
+func TestInspector_GetConfig(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	require.NoError(t, err)
+
+	signer := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	config := bindings.ManyChainMultiSigConfig{
+		Signers:      []bindings.ManyChainMultiSigSigner{{Addr: signer, Index: 0, Group: 0}},
+		GroupQuorums: [32]uint8{1},
+	}
+
+	client := mocks.NewClient(t)
+	expectCall(t, client, func() ([]byte, error) { return parsed.Methods["getConfig"].Outputs.Pack(config) })
+
+	got, err := NewInspector(client).GetConfig(context.Background(), testMCMAddress)
+	require.NoError(t, err)
+	require.Equal(t, &types.Config{Quorum: 1, Signers: []common.Address{signer}, GroupSigners: []types.Config{}}, got)
+}
+
+func TestInspector_GetOpCount(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	require.NoError(t, err)
+
+	client := mocks.NewClient(t)
+	expectCall(t, client, func() ([]byte, error) { return parsed.Methods["getOpCount"].Outputs.Pack(big.NewInt(42)) })
+
+	got, err := NewInspector(client).GetOpCount(context.Background(), testMCMAddress)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), got)
+}
+
+func TestInspector_GetRoot(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	require.NoError(t, err)
+
+	root := common.HexToHash("0x01")
+	client := mocks.NewClient(t)
+	expectCall(t, client, func() ([]byte, error) { return parsed.Methods["getRoot"].Outputs.Pack(root, uint32(1000)) })
+
+	gotRoot, gotValidUntil, err := NewInspector(client).GetRoot(context.Background(), testMCMAddress)
+	require.NoError(t, err)
+	require.Equal(t, root, gotRoot)
+	require.Equal(t, uint32(1000), gotValidUntil)
+}
+
+func TestInspector_GetRootMetadata(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	require.NoError(t, err)
+
+	client := mocks.NewClient(t)
+	expectCall(t, client, func() ([]byte, error) {
+		return parsed.Methods["getRootMetadata"].Outputs.Pack(bindings.ManyChainMultiSigRootMetadata{
+			ChainId:     zkSyncChainID,
+			MultiSig:    common.HexToAddress(testMCMAddress),
+			PreOpCount:  big.NewInt(3),
+			PostOpCount: big.NewInt(5),
+		})
+	})
+
+	got, err := NewInspector(client).GetRootMetadata(context.Background(), testMCMAddress)
+	require.NoError(t, err)
+	require.Equal(t, types.ChainMetadata{StartingOpCount: 3, MCMAddress: testMCMAddress}, got)
+}
+
+func TestInspector_CallFailure(t *testing.T) {
+	t.Parallel()
+
+	client := mocks.NewClient(t)
+	client.EXPECT().CallContract(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("call failed")).Once()
+
+	_, err := NewInspector(client).GetOpCount(context.Background(), testMCMAddress)
+	require.EqualError(t, err, "call failed")
+}
@@ -0,0 +1,134 @@
+package zksync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	zktypes "github.com/zksync-sdk/zksync2-go/types"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.Simulator = (*Simulator)(nil)
+
+// Simulator simulates MCMS calls on zkSync Era with EIP-712 eth_call requests, so paymaster
+// validation and pubdata limits are taken into account.
+type Simulator struct {
+	*evm.Encoder
+	*Inspector
+}
+
+func NewSimulator(encoder *evm.Encoder, client Client) (*Simulator, error) {
+	if encoder == nil {
+		return nil, errors.New("Simulator was created without an encoder")
+	}
+
+	if client == nil {
+		return nil, errors.New("Simulator was created without an inspector")
+	}
+
+	return &Simulator{
+		Encoder:   encoder,
+		Inspector: NewInspector(client),
+	}, nil
+}
+
+func (s *Simulator) SimulateSetRoot(
+	ctx context.Context,
+	originCaller string,
+	metadata types.ChainMetadata,
+	proof []common.Hash,
+	root [32]byte,
+	validUntil uint32,
+	sortedSignatures []types.Signature,
+) error {
+	if s.Encoder == nil {
+		return errors.New("Simulator was created without an encoder")
+	}
+
+	if s.Inspector == nil {
+		return errors.New("Simulator was created without an inspector")
+	}
+
+	zkMetadata, err := ParseChainMetadata(metadata)
+	if err != nil {
+		return err
+	}
+
+	bindMeta, err := s.ToGethRootMetadata(ctx, metadata)
+	if err != nil {
+		return err
+	}
+
+	abi, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+
+	data, err := abi.Pack(
+		"setRoot",
+		root,
+		validUntil,
+		bindMeta,
+		transformHashes(proof),
+		transformSignatures(sortedSignatures),
+	)
+	if err != nil {
+		return err
+	}
+
+	mcmAddr := common.HexToAddress(metadata.MCMAddress)
+	_, err = s.client.CallContractL2(ctx, zktypes.CallMsg{
+		From:            common.HexToAddress(originCaller),
+		To:              &mcmAddr,
+		Value:           big.NewInt(0),
+		Data:            data,
+		GasPerPubdata:   zkMetadata.GasPerPubdata,
+		PaymasterParams: zkMetadata.PaymasterParams(),
+	}, nil)
+
+	return err
+}
+
+func (s *Simulator) SimulateOperation(
+	ctx context.Context,
+	metadata types.ChainMetadata,
+	operation types.Operation,
+) error {
+	if s.Encoder == nil {
+		return errors.New("Simulator was created without an encoder")
+	}
+
+	if s.Inspector == nil {
+		return errors.New("Simulator was created without an inspector")
+	}
+
+	zkMetadata, err := ParseChainMetadata(metadata)
+	if err != nil {
+		return err
+	}
+
+	// Unmarshal the AdditionalFields from the operation
+	var additionalFields evm.AdditionalFields
+	if err = json.Unmarshal(operation.Transaction.AdditionalFields, &additionalFields); err != nil {
+		return err
+	}
+
+	toAddr := common.HexToAddress(operation.Transaction.To)
+	_, err = s.client.CallContractL2(ctx, zktypes.CallMsg{
+		From:            common.HexToAddress(metadata.MCMAddress),
+		To:              &toAddr,
+		Value:           additionalFields.Value,
+		Data:            operation.Transaction.Data,
+		GasPerPubdata:   zkMetadata.GasPerPubdata,
+		PaymasterParams: zkMetadata.PaymasterParams(),
+	}, nil)
+
+	return err
+}
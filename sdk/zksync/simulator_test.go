@@ -0,0 +1,125 @@
+package zksync
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	zktypes "github.com/zksync-sdk/zksync2-go/types"
+
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/sdk/zksync/mocks"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestNewSimulator(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSimulator(nil, mocks.NewClient(t))
+	require.EqualError(t, err, "Simulator was created without an encoder")
+
+	_, err = NewSimulator(evm.NewEncoder(zkSyncSelector, 1, false, true), nil)
+	require.EqualError(t, err, "Simulator was created without an inspector")
+}
+
+func TestSimulator_SimulateSetRoot(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.ManyChainMultiSigMetaData.GetAbi()
+	require.NoError(t, err)
+
+	client := mocks.NewClient(t)
+	simulator, err := NewSimulator(evm.NewEncoder(zkSyncSelector, 1, false, true), client)
+	require.NoError(t, err)
+
+	origin := common.HexToAddress("0xaaaa")
+	paymaster := common.HexToAddress("0x9999")
+	metadata := types.ChainMetadata{
+		MCMAddress:       testMCMAddress,
+		AdditionalFields: []byte(`{"paymaster": "` + paymaster.Hex() + `", "paymasterInput": "0x01"}`),
+	}
+
+	client.EXPECT().CallContractL2(mock.Anything, mock.MatchedBy(func(msg zktypes.CallMsg) bool {
+		return msg.From == origin &&
+			*msg.To == common.HexToAddress(testMCMAddress) &&
+			common.Bytes2Hex(msg.Data[:4]) == common.Bytes2Hex(parsed.Methods["setRoot"].ID) &&
+			msg.PaymasterParams != nil && msg.PaymasterParams.Paymaster == paymaster
+	}), (*big.Int)(nil)).Return(nil, nil).Once()
+
+	err = simulator.SimulateSetRoot(context.Background(), origin.Hex(), metadata, nil, [32]byte{0x01}, 100, nil)
+	require.NoError(t, err)
+}
+
+func TestSimulator_SimulateSetRoot_InvalidMetadata(t *testing.T) {
+	t.Parallel()
+
+	simulator, err := NewSimulator(evm.NewEncoder(zkSyncSelector, 1, false, true), mocks.NewClient(t))
+	require.NoError(t, err)
+
+	err = simulator.SimulateSetRoot(context.Background(), "0xaaaa",
+		types.ChainMetadata{AdditionalFields: []byte(`{"paymasterInput": "0x01"}`)}, nil, [32]byte{}, 0, nil)
+	require.EqualError(t, err, "paymaster input set without a paymaster")
+}
+
+func TestSimulator_SimulateOperation(t *testing.T) {
+	t.Parallel()
+
+	client := mocks.NewClient(t)
+	simulator, err := NewSimulator(evm.NewEncoder(zkSyncSelector, 1, false, true), client)
+	require.NoError(t, err)
+
+	target := common.HexToAddress("0x5678")
+	op := types.Operation{
+		ChainSelector: zkSyncSelector,
+		Transaction:   evm.NewTransaction(target, []byte{0x01}, big.NewInt(10), "Target", nil),
+	}
+
+	client.EXPECT().CallContractL2(mock.Anything, mock.MatchedBy(func(msg zktypes.CallMsg) bool {
+		return msg.From == common.HexToAddress(testMCMAddress) && *msg.To == target &&
+			msg.Value.Cmp(big.NewInt(10)) == 0 && len(msg.Data) == 1
+	}), (*big.Int)(nil)).Return(nil, errors.New("execution reverted")).Once()
+
+	err = simulator.SimulateOperation(context.Background(), types.ChainMetadata{MCMAddress: testMCMAddress}, op)
+	require.EqualError(t, err, "execution reverted")
+}
+
+func TestSimulator_SimulateOperation_Paymaster(t *testing.T) {
+	t.Parallel()
+
+	client := mocks.NewClient(t)
+	simulator, err := NewSimulator(evm.NewEncoder(zkSyncSelector, 1, false, true), client)
+	require.NoError(t, err)
+
+	paymaster := common.HexToAddress("0x9999")
+	metadata := types.ChainMetadata{
+		MCMAddress:       testMCMAddress,
+		AdditionalFields: []byte(`{"paymaster": "` + paymaster.Hex() + `", "paymasterInput": "0x01"}`),
+	}
+	op := types.Operation{
+		ChainSelector: zkSyncSelector,
+		Transaction:   evm.NewTransaction(common.HexToAddress("0x5678"), []byte{0x01}, big.NewInt(0), "Target", nil),
+	}
+
+	client.EXPECT().CallContractL2(mock.Anything, mock.MatchedBy(func(msg zktypes.CallMsg) bool {
+		return msg.PaymasterParams != nil && msg.PaymasterParams.Paymaster == paymaster
+	}), (*big.Int)(nil)).Return(nil, nil).Once()
+
+	require.NoError(t, simulator.SimulateOperation(context.Background(), metadata, op))
+}
+
+func TestSimulator_NilEncoder(t *testing.T) {
+	t.Parallel()
+
+	simulator := &Simulator{Inspector: NewInspector(mocks.NewClient(t))}
+
+	err := simulator.SimulateSetRoot(context.Background(), "0xaaaa", types.ChainMetadata{}, nil, [32]byte{}, 0, nil)
+	require.EqualError(t, err, "Simulator was created without an encoder")
+
+	err = simulator.SimulateOperation(context.Background(), types.ChainMetadata{}, types.Operation{})
+	require.EqualError(t, err, "Simulator was created without an encoder")
+}
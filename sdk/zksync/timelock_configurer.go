@@ -0,0 +1,89 @@
+package zksync
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.TimelockConfigurer = (*TimelockConfigurer)(nil)
+
+// TimelockConfigurer configures timelock parameters on zkSync Era.
+type TimelockConfigurer struct {
+	TimelockInspector
+	transactor transactor
+}
+
+// NewTimelockConfigurer creates a new TimelockConfigurer for zkSync Era.
+func NewTimelockConfigurer(client Client, opts *TransactOpts) *TimelockConfigurer {
+	return &TimelockConfigurer{
+		TimelockInspector: *NewTimelockInspector(client),
+		transactor:        transactor{client: client, opts: opts},
+	}
+}
+
+// UpdateDelay calls updateDelay on the RBACTimelock contract to change the minimum delay.
+func (c *TimelockConfigurer) UpdateDelay(
+	ctx context.Context, timelockAddress string, newDelay uint64,
+) (types.TransactionResult, error) {
+	abi, err := bindings.RBACTimelockMetaData.GetAbi()
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+	data, err := abi.Pack("updateDelay", new(big.Int).SetUint64(newDelay))
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to build updateDelay call data: %w", err)
+	}
+
+	result, err := c.transactor.transact(ctx, common.HexToAddress(timelockAddress), data, nil)
+	if err != nil {
+		return result, fmt.Errorf("failed to update delay on %s: %w", timelockAddress, err)
+	}
+
+	return result, nil
+}
+
+// GrantRole calls grantRole on the RBACTimelock contract for a target address.
+func (c *TimelockConfigurer) GrantRole(
+	ctx context.Context,
+	timelockAddress string,
+	role sdk.TimelockRole,
+	targetAddress string,
+) (types.TransactionResult, error) {
+	if !common.IsHexAddress(timelockAddress) || common.HexToAddress(timelockAddress) == (common.Address{}) {
+		return types.TransactionResult{}, fmt.Errorf("invalid timelock address: %s", timelockAddress)
+	}
+
+	roleHash, err := evm.TimelockRoleHash(role)
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+
+	if !common.IsHexAddress(targetAddress) || common.HexToAddress(targetAddress) == (common.Address{}) {
+		return types.TransactionResult{}, fmt.Errorf("invalid target address: %s", targetAddress)
+	}
+	account := common.HexToAddress(targetAddress)
+
+	abi, err := bindings.RBACTimelockMetaData.GetAbi()
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+	data, err := abi.Pack("grantRole", [32]byte(roleHash), account)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to build grantRole call data: %w", err)
+	}
+
+	result, err := c.transactor.transact(ctx, common.HexToAddress(timelockAddress), data, nil)
+	if err != nil {
+		return result, fmt.Errorf("failed to grant role %s to %s on %s: %w", role, account.Hex(), timelockAddress, err)
+	}
+
+	return result, nil
+}
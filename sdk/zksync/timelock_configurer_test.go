@@ -0,0 +1,84 @@
+package zksync
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/sdk/zksync/mocks"
+)
+
+func TestTimelockConfigurer_UpdateDelay(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.RBACTimelockMetaData.GetAbi()
+	require.NoError(t, err)
+
+	client := mocks.NewClient(t)
+	decode := expectTransaction(t, client)
+
+	_, err = NewTimelockConfigurer(client, &TransactOpts{Signer: newTestSigner(t)}).
+		UpdateDelay(context.Background(), testTimelockAddress, 7200)
+	require.NoError(t, err)
+
+	tx := decode()
+	want, err := parsed.Pack("updateDelay", big.NewInt(7200))
+	require.NoError(t, err)
+	require.Equal(t, want, []byte(tx.Data))
+}
+
+func TestTimelockConfigurer_GrantRole(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.RBACTimelockMetaData.GetAbi()
+	require.NoError(t, err)
+
+	client := mocks.NewClient(t)
+	decode := expectTransaction(t, client)
+	account := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	_, err = NewTimelockConfigurer(client, &TransactOpts{Signer: newTestSigner(t)}).
+		GrantRole(context.Background(), testTimelockAddress, sdk.TimelockRoleCanceller, account.Hex())
+	require.NoError(t, err)
+
+	roleHash, err := evm.TimelockRoleHash(sdk.TimelockRoleCanceller)
+	require.NoError(t, err)
+	want, err := parsed.Pack("grantRole", [32]byte(roleHash), account)
+	require.NoError(t, err)
+	require.Equal(t, want, []byte(decode().Data))
+}
+
+func TestTimelockConfigurer_GrantRole_Errors(t *testing.T) {
+	t.Parallel()
+
+	configurer := NewTimelockConfigurer(mocks.NewClient(t), &TransactOpts{Signer: newTestSigner(t)})
+	account := "0x1111111111111111111111111111111111111111"
+
+	tests := []struct {
+		name     string
+		timelock string
+		role     sdk.TimelockRole
+		target   string
+		wantErr  string
+	}{
+		{name: "invalid timelock", timelock: "0xinvalid", role: sdk.TimelockRoleAdmin, target: account, wantErr: "invalid timelock address: 0xinvalid"},
+		{name: "zero timelock", timelock: common.Address{}.Hex(), role: sdk.TimelockRoleAdmin, target: account, wantErr: "invalid timelock address"},
+		{name: "invalid role", timelock: testTimelockAddress, role: sdk.TimelockRole(99), target: account, wantErr: "invalid timelock role: 99"},
+		{name: "invalid target", timelock: testTimelockAddress, role: sdk.TimelockRoleAdmin, target: "0xinvalid", wantErr: "invalid target address: 0xinvalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := configurer.GrantRole(context.Background(), tt.timelock, tt.role, tt.target)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package zksync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+var _ sdk.TimelockExecutor = (*TimelockExecutor)(nil)
+
+// TimelockExecutor is an Executor implementation for zkSync Era for accessing the RBACTimelock contract
+type TimelockExecutor struct {
+	TimelockInspector
+	transactor transactor
+}
+
+// NewTimelockExecutor creates a new TimelockExecutor
+func NewTimelockExecutor(client Client, opts *TransactOpts) *TimelockExecutor {
+	return &TimelockExecutor{
+		TimelockInspector: *NewTimelockInspector(client),
+		transactor:        transactor{client: client, opts: opts},
+	}
+}
+
+// NOTE: a CallProxy can be used to execute the calls by replacing the
+// timelock address with the proxy address.
+func (t *TimelockExecutor) Execute(
+	ctx context.Context, bop types.BatchOperation, timelockAddress string, predecessor common.Hash, salt common.Hash,
+) (types.TransactionResult, error) {
+	calls := make([]bindings.RBACTimelockCall, len(bop.Transactions))
+	for i, tx := range bop.Transactions {
+		// Unmarshal the AdditionalFields from the operation
+		var additionalFields evm.AdditionalFields
+		if err := json.Unmarshal(tx.AdditionalFields, &additionalFields); err != nil {
+			return types.TransactionResult{}, err
+		}
+
+		calls[i] = bindings.RBACTimelockCall{
+			Target: common.HexToAddress(tx.To),
+			Data:   tx.Data,
+			Value:  additionalFields.Value,
+		}
+	}
+
+	abi, err := bindings.RBACTimelockMetaData.GetAbi()
+	if err != nil {
+		return types.TransactionResult{}, err
+	}
+	data, err := abi.Pack("executeBatch", calls, predecessor, salt)
+	if err != nil {
+		return types.TransactionResult{}, fmt.Errorf("failed to build execute call data: %w", err)
+	}
+
+	result, err := t.transactor.transact(ctx, common.HexToAddress(timelockAddress), data, nil)
+	if err != nil {
+		return result, fmt.Errorf("failed to execute batch on %s: %w", timelockAddress, err)
+	}
+
+	return result, nil
+}
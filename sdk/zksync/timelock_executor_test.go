@@ -0,0 +1,57 @@
+package zksync
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/sdk/zksync/mocks"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestTimelockExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.RBACTimelockMetaData.GetAbi()
+	require.NoError(t, err)
+
+	client := mocks.NewClient(t)
+	decode := expectTransaction(t, client)
+
+	target := common.HexToAddress("0x5678")
+	bop := types.BatchOperation{
+		ChainSelector: zkSyncSelector,
+		Transactions:  []types.Transaction{evm.NewTransaction(target, []byte{0x01}, big.NewInt(2), "Target", nil)},
+	}
+	predecessor := common.HexToHash("0x01")
+	salt := common.HexToHash("0x02")
+
+	result, err := NewTimelockExecutor(client, &TransactOpts{Signer: newTestSigner(t)}).
+		Execute(context.Background(), bop, testTimelockAddress, predecessor, salt)
+	require.NoError(t, err)
+	require.Equal(t, testTxHash.Hex(), result.Hash)
+
+	tx := decode()
+	require.Equal(t, common.HexToAddress(testTimelockAddress), *tx.To)
+	require.Equal(t, parsed.Methods["executeBatch"].ID, []byte(tx.Data[:4]))
+
+	args, err := parsed.Methods["executeBatch"].Inputs.Unpack(tx.Data[4:])
+	require.NoError(t, err)
+	require.Equal(t, [32]byte(predecessor), args[1])
+	require.Equal(t, [32]byte(salt), args[2])
+}
+
+func TestTimelockExecutor_Execute_InvalidAdditionalFields(t *testing.T) {
+	t.Parallel()
+
+	bop := types.BatchOperation{Transactions: []types.Transaction{{AdditionalFields: []byte(`{"value": "abc"}`)}}}
+
+	_, err := NewTimelockExecutor(mocks.NewClient(t), &TransactOpts{Signer: newTestSigner(t)}).
+		Execute(context.Background(), bop, testTimelockAddress, common.Hash{}, common.Hash{})
+	require.Error(t, err)
+}
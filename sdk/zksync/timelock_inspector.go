@@ -0,0 +1,135 @@
+package zksync
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/internal/utils/safecast"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+)
+
+var _ sdk.TimelockInspector = (*TimelockInspector)(nil)
+
+// TimelockInspector is an Inspector implementation for zkSync Era for accessing the RBACTimelock contract
+type TimelockInspector struct {
+	client Client
+}
+
+// NewTimelockInspector creates a new TimelockInspector
+func NewTimelockInspector(client Client) *TimelockInspector {
+	return &TimelockInspector{
+		client: client,
+	}
+}
+
+// getAddressesWithRole returns the list of addresses with the given role
+func (tm TimelockInspector) getAddressesWithRole(ctx context.Context, address string, role sdk.TimelockRole) ([]string, error) {
+	timelock, err := bindings.NewRBACTimelockCaller(common.HexToAddress(address), tm.client)
+	if err != nil {
+		return nil, err
+	}
+	roleHash, err := evm.TimelockRoleHash(role)
+	if err != nil {
+		return nil, err
+	}
+
+	numAddresses, err := timelock.GetRoleMemberCount(&bind.CallOpts{Context: ctx}, roleHash)
+	if err != nil {
+		return nil, err
+	}
+	// For each address index in the roles count, get the address
+	addresses := make([]string, 0, numAddresses.Uint64())
+	for i := range numAddresses.Uint64() {
+		idx, err := safecast.Uint64ToInt64(i)
+		if err != nil {
+			return nil, err
+		}
+		member, err := timelock.GetRoleMember(&bind.CallOpts{Context: ctx}, roleHash, big.NewInt(idx))
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, member.String())
+	}
+
+	return addresses, nil
+}
+
+// GetAdmins returns the list of addresses with the admin role
+func (tm TimelockInspector) GetAdmins(ctx context.Context, address string) ([]string, error) {
+	return tm.getAddressesWithRole(ctx, address, sdk.TimelockRoleAdmin)
+}
+
+// GetProposers returns the list of addresses with the proposer role
+func (tm TimelockInspector) GetProposers(ctx context.Context, address string) ([]string, error) {
+	return tm.getAddressesWithRole(ctx, address, sdk.TimelockRoleProposer)
+}
+
+// GetExecutors returns the list of addresses with the executor role
+func (tm TimelockInspector) GetExecutors(ctx context.Context, address string) ([]string, error) {
+	return tm.getAddressesWithRole(ctx, address, sdk.TimelockRoleExecutor)
+}
+
+// GetBypassers returns the list of addresses with the bypasser role
+func (tm TimelockInspector) GetBypassers(ctx context.Context, address string) ([]string, error) {
+	return tm.getAddressesWithRole(ctx, address, sdk.TimelockRoleBypasser)
+}
+
+// GetCancellers returns the list of addresses with the canceller role
+func (tm TimelockInspector) GetCancellers(ctx context.Context, address string) ([]string, error) {
+	return tm.getAddressesWithRole(ctx, address, sdk.TimelockRoleCanceller)
+}
+
+func (tm TimelockInspector) IsOperation(ctx context.Context, address string, opID [32]byte) (bool, error) {
+	timelock, err := bindings.NewRBACTimelockCaller(common.HexToAddress(address), tm.client)
+	if err != nil {
+		return false, err
+	}
+
+	return timelock.IsOperation(&bind.CallOpts{Context: ctx}, opID)
+}
+
+func (tm TimelockInspector) IsOperationPending(ctx context.Context, address string, opID [32]byte) (bool, error) {
+	timelock, err := bindings.NewRBACTimelockCaller(common.HexToAddress(address), tm.client)
+	if err != nil {
+		return false, err
+	}
+
+	return timelock.IsOperationPending(&bind.CallOpts{Context: ctx}, opID)
+}
+
+func (tm TimelockInspector) IsOperationReady(ctx context.Context, address string, opID [32]byte) (bool, error) {
+	timelock, err := bindings.NewRBACTimelockCaller(common.HexToAddress(address), tm.client)
+	if err != nil {
+		return false, err
+	}
+
+	return timelock.IsOperationReady(&bind.CallOpts{Context: ctx}, opID)
+}
+
+func (tm TimelockInspector) IsOperationDone(ctx context.Context, address string, opID [32]byte) (bool, error) {
+	timelock, err := bindings.NewRBACTimelockCaller(common.HexToAddress(address), tm.client)
+	if err != nil {
+		return false, err
+	}
+
+	return timelock.IsOperationDone(&bind.CallOpts{Context: ctx}, opID)
+}
+
+// GetMinDelay returns the minimum delay for the timelock at the given address
+func (tm TimelockInspector) GetMinDelay(ctx context.Context, address string) (uint64, error) {
+	timelock, err := bindings.NewRBACTimelockCaller(common.HexToAddress(address), tm.client)
+	if err != nil {
+		return 0, err
+	}
+	d, err := timelock.GetMinDelay(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, err
+	}
+
+	return d.Uint64(), nil
+}
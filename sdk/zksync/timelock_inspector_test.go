@@ -0,0 +1,127 @@
+package zksync
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/sdk/zksync/mocks"
+)
+
+const testTimelockAddress = "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd"
+
+func TestTimelockInspector_GetRoles(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.RBACTimelockMetaData.GetAbi()
+	require.NoError(t, err)
+
+	members := []common.Address{
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		common.HexToAddress("0x2222222222222222222222222222222222222222"),
+	}
+
+	tests := []struct {
+		name string
+		role sdk.TimelockRole
+		get  func(TimelockInspector) ([]string, error)
+	}{
+		{name: "admins", role: sdk.TimelockRoleAdmin, get: func(i TimelockInspector) ([]string, error) {
+			return i.GetAdmins(context.Background(), testTimelockAddress)
+		}},
+		{name: "proposers", role: sdk.TimelockRoleProposer, get: func(i TimelockInspector) ([]string, error) {
+			return i.GetProposers(context.Background(), testTimelockAddress)
+		}},
+		{name: "executors", role: sdk.TimelockRoleExecutor, get: func(i TimelockInspector) ([]string, error) {
+			return i.GetExecutors(context.Background(), testTimelockAddress)
+		}},
+		{name: "bypassers", role: sdk.TimelockRoleBypasser, get: func(i TimelockInspector) ([]string, error) {
+			return i.GetBypassers(context.Background(), testTimelockAddress)
+		}},
+		{name: "cancellers", role: sdk.TimelockRoleCanceller, get: func(i TimelockInspector) ([]string, error) {
+			return i.GetCancellers(context.Background(), testTimelockAddress)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			roleHash, err := evm.TimelockRoleHash(tt.role)
+			require.NoError(t, err)
+			countCall, err := parsed.Pack("getRoleMemberCount", [32]byte(roleHash))
+			require.NoError(t, err)
+			count, err := parsed.Methods["getRoleMemberCount"].Outputs.Pack(big.NewInt(int64(len(members))))
+			require.NoError(t, err)
+
+			client := mocks.NewClient(t)
+			client.EXPECT().CallContract(mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+				return common.Bytes2Hex(msg.Data) == common.Bytes2Hex(countCall)
+			}), mock.Anything).Return(count, nil).Once()
+			for i, member := range members {
+				memberCall, err := parsed.Pack("getRoleMember", [32]byte(roleHash), big.NewInt(int64(i)))
+				require.NoError(t, err)
+				out, err := parsed.Methods["getRoleMember"].Outputs.Pack(member)
+				require.NoError(t, err)
+				client.EXPECT().CallContract(mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+					return common.Bytes2Hex(msg.Data) == common.Bytes2Hex(memberCall)
+				}), mock.Anything).Return(out, nil).Once()
+			}
+
+			got, err := tt.get(*NewTimelockInspector(client))
+			require.NoError(t, err)
+			require.Equal(t, []string{members[0].Hex(), members[1].Hex()}, got)
+		})
+	}
+}
+
+func TestTimelockInspector_IsOperation(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.RBACTimelockMetaData.GetAbi()
+	require.NoError(t, err)
+	yes, err := parsed.Methods["isOperation"].Outputs.Pack(true)
+	require.NoError(t, err)
+
+	opID := [32]byte{0x01}
+	client := mocks.NewClient(t)
+	client.EXPECT().CallContract(mock.Anything, mock.Anything, mock.Anything).Return(yes, nil).Times(4)
+	inspector := NewTimelockInspector(client)
+
+	for _, fn := range []func(context.Context, string, [32]byte) (bool, error){
+		inspector.IsOperation, inspector.IsOperationPending, inspector.IsOperationReady, inspector.IsOperationDone,
+	} {
+		ok, err := fn(context.Background(), testTimelockAddress, opID)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+}
+
+func TestTimelockInspector_GetMinDelay(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := bindings.RBACTimelockMetaData.GetAbi()
+	require.NoError(t, err)
+	out, err := parsed.Methods["getMinDelay"].Outputs.Pack(big.NewInt(3600))
+	require.NoError(t, err)
+
+	client := mocks.NewClient(t)
+	client.EXPECT().CallContract(mock.Anything, mock.Anything, mock.Anything).Return(out, nil).Once()
+
+	got, err := NewTimelockInspector(client).GetMinDelay(context.Background(), testTimelockAddress)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3600), got)
+
+	client.EXPECT().CallContract(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("call failed")).Once()
+	_, err = NewTimelockInspector(client).GetMinDelay(context.Background(), testTimelockAddress)
+	require.EqualError(t, err, "call failed")
+}
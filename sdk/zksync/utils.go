@@ -0,0 +1,105 @@
+package zksync
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	zktypes "github.com/zksync-sdk/zksync2-go/types"
+
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/sdk/zksync/bindings"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// ChainMetadata holds the zkSync specific fields found in the chain metadata additional fields.
+// The gas fields mirror the EVM chain metadata so existing proposals keep working.
+type ChainMetadata struct {
+	GasPrice      *big.Int `json:"gasPrice,omitempty"`
+	GasLimit      uint64   `json:"gasLimit,omitempty"`
+	GasPerPubdata *big.Int `json:"gasPerPubdata,omitempty"`
+
+	// Paymaster is the address of the paymaster sponsoring the MCMS transactions.
+	Paymaster *common.Address `json:"paymaster,omitempty"`
+	// PaymasterInput is the encoded input passed to the paymaster, e.g. from
+	// zkutils.GetGeneralPaymasterInput.
+	PaymasterInput hexutil.Bytes `json:"paymasterInput,omitempty"`
+}
+
+// Validate ensures the zkSync chain metadata fields are correct
+func (m ChainMetadata) Validate() error {
+	if m.GasPrice != nil && m.GasPrice.Sign() < 0 {
+		return fmt.Errorf("invalid gas price: %v", m.GasPrice)
+	}
+	if m.GasPerPubdata != nil && m.GasPerPubdata.Sign() <= 0 {
+		return fmt.Errorf("invalid gas per pubdata: %v", m.GasPerPubdata)
+	}
+	if m.Paymaster == nil && len(m.PaymasterInput) > 0 {
+		return errors.New("paymaster input set without a paymaster")
+	}
+	if m.Paymaster != nil && *m.Paymaster == (common.Address{}) {
+		return errors.New("invalid paymaster address: zero address")
+	}
+
+	return nil
+}
+
+// PaymasterParams returns the paymaster parameters of the transactions, or nil if no paymaster is
+// configured.
+func (m ChainMetadata) PaymasterParams() *zktypes.PaymasterParams {
+	if m.Paymaster == nil {
+		return nil
+	}
+
+	return &zktypes.PaymasterParams{
+		Paymaster:      *m.Paymaster,
+		PaymasterInput: m.PaymasterInput,
+	}
+}
+
+func ParseChainMetadata(chainMetadata types.ChainMetadata) (ChainMetadata, error) {
+	if len(chainMetadata.AdditionalFields) == 0 {
+		return ChainMetadata{}, nil
+	}
+
+	var zkChainMetadata ChainMetadata
+	if err := json.Unmarshal(chainMetadata.AdditionalFields, &zkChainMetadata); err != nil {
+		return ChainMetadata{}, fmt.Errorf("failed to unmarshal chain metadata additional fields: %w", err)
+	}
+	if err := zkChainMetadata.Validate(); err != nil {
+		return ChainMetadata{}, err
+	}
+
+	return zkChainMetadata, nil
+}
+
+// transformHashes transforms a slice of common.Hash to a slice of [32]byte.
+func transformHashes(hashes []common.Hash) [][32]byte {
+	bs := make([][32]byte, 0, len(hashes))
+	for _, h := range hashes {
+		bs = append(bs, [32]byte(h))
+	}
+
+	return bs
+}
+
+// transformSignatures transforms a slice of types.Signature to a slice of
+// bindings.ManyChainMultiSigSignature, normalizing V to the 27/28 form.
+func transformSignatures(signatures []types.Signature) []bindings.ManyChainMultiSigSignature {
+	sigs := make([]bindings.ManyChainMultiSigSignature, 0, len(signatures))
+	for _, sig := range signatures {
+		if sig.V < evm.SignatureVThreshold {
+			sig.V += evm.SignatureVOffset
+		}
+		sigs = append(sigs, bindings.ManyChainMultiSigSignature{
+			R: [32]byte(sig.R.Bytes()),
+			S: [32]byte(sig.S.Bytes()),
+			V: sig.V,
+		})
+	}
+
+	return sigs
+}
@@ -0,0 +1,95 @@
+package zksync
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	zktypes "github.com/zksync-sdk/zksync2-go/types"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestParseChainMetadata(t *testing.T) {
+	t.Parallel()
+
+	paymaster := common.HexToAddress("0x0000000000000000000000000000000000009999")
+
+	tests := []struct {
+		name             string
+		additionalFields string
+		want             ChainMetadata
+		wantErr          string
+	}{
+		{
+			name: "empty",
+			want: ChainMetadata{},
+		},
+		{
+			name:             "evm compatible gas fields",
+			additionalFields: `{"gasPrice": 100, "gasLimit": 1234}`,
+			want:             ChainMetadata{GasPrice: big.NewInt(100), GasLimit: 1234},
+		},
+		{
+			name:             "paymaster",
+			additionalFields: `{"gasPerPubdata": 800, "paymaster": "0x0000000000000000000000000000000000009999", "paymasterInput": "0x0102"}`,
+			want: ChainMetadata{
+				GasPerPubdata:  big.NewInt(800),
+				Paymaster:      &paymaster,
+				PaymasterInput: []byte{0x01, 0x02},
+			},
+		},
+		{
+			name:             "invalid json",
+			additionalFields: `{"gasLimit": "abc"}`,
+			wantErr:          "failed to unmarshal chain metadata additional fields",
+		},
+		{
+			name:             "negative gas price",
+			additionalFields: `{"gasPrice": -1}`,
+			wantErr:          "invalid gas price: -1",
+		},
+		{
+			name:             "zero gas per pubdata",
+			additionalFields: `{"gasPerPubdata": 0}`,
+			wantErr:          "invalid gas per pubdata: 0",
+		},
+		{
+			name:             "paymaster input without paymaster",
+			additionalFields: `{"paymasterInput": "0x01"}`,
+			wantErr:          "paymaster input set without a paymaster",
+		},
+		{
+			name:             "zero paymaster",
+			additionalFields: `{"paymaster": "0x0000000000000000000000000000000000000000"}`,
+			wantErr:          "invalid paymaster address: zero address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseChainMetadata(types.ChainMetadata{AdditionalFields: []byte(tt.additionalFields)})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChainMetadata_PaymasterParams(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, ChainMetadata{}.PaymasterParams())
+
+	paymaster := common.HexToAddress("0x9999")
+	require.Equal(t,
+		&zktypes.PaymasterParams{Paymaster: paymaster, PaymasterInput: []byte{0x01}},
+		ChainMetadata{Paymaster: &paymaster, PaymasterInput: []byte{0x01}}.PaymasterParams(),
+	)
+}
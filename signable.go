@@ -7,6 +7,9 @@ import (
 	"slices"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
 	"github.com/smartcontractkit/mcms/internal/core/merkle"
 	"github.com/smartcontractkit/mcms/sdk"
 	"github.com/smartcontractkit/mcms/types"
@@ -61,7 +64,7 @@ func (s *Signable) SetSimulators(simulators map[types.ChainSelector]sdk.Simulato
 }
 
 // Sign signs the root of the proposal's Merkle tree with the provided signer.
-func (s *Signable) Sign(signer signer) (sig types.Signature, err error) {
+func (s *Signable) Sign(signer Signer) (sig types.Signature, err error) {
 	// Validate proposal
 	if err = s.proposal.Validate(); err != nil {
 		return sig, err
@@ -82,12 +85,72 @@ func (s *Signable) Sign(signer signer) (sig types.Signature, err error) {
 	return types.NewSignatureFromBytes(sigB)
 }
 
+// SignTypedData signs the proposal with the provided signer after having the signer review its
+// EIP 712 typed data, so that signing devices display the merkle root and the valid until timestamp
+// being approved, along with the signing message they hash to.
+//
+// The typed data is review only: the MCMS contracts only verify EIP 191 signatures of the signing
+// hash, and no EIP 712 signature recovers against it. The signer first signs the typed data, which
+// the device displays field by field, and then signs the signing message as Sign does, which the
+// device displays as hex: it must match the signingMessage field of the reviewed typed data. The returned
+// signature is the latter, which recovers its signer against Proposal.SigningHash and can be
+// appended to the proposal; the typed data signature is only checked to come from the same signer,
+// and discarded. On a Ledger, this takes two confirmations on the device.
+func (s *Signable) SignTypedData(signer TypedDataSigner, opts ...TypedDataOption) (sig types.Signature, err error) {
+	// Validate proposal
+	if err = s.proposal.Validate(); err != nil {
+		return sig, err
+	}
+
+	typedData, err := s.proposal.SigningTypedData(opts...)
+	if err != nil {
+		return sig, err
+	}
+	typedDataHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return sig, fmt.Errorf("failed to hash typed data: %w", err)
+	}
+
+	// Have the signer review the typed data
+	reviewB, err := signer.SignTypedData(typedData)
+	if err != nil {
+		return sig, err
+	}
+	review, err := types.NewSignatureFromBytes(reviewB)
+	if err != nil {
+		return sig, err
+	}
+	reviewer, err := review.Recover(common.BytesToHash(typedDataHash))
+	if err != nil {
+		return sig, err
+	}
+
+	// Sign the signing message
+	sig, err = s.Sign(signer)
+	if err != nil {
+		return sig, err
+	}
+	signingHash, err := s.proposal.SigningHash()
+	if err != nil {
+		return types.Signature{}, err
+	}
+	recovered, err := sig.Recover(signingHash)
+	if err != nil {
+		return types.Signature{}, err
+	}
+	if recovered != reviewer {
+		return types.Signature{}, fmt.Errorf("typed data reviewed by %s but proposal signed by %s", reviewer, recovered)
+	}
+
+	return sig, nil
+}
+
 // SignAndAppend signs the proposal using the provided signer and appends the resulting signature
 // to the proposal's list of signatures.
 //
 // This function modifies the proposal in place by adding the new signature to its Signatures
 // slice.
-func (s *Signable) SignAndAppend(signer signer) (types.Signature, error) {
+func (s *Signable) SignAndAppend(signer Signer) (types.Signature, error) {
 	// Sign the proposal
 	sig, err := s.Sign(signer)
 	if err != nil {
@@ -595,7 +595,7 @@ func TestSignable_Sign(t *testing.T) {
 	tests := []struct {
 		name         string
 		giveProposal *Proposal
-		giveSigner   signer
+		giveSigner   Signer
 		want         types.Signature
 		wantErr      string
 	}{
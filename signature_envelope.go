@@ -0,0 +1,222 @@
+package mcms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// SigningRequest holds everything a signer needs to sign a proposal without having access to the
+// proposal itself, allowing signatures to be collected in parallel and offline.
+type SigningRequest struct {
+	// SigningHash is the EIP-191 prefixed hash that the signatures are verified against.
+	SigningHash common.Hash `json:"signingHash"`
+	// SigningMessage is the hash without the EIP-191 prefix, which is what signers sign.
+	SigningMessage common.Hash `json:"signingMessage"`
+	ValidUntil     uint32      `json:"validUntil"`
+	Description    string      `json:"description,omitempty"`
+}
+
+// SignatureEnvelope is a detached signature of a proposal. It records the signer and the signing
+// hash it was produced for, so that envelopes collected separately can be verified and merged
+// back into the proposal.
+type SignatureEnvelope struct {
+	Signer      common.Address  `json:"signer"`
+	SigningHash common.Hash     `json:"signingHash"`
+	ValidUntil  uint32          `json:"validUntil"`
+	Signature   types.Signature `json:"signature"`
+}
+
+// SigningRequest exports a signing request for the proposal.
+func (p *Proposal) SigningRequest() (SigningRequest, error) {
+	msg, err := p.SigningMessage()
+	if err != nil {
+		return SigningRequest{}, err
+	}
+
+	return SigningRequest{
+		SigningHash:    toEthSignedMessageHash(msg.Bytes()),
+		SigningMessage: msg,
+		ValidUntil:     p.ValidUntil,
+		Description:    p.Description,
+	}, nil
+}
+
+// SigningRequest exports a signing request for the timelock proposal. The proposal is signed in its
+// converted, MCMS only, form.
+func (m *TimelockProposal) SigningRequest(ctx context.Context) (SigningRequest, error) {
+	proposal, err := m.convertForSigning(ctx)
+	if err != nil {
+		return SigningRequest{}, err
+	}
+
+	return proposal.SigningRequest()
+}
+
+// Sign signs the request with the provided signer and returns the resulting envelope.
+func (r SigningRequest) Sign(signer Signer) (SignatureEnvelope, error) {
+	sigB, err := signer.Sign(r.SigningMessage.Bytes())
+	if err != nil {
+		return SignatureEnvelope{}, err
+	}
+
+	sig, err := types.NewSignatureFromBytes(sigB)
+	if err != nil {
+		return SignatureEnvelope{}, err
+	}
+
+	address, err := signer.GetAddress()
+	if err != nil {
+		return SignatureEnvelope{}, err
+	}
+
+	envelope := SignatureEnvelope{
+		Signer:      address,
+		SigningHash: r.SigningHash,
+		ValidUntil:  r.ValidUntil,
+		Signature:   sig,
+	}
+
+	// Catch signers whose address does not match the key they signed with
+	if err = envelope.Verify(r.SigningHash); err != nil {
+		return SignatureEnvelope{}, err
+	}
+
+	return envelope, nil
+}
+
+// Verify checks that the envelope was produced for the given signing hash and that the signature
+// recovers to the envelope's signer.
+func (e SignatureEnvelope) Verify(signingHash common.Hash) error {
+	if e.SigningHash != signingHash {
+		return &ForeignSignatureError{Signer: e.Signer, SigningHash: e.SigningHash, Expected: signingHash}
+	}
+
+	recovered, err := e.Signature.Recover(signingHash)
+	if err != nil {
+		return fmt.Errorf("failed to recover signer of envelope for %s: %w", e.Signer, err)
+	}
+	if recovered != e.Signer {
+		return fmt.Errorf("signature recovers to %s, not to the envelope signer %s", recovered, e.Signer)
+	}
+
+	return nil
+}
+
+// NewSignatureEnvelope unmarshals a signature envelope from the reader.
+func NewSignatureEnvelope(r io.Reader) (SignatureEnvelope, error) {
+	var envelope SignatureEnvelope
+	if err := json.NewDecoder(r).Decode(&envelope); err != nil {
+		return SignatureEnvelope{}, fmt.Errorf("failed to decode signature envelope: %w", err)
+	}
+
+	return envelope, nil
+}
+
+// WriteSignatureEnvelope marshals the envelope to JSON and writes it to the provided writer.
+func WriteSignatureEnvelope(w io.Writer, envelope SignatureEnvelope) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(envelope)
+}
+
+// MergeSignatures verifies the envelopes against the proposal's signing hash and appends their
+// signatures to the proposal.
+//
+// Envelopes for a different signing hash, envelopes whose signature does not match the signer,
+// and signers that already signed the proposal or appear more than once are rejected. The proposal
+// is only modified if every envelope is accepted.
+func (p *Proposal) MergeSignatures(envelopes ...SignatureEnvelope) error {
+	signingHash, err := p.SigningHash()
+	if err != nil {
+		return err
+	}
+
+	sigs, err := mergeSignatures(signingHash, p.Signatures, envelopes)
+	if err != nil {
+		return err
+	}
+	p.Signatures = sigs
+
+	return nil
+}
+
+// MergeSignatures verifies the envelopes against the signing hash of the converted timelock
+// proposal and appends their signatures to it. See Proposal.MergeSignatures.
+func (m *TimelockProposal) MergeSignatures(ctx context.Context, envelopes ...SignatureEnvelope) error {
+	proposal, err := m.convertForSigning(ctx)
+	if err != nil {
+		return err
+	}
+
+	signingHash, err := proposal.SigningHash()
+	if err != nil {
+		return err
+	}
+
+	sigs, err := mergeSignatures(signingHash, m.Signatures, envelopes)
+	if err != nil {
+		return err
+	}
+	m.Signatures = sigs
+
+	return nil
+}
+
+// convertForSigning converts the timelock proposal into the MCMS proposal that is signed.
+func (m *TimelockProposal) convertForSigning(ctx context.Context) (*Proposal, error) {
+	converters, err := m.buildTimelockConverters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, _, err := m.Convert(ctx, converters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert timelock proposal: %w", err)
+	}
+
+	return &proposal, nil
+}
+
+func mergeSignatures(
+	signingHash common.Hash, existing []types.Signature, envelopes []SignatureEnvelope,
+) ([]types.Signature, error) {
+	recovered, failures := RecoverSigningAddresses(signingHash, existing)
+	if len(failures) > 0 {
+		return nil, fmt.Errorf("failed to recover existing signature %d: %w", failures[0].Index, failures[0].Err)
+	}
+
+	signers := make(map[common.Address]struct{}, len(recovered)+len(envelopes))
+	for _, address := range recovered {
+		signers[address] = struct{}{}
+	}
+
+	merged := append([]types.Signature{}, existing...)
+	var errs []error
+	for i, envelope := range envelopes {
+		if err := envelope.Verify(signingHash); err != nil {
+			errs = append(errs, fmt.Errorf("envelope %d: %w", i, err))
+			continue
+		}
+		if _, ok := signers[envelope.Signer]; ok {
+			errs = append(errs, fmt.Errorf("envelope %d: %w", i, &DuplicateSignersError{signer: envelope.Signer.Hex()}))
+			continue
+		}
+
+		signers[envelope.Signer] = struct{}{}
+		merged = append(merged, envelope.Signature)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,165 @@
+package mcms
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func TestSigningRequest_SignAndMerge(t *testing.T) {
+	t.Parallel()
+
+	proposal := orchestratorTestProposal(chaintest.Chain1Selector)
+	request, err := proposal.SigningRequest()
+	require.NoError(t, err)
+
+	signingHash, err := proposal.SigningHash()
+	require.NoError(t, err)
+	require.Equal(t, signingHash, request.SigningHash)
+	require.Equal(t, proposal.ValidUntil, request.ValidUntil)
+
+	key1, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key2, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	envelope1, err := request.Sign(NewPrivateKeySigner(key1))
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key1.PublicKey), envelope1.Signer)
+	require.NoError(t, envelope1.Verify(signingHash))
+
+	envelope2, err := request.Sign(NewPrivateKeySigner(key2))
+	require.NoError(t, err)
+
+	// Envelopes survive a round trip through JSON
+	var buf bytes.Buffer
+	require.NoError(t, WriteSignatureEnvelope(&buf, envelope2))
+	decoded, err := NewSignatureEnvelope(&buf)
+	require.NoError(t, err)
+	require.Equal(t, envelope2, decoded)
+
+	require.NoError(t, proposal.MergeSignatures(envelope1, decoded))
+
+	signers, err := proposal.RecoverSigningAddressesStrict()
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{envelope1.Signer, envelope2.Signer}, signers)
+}
+
+func TestProposal_MergeSignatures_Rejections(t *testing.T) {
+	t.Parallel()
+
+	proposal := orchestratorTestProposal(chaintest.Chain1Selector)
+	request, err := proposal.SigningRequest()
+	require.NoError(t, err)
+
+	other := orchestratorTestProposal(chaintest.Chain1Selector, chaintest.Chain2Selector)
+	otherRequest, err := other.SigningRequest()
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := NewPrivateKeySigner(key)
+
+	envelope, err := request.Sign(signer)
+	require.NoError(t, err)
+	foreign, err := otherRequest.Sign(signer)
+	require.NoError(t, err)
+
+	tampered := envelope
+	tampered.Signer = common.HexToAddress("0x1234")
+
+	tests := []struct {
+		name      string
+		envelopes []SignatureEnvelope
+		wantErr   string
+	}{
+		{
+			name:      "foreign signing hash",
+			envelopes: []SignatureEnvelope{foreign},
+			wantErr: "envelope 0: signature from " + envelope.Signer.Hex() + " is for signing hash " +
+				otherRequest.SigningHash.Hex() + ", expected " + request.SigningHash.Hex(),
+		},
+		{
+			name:      "signature does not match signer",
+			envelopes: []SignatureEnvelope{tampered},
+			wantErr: "envelope 0: signature recovers to " + envelope.Signer.Hex() +
+				", not to the envelope signer 0x0000000000000000000000000000000000001234",
+		},
+		{
+			name:      "duplicate signer",
+			envelopes: []SignatureEnvelope{envelope, envelope},
+			wantErr:   "envelope 1: duplicate signer detected: " + envelope.Signer.Hex(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := orchestratorTestProposal(chaintest.Chain1Selector)
+			err := p.MergeSignatures(tt.envelopes...)
+			require.EqualError(t, err, tt.wantErr)
+			require.Empty(t, p.Signatures)
+		})
+	}
+
+	var foreignErr *ForeignSignatureError
+	require.ErrorAs(t, proposal.MergeSignatures(foreign), &foreignErr)
+	require.Equal(t, otherRequest.SigningHash, foreignErr.SigningHash)
+
+	// Signers already present on the proposal are rejected as well
+	require.NoError(t, proposal.MergeSignatures(envelope))
+	require.ErrorContains(t, proposal.MergeSignatures(envelope), "duplicate signer detected")
+	require.Len(t, proposal.Signatures, 1)
+}
+
+func TestTimelockProposal_MergeSignatures(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	proposal := &TimelockProposal{
+		BaseProposal: BaseProposal{
+			Version:    "v1",
+			Kind:       types.KindTimelockProposal,
+			ValidUntil: 2004259681,
+			ChainMetadata: map[types.ChainSelector]types.ChainMetadata{
+				chaintest.Chain1Selector: {MCMAddress: "0x1"},
+			},
+		},
+		Action:            types.TimelockActionSchedule,
+		Delay:             types.MustParseDuration("1h"),
+		TimelockAddresses: map[types.ChainSelector]string{chaintest.Chain1Selector: "0x2"},
+		Operations: []types.BatchOperation{{
+			ChainSelector: chaintest.Chain1Selector,
+			Transactions: []types.Transaction{
+				evm.NewTransaction(common.HexToAddress("0x1234"), []byte{0x01}, big.NewInt(0), "", nil),
+			},
+		}},
+	}
+
+	request, err := proposal.SigningRequest(ctx)
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	envelope, err := request.Sign(NewPrivateKeySigner(key))
+	require.NoError(t, err)
+
+	require.NoError(t, proposal.MergeSignatures(ctx, envelope))
+	require.Equal(t, []types.Signature{envelope.Signature}, proposal.Signatures)
+
+	// The converted proposal carries the merged signature
+	converted, err := proposal.convertForSigning(ctx)
+	require.NoError(t, err)
+	signers, err := converted.RecoverSigningAddressesStrict()
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{envelope.Signer}, signers)
+}
@@ -8,17 +8,29 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
 	"github.com/smartcontractkit/mcms/sdk/usbwallet"
 )
 
-// signer is an interface for different strategies for signing payloads.
-type signer interface {
+// Signer is an interface for different strategies for signing payloads.
+//
+// Sign receives the signing message of a proposal without the EIP 191 prefix, and must return the
+// 65 byte [R || S || V] signature of its EIP 191 hash. V may be either 0/1 or 27/28.
+type Signer interface {
 	Sign(payload []byte) ([]byte, error)
 	GetAddress() (common.Address, error)
 }
 
-var _ signer = &PrivateKeySigner{}
+// TypedDataSigner is a Signer able to sign EIP 712 typed data, such as the typed data returned by
+// Proposal.SigningTypedData. SignTypedData must return the 65 byte [R || S || V] signature of the
+// EIP 712 hash of the typed data. V may be either 0/1 or 27/28.
+type TypedDataSigner interface {
+	Signer
+	SignTypedData(typedData apitypes.TypedData) ([]byte, error)
+}
+
+var _ TypedDataSigner = &PrivateKeySigner{}
 
 // PrivateKeySigner signs payloads using a private key.
 type PrivateKeySigner struct {
@@ -37,12 +49,22 @@ func (s *PrivateKeySigner) Sign(payload []byte) ([]byte, error) {
 	return crypto.Sign(toEthSignedMessageHash(payload).Bytes(), s.pk)
 }
 
+// SignTypedData signs the EIP 712 hash of the typed data using the private key.
+func (s *PrivateKeySigner) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash typed data: %w", err)
+	}
+
+	return crypto.Sign(hash, s.pk)
+}
+
 // GetAddress returns the address of the signer.
 func (s *PrivateKeySigner) GetAddress() (common.Address, error) {
 	return crypto.PubkeyToAddress(s.pk.PublicKey), nil
 }
 
-var _ signer = &LedgerSigner{}
+var _ TypedDataSigner = &LedgerSigner{}
 
 // LedgerSigner signs payloads using a Ledger.
 type LedgerSigner struct {
@@ -68,6 +90,23 @@ func (s *LedgerSigner) Sign(payload []byte) ([]byte, error) {
 	return wallet.SignText(account, payload[:])
 }
 
+// SignTypedData signs the typed data using the first wallet found on a Ledger. The Ledger
+// displays the fields of the typed data, which requires the Ethereum app v1.10.0 or later.
+func (s *LedgerSigner) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	wallet, account, err := s.setupLedgerAccount()
+	if err != nil {
+		return nil, err
+	}
+	defer wallet.Close()
+
+	typedDataWallet, ok := wallet.(usbwallet.TypedDataWallet)
+	if !ok {
+		return nil, errors.New("wallet does not support typed data signing")
+	}
+
+	return typedDataWallet.SignTypedData(account, typedData)
+}
+
 func (s *LedgerSigner) GetAddress() (common.Address, error) {
 	wallet, account, err := s.setupLedgerAccount()
 	if err != nil {
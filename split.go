@@ -0,0 +1,232 @@
+package mcms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// SplitOption configures how a timelock proposal is split.
+type SplitOption func(*splitOptions)
+
+type splitOptions struct {
+	byChain         bool
+	maxOperations   int
+	maxTransactions int
+	maxSize         int
+	size            func(types.BatchOperation) (int, error)
+}
+
+// WithSplitByChain splits the proposal into one proposal per chain, in chain selector order.
+func WithSplitByChain() SplitOption {
+	return func(o *splitOptions) {
+		o.byChain = true
+	}
+}
+
+// WithMaxOperations limits the number of batch operations of every chain in a split proposal.
+func WithMaxOperations(maxOperations int) SplitOption {
+	return func(o *splitOptions) {
+		o.maxOperations = maxOperations
+	}
+}
+
+// WithMaxTransactions limits the number of transactions of every chain in a split proposal.
+func WithMaxTransactions(maxTransactions int) SplitOption {
+	return func(o *splitOptions) {
+		o.maxTransactions = maxTransactions
+	}
+}
+
+// WithSizeBudget limits the total size of the batch operations of every chain in a split
+// proposal, as measured by the size function, e.g. the transaction size on Solana or the gas used
+// on EVM chains.
+func WithSizeBudget(maxSize int, size func(types.BatchOperation) (int, error)) SplitOption {
+	return func(o *splitOptions) {
+		o.maxSize = maxSize
+		o.size = size
+	}
+}
+
+// Split partitions the operations of the proposal into an ordered chain of smaller proposals, the
+// inverse of Merge. Operations keep their relative order, and a new proposal is started whenever
+// the next operation would exceed a budget of its chain. Without options the proposal is returned
+// as a single part.
+//
+// The StartingOpCount of every chain is chained from the operation counts of the parts before it
+// after conversion, as WithPredecessors computes it, so the parts must be executed in order. Each
+// part gets its own salt derived from the salt of the proposal, so that identical operations in
+// different parts have distinct timelock operation IDs. Timelock predecessors are chained within
+// each part only. Only the first part keeps OverridePreviousRoot, and signatures are dropped as
+// they are not valid for the parts.
+func (m *TimelockProposal) Split(ctx context.Context, opts ...SplitOption) ([]*TimelockProposal, error) {
+	o := splitOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.maxOperations < 0 || o.maxTransactions < 0 || o.maxSize < 0 {
+		return nil, errors.New("split budgets must not be negative")
+	}
+	if o.maxSize > 0 && o.size == nil {
+		return nil, errors.New("size budget requires a size function")
+	}
+
+	for _, op := range m.Operations {
+		if _, ok := m.ChainMetadata[op.ChainSelector]; !ok {
+			return nil, fmt.Errorf("missing chain metadata for chain selector %d", op.ChainSelector)
+		}
+	}
+
+	var groups [][]types.BatchOperation
+	if o.byChain {
+		for _, selector := range slices.Sorted(maps.Keys(m.ChainMetadata)) {
+			var chainOps []types.BatchOperation
+			for _, op := range m.Operations {
+				if op.ChainSelector == selector {
+					chainOps = append(chainOps, op)
+				}
+			}
+			if len(chainOps) > 0 {
+				groups = append(groups, chainOps)
+			}
+		}
+	} else {
+		groups = [][]types.BatchOperation{m.Operations}
+	}
+
+	var parts [][]types.BatchOperation
+	for _, group := range groups {
+		groupParts, err := splitOperations(group, o)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, groupParts...)
+	}
+
+	startingOpCounts := make(map[types.ChainSelector]uint64, len(m.ChainMetadata))
+	for selector, metadata := range m.ChainMetadata {
+		startingOpCounts[selector] = metadata.StartingOpCount
+	}
+
+	salt := m.Salt()
+	proposals := make([]*TimelockProposal, len(parts))
+	for i, ops := range parts {
+		proposal := m.newSplitPart(ops, startingOpCounts)
+		if len(parts) > 1 {
+			partSalt := splitSalt(salt, i)
+			proposal.SaltOverride = &partSalt
+			proposal.OverridePreviousRoot = m.OverridePreviousRoot && i == 0
+		}
+
+		counts, err := proposal.OperationCounts(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count operations of part %d: %w", i, err)
+		}
+		for selector, count := range counts {
+			startingOpCounts[selector] += count
+		}
+		proposals[i] = proposal
+	}
+
+	return proposals, nil
+}
+
+// newSplitPart returns a proposal with the given operations and the chain metadata and timelock
+// addresses of their chains.
+func (m *TimelockProposal) newSplitPart(
+	ops []types.BatchOperation, startingOpCounts map[types.ChainSelector]uint64,
+) *TimelockProposal {
+	proposal := &TimelockProposal{
+		BaseProposal: BaseProposal{
+			Version:              m.Version,
+			Kind:                 m.Kind,
+			ValidUntil:           m.ValidUntil,
+			Description:          m.Description,
+			OverridePreviousRoot: m.OverridePreviousRoot,
+			Metadata:             maps.Clone(m.Metadata),
+			ChainMetadata:        make(map[types.ChainSelector]types.ChainMetadata),
+		},
+		Action:            m.Action,
+		Delay:             m.Delay,
+		TimelockAddresses: make(map[types.ChainSelector]string),
+		Operations:        slices.Clone(ops),
+		SaltOverride:      m.SaltOverride,
+	}
+
+	for _, op := range ops {
+		metadata := m.ChainMetadata[op.ChainSelector]
+		metadata.StartingOpCount = startingOpCounts[op.ChainSelector]
+		proposal.ChainMetadata[op.ChainSelector] = metadata
+		proposal.TimelockAddresses[op.ChainSelector] = m.TimelockAddresses[op.ChainSelector]
+	}
+
+	return proposal
+}
+
+// splitOperations greedily partitions the operations in order, starting a new part when the next
+// operation would exceed a budget of its chain.
+func splitOperations(ops []types.BatchOperation, o splitOptions) ([][]types.BatchOperation, error) {
+	type usage struct {
+		operations   int
+		transactions int
+		size         int
+	}
+
+	var (
+		parts   [][]types.BatchOperation
+		current []types.BatchOperation
+		used    = make(map[types.ChainSelector]usage)
+	)
+	for _, op := range ops {
+		size := 0
+		if o.maxSize > 0 {
+			var err error
+			size, err = o.size(op)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compute size of operation on chain %d: %w", op.ChainSelector, err)
+			}
+		}
+
+		if (o.maxTransactions > 0 && len(op.Transactions) > o.maxTransactions) || (o.maxSize > 0 && size > o.maxSize) {
+			return nil, fmt.Errorf("operation on chain %d exceeds the split budget on its own", op.ChainSelector)
+		}
+
+		next := used[op.ChainSelector]
+		next.operations++
+		next.transactions += len(op.Transactions)
+		next.size += size
+
+		exceeded := (o.maxOperations > 0 && next.operations > o.maxOperations) ||
+			(o.maxTransactions > 0 && next.transactions > o.maxTransactions) ||
+			(o.maxSize > 0 && next.size > o.maxSize)
+		if exceeded {
+			parts = append(parts, current)
+			current = nil
+			clear(used)
+			next = usage{operations: 1, transactions: len(op.Transactions), size: size}
+		}
+
+		current = append(current, op)
+		used[op.ChainSelector] = next
+	}
+
+	if len(current) > 0 {
+		parts = append(parts, current)
+	}
+
+	return parts, nil
+}
+
+// splitSalt derives the salt of the part at index from the salt of the split proposal.
+func splitSalt(salt [32]byte, index int) common.Hash {
+	return crypto.Keccak256Hash(salt[:], common.BigToHash(big.NewInt(int64(index))).Bytes())
+}
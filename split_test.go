@@ -0,0 +1,209 @@
+package mcms
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+func newSplitTestProposal(t *testing.T) *TimelockProposal {
+	t.Helper()
+
+	tx := func(data string) types.Transaction {
+		return types.Transaction{
+			To:               "0x0000000000000000000000000000000000000001",
+			AdditionalFields: []byte(`{"value": 0}`),
+			Data:             []byte(data),
+		}
+	}
+
+	return mustBuild(t, NewTimelockProposalBuilder().
+		SetVersion("v1").
+		SetValidUntil(2004259681).
+		SetDescription("Split proposal").
+		SetOverridePreviousRoot(true).
+		SetAction(types.TimelockActionSchedule).
+		SetDelay(types.MustParseDuration("1h")).
+		SetChainMetadata(map[types.ChainSelector]types.ChainMetadata{
+			chaintest.Chain1Selector: {StartingOpCount: 5, MCMAddress: "0x0000000000000000000000000000000000000011"},
+			chaintest.Chain2Selector: {StartingOpCount: 1, MCMAddress: "0x0000000000000000000000000000000000000012"},
+		}).
+		AddTimelockAddress(chaintest.Chain1Selector, "0x0000000000000000000000000000000000000021").
+		AddTimelockAddress(chaintest.Chain2Selector, "0x0000000000000000000000000000000000000022").
+		SetOperations([]types.BatchOperation{
+			{ChainSelector: chaintest.Chain1Selector, Transactions: []types.Transaction{tx("a")}},
+			{ChainSelector: chaintest.Chain2Selector, Transactions: []types.Transaction{tx("b"), tx("c")}},
+			{ChainSelector: chaintest.Chain1Selector, Transactions: []types.Transaction{tx("a")}},
+			{ChainSelector: chaintest.Chain2Selector, Transactions: []types.Transaction{tx("d")}},
+			{ChainSelector: chaintest.Chain1Selector, Transactions: []types.Transaction{tx("e")}},
+		}))
+}
+
+func TestTimelockProposal_Split(t *testing.T) {
+	t.Parallel()
+
+	// sizeOf measures an operation by the length of its data
+	sizeOf := func(op types.BatchOperation) (int, error) {
+		size := 0
+		for _, tx := range op.Transactions {
+			size += len(tx.Data)
+		}
+
+		return size, nil
+	}
+
+	tests := []struct {
+		name string
+		opts []SplitOption
+		// wantParts are the data of the first transaction of the operations of each part
+		wantParts []string
+		// wantStartingOpCounts are the starting op counts of chain 1 and 2 of each part, 0 when
+		// the chain is not part of it. Every scheduled batch operation is a single MCMS operation.
+		wantStartingOpCounts [][2]uint64
+		wantErr              string
+	}{
+		{
+			name:                 "success: no options",
+			wantParts:            []string{"abade"},
+			wantStartingOpCounts: [][2]uint64{{5, 1}},
+		},
+		{
+			name:                 "success: by chain",
+			opts:                 []SplitOption{WithSplitByChain()},
+			wantParts:            []string{"aae", "bd"},
+			wantStartingOpCounts: [][2]uint64{{5, 0}, {0, 1}},
+		},
+		{
+			name:                 "success: max operations per chain",
+			opts:                 []SplitOption{WithMaxOperations(1)},
+			wantParts:            []string{"ab", "ad", "e"},
+			wantStartingOpCounts: [][2]uint64{{5, 1}, {6, 2}, {7, 0}},
+		},
+		{
+			name:                 "success: max transactions per chain",
+			opts:                 []SplitOption{WithMaxTransactions(2)},
+			wantParts:            []string{"aba", "de"},
+			wantStartingOpCounts: [][2]uint64{{5, 1}, {7, 2}},
+		},
+		{
+			name:                 "success: by chain with size budget",
+			opts:                 []SplitOption{WithSplitByChain(), WithSizeBudget(2, sizeOf)},
+			wantParts:            []string{"aa", "e", "b", "d"},
+			wantStartingOpCounts: [][2]uint64{{5, 0}, {7, 0}, {0, 1}, {0, 2}},
+		},
+		{
+			name:    "failure: operation over budget",
+			opts:    []SplitOption{WithMaxTransactions(1)},
+			wantErr: "operation on chain 16015286601757825753 exceeds the split budget on its own",
+		},
+		{
+			name: "failure: size function error",
+			opts: []SplitOption{WithSizeBudget(1, func(types.BatchOperation) (int, error) {
+				return 0, errors.New("boom")
+			})},
+			wantErr: "failed to compute size of operation on chain 3379446385462418246: boom",
+		},
+		{
+			name:    "failure: size budget without size function",
+			opts:    []SplitOption{WithSizeBudget(1, nil)},
+			wantErr: "size budget requires a size function",
+		},
+		{
+			name:    "failure: negative budget",
+			opts:    []SplitOption{WithMaxOperations(-1)},
+			wantErr: "split budgets must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			proposal := newSplitTestProposal(t)
+			parts, err := proposal.Split(t.Context(), tt.opts...)
+
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, parts, len(tt.wantParts))
+
+			for i, part := range parts {
+				require.NoError(t, part.Validate())
+
+				data := ""
+				for _, op := range part.Operations {
+					data += string(op.Transactions[0].Data)
+				}
+				require.Equal(t, tt.wantParts[i], data, "part %d", i)
+
+				var counts [2]uint64
+				for j, selector := range []types.ChainSelector{chaintest.Chain1Selector, chaintest.Chain2Selector} {
+					metadata, ok := part.ChainMetadata[selector]
+					if ok {
+						counts[j] = metadata.StartingOpCount
+						require.Equal(t, proposal.TimelockAddresses[selector], part.TimelockAddresses[selector])
+					} else {
+						require.NotContains(t, part.TimelockAddresses, selector)
+					}
+				}
+				require.Equal(t, tt.wantStartingOpCounts[i], counts, "part %d", i)
+
+				require.Equal(t, proposal.Action, part.Action)
+				require.Equal(t, proposal.Delay, part.Delay)
+				require.Empty(t, part.Signatures)
+				require.Equal(t, i == 0, part.OverridePreviousRoot, "part %d", i)
+			}
+		})
+	}
+}
+
+func TestTimelockProposal_Split_ChainsWithPredecessors(t *testing.T) {
+	t.Parallel()
+
+	proposal := newSplitTestProposal(t)
+	parts, err := proposal.Split(t.Context(), WithMaxOperations(1))
+	require.NoError(t, err)
+	require.Len(t, parts, 3)
+
+	encoded := make([][]byte, len(parts))
+	for i, part := range parts {
+		var b bytes.Buffer
+		require.NoError(t, WriteTimelockProposal(&b, part))
+		encoded[i] = b.Bytes()
+	}
+
+	// WithPredecessors derives the same starting op counts from the previous parts
+	for i := 1; i < len(parts); i++ {
+		predecessors := make([]io.Reader, i)
+		for j := range i {
+			predecessors[j] = bytes.NewReader(encoded[j])
+		}
+
+		derived, err := NewTimelockProposal(bytes.NewReader(encoded[i]), WithPredecessors(predecessors))
+		require.NoError(t, err)
+		require.Equal(t, parts[i].ChainMetadata, derived.ChainMetadata, "part %d", i)
+	}
+
+	// The identical operations of the first and second parts have distinct operation IDs
+	ids := make(map[common.Hash]struct{})
+	for _, part := range parts {
+		require.NotNil(t, part.SaltOverride)
+
+		partIDs, _, err := part.OperationIDs(t.Context())
+		require.NoError(t, err)
+		for _, id := range partIDs {
+			require.NotContains(t, ids, id)
+			ids[id] = struct{}{}
+		}
+	}
+	require.Len(t, ids, len(proposal.Operations))
+}
@@ -0,0 +1,255 @@
+package mcms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// OperationState is the on-chain state of an operation of a proposal.
+type OperationState string
+
+const (
+	// OperationStateUnexecuted means the operation has not been executed by the MCM.
+	OperationStateUnexecuted OperationState = "unexecuted"
+	// OperationStateExecuted means the operation has been executed by the MCM. It is only used for
+	// operations which are not scheduled on a timelock.
+	OperationStateExecuted OperationState = "executed"
+	// OperationStateScheduled means the operation is scheduled on the timelock and its delay has
+	// not passed yet.
+	OperationStateScheduled OperationState = "scheduled"
+	// OperationStateReady means the operation is scheduled on the timelock and can be executed.
+	OperationStateReady OperationState = "ready"
+	// OperationStateDone means the operation has been executed by the timelock.
+	OperationStateDone OperationState = "done"
+	// OperationStateCancelled means the operation has been scheduled or cancelled by the MCM, but
+	// is not an operation of the timelock anymore.
+	OperationStateCancelled OperationState = "cancelled"
+)
+
+// ChainStatus is the state of the MCM contract of a chain of a proposal.
+type ChainStatus struct {
+	ChainSelector types.ChainSelector `json:"chainSelector"`
+	MCMAddress    string              `json:"mcmAddress"`
+	// RootSet is true if the MCM holds the merkle root of the proposal, set at the starting op
+	// count of the proposal.
+	RootSet bool `json:"rootSet"`
+	// ValidUntil is the expiry of the root held by the MCM.
+	ValidUntil uint32 `json:"validUntil"`
+	OpCount    uint64 `json:"opCount"`
+}
+
+// OperationStatus is the state of an operation of a proposal.
+type OperationStatus struct {
+	// Index is the index of the operation in the proposal.
+	Index         int                 `json:"index"`
+	ChainSelector types.ChainSelector `json:"chainSelector"`
+	State         OperationState      `json:"state"`
+	// OperationID is the timelock operation ID of the operation. It is only set for the
+	// operations of timelock proposals scheduling or cancelling operations.
+	OperationID *common.Hash `json:"operationId,omitempty"`
+}
+
+// ProposalStatus reports where a proposal is on every chain. Chains are ordered by chain
+// selector and operations follow the order of the proposal.
+type ProposalStatus struct {
+	Chains     []ChainStatus     `json:"chains"`
+	Operations []OperationStatus `json:"operations"`
+}
+
+// Status fetches the state of the proposal from the MCM contracts of every chain using the given
+// inspectors.
+//
+// An operation is reported as executed once the op count of its MCM is past its nonce, so
+// operations of another proposal executed with the same nonces are reported as executed too.
+func (p *Proposal) Status(ctx context.Context, inspectors map[types.ChainSelector]sdk.Inspector) (*ProposalStatus, error) {
+	chains, err := p.chainStatuses(ctx, inspectors)
+	if err != nil {
+		return nil, err
+	}
+
+	txNonces, err := p.TransactionNonces()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &ProposalStatus{Chains: chains, Operations: make([]OperationStatus, len(p.Operations))}
+	opCounts := status.opCounts()
+	for i, op := range p.Operations {
+		state := OperationStateUnexecuted
+		if txNonces[i] < opCounts[op.ChainSelector] {
+			state = OperationStateExecuted
+		}
+		status.Operations[i] = OperationStatus{Index: i, ChainSelector: op.ChainSelector, State: state}
+	}
+
+	return status, nil
+}
+
+// chainStatuses fetches the state of the MCM contracts of the proposal.
+func (p *Proposal) chainStatuses(ctx context.Context, inspectors map[types.ChainSelector]sdk.Inspector) ([]ChainStatus, error) {
+	tree, err := p.MerkleTree()
+	if err != nil {
+		return nil, err
+	}
+
+	chains := make([]ChainStatus, 0, len(p.ChainMetadata))
+	for _, sel := range p.ChainSelectors() {
+		inspector, ok := inspectors[sel]
+		if !ok {
+			return nil, fmt.Errorf("inspector not found for chain %d", sel)
+		}
+		metadata := p.ChainMetadata[sel]
+
+		root, validUntil, err := inspector.GetRoot(ctx, metadata.MCMAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get root for chain %d: %w", sel, err)
+		}
+		rootMetadata, err := inspector.GetRootMetadata(ctx, metadata.MCMAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get root metadata for chain %d: %w", sel, err)
+		}
+		opCount, err := inspector.GetOpCount(ctx, metadata.MCMAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get op count for chain %d: %w", sel, err)
+		}
+
+		chains = append(chains, ChainStatus{
+			ChainSelector: sel,
+			MCMAddress:    metadata.MCMAddress,
+			RootSet:       root == tree.Root && rootMetadata.StartingOpCount == metadata.StartingOpCount,
+			ValidUntil:    validUntil,
+			OpCount:       opCount,
+		})
+	}
+
+	return chains, nil
+}
+
+// Status fetches the state of the proposal from the MCM and timelock contracts of every chain
+// using the given inspectors. The chains report the state of the converted proposal.
+//
+// The operations of schedule and cancel proposals are reported with the state of their timelock
+// operation, falling back to unexecuted or cancelled when the timelock does not know about them.
+// The operations of bypass proposals are reported as executed or unexecuted.
+func (m *TimelockProposal) Status(
+	ctx context.Context,
+	inspectors map[types.ChainSelector]sdk.Inspector,
+	timelockInspectors map[types.ChainSelector]sdk.TimelockInspector,
+) (*ProposalStatus, error) {
+	converters, err := m.buildTimelockConverters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	converted, _, err := m.Convert(ctx, converters)
+	if err != nil {
+		return nil, err
+	}
+
+	chains, err := converted.chainStatuses(ctx, inspectors)
+	if err != nil {
+		return nil, err
+	}
+	status := &ProposalStatus{Chains: chains, Operations: make([]OperationStatus, len(m.Operations))}
+
+	opIDs, predecessors, err := m.OperationIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opCounts := status.opCounts()
+	// nextNonces holds the nonce of the next converted operation of every chain
+	nextNonces := make(map[types.ChainSelector]uint64, len(m.ChainMetadata))
+	for sel, metadata := range m.ChainMetadata {
+		nextNonces[sel] = metadata.StartingOpCount
+	}
+
+	for i, bop := range m.Operations {
+		sel := bop.ChainSelector
+		metadata := m.ChainMetadata[sel]
+
+		// A batch operation may be converted to several operations, all of which must be executed
+		chainOps, _, err := converters[sel].ConvertBatchToChainOperations(
+			ctx, metadata, bop, m.TimelockAddresses[sel], metadata.MCMAddress, m.Delay, m.Action, predecessors[i], m.Salt(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		nextNonces[sel] += uint64(len(chainOps))
+		executed := nextNonces[sel] <= opCounts[sel]
+
+		opStatus := OperationStatus{Index: i, ChainSelector: sel, State: OperationStateUnexecuted}
+		if m.Action == types.TimelockActionBypass {
+			if executed {
+				opStatus.State = OperationStateExecuted
+			}
+			status.Operations[i] = opStatus
+
+			continue
+		}
+
+		inspector, ok := timelockInspectors[sel]
+		if !ok {
+			return nil, fmt.Errorf("timelock inspector not found for chain %d", sel)
+		}
+		opID := opIDs[i]
+		opStatus.OperationID = &opID
+		opStatus.State, err = timelockOperationState(ctx, inspector, m.TimelockAddresses[sel], opID, executed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get state of operation %d: %w", i, err)
+		}
+		status.Operations[i] = opStatus
+	}
+
+	return status, nil
+}
+
+// timelockOperationState returns the state of a scheduled or cancelled operation. executed tells
+// whether the MCM executed the operations scheduling or cancelling it.
+func timelockOperationState(
+	ctx context.Context, inspector sdk.TimelockInspector, timelockAddress string, opID common.Hash, executed bool,
+) (OperationState, error) {
+	done, err := inspector.IsOperationDone(ctx, timelockAddress, opID)
+	if err != nil {
+		return "", err
+	}
+	if done {
+		return OperationStateDone, nil
+	}
+
+	ready, err := inspector.IsOperationReady(ctx, timelockAddress, opID)
+	if err != nil {
+		return "", err
+	}
+	if ready {
+		return OperationStateReady, nil
+	}
+
+	pending, err := inspector.IsOperationPending(ctx, timelockAddress, opID)
+	if err != nil {
+		return "", err
+	}
+	if pending {
+		return OperationStateScheduled, nil
+	}
+
+	if executed {
+		return OperationStateCancelled, nil
+	}
+
+	return OperationStateUnexecuted, nil
+}
+
+// opCounts returns the op count of the MCM of every chain.
+func (s *ProposalStatus) opCounts() map[types.ChainSelector]uint64 {
+	opCounts := make(map[types.ChainSelector]uint64, len(s.Chains))
+	for _, chain := range s.Chains {
+		opCounts[chain.ChainSelector] = chain.OpCount
+	}
+
+	return opCounts
+}
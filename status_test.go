@@ -0,0 +1,226 @@
+package mcms
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils"
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+	"github.com/smartcontractkit/mcms/internal/testutils/memchain"
+	"github.com/smartcontractkit/mcms/sdk"
+	"github.com/smartcontractkit/mcms/sdk/evm"
+	"github.com/smartcontractkit/mcms/types"
+)
+
+// memchainEnv is a preflightEnv which also keeps the owner of its contracts, the only executor of
+// its timelock, and executes the proposals it signs.
+type memchainEnv struct {
+	preflightEnv
+	owner common.Address
+}
+
+func newMemchainEnv(t *testing.T) memchainEnv {
+	t.Helper()
+
+	chain, err := memchain.NewChain(chaintest.Chain1Selector)
+	require.NoError(t, err)
+
+	owner := testutils.NewECDSASigner().Address()
+	signer := *testutils.NewECDSASigner()
+	mcm := chain.DeployMCM(owner)
+	_, err = memchain.NewConfigurer(chain, owner).SetConfig(t.Context(), mcm.Hex(),
+		&types.Config{Quorum: 1, Signers: []common.Address{signer.Address()}}, false)
+	require.NoError(t, err)
+	timelock := chain.DeployTimelock(time.Hour, owner, []common.Address{mcm}, []common.Address{owner}, []common.Address{mcm}, nil)
+
+	return memchainEnv{
+		preflightEnv: preflightEnv{chain: chain, signer: signer, mcm: mcm, timelock: timelock},
+		owner:        owner,
+	}
+}
+
+// newExecutable signs the proposal and returns its executable.
+func (e memchainEnv) newExecutable(t *testing.T, proposal *Proposal) *Executable {
+	t.Helper()
+
+	signable, err := NewSignable(proposal, e.inspectors())
+	require.NoError(t, err)
+	_, err = signable.SignAndAppend(NewPrivateKeySigner(e.signer.Key))
+	require.NoError(t, err)
+
+	encoders, err := proposal.GetEncoders()
+	require.NoError(t, err)
+	encoder, ok := encoders[chaintest.Chain1Selector].(*evm.Encoder)
+	require.True(t, ok)
+	executable, err := NewExecutable(proposal, map[types.ChainSelector]sdk.Executor{
+		chaintest.Chain1Selector: memchain.NewExecutor(encoder, e.chain),
+	})
+	require.NoError(t, err)
+
+	return executable
+}
+
+func operationStates(status *ProposalStatus) []OperationState {
+	states := make([]OperationState, 0, len(status.Operations))
+	for _, op := range status.Operations {
+		states = append(states, op.State)
+	}
+
+	return states
+}
+
+func TestProposal_Status(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newMemchainEnv(t)
+	proposal, err := NewProposalBuilder().
+		SetVersion("v1").
+		SetValidUntil(uint32(time.Now().Add(time.Hour).Unix())). //nolint:gosec // test time
+		AddChainMetadata(chaintest.Chain1Selector, types.ChainMetadata{MCMAddress: env.mcm.Hex()}).
+		AddOperation(types.Operation{
+			ChainSelector: chaintest.Chain1Selector,
+			Transaction:   evm.NewTransaction(common.HexToAddress("0x1000"), []byte{0x01}, big.NewInt(0), "Target", nil),
+		}).
+		AddOperation(types.Operation{
+			ChainSelector: chaintest.Chain1Selector,
+			Transaction:   evm.NewTransaction(common.HexToAddress("0x1000"), []byte{0x02}, big.NewInt(0), "Target", nil),
+		}).
+		Build()
+	require.NoError(t, err)
+
+	status, err := proposal.Status(ctx, env.inspectors())
+	require.NoError(t, err)
+	assert.Equal(t, []ChainStatus{{ChainSelector: chaintest.Chain1Selector, MCMAddress: env.mcm.Hex()}}, status.Chains)
+	assert.Equal(t, []OperationState{OperationStateUnexecuted, OperationStateUnexecuted}, operationStates(status))
+
+	executable := env.newExecutable(t, proposal)
+	_, err = executable.SetRoot(ctx, chaintest.Chain1Selector)
+	require.NoError(t, err)
+	_, err = executable.Execute(ctx, 0)
+	require.NoError(t, err)
+
+	status, err = proposal.Status(ctx, env.inspectors())
+	require.NoError(t, err)
+	assert.Equal(t, []ChainStatus{{
+		ChainSelector: chaintest.Chain1Selector,
+		MCMAddress:    env.mcm.Hex(),
+		RootSet:       true,
+		ValidUntil:    proposal.ValidUntil,
+		OpCount:       1,
+	}}, status.Chains)
+	assert.Equal(t, []OperationStatus{
+		{Index: 0, ChainSelector: chaintest.Chain1Selector, State: OperationStateExecuted},
+		{Index: 1, ChainSelector: chaintest.Chain1Selector, State: OperationStateUnexecuted},
+	}, status.Operations)
+
+	// The report is serializable
+	data, err := json.Marshal(status)
+	require.NoError(t, err)
+	var decoded ProposalStatus
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, *status, decoded)
+
+	_, err = proposal.Status(ctx, map[types.ChainSelector]sdk.Inspector{})
+	require.EqualError(t, err, "inspector not found for chain 3379446385462418246")
+}
+
+func TestTimelockProposal_Status(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	env := newMemchainEnv(t)
+	newTimelockProposal := func(action types.TimelockAction, data byte) *TimelockProposal {
+		opCount, err := memchain.NewInspector(env.chain).GetOpCount(ctx, env.mcm.Hex())
+		require.NoError(t, err)
+
+		proposal, err := NewTimelockProposalBuilder().
+			SetVersion("v1").
+			SetValidUntil(uint32(time.Now().Add(time.Hour).Unix())). //nolint:gosec // test time
+			AddChainMetadata(chaintest.Chain1Selector, types.ChainMetadata{StartingOpCount: opCount, MCMAddress: env.mcm.Hex()}).
+			AddTimelockAddress(chaintest.Chain1Selector, env.timelock.Hex()).
+			SetAction(action).
+			SetDelay(types.NewDuration(time.Hour)).
+			AddOperation(types.BatchOperation{
+				ChainSelector: chaintest.Chain1Selector,
+				Transactions: []types.Transaction{
+					evm.NewTransaction(common.HexToAddress("0x1000"), []byte{data}, big.NewInt(0), "Target", nil),
+				},
+			}).
+			AddOperation(types.BatchOperation{
+				ChainSelector: chaintest.Chain1Selector,
+				Transactions: []types.Transaction{
+					evm.NewTransaction(common.HexToAddress("0x1000"), []byte{data + 1}, big.NewInt(0), "Target", nil),
+				},
+			}).
+			Build()
+		require.NoError(t, err)
+
+		return proposal
+	}
+	setRoot := func(proposal *TimelockProposal) *Executable {
+		converted, _, err := proposal.Convert(ctx, map[types.ChainSelector]sdk.TimelockConverter{
+			chaintest.Chain1Selector: memchain.NewTimelockConverter(),
+		})
+		require.NoError(t, err)
+		executable := env.newExecutable(t, &converted)
+		_, err = executable.SetRoot(ctx, chaintest.Chain1Selector)
+		require.NoError(t, err)
+
+		return executable
+	}
+	requireStates := func(proposal *TimelockProposal, want ...OperationState) {
+		status, err := proposal.Status(ctx, env.inspectors(), env.timelockInspectors())
+		require.NoError(t, err)
+		require.Equal(t, want, operationStates(status))
+	}
+
+	schedule := newTimelockProposal(types.TimelockActionSchedule, 0x01)
+	requireStates(schedule, OperationStateUnexecuted, OperationStateUnexecuted)
+
+	executable := setRoot(schedule)
+	_, err := executable.Execute(ctx, 0)
+	require.NoError(t, err)
+	requireStates(schedule, OperationStateScheduled, OperationStateUnexecuted)
+	_, err = executable.Execute(ctx, 1)
+	require.NoError(t, err)
+	requireStates(schedule, OperationStateScheduled, OperationStateScheduled)
+
+	env.chain.AdvanceTime(time.Hour)
+	requireStates(schedule, OperationStateReady, OperationStateReady)
+
+	_, err = memchain.NewTimelockExecutor(env.chain, env.owner).
+		Execute(ctx, schedule.Operations[0], env.timelock.Hex(), ZeroHash, schedule.Salt())
+	require.NoError(t, err)
+	requireStates(schedule, OperationStateDone, OperationStateReady)
+
+	// Operations of a cancellation proposal are cancelled once it is executed
+	cancelled := newTimelockProposal(types.TimelockActionSchedule, 0x10)
+	executable = setRoot(cancelled)
+	for i := range 2 {
+		_, err = executable.Execute(ctx, i)
+		require.NoError(t, err)
+	}
+	cancel, err := cancelled.DeriveCancellationProposal(map[types.ChainSelector]types.ChainMetadata{
+		chaintest.Chain1Selector: {StartingOpCount: 4, MCMAddress: env.mcm.Hex()},
+	})
+	require.NoError(t, err)
+	requireStates(&cancel, OperationStateScheduled, OperationStateScheduled)
+	executable = setRoot(&cancel)
+	_, err = executable.Execute(ctx, 0)
+	require.NoError(t, err)
+	requireStates(&cancel, OperationStateCancelled, OperationStateScheduled)
+	requireStates(cancelled, OperationStateCancelled, OperationStateScheduled)
+
+	status, err := schedule.Status(ctx, env.inspectors(), env.timelockInspectors())
+	require.NoError(t, err)
+	opIDs, _, err := schedule.OperationIDs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &opIDs[0], status.Operations[0].OperationID)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/smartcontractkit/mcms/sdk"
 	"github.com/smartcontractkit/mcms/types"
@@ -18,6 +19,7 @@ type TimelockExecutable struct {
 	proposal     *TimelockProposal
 	predecessors []common.Hash
 	executors    map[types.ChainSelector]sdk.TimelockExecutor
+	journal      *executionJournal
 }
 
 // NewTimelockExecutable creates a new TimelockExecutable from a proposal and a map of executors.
@@ -25,7 +27,13 @@ func NewTimelockExecutable(
 	ctx context.Context,
 	proposal *TimelockProposal,
 	executors map[types.ChainSelector]sdk.TimelockExecutor,
+	opts ...ExecutableOption,
 ) (*TimelockExecutable, error) {
+	execOpts := &executableOptions{}
+	for _, opt := range opts {
+		opt(execOpts)
+	}
+
 	if proposal.Action != types.TimelockActionSchedule {
 		return nil, errors.New("TimelockExecutable can only be created from a TimelockProposal with action 'schedule'")
 	}
@@ -43,6 +51,24 @@ func NewTimelockExecutable(
 		return nil, fmt.Errorf("unable to set predecessors: %w", err)
 	}
 
+	// Timelock proposals have no signing hash, so entries are keyed by the hash of all the
+	// proposal's operation IDs instead
+	if execOpts.journal != nil {
+		opIDs, _, err := proposal.OperationIDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compute journal key: %w", err)
+		}
+		idBytes := make([][]byte, 0, len(opIDs))
+		for _, opID := range opIDs {
+			idBytes = append(idBytes, opID.Bytes())
+		}
+		te.journal = &executionJournal{
+			journal:      execOpts.journal,
+			proposalHash: crypto.Keccak256Hash(idBytes...),
+			checkers:     execOpts.checkers,
+		}
+	}
+
 	return te, nil
 }
 
@@ -157,6 +183,18 @@ func (t *TimelockExecutable) IsChainDone(ctx context.Context, chainSelector type
 }
 
 func (t *TimelockExecutable) IsOperationDone(ctx context.Context, idx int) error {
+	isDone, err := t.isOperationDone(ctx, idx)
+	if err != nil {
+		return err
+	}
+	if !isDone {
+		return &OperationNotDoneError{OpIndex: idx}
+	}
+
+	return nil
+}
+
+func (t *TimelockExecutable) isOperationDone(ctx context.Context, idx int) (bool, error) {
 	op := t.proposal.Operations[idx]
 
 	cs := op.ChainSelector
@@ -164,18 +202,10 @@ func (t *TimelockExecutable) IsOperationDone(ctx context.Context, idx int) error
 
 	operationID, err := t.GetOpID(ctx, idx, op, cs)
 	if err != nil {
-		return fmt.Errorf("unable to get operation ID: %w", err)
-	}
-
-	isDone, err := t.executors[cs].IsOperationDone(ctx, timelock, operationID)
-	if err != nil {
-		return err
-	}
-	if !isDone {
-		return &OperationNotDoneError{OpIndex: idx}
+		return false, fmt.Errorf("unable to get operation ID: %w", err)
 	}
 
-	return nil
+	return t.executors[cs].IsOperationDone(ctx, timelock, operationID)
 }
 
 type Option func(*executeOptions)
@@ -207,13 +237,28 @@ func (t *TimelockExecutable) GetChainSpecificIndex(index int) int {
 // Execute executes the operation at the given index.
 // Includes an option to set callProxy to execute the calls through a proxy.
 // If the callProxy is not set, the calls will be executed directly
-// to the timelock.
+// to the timelock. If the executable has a journal, an operation
+// already submitted is not sent again.
 func (t *TimelockExecutable) Execute(ctx context.Context, index int, opts ...Option) (types.TransactionResult, error) {
 	execOpts := &executeOptions{}
 	for _, opt := range opts {
 		opt(execOpts)
 	}
 
+	op := t.proposal.Operations[index]
+	key := JournalKey{Kind: ExecutionStepTimelockExecute, ChainSelector: op.ChainSelector, OpIndex: index}
+
+	return t.journal.run(ctx, key,
+		func(ctx context.Context) (bool, error) {
+			return t.isOperationDone(ctx, index)
+		},
+		func(ctx context.Context) (types.TransactionResult, error) {
+			return t.execute(ctx, index, execOpts)
+		},
+	)
+}
+
+func (t *TimelockExecutable) execute(ctx context.Context, index int, execOpts *executeOptions) (types.TransactionResult, error) {
 	op := t.proposal.Operations[index]
 
 	// Get target contract
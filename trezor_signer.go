@@ -0,0 +1,162 @@
+package mcms
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/mcms/sdk/usbwallet"
+)
+
+// TrezorSignerOption configures a TrezorSigner.
+type TrezorSignerOption func(*TrezorSigner)
+
+// WithTrezorEmulator connects to a Trezor emulator listening on the UDP address addr instead of a
+// USB device, see usbwallet.DefaultTrezorEmulatorAddr.
+func WithTrezorEmulator(addr string) TrezorSignerOption {
+	return func(s *TrezorSigner) {
+		s.emulatorAddr = addr
+	}
+}
+
+// WithTrezorPIN sets the source of the PIN of devices without a touchscreen. The PIN is entered
+// as the positions of its digits on the scrambled pinpad shown by the device, with 1 being the
+// bottom left and 9 the top right position.
+func WithTrezorPIN(pin PasswordSource) TrezorSignerOption {
+	return func(s *TrezorSigner) {
+		s.pin = pin
+	}
+}
+
+// WithTrezorPassphrase sets the source of the passphrase of the hidden wallet to sign with. The
+// standard wallet is used when the device asks for a passphrase and none is set.
+func WithTrezorPassphrase(passphrase PasswordSource) TrezorSignerOption {
+	return func(s *TrezorSigner) {
+		s.passphrase = passphrase
+	}
+}
+
+var _ Signer = &TrezorSigner{}
+
+// TrezorSigner signs payloads using a Trezor.
+type TrezorSigner struct {
+	derivationPath []uint32
+	emulatorAddr   string
+	pin            PasswordSource
+	passphrase     PasswordSource
+}
+
+// NewTrezorSigner creates a new TrezorSigner.
+func NewTrezorSigner(derivationPath []uint32, opts ...TrezorSignerOption) *TrezorSigner {
+	s := &TrezorSigner{derivationPath: derivationPath}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Sign signs the payload using the first wallet found on a Trezor.
+// The payload here should be without the EIP 191 prefix,
+// and the trezor will add it before signing.
+func (s *TrezorSigner) Sign(payload []byte) ([]byte, error) {
+	wallet, account, err := s.setupTrezorAccount()
+	if err != nil {
+		return nil, err
+	}
+	defer wallet.Close()
+
+	// Sign the payload with EIP 191
+	return wallet.SignText(account, payload)
+}
+
+// GetAddress returns the address of the account at the derivation path.
+func (s *TrezorSigner) GetAddress() (common.Address, error) {
+	wallet, account, err := s.setupTrezorAccount()
+	if err != nil {
+		return common.Address{}, err
+	}
+	defer wallet.Close()
+
+	return account.Address, nil
+}
+
+// setupTrezorAccount loads the wallet and account from the trezor. Caller is responsible for closing the wallet.
+func (s *TrezorSigner) setupTrezorAccount() (accounts.Wallet, accounts.Account, error) {
+	wallets, err := s.trezorWallets()
+	if err != nil {
+		return nil, accounts.Account{}, err
+	}
+	if len(wallets) == 0 {
+		return nil, accounts.Account{}, errors.New("no wallets found")
+	}
+	wallet := wallets[0]
+
+	// Open the trezor, unlocking it if it asks for a PIN or a passphrase
+	if err = s.openWallet(wallet); err != nil {
+		wallet.Close()
+		return nil, accounts.Account{}, fmt.Errorf("failed to open wallet: %w", err)
+	}
+
+	// Load account
+	account, err := wallet.Derive(s.derivationPath, true)
+	if err != nil {
+		wallet.Close() // Only close on error since caller won't be able to
+		return nil, accounts.Account{}, fmt.Errorf("failed to derive account: %w derivation path %v", err, s.derivationPath)
+	}
+
+	return wallet, account, nil
+}
+
+// trezorWallets returns the wallets of the emulator if one is set, or of the Trezor devices
+// connected over WebUSB, followed by the older ones connected over HID.
+func (s *TrezorSigner) trezorWallets() ([]accounts.Wallet, error) {
+	if s.emulatorAddr != "" {
+		hub, err := usbwallet.NewTrezorEmulatorHub(s.emulatorAddr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open trezor emulator hub: %w", err)
+		}
+
+		return hub.Wallets(), nil
+	}
+
+	webUSBHub, err := usbwallet.NewTrezorHubWithWebUSB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trezor hub: %w", err)
+	}
+	hidHub, err := usbwallet.NewTrezorHubWithHID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trezor hub: %w", err)
+	}
+
+	return append(webUSBHub.Wallets(), hidHub.Wallets()...), nil
+}
+
+// openWallet opens the wallet, going through the PIN and passphrase phases when requested.
+func (s *TrezorSigner) openWallet(wallet accounts.Wallet) error {
+	err := wallet.Open("")
+	if errors.Is(err, usbwallet.ErrTrezorPINNeeded) {
+		if s.pin == nil {
+			return errors.New("trezor is locked: unlock it or provide a PIN")
+		}
+		pin, perr := s.pin()
+		if perr != nil {
+			return perr
+		}
+		err = wallet.Open(pin)
+	}
+	if errors.Is(err, usbwallet.ErrTrezorPassphraseNeeded) {
+		var passphrase string
+		if s.passphrase != nil {
+			passphrase, err = s.passphrase()
+			if err != nil {
+				return err
+			}
+		}
+		err = wallet.Open(passphrase)
+	}
+
+	return err
+}
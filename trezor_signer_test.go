@@ -0,0 +1,95 @@
+package mcms
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/trezoremu"
+)
+
+func TestTrezorSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	payload := []byte("0x000000000000000000000000000000")
+	want, err := NewPrivateKeySigner(privKey).Sign(payload)
+	require.NoError(t, err)
+	want[crypto.RecoveryIDOffset] += 27 // The Trezor returns V as 27/28
+
+	pin := func() (string, error) { return "1234", nil }
+
+	tests := []struct {
+		name    string
+		opts    []trezoremu.Option
+		give    []TrezorSignerOption
+		wantErr string
+	}{
+		{
+			name: "success: unlocked device",
+		},
+		{
+			name: "success: unlocked with PIN",
+			opts: []trezoremu.Option{trezoremu.WithPIN("1234")},
+			give: []TrezorSignerOption{WithTrezorPIN(pin)},
+		},
+		{
+			name:    "failure: missing PIN",
+			opts:    []trezoremu.Option{trezoremu.WithPIN("1234")},
+			wantErr: "failed to open wallet: trezor is locked: unlock it or provide a PIN",
+		},
+		{
+			name:    "failure: wrong PIN",
+			opts:    []trezoremu.Option{trezoremu.WithPIN("4321")},
+			give:    []TrezorSignerOption{WithTrezorPIN(pin)},
+			wantErr: "failed to open wallet: trezor: PIN invalid",
+		},
+		{
+			name: "failure: PIN source error",
+			opts: []trezoremu.Option{trezoremu.WithPIN("1234")},
+			give: []TrezorSignerOption{WithTrezorPIN(func() (string, error) {
+				return "", errors.New("no terminal")
+			})},
+			wantErr: "failed to open wallet: no terminal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			emulator := trezoremu.New(t, privKey, tt.opts...)
+			opts := append([]TrezorSignerOption{WithTrezorEmulator(emulator.Addr())}, tt.give...)
+			signer := NewTrezorSigner(accounts.DefaultBaseDerivationPath, opts...)
+
+			got, err := signer.Sign(payload)
+
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, want, got)
+
+				paths, _ := emulator.SignRequests()
+				require.Equal(t, []accounts.DerivationPath{accounts.DefaultBaseDerivationPath}, paths)
+			}
+		})
+	}
+}
+
+func TestTrezorSigner_GetAddress(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	emulator := trezoremu.New(t, privKey)
+
+	signer := NewTrezorSigner(accounts.DefaultBaseDerivationPath, WithTrezorEmulator(emulator.Addr()))
+	addr, err := signer.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey), addr)
+}
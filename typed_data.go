@@ -0,0 +1,126 @@
+package mcms
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/smartcontractkit/mcms/internal/utils/safecast"
+)
+
+const (
+	// TypedDataDomainName is the name of the EIP 712 domain of the proposal typed data.
+	TypedDataDomainName = "ManyChainMultiSig"
+	// TypedDataDomainVersion is the version of the EIP 712 domain of the proposal typed data.
+	TypedDataDomainVersion = "1"
+	// TypedDataPrimaryType is the EIP 712 struct type of the proposal typed data.
+	TypedDataPrimaryType = "MCMSProposal"
+)
+
+// TypedDataOption configures the EIP 712 typed data of a proposal.
+type TypedDataOption func(*typedDataOptions)
+
+type typedDataOptions struct {
+	summary bool
+}
+
+// WithTypedDataSummary adds human readable summary fields to the typed data: the description of
+// the proposal, its number of chains and operations, and whether it overrides the previous root.
+// The fields are derived from the proposal, so that verifiers can rebuild the same typed data.
+func WithTypedDataSummary() TypedDataOption {
+	return func(o *typedDataOptions) {
+		o.summary = true
+	}
+}
+
+// SigningTypedData returns the signing payload of the proposal, its merkle root and valid until
+// timestamp, as EIP 712 typed data. Signing devices supporting EIP 712 display the fields of the
+// typed data, so that signers can review them. The typed data also holds the SigningMessage, the
+// keccak hash of the root and valid until timestamp, which the device displays again when it is
+// signed: matching it binds the reviewed fields to the signature appended to the proposal.
+//
+// The typed data is for review only. It is hashed with its domain following EIP 712, which differs
+// from the EIP 191 hash of the SigningMessage: a signature of the typed data recovers its signer
+// against SigningTypedDataHash, and is not accepted by the MCMS contracts, which only verify
+// signatures against SigningHash. Signable.SignTypedData returns a signature of the SigningMessage
+// once the typed data has been reviewed.
+func (p *Proposal) SigningTypedData(opts ...TypedDataOption) (apitypes.TypedData, error) {
+	options := typedDataOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	tree, err := p.MerkleTree()
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	signingMessage, err := p.SigningMessage()
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	fields := []apitypes.Type{
+		{Name: "root", Type: "bytes32"},
+		{Name: "validUntil", Type: "uint32"},
+		{Name: "signingMessage", Type: "bytes32"},
+	}
+	message := apitypes.TypedDataMessage{
+		"root":           tree.Root.Hex(),
+		"validUntil":     math.NewHexOrDecimal256(int64(p.ValidUntil)),
+		"signingMessage": signingMessage.Hex(),
+	}
+
+	if options.summary {
+		chains, err := safecast.IntToUint32(len(p.ChainMetadata))
+		if err != nil {
+			return apitypes.TypedData{}, err
+		}
+		operations, err := safecast.IntToUint32(len(p.Operations))
+		if err != nil {
+			return apitypes.TypedData{}, err
+		}
+
+		fields = append(fields,
+			apitypes.Type{Name: "description", Type: "string"},
+			apitypes.Type{Name: "chains", Type: "uint32"},
+			apitypes.Type{Name: "operations", Type: "uint32"},
+			apitypes.Type{Name: "overridePreviousRoot", Type: "bool"},
+		)
+		message["description"] = p.Description
+		message["chains"] = math.NewHexOrDecimal256(int64(chains))
+		message["operations"] = math.NewHexOrDecimal256(int64(operations))
+		message["overridePreviousRoot"] = p.OverridePreviousRoot
+	}
+
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+			},
+			TypedDataPrimaryType: fields,
+		},
+		PrimaryType: TypedDataPrimaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:    TypedDataDomainName,
+			Version: TypedDataDomainVersion,
+		},
+		Message: message,
+	}, nil
+}
+
+// SigningTypedDataHash returns the EIP 712 hash of the typed data of the proposal, against which
+// signatures of the typed data are recovered with types.Signature.Recover.
+func (p *Proposal) SigningTypedDataHash(opts ...TypedDataOption) (common.Hash, error) {
+	typedData, err := p.SigningTypedData(opts...)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.BytesToHash(hash), nil
+}
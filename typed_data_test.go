@@ -0,0 +1,167 @@
+package mcms
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/mcms/internal/testutils/chaintest"
+)
+
+func TestProposal_SigningTypedData(t *testing.T) {
+	t.Parallel()
+
+	proposal := orchestratorTestProposal(chaintest.Chain1Selector, chaintest.Chain2Selector)
+	proposal.Description = "upgrade the timelock"
+	tree, err := proposal.MerkleTree()
+	require.NoError(t, err)
+	signingMessage, err := proposal.SigningMessage()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		opts        []TypedDataOption
+		wantFields  []string
+		wantMessage apitypes.TypedDataMessage
+	}{
+		{
+			name:       "success: root, valid until and signing message",
+			wantFields: []string{"root", "validUntil", "signingMessage"},
+			wantMessage: apitypes.TypedDataMessage{
+				"root":           tree.Root.Hex(),
+				"validUntil":     math.NewHexOrDecimal256(2004259681),
+				"signingMessage": signingMessage.Hex(),
+			},
+		},
+		{
+			name:       "success: with summary",
+			opts:       []TypedDataOption{WithTypedDataSummary()},
+			wantFields: []string{"root", "validUntil", "signingMessage", "description", "chains", "operations", "overridePreviousRoot"},
+			wantMessage: apitypes.TypedDataMessage{
+				"root":                 tree.Root.Hex(),
+				"validUntil":           math.NewHexOrDecimal256(2004259681),
+				"signingMessage":       signingMessage.Hex(),
+				"description":          "upgrade the timelock",
+				"chains":               math.NewHexOrDecimal256(2),
+				"operations":           math.NewHexOrDecimal256(4),
+				"overridePreviousRoot": false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			typedData, err := proposal.SigningTypedData(tt.opts...)
+			require.NoError(t, err)
+
+			require.Equal(t, TypedDataPrimaryType, typedData.PrimaryType)
+			require.Equal(t, apitypes.TypedDataDomain{Name: TypedDataDomainName, Version: TypedDataDomainVersion}, typedData.Domain)
+			fields := make([]string, 0, len(typedData.Types[TypedDataPrimaryType]))
+			for _, field := range typedData.Types[TypedDataPrimaryType] {
+				fields = append(fields, field.Name)
+			}
+			require.Equal(t, tt.wantFields, fields)
+			require.Equal(t, tt.wantMessage, typedData.Message)
+
+			hash, err := proposal.SigningTypedDataHash(tt.opts...)
+			require.NoError(t, err)
+			want, _, err := apitypes.TypedDataAndHash(typedData)
+			require.NoError(t, err)
+			require.Equal(t, want, hash.Bytes())
+		})
+	}
+}
+
+// typedDataTestSigner signs the signing message and the typed data with separate keys, and records
+// the typed data it reviewed.
+type typedDataTestSigner struct {
+	*PrivateKeySigner
+
+	typedDataSigner *PrivateKeySigner
+	typedDataErr    error
+	reviewed        []apitypes.TypedData
+}
+
+func (s *typedDataTestSigner) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	s.reviewed = append(s.reviewed, typedData)
+	if s.typedDataErr != nil {
+		return nil, s.typedDataErr
+	}
+
+	return s.typedDataSigner.SignTypedData(typedData)
+}
+
+func TestSignable_SignTypedData(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := crypto.HexToECDSA(testPrivateKeyHex)
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	tests := []struct {
+		name    string
+		signer  *typedDataTestSigner
+		wantErr string
+	}{
+		{
+			name:   "success: signs the signing hash after the review",
+			signer: &typedDataTestSigner{PrivateKeySigner: NewPrivateKeySigner(privKey), typedDataSigner: NewPrivateKeySigner(privKey)},
+		},
+		{
+			name: "failure: typed data signing error",
+			signer: &typedDataTestSigner{
+				PrivateKeySigner: NewPrivateKeySigner(privKey), typedDataErr: errors.New("rejected on device"),
+			},
+			wantErr: "rejected on device",
+		},
+		{
+			name:    "failure: typed data reviewed by another signer",
+			signer:  &typedDataTestSigner{PrivateKeySigner: NewPrivateKeySigner(privKey), typedDataSigner: NewPrivateKeySigner(otherKey)},
+			wantErr: "typed data reviewed by " + crypto.PubkeyToAddress(otherKey.PublicKey).Hex() + " but proposal signed by " + address.Hex(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			proposal := orchestratorTestProposal(chaintest.Chain1Selector)
+			signable, err := NewSignable(proposal, nil)
+			require.NoError(t, err)
+
+			sig, err := signable.SignTypedData(tt.signer, WithTypedDataSummary())
+
+			// The typed data is reviewed with the summary fields
+			typedData, terr := proposal.SigningTypedData(WithTypedDataSummary())
+			require.NoError(t, terr)
+			require.Equal(t, []apitypes.TypedData{typedData}, tt.signer.reviewed)
+
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+
+			// The signature is verified against the EIP 191 signing hash, as the contracts do
+			signingHash, err := proposal.SigningHash()
+			require.NoError(t, err)
+			recovered, err := sig.Recover(signingHash)
+			require.NoError(t, err)
+			require.Equal(t, address, recovered)
+
+			want, err := signable.Sign(tt.signer)
+			require.NoError(t, err)
+			require.Equal(t, want, sig)
+			require.Empty(t, proposal.Signatures)
+		})
+	}
+}
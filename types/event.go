@@ -0,0 +1,70 @@
+package types //nolint:revive,nolintlint // allow pkg name 'types'
+
+import "github.com/ethereum/go-ethereum/common"
+
+// EventKind is the kind of an event emitted by the MCMS or timelock contracts.
+type EventKind string
+
+const (
+	// EventKindNewRoot is emitted by the MCM contract when a new root is set.
+	EventKindNewRoot EventKind = "NewRoot"
+	// EventKindOpExecuted is emitted by the MCM contract when an operation is executed.
+	EventKindOpExecuted EventKind = "OpExecuted"
+	// EventKindConfigSet is emitted by the MCM contract when a new config is set.
+	EventKindConfigSet EventKind = "ConfigSet"
+	// EventKindCallScheduled is emitted by the timelock contract for every call of a scheduled
+	// operation.
+	EventKindCallScheduled EventKind = "CallScheduled"
+	// EventKindCallExecuted is emitted by the timelock contract for every call of an executed
+	// operation.
+	EventKindCallExecuted EventKind = "CallExecuted"
+	// EventKindCancelled is emitted by the timelock contract when an operation is cancelled.
+	EventKindCancelled EventKind = "Cancelled"
+	// EventKindRoleGranted is emitted by the timelock contract when a role is granted.
+	EventKindRoleGranted EventKind = "RoleGranted"
+)
+
+// Event is an event emitted by the MCMS or timelock contracts, in a family agnostic form.
+// Only the fields of the event kind are set, the decoded event of the chain family is available
+// in RawData.
+type Event struct {
+	Kind          EventKind     `json:"kind"`
+	ChainSelector ChainSelector `json:"chainSelector"`
+	// Address is the address of the contract that emitted the event, as given in the EventQuery.
+	Address string `json:"address"`
+	// Block is the position of the event on the chain: the block number on EVM, the slot on
+	// Solana, the block height on Aptos, the checkpoint on Sui, the logical time on TON and the
+	// ledger offset on Canton.
+	Block  uint64 `json:"block"`
+	TxHash string `json:"txHash"`
+	// Index is the index of the event in its block on EVM, and in its transaction otherwise.
+	Index uint `json:"index"`
+
+	// Root and ValidUntil are set for NewRoot events.
+	Root       common.Hash `json:"root,omitzero"`
+	ValidUntil uint32      `json:"validUntil,omitempty"`
+	// Nonce is set for OpExecuted events.
+	Nonce uint64 `json:"nonce,omitempty"`
+	// IsRootCleared is set for ConfigSet events.
+	IsRootCleared bool `json:"isRootCleared,omitempty"`
+	// OperationID is set for CallScheduled, CallExecuted and Cancelled events, CallIndex for
+	// CallScheduled and CallExecuted events.
+	OperationID common.Hash `json:"operationId,omitzero"`
+	CallIndex   uint64      `json:"callIndex,omitempty"`
+	// Role and Account are set for RoleGranted events.
+	Role    common.Hash `json:"role,omitzero"`
+	Account string      `json:"account,omitempty"`
+
+	RawData any `json:"-"`
+}
+
+// EventQuery selects the events of an MCM and a timelock contract in a range of blocks. Either
+// address may be left empty to skip the events of that contract.
+type EventQuery struct {
+	MCMAddress      string `json:"mcmAddress,omitempty"`
+	TimelockAddress string `json:"timelockAddress,omitempty"`
+	// FromBlock and ToBlock are the inclusive range of blocks to query, in the units of
+	// Event.Block.
+	FromBlock uint64 `json:"fromBlock"`
+	ToBlock   uint64 `json:"toBlock"`
+}
@@ -1,9 +1,15 @@
 package mcms
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/asn1"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -21,12 +27,94 @@ func toEthSignedMessageHash(payload []byte) common.Hash {
 	return crypto.Keccak256Hash(data)
 }
 
-func generateQueuedProposalStartingOpCounts[T ProposalInterface](predecessorProposals []T) map[types.ChainSelector]uint64 {
-	// Set the transaction counts for each chain selector
+var (
+	// oidPublicKeyECDSA and oidNamedCurveSecp256k1 identify secp256k1 keys in a
+	// SubjectPublicKeyInfo.
+	oidPublicKeyECDSA      = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidNamedCurveSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+
+	secp256k1HalfN = new(big.Int).Rsh(crypto.S256().Params().N, 1)
+)
+
+// recoverableSignature converts the R and S values of the ECDSA signature of hash by the key of
+// address into the 65 byte [R || S || V] form of crypto.Sign. S is normalized to the lower half
+// of the curve order as Ethereum requires, and V is found by recovering the address.
+func recoverableSignature(hash common.Hash, r, s *big.Int, address common.Address) ([]byte, error) {
+	if r.Sign() <= 0 || s.Sign() <= 0 || r.BitLen() > 256 || s.BitLen() > 256 {
+		return nil, errors.New("invalid signature values")
+	}
+	if s.Cmp(secp256k1HalfN) > 0 {
+		s = new(big.Int).Sub(crypto.S256().Params().N, s)
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	for v := range byte(2) {
+		sig[64] = v
+		pubKey, err := crypto.SigToPub(hash.Bytes(), sig)
+		if err == nil && crypto.PubkeyToAddress(*pubKey) == address {
+			return sig, nil
+		}
+	}
+
+	return nil, fmt.Errorf("signature does not recover to %s", address)
+}
+
+// parseDERSignature parses an ASN.1 DER encoded ECDSA signature into its R and S values.
+func parseDERSignature(der []byte) (r, s *big.Int, err error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(der, &sig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse DER signature: %w", err)
+	}
+	if len(rest) != 0 {
+		return nil, nil, errors.New("failed to parse DER signature: trailing data")
+	}
+
+	return sig.R, sig.S, nil
+}
+
+// parseSecp256k1PublicKeyInfo parses a DER or PEM encoded SubjectPublicKeyInfo of a secp256k1
+// key, which x509.ParsePKIXPublicKey does not support.
+func parseSecp256k1PublicKeyInfo(b []byte) (*ecdsa.PublicKey, error) {
+	if block, _ := pem.Decode(b); block != nil {
+		b = block.Bytes
+	}
+
+	var info struct {
+		Algorithm struct {
+			Algorithm  asn1.ObjectIdentifier
+			Parameters asn1.ObjectIdentifier
+		}
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(b, &info); err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+	if !info.Algorithm.Algorithm.Equal(oidPublicKeyECDSA) || !info.Algorithm.Parameters.Equal(oidNamedCurveSecp256k1) {
+		return nil, fmt.Errorf("unsupported public key algorithm %s with parameters %s",
+			info.Algorithm.Algorithm, info.Algorithm.Parameters)
+	}
+
+	return crypto.UnmarshalPubkey(info.PublicKey.Bytes)
+}
+
+func generateQueuedProposalStartingOpCounts[T ProposalInterface](
+	ctx context.Context, predecessorProposals []T,
+) (map[types.ChainSelector]uint64, error) {
+	// Set the operation counts for each chain selector
 	startingOpCounts := make(map[types.ChainSelector]uint64)
-	for _, pred := range predecessorProposals {
+	for i, pred := range predecessorProposals {
+		counts, err := proposalOpCounts(ctx, pred)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count operations of predecessor proposal %d: %w", i, err)
+		}
+
 		chainMetadata := pred.ChainMetadatas()
-		for chainSelector, count := range pred.TransactionCounts() {
+		for chainSelector, count := range counts {
 			if _, ok := startingOpCounts[chainSelector]; !ok {
 				startingOpCounts[chainSelector] = chainMetadata[chainSelector].StartingOpCount
 			}
@@ -35,7 +123,7 @@ func generateQueuedProposalStartingOpCounts[T ProposalInterface](predecessorProp
 		}
 	}
 
-	return startingOpCounts
+	return startingOpCounts, nil
 }
 
 func decodeAndValidateProposal[T ProposalInterface](reader io.Reader) (T, error) {
@@ -71,7 +159,10 @@ func newProposal[T ProposalInterface](r io.Reader, predecessors []io.Reader) (T,
 		predecessorProposals[i] = predObj
 	}
 
-	startingOpCounts := generateQueuedProposalStartingOpCounts(predecessorProposals)
+	startingOpCounts, err := generateQueuedProposalStartingOpCounts(context.Background(), predecessorProposals)
+	if err != nil {
+		return p, err
+	}
 
 	// Set the starting op count for each chain selector in the new proposal
 	for chainSelector, chainMetadata := range p.ChainMetadatas() {
@@ -108,6 +108,12 @@ func TestValidateChainMetadata(t *testing.T) {
 			additionalFields: types.ChainMetadata{AdditionalFields: nil},
 			expectedErr:      errors.New("family not found for selector 999"),
 		},
+		{
+			name:             "unregistered chain family",
+			chainSelector:    chaintest.Chain8Selector,
+			additionalFields: types.ChainMetadata{AdditionalFields: nil},
+			expectedErr:      errors.New("unsupported chain family: starknet"),
+		},
 		{
 			name:             "invalid JSON for Solana metadata",
 			chainSelector:    types.ChainSelector(chainsel.SOLANA_DEVNET.Selector),
@@ -315,6 +321,16 @@ func TestValidateAdditionalFields(t *testing.T) {
 			},
 			expectedErr: errors.New("family not found for selector 999"),
 		},
+		{
+			name: "unregistered chain family",
+			operation: types.Operation{
+				ChainSelector: chaintest.Chain8Selector,
+				Transaction: types.Transaction{
+					AdditionalFields: nil,
+				},
+			},
+			expectedErr: errors.New("unsupported chain family: starknet"),
+		},
 		{
 			name: "invalid JSON for EVM fields",
 			operation: types.Operation{